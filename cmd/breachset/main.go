@@ -0,0 +1,54 @@
+// Command breachset converts a breached password dataset in the HIBP text
+// format (one SHA-1 per line, optionally followed by ":count") into the
+// compact binary file loaded through PASSWORD_BREACH_DATASET_PATH.
+//
+//	go run ./cmd/breachset -in pwned-passwords-sha1.txt -out breached.bin
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"iam-service/pkg/password"
+)
+
+func main() {
+	in := flag.String("in", "", "path to the HIBP SHA-1 text file")
+	out := flag.String("out", "", "path of the binary file to write (must end in .bin)")
+	flag.Parse()
+
+	if *in == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if !strings.HasSuffix(*out, ".bin") {
+		log.Fatalf("output path %q must end in .bin to be loaded as a binary dataset", *out)
+	}
+
+	source, err := os.Open(*in)
+	if err != nil {
+		log.Fatalf("failed to open input: %v", err)
+	}
+	defer source.Close()
+
+	set, err := password.ReadSHA1Prefixes(source)
+	if err != nil {
+		log.Fatalf("failed to read input: %v", err)
+	}
+
+	target, err := os.Create(*out)
+	if err != nil {
+		log.Fatalf("failed to create output: %v", err)
+	}
+	if err := set.WriteBinary(target); err != nil {
+		target.Close()
+		log.Fatalf("failed to write output: %v", err)
+	}
+	if err := target.Close(); err != nil {
+		log.Fatalf("failed to close output: %v", err)
+	}
+
+	log.Printf("wrote %d prefixes to %s", set.Len(), *out)
+}
@@ -1,6 +1,10 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	jwtpkg "iam-service/pkg/jwt"
+)
 
 type AppConfig struct {
 	Name        string `mapstructure:"name"`
@@ -17,6 +21,11 @@ type ServerConfig struct {
 	CORSOrigins  string        `mapstructure:"cors_origins"`
 }
 
+const (
+	JWTKeyBackendFile         = "file"
+	JWTKeyBackendVaultTransit = "vault_transit"
+)
+
 type JWTConfig struct {
 	AccessSecret       string `mapstructure:"access_secret"`
 	RefreshSecret      string `mapstructure:"refresh_secret"`
@@ -26,12 +35,38 @@ type JWTConfig struct {
 	PublicKeyPath  string `mapstructure:"public_key_path"`
 	SigningMethod  string `mapstructure:"signing_method"`
 
+	KeyBackend          string        `mapstructure:"key_backend"`
+	TransitKeyName      string        `mapstructure:"transit_key_name"`
+	KeysDir             string        `mapstructure:"keys_dir"`
+	KeyRotationInterval time.Duration `mapstructure:"key_rotation_interval"`
+	KeyRefreshInterval  time.Duration `mapstructure:"key_refresh_interval"`
+
+	// KeyRing is built at startup from the settings above and shared by the
+	// token issuer, the JWT middleware and the JWKS endpoint.
+	KeyRing *jwtpkg.KeyRing `mapstructure:"-"`
+
 	AccessExpiry       time.Duration `mapstructure:"access_expiry"`
 	RefreshExpiry      time.Duration `mapstructure:"refresh_expiry"`
 	Issuer             string        `mapstructure:"issuer"`
 	Audience           []string      `mapstructure:"audience"`
 	PINTokenExpiry     time.Duration `mapstructure:"pin_token_expiry"`
 	RegistrationExpiry time.Duration `mapstructure:"registration_expiry"`
+
+	// ExchangeTokenExpiry caps tokens issued by the token exchange endpoint;
+	// they never outlive the subject token they were exchanged for.
+	ExchangeTokenExpiry time.Duration `mapstructure:"exchange_token_expiry"`
+
+	// ImpersonationExpiry bounds admin impersonation sessions. They cannot be
+	// refreshed; the admin has to start a new session once it runs out.
+	ImpersonationExpiry time.Duration `mapstructure:"impersonation_expiry"`
+
+	// PasswordChangeTokenExpiry bounds the restricted token issued at login
+	// when the user has to change their password before getting full access.
+	PasswordChangeTokenExpiry time.Duration `mapstructure:"password_change_token_expiry"`
+
+	// ConsentTokenExpiry bounds the restricted token issued at login when the
+	// user has to accept a newly published terms or privacy version.
+	ConsentTokenExpiry time.Duration `mapstructure:"consent_token_expiry"`
 }
 
 type LogConfig struct {
@@ -73,6 +108,8 @@ type PasswordConfig struct {
 	RequireNumber    bool `mapstructure:"require_number"`
 	RequireSpecial   bool `mapstructure:"require_special"`
 	HistoryCount     int  `mapstructure:"history_count"`
+
+	BreachDatasetPath string `mapstructure:"breach_dataset_path"`
 }
 
 type MasterdataConfig struct {
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	jwtpkg "iam-service/pkg/jwt"
+
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
 )
@@ -103,6 +105,15 @@ func bindEnvVariables() {
 	_ = viper.BindEnv("jwt.pin_token_expiry", "JWT_PIN_TOKEN_EXPIRY")
 	_ = viper.BindEnv("jwt.registration_expiry", "JWT_REGISTRATION_EXPIRY")
 	_ = viper.BindEnv("jwt.registration_secret", "JWT_REGISTRATION_SECRET")
+	_ = viper.BindEnv("jwt.key_backend", "JWT_KEY_BACKEND")
+	_ = viper.BindEnv("jwt.transit_key_name", "JWT_TRANSIT_KEY_NAME")
+	_ = viper.BindEnv("jwt.keys_dir", "JWT_KEYS_DIR")
+	_ = viper.BindEnv("jwt.key_rotation_interval", "JWT_KEY_ROTATION_INTERVAL")
+	_ = viper.BindEnv("jwt.key_refresh_interval", "JWT_KEY_REFRESH_INTERVAL")
+	_ = viper.BindEnv("jwt.exchange_token_expiry", "JWT_EXCHANGE_TOKEN_EXPIRY")
+	_ = viper.BindEnv("jwt.impersonation_expiry", "JWT_IMPERSONATION_EXPIRY")
+	_ = viper.BindEnv("jwt.password_change_token_expiry", "JWT_PASSWORD_CHANGE_TOKEN_EXPIRY")
+	_ = viper.BindEnv("jwt.consent_token_expiry", "JWT_CONSENT_TOKEN_EXPIRY")
 
 	_ = viper.BindEnv("log.level", "LOG_LEVEL")
 	_ = viper.BindEnv("log.format", "LOG_FORMAT")
@@ -121,6 +132,8 @@ func bindEnvVariables() {
 	_ = viper.BindEnv("email.from_address", "EMAIL_FROM_ADDRESS")
 	_ = viper.BindEnv("email.from_name", "EMAIL_FROM_NAME")
 
+	_ = viper.BindEnv("password.breach_dataset_path", "PASSWORD_BREACH_DATASET_PATH")
+
 	_ = viper.BindEnv("masterdata.cache_ttl_categories", "MASTERDATA_CACHE_TTL_CATEGORIES")
 	_ = viper.BindEnv("masterdata.cache_ttl_items", "MASTERDATA_CACHE_TTL_ITEMS")
 	_ = viper.BindEnv("masterdata.cache_ttl_tree", "MASTERDATA_CACHE_TTL_TREE")
@@ -179,6 +192,14 @@ func setDefaults() {
 	viper.SetDefault("jwt.audience", []string{"backoffice", "main-app"})
 	viper.SetDefault("jwt.pin_token_expiry", 10*time.Minute)
 	viper.SetDefault("jwt.registration_expiry", 10*time.Minute)
+	viper.SetDefault("jwt.key_backend", JWTKeyBackendFile)
+	viper.SetDefault("jwt.transit_key_name", "iam-jwt")
+	viper.SetDefault("jwt.key_rotation_interval", 0)
+	viper.SetDefault("jwt.key_refresh_interval", 1*time.Minute)
+	viper.SetDefault("jwt.exchange_token_expiry", 5*time.Minute)
+	viper.SetDefault("jwt.impersonation_expiry", 15*time.Minute)
+	viper.SetDefault("jwt.password_change_token_expiry", 10*time.Minute)
+	viper.SetDefault("jwt.consent_token_expiry", 10*time.Minute)
 
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.format", "json")
@@ -211,12 +232,12 @@ func setDefaults() {
 }
 
 func (c *Config) Validate() error {
-	if c.JWT.SigningMethod == "RS256" {
+	if jwtpkg.IsAsymmetric(c.JWT.SigningMethod) {
 		if c.JWT.PrivateKeyPath == "" {
-			return fmt.Errorf("JWT_PRIVATE_KEY_PATH is required for RS256 signing")
+			return fmt.Errorf("JWT_PRIVATE_KEY_PATH is required for %s signing", c.JWT.SigningMethod)
 		}
 		if c.JWT.PublicKeyPath == "" {
-			return fmt.Errorf("JWT_PUBLIC_KEY_PATH is required for RS256 signing")
+			return fmt.Errorf("JWT_PUBLIC_KEY_PATH is required for %s signing", c.JWT.SigningMethod)
 		}
 	} else if c.JWT.SigningMethod == "HS256" {
 		if c.JWT.AccessSecret == "" {
@@ -226,7 +247,20 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("JWT_REFRESH_SECRET is required for HS256 signing")
 		}
 	} else {
-		return fmt.Errorf("JWT_SIGNING_METHOD must be either 'HS256' or 'RS256'")
+		return fmt.Errorf("JWT_SIGNING_METHOD must be one of 'HS256', 'RS256', 'ES256' or 'EdDSA'")
+	}
+
+	switch c.JWT.KeyBackend {
+	case "", JWTKeyBackendFile:
+	case JWTKeyBackendVaultTransit:
+		if !jwtpkg.IsAsymmetric(c.JWT.SigningMethod) {
+			return fmt.Errorf("JWT_KEY_BACKEND 'vault_transit' requires an asymmetric signing method")
+		}
+		if c.JWT.TransitKeyName == "" {
+			return fmt.Errorf("JWT_TRANSIT_KEY_NAME is required for the vault_transit key backend")
+		}
+	default:
+		return fmt.Errorf("JWT_KEY_BACKEND must be either 'file' or 'vault_transit'")
 	}
 
 	if c.Infra.Postgres.Platform.User == "" {
@@ -0,0 +1,133 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/apikey"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func convertAPIKeyValidationErrors(errs validator.ValidationErrors) []errors.FieldError {
+	result := make([]errors.FieldError, len(errs))
+	for i, err := range errs {
+		field := err.Field()
+		var message string
+		switch err.Tag() {
+		case "required":
+			message = field + " is required"
+		case "min":
+			message = field + " must be at least " + err.Param() + " characters"
+		case "max":
+			message = field + " must be at most " + err.Param() + " characters"
+		default:
+			message = field + " is invalid"
+		}
+		result[i] = errors.FieldError{Field: field, Message: message}
+	}
+	return result
+}
+
+type APIKeyController struct {
+	config        *config.Config
+	apiKeyUsecase apikey.Usecase
+	validate      *validator.Validate
+}
+
+func NewAPIKeyController(cfg *config.Config, apiKeyUsecase apikey.Usecase) *APIKeyController {
+	return &APIKeyController{
+		config:        cfg,
+		apiKeyUsecase: apiKeyUsecase,
+		validate:      validate,
+	}
+}
+
+func (ac *APIKeyController) Create(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req apikeydto.CreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := ac.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertAPIKeyValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := ac.apiKeyUsecase.Create(c.Context(), tenantID, userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"API key created successfully",
+		presenter.ToCreateAPIKeyResponse(resp),
+	))
+}
+
+func (ac *APIKeyController) List(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ac.apiKeyUsecase.List(c.Context(), tenantID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"API keys retrieved successfully",
+		presenter.ToAPIKeyListResponse(resp),
+	))
+}
+
+func (ac *APIKeyController) Revoke(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid API key ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req apikeydto.RevokeRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.ErrBadRequest("Invalid request body")
+		}
+	}
+
+	if err := ac.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertAPIKeyValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	if err := ac.apiKeyUsecase.Revoke(c.Context(), tenantID, id, userID, &req); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"API key revoked successfully",
+		nil,
+	))
+}
@@ -271,6 +271,8 @@ func (rc *AuthController) CompleteProfileRegistration(c *fiber.Ctx) error {
 
 	req.RegistrationID = registrationID
 	req.RegistrationToken = registrationToken
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
 
 	resp, err := rc.authUsecase.CompleteProfileRegistration(c.Context(), &req)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"iam-service/config"
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -40,6 +41,27 @@ func (m *MockAuthUsecase) RefreshToken(ctx context.Context, req *authdto.Refresh
 	return args.Get(0).(*authdto.RefreshTokenResponse), args.Error(1)
 }
 
+func (m *MockAuthUsecase) ExchangeToken(ctx context.Context, req *authdto.TokenExchangeRequest) (*authdto.TokenExchangeResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authdto.TokenExchangeResponse), args.Error(1)
+}
+
+func (m *MockAuthUsecase) StartImpersonation(ctx context.Context, req *authdto.StartImpersonationRequest) (*authdto.StartImpersonationResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authdto.StartImpersonationResponse), args.Error(1)
+}
+
+func (m *MockAuthUsecase) StopImpersonation(ctx context.Context, req *authdto.StopImpersonationRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
 func (m *MockAuthUsecase) InitiateRegistration(ctx context.Context, req *authdto.InitiateRegistrationRequest) (*authdto.InitiateRegistrationResponse, error) {
 	args := m.Called(ctx, req)
 	if args.Get(0) == nil {
@@ -128,6 +150,35 @@ func (m *MockAuthUsecase) GetLoginStatus(ctx context.Context, req *authdto.GetLo
 	return args.Get(0).(*authdto.LoginStatusResponse), args.Error(1)
 }
 
+func (m *MockAuthUsecase) CreatePersonalAccessToken(ctx context.Context, req *authdto.CreatePersonalAccessTokenRequest) (*authdto.CreatePersonalAccessTokenResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*authdto.CreatePersonalAccessTokenResponse), args.Error(1)
+}
+
+func (m *MockAuthUsecase) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]authdto.PersonalAccessTokenResponse, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]authdto.PersonalAccessTokenResponse), args.Error(1)
+}
+
+func (m *MockAuthUsecase) RevokePersonalAccessToken(ctx context.Context, req *authdto.RevokePersonalAccessTokenRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+func (m *MockAuthUsecase) AuthenticatePersonalAccessToken(ctx context.Context, rawToken string, clientIP string) (*jwtpkg.MultiTenantClaims, error) {
+	args := m.Called(ctx, rawToken, clientIP)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*jwtpkg.MultiTenantClaims), args.Error(1)
+}
+
 func setupTestApp() *fiber.App {
 	return fiber.New(fiber.Config{
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
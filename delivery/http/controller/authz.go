@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/authz"
+	"iam-service/iam/authz/authzdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+)
+
+type AuthzController struct {
+	config       *config.Config
+	authzUsecase authz.Usecase
+	validate     *validator.Validate
+}
+
+func NewAuthzController(cfg *config.Config, authzUsecase authz.Usecase) *AuthzController {
+	return &AuthzController{
+		config:       cfg,
+		authzUsecase: authzUsecase,
+		validate:     validate,
+	}
+}
+
+func (ac *AuthzController) Check(c *fiber.Ctx) error {
+	key, err := middleware.GetAPIKey(c)
+	if err != nil {
+		return err
+	}
+
+	var req authzdto.CheckRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := ac.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.CallerKeyID = key.KeyID
+
+	resp, err := ac.authzUsecase.Check(c.UserContext(), key.TenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permission checked successfully",
+		resp,
+	))
+}
+
+func (ac *AuthzController) BatchCheck(c *fiber.Ctx) error {
+	key, err := middleware.GetAPIKey(c)
+	if err != nil {
+		return err
+	}
+
+	var req authzdto.BatchCheckRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := ac.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.CallerKeyID = key.KeyID
+
+	resp, err := ac.authzUsecase.BatchCheck(c.UserContext(), key.TenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permissions checked successfully",
+		resp,
+	))
+}
+
+func (ac *AuthzController) Explain(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	var req authzdto.ExplainRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := ac.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := ac.authzUsecase.Explain(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Effective permissions retrieved successfully",
+		resp,
+	))
+}
@@ -0,0 +1,269 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/consent"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ConsentController struct {
+	config         *config.Config
+	consentUsecase consent.Usecase
+	validate       *validator.Validate
+}
+
+func NewConsentController(cfg *config.Config, consentUsecase consent.Usecase) *ConsentController {
+	return &ConsentController{
+		config:         cfg,
+		consentUsecase: consentUsecase,
+		validate:       validate,
+	}
+}
+
+func (cc *ConsentController) CreateDocument(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req consentdto.CreateDocumentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.CreateDocument(c.Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Consent document created successfully",
+		presenter.ToConsentDocumentResponse(resp),
+	))
+}
+
+func (cc *ConsentController) UpdateDocument(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid document ID")
+	}
+
+	var req consentdto.UpdateDocumentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.UpdateDocument(c.Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consent document updated successfully",
+		presenter.ToConsentDocumentResponse(resp),
+	))
+}
+
+func (cc *ConsentController) GetDocument(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid document ID")
+	}
+
+	resp, err := cc.consentUsecase.GetDocument(c.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consent document retrieved successfully",
+		presenter.ToConsentDocumentResponse(resp),
+	))
+}
+
+func (cc *ConsentController) ListDocuments(c *fiber.Ctx) error {
+	var req consentdto.ListDocumentsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.ListDocuments(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Consent documents retrieved successfully",
+		Data:    presenter.ToConsentDocumentListResponse(resp.Documents),
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (cc *ConsentController) PublishDocument(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid document ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cc.consentUsecase.PublishDocument(c.Context(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consent document published successfully",
+		presenter.ToConsentDocumentResponse(resp),
+	))
+}
+
+func (cc *ConsentController) GetCurrentDocuments(c *fiber.Ctx) error {
+	var req consentdto.CurrentDocumentsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.GetCurrentDocuments(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Current consent documents retrieved successfully",
+		presenter.ToConsentDocumentListResponse(resp),
+	))
+}
+
+func (cc *ConsentController) GetMyConsents(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cc.consentUsecase.GetMyConsents(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consents retrieved successfully",
+		presenter.ToMyConsentsResponse(resp),
+	))
+}
+
+func (cc *ConsentController) Accept(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req consentdto.AcceptRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.UserID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := cc.consentUsecase.Accept(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consent recorded successfully",
+		presenter.ToMyConsentsResponse(resp),
+	))
+}
+
+func (cc *ConsentController) GetReport(c *fiber.Ctx) error {
+	var req consentdto.ReportRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.GetReport(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Consent report retrieved successfully",
+		presenter.ToConsentReportResponse(resp),
+	))
+}
+
+func (cc *ConsentController) ListAudience(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid document ID")
+	}
+
+	var req consentdto.AudienceListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := cc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := cc.consentUsecase.ListAudience(c.Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Consent audience retrieved successfully",
+		Data:    presenter.ToConsentAudienceResponse(resp),
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
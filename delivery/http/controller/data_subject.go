@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/datasubject"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type DataSubjectController struct {
+	config             *config.Config
+	dataSubjectUsecase datasubject.Usecase
+	validate           *validator.Validate
+}
+
+func NewDataSubjectController(cfg *config.Config, dataSubjectUsecase datasubject.Usecase) *DataSubjectController {
+	return &DataSubjectController{
+		config:             cfg,
+		dataSubjectUsecase: dataSubjectUsecase,
+		validate:           validate,
+	}
+}
+
+func (dc *DataSubjectController) RequestExport(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := dc.dataSubjectUsecase.RequestExport(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(response.SuccessResponse(
+		"Data export requested successfully",
+		presenter.ToDataSubjectRequestResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) RequestErasure(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req datasubjectdto.ErasureRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := dc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := dc.dataSubjectUsecase.RequestErasure(c.Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(response.SuccessResponse(
+		"Account deletion requested successfully",
+		presenter.ToDataSubjectRequestResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) ListMine(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := dc.dataSubjectUsecase.ListMine(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Data subject requests retrieved successfully",
+		presenter.ToDataSubjectRequestListResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) GetExportDownload(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid request ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := dc.dataSubjectUsecase.GetExportDownload(c.Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Download link generated successfully",
+		presenter.ToDataExportDownloadResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) Cancel(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid request ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := dc.dataSubjectUsecase.Cancel(c.Context(), userID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Request cancelled successfully",
+		presenter.ToDataSubjectRequestResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) List(c *fiber.Ctx) error {
+	var req datasubjectdto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := dc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := dc.dataSubjectUsecase.List(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Data subject requests retrieved successfully",
+		Data:    presenter.ToDataSubjectRequestListResponse(resp.Requests),
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (dc *DataSubjectController) ApproveErasure(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid request ID")
+	}
+
+	reviewerID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req datasubjectdto.ReviewRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.ErrBadRequest("Invalid request body")
+		}
+	}
+
+	if err := dc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := dc.dataSubjectUsecase.ApproveErasure(c.Context(), id, reviewerID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Erasure request approved successfully",
+		presenter.ToDataSubjectRequestResponse(resp),
+	))
+}
+
+func (dc *DataSubjectController) RejectErasure(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid request ID")
+	}
+
+	reviewerID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req datasubjectdto.ReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := dc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := dc.dataSubjectUsecase.RejectErasure(c.Context(), id, reviewerID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Erasure request rejected successfully",
+		presenter.ToDataSubjectRequestResponse(resp),
+	))
+}
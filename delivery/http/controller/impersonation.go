@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func (rc *AuthController) StartImpersonation(c *fiber.Ctx) error {
+	claims, err := getUserClaims(c)
+	if err != nil {
+		return err
+	}
+
+	var req authdto.StartImpersonationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	if c.Get("X-Tenant-ID") != "" {
+		tenantID, err := getTenantIDFromHeader(c)
+		if err != nil {
+			return err
+		}
+		req.TenantID = &tenantID
+	}
+
+	req.ActorID = claims.UserID
+	req.ActorEmail = claims.Email
+	req.IsPlatformAdmin = claims.IsPlatformAdmin()
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := rc.authUsecase.StartImpersonation(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Impersonation started successfully",
+		presenter.ToStartImpersonationResponse(resp),
+	))
+}
+
+func (rc *AuthController) StopImpersonation(c *fiber.Ctx) error {
+	claims, err := getUserClaims(c)
+	if err != nil {
+		return err
+	}
+
+	if !claims.IsImpersonated() {
+		return errors.ErrBadRequest("Not an impersonation session")
+	}
+
+	actorID, err := uuid.Parse(claims.Actor.Subject)
+	if err != nil {
+		return errors.ErrTokenInvalid()
+	}
+
+	req := &authdto.StopImpersonationRequest{
+		ActorID:      actorID,
+		TargetUserID: claims.UserID,
+		SessionID:    claims.SessionID,
+		TokenJTI:     claims.RegisteredClaims.ID,
+		IPAddress:    getClientIP(c).String(),
+		UserAgent:    getUserAgent(c),
+	}
+	if claims.ExpiresAt != nil {
+		req.TokenExp = claims.ExpiresAt.Time
+	}
+	if c.Get("X-Tenant-ID") != "" {
+		tenantID, err := getTenantIDFromHeader(c)
+		if err != nil {
+			return err
+		}
+		req.TenantID = &tenantID
+	}
+
+	if err := rc.authUsecase.StopImpersonation(c.Context(), req); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Impersonation stopped successfully",
+		nil,
+	))
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/invitation"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type InvitationController struct {
+	config            *config.Config
+	invitationUsecase invitation.Usecase
+	validate          *validator.Validate
+}
+
+func NewInvitationController(cfg *config.Config, invitationUsecase invitation.Usecase) *InvitationController {
+	return &InvitationController{
+		config:            cfg,
+		invitationUsecase: invitationUsecase,
+		validate:          validate,
+	}
+}
+
+func (ic *InvitationController) Create(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req invitationdto.CreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := ic.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := ic.invitationUsecase.Create(c.Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Invitation sent successfully",
+		presenter.ToInvitationResponse(resp),
+	))
+}
+
+func (ic *InvitationController) List(c *fiber.Ctx) error {
+	var req invitationdto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := ic.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := ic.invitationUsecase.List(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Invitations retrieved successfully",
+		Data:    presenter.ToInvitationListResponse(resp.Invitations),
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (ic *InvitationController) Resend(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid invitation ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ic.invitationUsecase.Resend(c.Context(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Invitation resent successfully",
+		presenter.ToInvitationResponse(resp),
+	))
+}
+
+func (ic *InvitationController) Revoke(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid invitation ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ic.invitationUsecase.Revoke(c.Context(), id, userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Invitation revoked successfully",
+		presenter.ToInvitationResponse(resp),
+	))
+}
+
+func (ic *InvitationController) Accept(c *fiber.Ctx) error {
+	var req invitationdto.AcceptRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := ic.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := ic.invitationUsecase.Accept(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Invitation accepted successfully",
+		presenter.ToAcceptInvitationResponse(resp),
+	))
+}
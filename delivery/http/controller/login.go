@@ -83,6 +83,20 @@ func (rc *AuthController) VerifyLoginOTP(c *fiber.Ctx) error {
 		return err
 	}
 
+	if resp.PasswordChangeRequired {
+		return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+			"Password change required",
+			presenter.ToVerifyLoginOTPResponse(resp),
+		))
+	}
+
+	if resp.ConsentRequired {
+		return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+			"Consent to updated terms required",
+			presenter.ToVerifyLoginOTPResponse(resp),
+		))
+	}
+
 	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
 		"Login successful",
 		presenter.ToVerifyLoginOTPResponse(resp),
@@ -69,6 +69,7 @@ func (ctrl *ParticipantController) Create(c *fiber.Ctx) error {
 	req.TenantID = tenantID
 	req.ApplicationID = appID
 	req.UserID = userClaims.UserID
+	req.Scope = middleware.GetAccessScope(c)
 
 	result, err := ctrl.usecase.CreateParticipant(c.UserContext(), &req)
 	if err != nil {
@@ -96,7 +97,7 @@ func (ctrl *ParticipantController) Get(c *fiber.Ctx) error {
 		})
 	}
 
-	result, err := ctrl.usecase.GetParticipant(c.UserContext(), participantID, tenantID.String())
+	result, err := ctrl.usecase.GetParticipant(c.UserContext(), participantID, tenantID.String(), middleware.GetAccessScope(c))
 	if err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
@@ -138,6 +139,7 @@ func (ctrl *ParticipantController) List(c *fiber.Ctx) error {
 		PerPage:   perPage,
 		SortBy:    c.Query("sort_by", "created_at"),
 		SortOrder: c.Query("sort_order", "desc"),
+		Scope:     middleware.GetAccessScope(c),
 	}
 
 	if status := c.Query("status"); status != "" {
@@ -212,6 +214,8 @@ func (ctrl *ParticipantController) UpdatePersonalData(c *fiber.Ctx) error {
 	req.TenantID = tenantID
 	req.ParticipantID = pID
 	req.UserID = userClaims.UserID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.UpdatePersonalData(c.UserContext(), &req)
 	if err != nil {
@@ -260,6 +264,8 @@ func (ctrl *ParticipantController) SaveIdentity(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveIdentity(c.UserContext(), &req)
 	if err != nil {
@@ -289,7 +295,7 @@ func (ctrl *ParticipantController) DeleteIdentity(c *fiber.Ctx) error {
 		})
 	}
 
-	if err := ctrl.usecase.DeleteIdentity(c.UserContext(), identityID, participantID, tenantID.String()); err != nil {
+	if err := ctrl.usecase.DeleteIdentity(c.UserContext(), identityID, participantID, tenantID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c)); err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
 			"success": false,
@@ -332,6 +338,8 @@ func (ctrl *ParticipantController) SaveAddress(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveAddress(c.UserContext(), &req)
 	if err != nil {
@@ -361,7 +369,7 @@ func (ctrl *ParticipantController) DeleteAddress(c *fiber.Ctx) error {
 		})
 	}
 
-	if err := ctrl.usecase.DeleteAddress(c.UserContext(), addressID, participantID, tenantID.String()); err != nil {
+	if err := ctrl.usecase.DeleteAddress(c.UserContext(), addressID, participantID, tenantID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c)); err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
 			"success": false,
@@ -404,6 +412,8 @@ func (ctrl *ParticipantController) SaveBankAccount(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveBankAccount(c.UserContext(), &req)
 	if err != nil {
@@ -433,7 +443,7 @@ func (ctrl *ParticipantController) DeleteBankAccount(c *fiber.Ctx) error {
 		})
 	}
 
-	if err := ctrl.usecase.DeleteBankAccount(c.UserContext(), accountID, participantID, tenantID.String()); err != nil {
+	if err := ctrl.usecase.DeleteBankAccount(c.UserContext(), accountID, participantID, tenantID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c)); err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
 			"success": false,
@@ -476,6 +486,8 @@ func (ctrl *ParticipantController) SaveFamilyMember(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveFamilyMember(c.UserContext(), &req)
 	if err != nil {
@@ -505,7 +517,7 @@ func (ctrl *ParticipantController) DeleteFamilyMember(c *fiber.Ctx) error {
 		})
 	}
 
-	if err := ctrl.usecase.DeleteFamilyMember(c.UserContext(), memberID, participantID, tenantID.String()); err != nil {
+	if err := ctrl.usecase.DeleteFamilyMember(c.UserContext(), memberID, participantID, tenantID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c)); err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
 			"success": false,
@@ -548,6 +560,8 @@ func (ctrl *ParticipantController) SaveEmployment(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveEmployment(c.UserContext(), &req)
 	if err != nil {
@@ -596,6 +610,8 @@ func (ctrl *ParticipantController) SaveBeneficiary(c *fiber.Ctx) error {
 
 	req.TenantID = tenantID
 	req.ParticipantID = pID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
 
 	result, err := ctrl.usecase.SaveBeneficiary(c.UserContext(), &req)
 	if err != nil {
@@ -625,7 +641,7 @@ func (ctrl *ParticipantController) DeleteBeneficiary(c *fiber.Ctx) error {
 		})
 	}
 
-	if err := ctrl.usecase.DeleteBeneficiary(c.UserContext(), beneficiaryID, participantID, tenantID.String()); err != nil {
+	if err := ctrl.usecase.DeleteBeneficiary(c.UserContext(), beneficiaryID, participantID, tenantID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c)); err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
 			"success": false,
@@ -730,6 +746,8 @@ func (ctrl *ParticipantController) UploadFile(c *fiber.Ctx) error {
 		TenantID:      tenantID,
 		ParticipantID: pID,
 		FieldName:     fieldName,
+		Scope:         middleware.GetAccessScope(c),
+		Subject:       middleware.GetPolicySubject(c),
 	}
 
 	result, err := ctrl.usecase.UploadFile(c.UserContext(), req, file, fileHeader.Size, detectedType, fileHeader.Filename)
@@ -759,7 +777,7 @@ func (ctrl *ParticipantController) GetStatusHistory(c *fiber.Ctx) error {
 		})
 	}
 
-	result, err := ctrl.usecase.GetStatusHistory(c.UserContext(), participantID, tenantID.String())
+	result, err := ctrl.usecase.GetStatusHistory(c.UserContext(), participantID, tenantID.String(), middleware.GetAccessScope(c))
 	if err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
@@ -805,6 +823,8 @@ func (ctrl *ParticipantController) Submit(c *fiber.Ctx) error {
 		TenantID:      tenantID,
 		ParticipantID: pID,
 		UserID:        userClaims.UserID,
+		Scope:         middleware.GetAccessScope(c),
+		Subject:       middleware.GetPolicySubject(c),
 	}
 
 	result, err := ctrl.usecase.SubmitParticipant(c.UserContext(), req)
@@ -853,6 +873,8 @@ func (ctrl *ParticipantController) Approve(c *fiber.Ctx) error {
 		TenantID:      tenantID,
 		ParticipantID: pID,
 		UserID:        userClaims.UserID,
+		Scope:         middleware.GetAccessScope(c),
+		Subject:       middleware.GetPolicySubject(c),
 	}
 
 	result, err := ctrl.usecase.ApproveParticipant(c.UserContext(), req)
@@ -916,6 +938,8 @@ func (ctrl *ParticipantController) Reject(c *fiber.Ctx) error {
 		ParticipantID: pID,
 		UserID:        userClaims.UserID,
 		Reason:        body.Reason,
+		Scope:         middleware.GetAccessScope(c),
+		Subject:       middleware.GetPolicySubject(c),
 	}
 
 	result, err := ctrl.usecase.RejectParticipant(c.UserContext(), req)
@@ -933,6 +957,116 @@ func (ctrl *ParticipantController) Reject(c *fiber.Ctx) error {
 	})
 }
 
+func (ctrl *ParticipantController) LinkUser(c *fiber.Ctx) error {
+	pID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "invalid participant ID",
+		})
+	}
+
+	tenantID, err := middleware.GetTenantIDFromContext(c)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	userClaims, err := middleware.GetMultiTenantClaims(c)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	var req participantdto.LinkUserRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "invalid request body",
+		})
+	}
+
+	if err := validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.TenantID = tenantID
+	req.ParticipantID = pID
+	req.UserID = userClaims.UserID
+	req.Scope = middleware.GetAccessScope(c)
+	req.Subject = middleware.GetPolicySubject(c)
+
+	result, err := ctrl.usecase.LinkUser(c.UserContext(), &req)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    presenter.MapParticipantResponse(result),
+	})
+}
+
+func (ctrl *ParticipantController) UnlinkUser(c *fiber.Ctx) error {
+	pID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"success": false,
+			"error":   "invalid participant ID",
+		})
+	}
+
+	tenantID, err := middleware.GetTenantIDFromContext(c)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	userClaims, err := middleware.GetMultiTenantClaims(c)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	req := &participantdto.UnlinkUserRequest{
+		TenantID:      tenantID,
+		ParticipantID: pID,
+		UserID:        userClaims.UserID,
+		Scope:         middleware.GetAccessScope(c),
+		Subject:       middleware.GetPolicySubject(c),
+	}
+
+	result, err := ctrl.usecase.UnlinkUser(c.UserContext(), req)
+	if err != nil {
+		appErr := errors.GetAppError(err)
+		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+			"success": false,
+			"error":   appErr.Message,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"data":    presenter.MapParticipantResponse(result),
+	})
+}
+
 func (ctrl *ParticipantController) Delete(c *fiber.Ctx) error {
 	participantID := c.Params("id")
 	tenantID, err := middleware.GetTenantIDFromContext(c)
@@ -953,7 +1087,7 @@ func (ctrl *ParticipantController) Delete(c *fiber.Ctx) error {
 		})
 	}
 
-	err = ctrl.usecase.DeleteParticipant(c.UserContext(), participantID, tenantID.String(), userClaims.UserID.String())
+	err = ctrl.usecase.DeleteParticipant(c.UserContext(), participantID, tenantID.String(), userClaims.UserID.String(), middleware.GetAccessScope(c), middleware.GetPolicySubject(c))
 	if err != nil {
 		appErr := errors.GetAppError(err)
 		return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
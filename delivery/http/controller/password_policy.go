@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/passwordpolicy"
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type PasswordPolicyController struct {
+	config                *config.Config
+	passwordPolicyUsecase passwordpolicy.Usecase
+	validate              *validator.Validate
+}
+
+func NewPasswordPolicyController(cfg *config.Config, passwordPolicyUsecase passwordpolicy.Usecase) *PasswordPolicyController {
+	return &PasswordPolicyController{
+		config:                cfg,
+		passwordPolicyUsecase: passwordPolicyUsecase,
+		validate:              validate,
+	}
+}
+
+func (pc *PasswordPolicyController) List(c *fiber.Ctx) error {
+	tenantID, err := parseOptionalUUIDQuery(c, "tenant_id")
+	if err != nil {
+		return err
+	}
+
+	resp, err := pc.passwordPolicyUsecase.List(c.Context(), tenantID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Password policies retrieved successfully",
+		presenter.ToPasswordPolicyListResponse(resp),
+	))
+}
+
+func (pc *PasswordPolicyController) GetEffective(c *fiber.Ctx) error {
+	tenantID, err := parseOptionalUUIDQuery(c, "tenant_id")
+	if err != nil {
+		return err
+	}
+	productID, err := parseOptionalUUIDQuery(c, "product_id")
+	if err != nil {
+		return err
+	}
+
+	resp, err := pc.passwordPolicyUsecase.GetEffective(c.Context(), tenantID, productID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Effective password policy retrieved successfully",
+		presenter.ToPasswordPolicyResponse(resp),
+	))
+}
+
+func (pc *PasswordPolicyController) Upsert(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req passwordpolicydto.UpsertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := pc.passwordPolicyUsecase.Upsert(c.Context(), userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Password policy saved successfully",
+		presenter.ToPasswordPolicyResponse(resp),
+	))
+}
+
+func (pc *PasswordPolicyController) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid password policy ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	if err := pc.passwordPolicyUsecase.Delete(c.Context(), id, userID); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Password policy deleted successfully",
+		nil,
+	))
+}
+
+func parseOptionalUUIDQuery(c *fiber.Ctx, name string) (*uuid.UUID, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.ErrBadRequest("Invalid " + name + " query parameter")
+	}
+	return &id, nil
+}
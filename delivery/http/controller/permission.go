@@ -0,0 +1,239 @@
+package controller
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/permission"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type PermissionController struct {
+	config            *config.Config
+	permissionUsecase permission.Usecase
+	validate          *validator.Validate
+}
+
+func NewPermissionController(cfg *config.Config, permissionUsecase permission.Usecase) *PermissionController {
+	return &PermissionController{
+		config:            cfg,
+		permissionUsecase: permissionUsecase,
+		validate:          validate,
+	}
+}
+
+func (pc *PermissionController) Create(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req permissiondto.CreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := pc.permissionUsecase.Create(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Permission created successfully",
+		resp,
+	))
+}
+
+func (pc *PermissionController) List(c *fiber.Ctx) error {
+	var req permissiondto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := pc.permissionUsecase.List(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Permissions retrieved successfully",
+		Data:    resp.Permissions,
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (pc *PermissionController) GetByID(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid permission ID")
+	}
+
+	resp, err := pc.permissionUsecase.GetByID(c.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permission retrieved successfully",
+		resp,
+	))
+}
+
+func (pc *PermissionController) Update(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid permission ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req permissiondto.UpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := pc.permissionUsecase.Update(c.Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permission updated successfully",
+		resp,
+	))
+}
+
+func (pc *PermissionController) Delete(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid permission ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	err = pc.permissionUsecase.Delete(c.Context(), id, &permissiondto.DeleteRequest{
+		ActorID:   userID,
+		IPAddress: getClientIP(c).String(),
+		UserAgent: getUserAgent(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permission deleted successfully",
+		nil,
+	))
+}
+
+const maxPermissionManifestSize = 1 * 1024 * 1024 // 1MB
+
+// ImportManifest applies a permission manifest sent either as the JSON body
+// or as an uploaded JSON file ("file"). For uploads, product_id and prune form
+// values take precedence over the file contents.
+func (pc *PermissionController) ImportManifest(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req permissiondto.ManifestRequest
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		if err := parseManifestForm(c, &req); err != nil {
+			return err
+		}
+	} else if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := pc.permissionUsecase.ImportManifest(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Permission manifest imported successfully",
+		resp,
+	))
+}
+
+func parseManifestForm(c *fiber.Ctx, req *permissiondto.ManifestRequest) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errors.ErrBadRequest("file is required")
+	}
+	if fileHeader.Size > maxPermissionManifestSize {
+		return errors.ErrBadRequest("file size exceeds 1MB limit")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errors.ErrInternal("failed to open uploaded file").WithError(err)
+	}
+	defer file.Close()
+
+	if err := json.NewDecoder(io.LimitReader(file, maxPermissionManifestSize)).Decode(req); err != nil {
+		return errors.ErrBadRequest("file must be a valid JSON manifest")
+	}
+
+	if value := c.FormValue("product_id"); value != "" {
+		if req.ProductID, err = uuid.Parse(value); err != nil {
+			return errors.ErrBadRequest("product_id must be a valid UUID")
+		}
+	}
+	if value := c.FormValue("prune"); value != "" {
+		if req.Prune, err = strconv.ParseBool(value); err != nil {
+			return errors.ErrBadRequest("prune must be a boolean")
+		}
+	}
+	return nil
+}
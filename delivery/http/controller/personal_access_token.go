@@ -0,0 +1,83 @@
+package controller
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func (rc *AuthController) CreatePersonalAccessToken(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req authdto.CreatePersonalAccessTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.UserID = userID
+
+	resp, err := rc.authUsecase.CreatePersonalAccessToken(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Personal access token created successfully",
+		presenter.ToCreatePersonalAccessTokenResponse(resp),
+	))
+}
+
+func (rc *AuthController) ListPersonalAccessTokens(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := rc.authUsecase.ListPersonalAccessTokens(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Personal access tokens retrieved successfully",
+		presenter.ToPersonalAccessTokenListResponse(resp),
+	))
+}
+
+func (rc *AuthController) RevokePersonalAccessToken(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	tokenID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid token ID")
+	}
+
+	req := &authdto.RevokePersonalAccessTokenRequest{
+		UserID:  userID,
+		TokenID: tokenID,
+	}
+
+	if err := rc.authUsecase.RevokePersonalAccessToken(c.Context(), req); err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Personal access token revoked successfully",
+		nil,
+	))
+}
@@ -0,0 +1,188 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/policy"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type PolicyController struct {
+	config        *config.Config
+	policyUsecase policy.Usecase
+	validate      *validator.Validate
+}
+
+func NewPolicyController(cfg *config.Config, policyUsecase policy.Usecase) *PolicyController {
+	return &PolicyController{
+		config:        cfg,
+		policyUsecase: policyUsecase,
+		validate:      validate,
+	}
+}
+
+func (pc *PolicyController) Create(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req policydto.CreateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := pc.policyUsecase.Create(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Policy created successfully",
+		resp,
+	))
+}
+
+func (pc *PolicyController) List(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	var req policydto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := pc.policyUsecase.List(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Policies retrieved successfully",
+		Data:    resp.Policies,
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (pc *PolicyController) GetByID(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid policy ID")
+	}
+
+	resp, err := pc.policyUsecase.GetByID(c.Context(), tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Policy retrieved successfully",
+		resp,
+	))
+}
+
+func (pc *PolicyController) Update(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid policy ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req policydto.UpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := pc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := pc.policyUsecase.Update(c.Context(), tenantID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Policy updated successfully",
+		resp,
+	))
+}
+
+func (pc *PolicyController) Delete(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid policy ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	err = pc.policyUsecase.Delete(c.Context(), tenantID, id, &policydto.DeleteRequest{
+		ActorID:   userID,
+		IPAddress: getClientIP(c).String(),
+		UserAgent: getUserAgent(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Policy deleted successfully",
+		nil,
+	))
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 func convertRoleValidationErrors(errs validator.ValidationErrors) []errors.FieldError {
@@ -48,6 +49,16 @@ func NewRoleController(cfg *config.Config, roleUsecase role.Usecase) *RoleContro
 }
 
 func (rc *RoleController) Create(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
 	var req roledto.CreateRequest
 	if err := c.BodyParser(&req); err != nil {
 		return errors.ErrBadRequest("Invalid request body")
@@ -57,7 +68,11 @@ func (rc *RoleController) Create(c *fiber.Ctx) error {
 		return errors.ErrValidationWithFields(convertRoleValidationErrors(err.(validator.ValidationErrors)))
 	}
 
-	resp, err := rc.roleUsecase.Create(c.Context(), &req)
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := rc.roleUsecase.Create(c.Context(), tenantID, &req)
 	if err != nil {
 		return err
 	}
@@ -67,3 +82,221 @@ func (rc *RoleController) Create(c *fiber.Ctx) error {
 		resp,
 	))
 }
+
+func (rc *RoleController) List(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	var req roledto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertRoleValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := rc.roleUsecase.List(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Roles retrieved successfully",
+		Data:    resp.Roles,
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (rc *RoleController) GetByID(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid role ID")
+	}
+
+	resp, err := rc.roleUsecase.GetByID(c.Context(), tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role retrieved successfully",
+		resp,
+	))
+}
+
+func (rc *RoleController) GetEffectivePermissions(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid role ID")
+	}
+
+	resp, err := rc.roleUsecase.GetEffectivePermissions(c.Context(), tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Effective permissions retrieved successfully",
+		resp,
+	))
+}
+
+func (rc *RoleController) Update(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid role ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req roledto.UpdateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertRoleValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := rc.roleUsecase.Update(c.Context(), tenantID, id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role updated successfully",
+		resp,
+	))
+}
+
+func (rc *RoleController) Delete(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid role ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	err = rc.roleUsecase.Delete(c.Context(), tenantID, id, &roledto.DeleteRequest{
+		ActorID:   userID,
+		IPAddress: getClientIP(c).String(),
+		UserAgent: getUserAgent(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role deleted successfully",
+		nil,
+	))
+}
+
+func (rc *RoleController) AddPermissions(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, req, err := rc.parsePermissionsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := rc.roleUsecase.AddPermissions(c.Context(), tenantID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role permissions added successfully",
+		resp,
+	))
+}
+
+func (rc *RoleController) RemovePermissions(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, req, err := rc.parsePermissionsRequest(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := rc.roleUsecase.RemovePermissions(c.Context(), tenantID, id, req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role permissions removed successfully",
+		resp,
+	))
+}
+
+func (rc *RoleController) parsePermissionsRequest(c *fiber.Ctx) (uuid.UUID, *roledto.PermissionsRequest, error) {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, nil, errors.ErrBadRequest("Invalid role ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+
+	var req roledto.PermissionsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return uuid.Nil, nil, errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return uuid.Nil, nil, errors.ErrValidationWithFields(convertRoleValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+	return id, &req, nil
+}
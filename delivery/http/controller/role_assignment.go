@@ -0,0 +1,310 @@
+package controller
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/roleassignment"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RoleAssignmentController struct {
+	config                *config.Config
+	roleAssignmentUsecase roleassignment.Usecase
+	validate              *validator.Validate
+}
+
+func NewRoleAssignmentController(cfg *config.Config, roleAssignmentUsecase roleassignment.Usecase) *RoleAssignmentController {
+	return &RoleAssignmentController{
+		config:                cfg,
+		roleAssignmentUsecase: roleAssignmentUsecase,
+		validate:              validate,
+	}
+}
+
+func (rc *RoleAssignmentController) Assign(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req roleassignmentdto.AssignRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := rc.roleAssignmentUsecase.Assign(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(response.SuccessResponse(
+		"Role assigned successfully",
+		resp,
+	))
+}
+
+func (rc *RoleAssignmentController) List(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	var req roleassignmentdto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := rc.roleAssignmentUsecase.List(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Role assignments retrieved successfully",
+		Data:    resp.Assignments,
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (rc *RoleAssignmentController) Revoke(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid role assignment ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := rc.roleAssignmentUsecase.Revoke(c.Context(), tenantID, id, &roleassignmentdto.RevokeRequest{
+		ActorID:   userID,
+		IPAddress: getClientIP(c).String(),
+		UserAgent: getUserAgent(c),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Role revoked successfully",
+		resp,
+	))
+}
+
+const maxBulkAssignCSVSize = 1 * 1024 * 1024 // 1MB
+
+// BulkAssign queues a role for many users. The users come either from a JSON
+// body or from a CSV upload ("file") with a user_id column; in the CSV case
+// the other fields are sent as form values.
+func (rc *RoleAssignmentController) BulkAssign(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req roleassignmentdto.BulkAssignRequest
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		if err := parseBulkAssignForm(c, &req); err != nil {
+			return err
+		}
+	} else if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := rc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	req.ActorID = userID
+	req.IPAddress = getClientIP(c).String()
+	req.UserAgent = getUserAgent(c)
+
+	resp, err := rc.roleAssignmentUsecase.BulkAssign(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(response.SuccessResponse(
+		"Role assignments queued successfully",
+		resp,
+	))
+}
+
+func (rc *RoleAssignmentController) GetBatch(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	batchID, err := uuid.Parse(c.Params("batchId"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid batch ID")
+	}
+
+	resp, err := rc.roleAssignmentUsecase.GetBatch(c.Context(), tenantID, batchID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Batch retrieved successfully",
+		resp,
+	))
+}
+
+func parseBulkAssignForm(c *fiber.Ctx, req *roleassignmentdto.BulkAssignRequest) error {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errors.ErrBadRequest("file is required")
+	}
+	if fileHeader.Size > maxBulkAssignCSVSize {
+		return errors.ErrBadRequest("file size exceeds 1MB limit")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errors.ErrInternal("failed to open uploaded file").WithError(err)
+	}
+	defer file.Close()
+
+	req.UserIDs, err = parseUserIDsCSV(io.LimitReader(file, maxBulkAssignCSVSize))
+	if err != nil {
+		return err
+	}
+
+	if req.RoleID, err = uuid.Parse(c.FormValue("role_id")); err != nil {
+		return errors.ErrBadRequest("role_id must be a valid UUID")
+	}
+	if req.ProductID, err = parseOptionalUUIDForm(c, "product_id"); err != nil {
+		return err
+	}
+	if req.BranchID, err = parseOptionalUUIDForm(c, "branch_id"); err != nil {
+		return err
+	}
+	if req.EffectiveFrom, err = parseOptionalTimeForm(c, "effective_from"); err != nil {
+		return err
+	}
+	if req.EffectiveTo, err = parseOptionalTimeForm(c, "effective_to"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// parseUserIDsCSV reads user IDs from the user_id column of a CSV file, or
+// from the first column when the file has no header row.
+func parseUserIDsCSV(r io.Reader) ([]uuid.UUID, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	column := 0
+	var userIDs []uuid.UUID
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.ErrBadRequest(fmt.Sprintf("invalid CSV on line %d", line))
+		}
+
+		if line == 1 {
+			if idx := headerColumn(record, "user_id"); idx >= 0 {
+				column = idx
+				continue
+			}
+		}
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			continue
+		}
+
+		id, err := uuid.Parse(strings.TrimSpace(record[column]))
+		if err != nil {
+			return nil, errors.ErrBadRequest(fmt.Sprintf("invalid user_id on line %d", line))
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	if len(userIDs) == 0 {
+		return nil, errors.ErrBadRequest("file does not contain any user IDs")
+	}
+	return userIDs, nil
+}
+
+func headerColumn(record []string, name string) int {
+	for i, field := range record {
+		if strings.EqualFold(strings.TrimSpace(field), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func parseOptionalUUIDForm(c *fiber.Ctx, key string) (*uuid.UUID, error) {
+	value := c.FormValue(key)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, errors.ErrBadRequest(key + " must be a valid UUID")
+	}
+	return &id, nil
+}
+
+func parseOptionalTimeForm(c *fiber.Ctx, key string) (*time.Time, error) {
+	value := c.FormValue(key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.ErrBadRequest(key + " must be an RFC 3339 timestamp")
+	}
+	return &t, nil
+}
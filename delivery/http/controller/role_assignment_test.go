@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUserIDsCSV(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+
+	t.Run("reads the user_id column of a file with a header", func(t *testing.T) {
+		input := "email,user_id\na@example.com," + first.String() + "\nb@example.com," + second.String() + "\n"
+
+		ids, err := parseUserIDsCSV(strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first, second}, ids)
+	})
+
+	t.Run("reads the first column of a file without a header", func(t *testing.T) {
+		input := first.String() + "\n\n" + second.String() + "\n"
+
+		ids, err := parseUserIDsCSV(strings.NewReader(input))
+
+		require.NoError(t, err)
+		assert.Equal(t, []uuid.UUID{first, second}, ids)
+	})
+
+	t.Run("reports the line of an invalid user id", func(t *testing.T) {
+		input := "user_id\n" + first.String() + "\nnot-a-uuid\n"
+
+		_, err := parseUserIDsCSV(strings.NewReader(input))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+
+	t.Run("rejects a file without user ids", func(t *testing.T) {
+		_, err := parseUserIDsCSV(strings.NewReader("user_id\n"))
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/signingkey"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type SigningKeyController struct {
+	config            *config.Config
+	signingKeyUsecase signingkey.Usecase
+}
+
+func NewSigningKeyController(cfg *config.Config, signingKeyUsecase signingkey.Usecase) *SigningKeyController {
+	return &SigningKeyController{
+		config:            cfg,
+		signingKeyUsecase: signingKeyUsecase,
+	}
+}
+
+func (sc *SigningKeyController) List(c *fiber.Ctx) error {
+	resp, err := sc.signingKeyUsecase.List(c.Context())
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Signing keys retrieved successfully",
+		presenter.ToSigningKeyListResponse(resp),
+	))
+}
+
+func (sc *SigningKeyController) Rotate(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	resp, err := sc.signingKeyUsecase.Rotate(c.Context(), userID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Signing keys rotated successfully",
+		presenter.ToRotateSigningKeyResponse(resp),
+	))
+}
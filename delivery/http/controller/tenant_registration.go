@@ -0,0 +1,131 @@
+package controller
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/dto/response"
+	"iam-service/delivery/http/presenter"
+	"iam-service/iam/tenantregistration"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+	"iam-service/pkg/errors"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TenantRegistrationController struct {
+	config                    *config.Config
+	tenantRegistrationUsecase tenantregistration.Usecase
+	validate                  *validator.Validate
+}
+
+func NewTenantRegistrationController(cfg *config.Config, tenantRegistrationUsecase tenantregistration.Usecase) *TenantRegistrationController {
+	return &TenantRegistrationController{
+		config:                    cfg,
+		tenantRegistrationUsecase: tenantRegistrationUsecase,
+		validate:                  validate,
+	}
+}
+
+func (tc *TenantRegistrationController) List(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	var req tenantregistrationdto.ListRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid query parameters")
+	}
+
+	if err := tc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := tc.tenantRegistrationUsecase.List(c.Context(), tenantID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.APIResponse{
+		Success: true,
+		Message: "Registrations retrieved successfully",
+		Data:    presenter.ToTenantRegistrationListResponse(resp.Registrations),
+		Pagination: &response.Pagination{
+			Total:      resp.Pagination.Total,
+			Page:       resp.Pagination.Page,
+			Limit:      resp.Pagination.PerPage,
+			TotalPages: resp.Pagination.TotalPages,
+		},
+	})
+}
+
+func (tc *TenantRegistrationController) Approve(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid registration ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req tenantregistrationdto.ApproveRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.ErrBadRequest("Invalid request body")
+		}
+	}
+
+	resp, err := tc.tenantRegistrationUsecase.Approve(c.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Registration approved successfully",
+		presenter.ToTenantRegistrationDetailResponse(resp),
+	))
+}
+
+func (tc *TenantRegistrationController) Reject(c *fiber.Ctx) error {
+	tenantID, err := getTenantIDFromHeader(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return errors.ErrBadRequest("Invalid registration ID")
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	var req tenantregistrationdto.RejectRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := tc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := tc.tenantRegistrationUsecase.Reject(c.Context(), tenantID, id, userID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Registration rejected successfully",
+		presenter.ToTenantRegistrationDetailResponse(resp),
+	))
+}
@@ -1,6 +1,9 @@
 package controller
 
 import (
+	"io"
+	"net/http"
+
 	"iam-service/config"
 	"iam-service/delivery/http/dto/response"
 	"iam-service/delivery/http/presenter"
@@ -71,19 +74,22 @@ func (uc *UserController) Create(c *fiber.Ctx) error {
 }
 
 func (uc *UserController) GetMe(c *fiber.Ctx) error {
-	userID, err := getUserID(c)
+	claims, err := getUserClaims(c)
 	if err != nil {
 		return err
 	}
 
-	resp, err := uc.userUsecase.GetMe(c.Context(), userID)
+	resp, err := uc.userUsecase.GetMe(c.Context(), claims.UserID)
 	if err != nil {
 		return err
 	}
 
+	me := presenter.ToUserResponse(resp)
+	me.Impersonation = presenter.ToImpersonationBanner(claims)
+
 	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
 		"User profile retrieved successfully",
-		presenter.ToUserResponse(resp),
+		me,
 	))
 }
 
@@ -113,6 +119,63 @@ func (uc *UserController) UpdateMe(c *fiber.Ctx) error {
 	))
 }
 
+const maxAvatarSize = 5 * 1024 * 1024 // 5MB
+
+var allowedAvatarTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+func (uc *UserController) UploadAvatar(c *fiber.Ctx) error {
+	userID, err := getUserID(c)
+	if err != nil {
+		return err
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errors.ErrBadRequest("file is required")
+	}
+
+	if fileHeader.Size > maxAvatarSize {
+		return errors.ErrBadRequest("file size exceeds 5MB limit")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errors.ErrInternal("failed to open uploaded file").WithError(err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxAvatarSize+1))
+	if err != nil {
+		return errors.ErrInternal("failed to read uploaded file").WithError(err)
+	}
+	if len(content) > maxAvatarSize {
+		return errors.ErrBadRequest("file size exceeds 5MB limit")
+	}
+
+	// The declared Content-Type is ignored; only the sniffed type of the bytes counts.
+	detectedType := http.DetectContentType(content)
+	if !allowedAvatarTypes[detectedType] {
+		return errors.ErrBadRequest("file content does not match an allowed type; allowed: jpeg, png, gif")
+	}
+
+	resp, err := uc.userUsecase.UploadAvatar(c.Context(), userID, &userdto.UploadAvatarRequest{
+		Content:     content,
+		ContentType: detectedType,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Profile picture updated successfully",
+		presenter.ToUserResponse(resp),
+	))
+}
+
 func (uc *UserController) List(c *fiber.Ctx) error {
 	tenantID, err := getTenantID(c)
 	if err != nil {
@@ -309,3 +372,95 @@ func (uc *UserController) ResetPIN(c *fiber.Ctx) error {
 		presenter.ToResetUserPINResponse(resp),
 	))
 }
+
+func (uc *UserController) ChangePassword(c *fiber.Ctx) error {
+	claims, err := getUserClaims(c)
+	if err != nil {
+		return err
+	}
+
+	var req userdto.ChangePasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := uc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertUserValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := uc.userUsecase.ChangePassword(c.Context(), claims.UserID, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		"Password changed successfully",
+		presenter.ToChangePasswordResponse(resp),
+	))
+}
+
+func (uc *UserController) ForcePasswordChange(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		return errors.ErrBadRequest("Invalid user ID")
+	}
+
+	resp, err := uc.userUsecase.ForcePasswordChange(c.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		resp.Message,
+		presenter.ToForcePasswordChangeResponse(resp),
+	))
+}
+
+func (uc *UserController) ForceTenantPasswordChange(c *fiber.Ctx) error {
+	var req userdto.ForceTenantPasswordChangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := uc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertUserValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := uc.userUsecase.ForceTenantPasswordChange(c.Context(), &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		resp.Message,
+		presenter.ToForceTenantPasswordChangeResponse(resp),
+	))
+}
+
+func (uc *UserController) ResetPassword(c *fiber.Ctx) error {
+	idParam := c.Params("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		return errors.ErrBadRequest("Invalid user ID")
+	}
+
+	var req userdto.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.ErrBadRequest("Invalid request body")
+	}
+
+	if err := uc.validate.Struct(&req); err != nil {
+		return errors.ErrValidationWithFields(convertUserValidationErrors(err.(validator.ValidationErrors)))
+	}
+
+	resp, err := uc.userUsecase.ResetPassword(c.Context(), id, &req)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(response.SuccessResponse(
+		resp.Message,
+		presenter.ToResetUserPasswordResponse(resp),
+	))
+}
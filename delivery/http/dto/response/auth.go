@@ -63,6 +63,7 @@ type SetPasswordResponse struct {
 	Message           string              `json:"message"`
 	RegistrationToken string              `json:"registration_token"`
 	NextStep          SetPasswordNextStep `json:"next_step"`
+	Warnings          []string            `json:"warnings,omitempty"`
 }
 
 type CompleteProfileRegistrationProfile struct {
@@ -71,15 +72,27 @@ type CompleteProfileRegistrationProfile struct {
 }
 
 type CompleteProfileRegistrationResponse struct {
-	UserID       uuid.UUID                          `json:"user_id"`
-	Email        string                             `json:"email"`
-	Status       string                             `json:"status"`
-	Message      string                             `json:"message"`
-	Profile      CompleteProfileRegistrationProfile `json:"profile"`
-	AccessToken  string                             `json:"access_token"`
-	RefreshToken string                             `json:"refresh_token"`
-	TokenType    string                             `json:"token_type"`
-	ExpiresIn    int                                `json:"expires_in"`
+	UserID             uuid.UUID                          `json:"user_id"`
+	Email              string                             `json:"email"`
+	Status             string                             `json:"status"`
+	Message            string                             `json:"message"`
+	Profile            CompleteProfileRegistrationProfile `json:"profile"`
+	TenantRegistration *TenantRegistrationResponse        `json:"tenant_registration,omitempty"`
+	AccessToken        string                             `json:"access_token"`
+	RefreshToken       string                             `json:"refresh_token"`
+	TokenType          string                             `json:"token_type"`
+	ExpiresIn          int                                `json:"expires_in"`
+}
+
+type TenantRegistrationResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	TenantID         uuid.UUID  `json:"tenant_id"`
+	ProductID        uuid.UUID  `json:"product_id"`
+	RegistrationType string     `json:"registration_type"`
+	Status           string     `json:"status"`
+	GrantedRoleID    *uuid.UUID `json:"granted_role_id,omitempty"`
+	ParticipantID    *uuid.UUID `json:"participant_id,omitempty"`
+	ParticipantLink  string     `json:"participant_link,omitempty"`
 }
 
 type CompleteRegistrationProfile struct {
@@ -94,6 +107,8 @@ type CompleteRegistrationResponse struct {
 	Message string                      `json:"message"`
 	Profile CompleteRegistrationProfile `json:"profile"`
 
+	TenantRegistration *TenantRegistrationResponse `json:"tenant_registration,omitempty"`
+
 	AccessToken  *string `json:"access_token,omitempty"`
 	RefreshToken *string `json:"refresh_token,omitempty"`
 	TokenType    *string `json:"token_type,omitempty"`
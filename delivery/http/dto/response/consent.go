@@ -0,0 +1,60 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ConsentDocumentResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty"`
+	DocumentType string     `json:"document_type"`
+	Version      string     `json:"version"`
+	Title        string     `json:"title"`
+	ContentURL   string     `json:"content_url"`
+	Summary      *string    `json:"summary,omitempty"`
+	IsRequired   bool       `json:"is_required"`
+	Status       string     `json:"status"`
+	PublishedAt  *time.Time `json:"published_at,omitempty"`
+	PublishedBy  *uuid.UUID `json:"published_by,omitempty"`
+	CreatedBy    uuid.UUID  `json:"created_by"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type UserConsentStatusResponse struct {
+	Document   *ConsentDocumentResponse `json:"document"`
+	Accepted   bool                     `json:"accepted"`
+	AcceptedAt *time.Time               `json:"accepted_at,omitempty"`
+}
+
+type MyConsentsResponse struct {
+	Documents       []*UserConsentStatusResponse `json:"documents"`
+	PendingRequired bool                         `json:"pending_required"`
+}
+
+type ConsentDocumentReportResponse struct {
+	Document      *ConsentDocumentResponse `json:"document"`
+	TotalUsers    int64                    `json:"total_users"`
+	AcceptedUsers int64                    `json:"accepted_users"`
+	PendingUsers  int64                    `json:"pending_users"`
+}
+
+type ConsentReportResponse struct {
+	TenantID  *uuid.UUID                       `json:"tenant_id,omitempty"`
+	Documents []*ConsentDocumentReportResponse `json:"documents"`
+}
+
+type ConsentAudienceMemberResponse struct {
+	UserID     uuid.UUID  `json:"user_id"`
+	Email      string     `json:"email"`
+	Accepted   bool       `json:"accepted"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+type ConsentAudienceResponse struct {
+	Document *ConsentDocumentResponse         `json:"document"`
+	Members  []*ConsentAudienceMemberResponse `json:"members"`
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataSubjectRequestResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	Type          string     `json:"type"`
+	Status        string     `json:"status"`
+	Reason        *string    `json:"reason,omitempty"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	ScheduledFor  *time.Time `json:"scheduled_for,omitempty"`
+	ReviewedBy    *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	ReviewNote    *string    `json:"review_note,omitempty"`
+	FailureReason *string    `json:"failure_reason,omitempty"`
+	CompletedAt   *time.Time `json:"completed_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type DataExportDownloadResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
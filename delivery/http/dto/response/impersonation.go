@@ -0,0 +1,25 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StartImpersonationResponse struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresIn   int       `json:"expires_in"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	SessionID   uuid.UUID `json:"session_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Email       string    `json:"email"`
+	ActorID     uuid.UUID `json:"actor_id"`
+}
+
+type ImpersonationBanner struct {
+	Active     bool       `json:"active"`
+	ActorID    string     `json:"actor_id"`
+	ActorEmail string     `json:"actor_email,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+}
@@ -0,0 +1,31 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	TenantID    uuid.UUID   `json:"tenant_id"`
+	BranchID    *uuid.UUID  `json:"branch_id,omitempty"`
+	Email       string      `json:"email"`
+	RoleIDs     []uuid.UUID `json:"role_ids"`
+	Status      string      `json:"status"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	ResendCount int         `json:"resend_count"`
+	LastSentAt  time.Time   `json:"last_sent_at"`
+	AcceptedAt  *time.Time  `json:"accepted_at,omitempty"`
+	RevokedAt   *time.Time  `json:"revoked_at,omitempty"`
+	InvitedBy   uuid.UUID   `json:"invited_by"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type AcceptInvitationResponse struct {
+	UserID   uuid.UUID   `json:"user_id"`
+	Email    string      `json:"email"`
+	TenantID uuid.UUID   `json:"tenant_id"`
+	RoleIDs  []uuid.UUID `json:"role_ids"`
+	Warnings []string    `json:"warnings,omitempty"`
+}
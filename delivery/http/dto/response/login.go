@@ -14,8 +14,9 @@ type LoginProductResponse struct {
 }
 
 type LoginTenantResponse struct {
-	TenantID uuid.UUID              `json:"tenant_id"`
-	Products []LoginProductResponse `json:"products,omitempty"`
+	TenantID          uuid.UUID              `json:"tenant_id"`
+	RegistrationTypes []string               `json:"registration_types,omitempty"`
+	Products          []LoginProductResponse `json:"products,omitempty"`
 }
 
 type LoginUserResponse struct {
@@ -26,11 +27,25 @@ type LoginUserResponse struct {
 }
 
 type VerifyLoginOTPResponse struct {
-	AccessToken  string            `json:"access_token"`
-	RefreshToken string            `json:"refresh_token"`
-	ExpiresIn    int               `json:"expires_in"`
-	TokenType    string            `json:"token_type"`
-	User         LoginUserResponse `json:"user"`
+	AccessToken            string            `json:"access_token"`
+	RefreshToken           string            `json:"refresh_token,omitempty"`
+	ExpiresIn              int               `json:"expires_in"`
+	TokenType              string            `json:"token_type"`
+	User                   LoginUserResponse `json:"user"`
+	PasswordChangeRequired bool              `json:"password_change_required,omitempty"`
+	PasswordChangeReason   string            `json:"password_change_reason,omitempty"`
+	ConsentRequired        bool              `json:"consent_required,omitempty"`
+	PendingConsents        []PendingConsent  `json:"pending_consents,omitempty"`
+}
+
+type PendingConsent struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty"`
+	DocumentType string     `json:"document_type"`
+	Version      string     `json:"version"`
+	Title        string     `json:"title"`
+	ContentURL   string     `json:"content_url"`
 }
 
 type UnifiedLoginResponse struct {
@@ -51,11 +66,15 @@ type UnifiedLoginResponse struct {
 }
 
 type RefreshTokenResponse struct {
-	AccessToken  string            `json:"access_token"`
-	RefreshToken string            `json:"refresh_token"`
-	ExpiresIn    int               `json:"expires_in"`
-	TokenType    string            `json:"token_type"`
-	User         LoginUserResponse `json:"user"`
+	AccessToken            string            `json:"access_token"`
+	RefreshToken           string            `json:"refresh_token,omitempty"`
+	ExpiresIn              int               `json:"expires_in"`
+	TokenType              string            `json:"token_type"`
+	User                   LoginUserResponse `json:"user"`
+	PasswordChangeRequired bool              `json:"password_change_required,omitempty"`
+	PasswordChangeReason   string            `json:"password_change_reason,omitempty"`
+	ConsentRequired        bool              `json:"consent_required,omitempty"`
+	PendingConsents        []PendingConsent  `json:"pending_consents,omitempty"`
 }
 
 type ResendLoginOTPResponse struct {
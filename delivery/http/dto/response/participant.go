@@ -11,6 +11,7 @@ type ParticipantResponse struct {
 	TenantID        uuid.UUID             `json:"tenant_id"`
 	ApplicationID   uuid.UUID             `json:"application_id"`
 	UserID          *uuid.UUID            `json:"user_id,omitempty"`
+	BranchID        *uuid.UUID            `json:"branch_id,omitempty"`
 	FullName        string                `json:"full_name"`
 	Gender          *string               `json:"gender,omitempty"`
 	PlaceOfBirth    *string               `json:"place_of_birth,omitempty"`
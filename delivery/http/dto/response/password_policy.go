@@ -0,0 +1,26 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordPolicyResponse struct {
+	ID               *uuid.UUID `json:"id,omitempty"`
+	TenantID         *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID        *uuid.UUID `json:"product_id,omitempty"`
+	Scope            string     `json:"scope"`
+	MinLength        int        `json:"min_length"`
+	MaxLength        int        `json:"max_length"`
+	RequireUppercase bool       `json:"require_uppercase"`
+	RequireLowercase bool       `json:"require_lowercase"`
+	RequireNumber    bool       `json:"require_number"`
+	RequireSpecial   bool       `json:"require_special"`
+	MaxAgeDays       int        `json:"max_age_days"`
+	HistoryDepth     int        `json:"history_depth"`
+	BannedWords      []string   `json:"banned_words"`
+	BreachCheck      string     `json:"breach_check"`
+	UpdatedBy        *uuid.UUID `json:"updated_by,omitempty"`
+	UpdatedAt        *time.Time `json:"updated_at,omitempty"`
+}
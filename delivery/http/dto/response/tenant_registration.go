@@ -0,0 +1,24 @@
+package response
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantRegistrationDetailResponse struct {
+	ID                   uuid.UUID  `json:"id"`
+	UserID               uuid.UUID  `json:"user_id"`
+	Email                string     `json:"email"`
+	TenantID             uuid.UUID  `json:"tenant_id"`
+	ProductID            *uuid.UUID `json:"product_id,omitempty"`
+	RegistrationType     string     `json:"registration_type"`
+	IdentificationNumber *string    `json:"identification_number,omitempty"`
+	Status               string     `json:"status"`
+	ApprovedBy           *uuid.UUID `json:"approved_by,omitempty"`
+	ApprovedAt           *time.Time `json:"approved_at,omitempty"`
+	GrantedRoleID        *uuid.UUID `json:"granted_role_id,omitempty"`
+	ParticipantID        *uuid.UUID `json:"participant_id,omitempty"`
+	ParticipantLink      string     `json:"participant_link,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+}
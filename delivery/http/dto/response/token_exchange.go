@@ -0,0 +1,11 @@
+package response
+
+type TokenExchangeResponse struct {
+	AccessToken     string   `json:"access_token"`
+	IssuedTokenType string   `json:"issued_token_type"`
+	TokenType       string   `json:"token_type"`
+	ExpiresIn       int      `json:"expires_in"`
+	Audience        []string `json:"audience"`
+	Roles           []string `json:"roles"`
+	Permissions     []string `json:"permissions"`
+}
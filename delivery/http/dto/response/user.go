@@ -7,17 +7,21 @@ import (
 )
 
 type UserResponse struct {
-	ID          uuid.UUID          `json:"id"`
-	Email       string             `json:"email"`
-	FirstName   string             `json:"first_name"`
-	LastName    string             `json:"last_name"`
-	FullName    string             `json:"full_name"`
-	PhoneNumber *string            `json:"phone_number,omitempty"`
-	DateOfBirth *string            `json:"date_of_birth,omitempty"`
-	Address     *string            `json:"address,omitempty"`
-	Status      string             `json:"status"`
-	IsActive    bool               `json:"is_active"`
-	Roles       []UserRoleResponse `json:"roles,omitempty"`
+	ID                uuid.UUID          `json:"id"`
+	Email             string             `json:"email"`
+	FirstName         string             `json:"first_name"`
+	LastName          string             `json:"last_name"`
+	FullName          string             `json:"full_name"`
+	PhoneNumber       *string            `json:"phone_number,omitempty"`
+	DateOfBirth       *string            `json:"date_of_birth,omitempty"`
+	Address           *string            `json:"address,omitempty"`
+	ProfilePictureURL *string            `json:"profile_picture_url,omitempty"`
+	ProfilePictures   map[string]string  `json:"profile_pictures,omitempty"`
+	Status            string             `json:"status"`
+	IsActive          bool               `json:"is_active"`
+	Roles             []UserRoleResponse `json:"roles,omitempty"`
+
+	Impersonation *ImpersonationBanner `json:"impersonation,omitempty"`
 }
 
 type UserRoleResponse struct {
@@ -40,6 +44,7 @@ type CreateUserResponse struct {
 	Email    string    `json:"email"`
 	FullName string    `json:"full_name"`
 	RoleCode string    `json:"role_code"`
+	Warnings []string  `json:"warnings,omitempty"`
 }
 
 type UpdateUserResponse struct {
@@ -66,3 +71,25 @@ type ResetUserPINResponse struct {
 	UserID  uuid.UUID `json:"user_id"`
 	Message string    `json:"message"`
 }
+
+type ResetUserPasswordResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Message  string    `json:"message"`
+	Warnings []string  `json:"warnings,omitempty"`
+}
+
+type ChangePasswordResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Warnings []string  `json:"warnings,omitempty"`
+}
+
+type ForcePasswordChangeResponse struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Message string    `json:"message"`
+}
+
+type ForceTenantPasswordChangeResponse struct {
+	TenantID      uuid.UUID `json:"tenant_id"`
+	AffectedUsers int64     `json:"affected_users"`
+	Message       string    `json:"message"`
+}
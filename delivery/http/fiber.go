@@ -11,9 +11,22 @@ import (
 	"iam-service/delivery/http/middleware"
 	"iam-service/delivery/http/router"
 	"iam-service/health"
+	"iam-service/iam/apikey"
 	"iam-service/iam/auth"
+	"iam-service/iam/authz"
+	"iam-service/iam/consent"
+	"iam-service/iam/datasubject"
+	"iam-service/iam/invitation"
+	"iam-service/iam/passwordpolicy"
+	"iam-service/iam/permission"
+	"iam-service/iam/policy"
+	"iam-service/iam/publickey"
 	"iam-service/iam/role"
+	"iam-service/iam/roleassignment"
+	"iam-service/iam/signingkey"
+	"iam-service/iam/tenantregistration"
 	"iam-service/iam/user"
+	"iam-service/impl/hashivault"
 	"iam-service/impl/mailer"
 	implminio "iam-service/impl/minio"
 	"iam-service/impl/postgres"
@@ -21,18 +34,28 @@ import (
 	"iam-service/infrastructure"
 	"iam-service/masterdata"
 	apperrors "iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
 	"iam-service/pkg/logger"
 	"iam-service/saving/participant"
 	"log"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
 )
 
+const (
+	signingKeyRotationCheckInterval = time.Minute
+	dataSubjectJobInterval          = time.Minute
+	roleAssignmentJobInterval       = 10 * time.Second
+)
+
 type Server struct {
 	app    *fiber.App
 	config *config.Config
 	logger *zap.Logger
+
+	stopBackgroundJobs context.CancelFunc
 }
 
 func NewServer(cfg *config.Config) *Server {
@@ -45,7 +68,7 @@ func NewServer(cfg *config.Config) *Server {
 		JSONEncoder:  json.Marshal,
 		JSONDecoder:  json.Unmarshal,
 		AppName:      cfg.App.Name,
-		BodyLimit:    256 * 1024,
+		BodyLimit:    middleware.UploadBodyLimit, // per-route limits are enforced by middleware.BodyLimit
 		ReadTimeout:  cfg.Server.ReadTimeout,
 		WriteTimeout: cfg.Server.WriteTimeout,
 		IdleTimeout:  cfg.Server.IdleTimeout,
@@ -63,6 +86,12 @@ func NewServer(cfg *config.Config) *Server {
 	}
 	inMemoryStore := implredis.NewRedis(redisClient)
 
+	keyRing, err := newJWTKeyRing(cfg, zapLogger)
+	if err != nil {
+		log.Fatal("failed to initialize jwt signing keys:", err)
+	}
+	cfg.JWT.KeyRing = keyRing
+
 	txManager := postgres.NewTransactionManager(postgresDB)
 
 	authUserRepo := postgres.NewUserRepository(postgresDB)
@@ -79,6 +108,22 @@ func NewServer(cfg *config.Config) *Server {
 	userSessionRepo := postgres.NewUserSessionRepository(postgresDB)
 	userTenantRegRepo := postgres.NewUserTenantRegistrationRepository(postgresDB)
 	productsByTenantRepo := postgres.NewProductsByTenantRepository(postgresDB)
+	productRegConfigRepo := postgres.NewProductRegistrationConfigRepository(postgresDB)
+	adminAPIKeyRepo := postgres.NewAdminAPIKeyRepository(postgresDB)
+	personalAccessTokenRepo := postgres.NewPersonalAccessTokenRepository(postgresDB)
+	adminAuditLogRepo := postgres.NewAdminAuditLogRepository(postgresDB)
+	passwordPolicyRepo := postgres.NewPasswordPolicyRepository(postgresDB)
+	passwordHistoryRepo := postgres.NewPasswordHistoryRepository(postgresDB)
+	invitationRepo := postgres.NewInvitationRepository(postgresDB)
+	branchRepo := postgres.NewBranchRepository(postgresDB)
+	dataSubjectRequestRepo := postgres.NewDataSubjectRequestRepository(postgresDB)
+	personalDataRepo := postgres.NewPersonalDataRepository(postgresDB)
+	consentDocumentRepo := postgres.NewConsentDocumentRepository(postgresDB)
+	userConsentRepo := postgres.NewUserConsentRepository(postgresDB)
+	roleAssignmentQueueRepo := postgres.NewRoleAssignmentQueueRepository(postgresDB)
+	authorizationPolicyRepo := postgres.NewAuthorizationPolicyRepository(postgresDB)
+	tenantSettingsRepo := postgres.NewTenantSettingsRepository(postgresDB)
+	permissionCheckRepo := postgres.NewPermissionCheckRepository(postgresDB)
 
 	masterdataCategoryRepo := postgres.NewMasterdataCategoryRepository(postgresDB)
 	masterdataItemRepo := postgres.NewMasterdataItemRepository(postgresDB)
@@ -100,6 +145,11 @@ func NewServer(cfg *config.Config) *Server {
 
 	emailService := mailer.NewEmailService(&cfg.Email)
 
+	breachChecker, err := infrastructure.NewBreachChecker(cfg.Password)
+	if err != nil {
+		log.Fatal("failed to load breached password dataset:", err)
+	}
+
 	healthUsecase := health.NewUsecase()
 	authUsecase := auth.NewUsecase(
 		txManager,
@@ -119,6 +169,15 @@ func NewServer(cfg *config.Config) *Server {
 		userSessionRepo,
 		userTenantRegRepo,
 		productsByTenantRepo,
+		productRegConfigRepo,
+		participantRepo,
+		personalAccessTokenRepo,
+		adminAuditLogRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		consentDocumentRepo,
+		userConsentRepo,
 		auditLogger,
 	)
 	roleUsecase := role.NewUsecase(
@@ -127,6 +186,19 @@ func NewServer(cfg *config.Config) *Server {
 		tenantRepo,
 		roleRepo,
 		rolePermissionRepo,
+		permissionRepo,
+		userRoleRepo,
+		productRepo,
+		adminAuditLogRepo,
+		inMemoryStore,
+	)
+	permissionUsecase := permission.NewUsecase(
+		txManager,
+		cfg,
+		permissionRepo,
+		productRepo,
+		rolePermissionRepo,
+		adminAuditLogRepo,
 	)
 	userUsecase := user.NewUsecase(
 		txManager,
@@ -138,6 +210,92 @@ func NewServer(cfg *config.Config) *Server {
 		tenantRepo,
 		roleRepo,
 		userRoleRepo,
+		userTenantRegRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		fileStorage,
+		refreshTokenRepo,
+		userSessionRepo,
+		personalAccessTokenRepo,
+		inMemoryStore,
+	)
+	apiKeyUsecase := apikey.NewUsecase(
+		cfg,
+		tenantRepo,
+		adminAPIKeyRepo,
+		auditLogger,
+	)
+	signingKeyUsecase := signingkey.NewUsecase(
+		cfg,
+		auditLogger,
+	)
+	passwordPolicyUsecase := passwordpolicy.NewUsecase(
+		cfg,
+		tenantRepo,
+		passwordPolicyRepo,
+		auditLogger,
+	)
+	invitationUsecase := invitation.NewUsecase(
+		txManager,
+		cfg,
+		invitationRepo,
+		tenantRepo,
+		branchRepo,
+		roleRepo,
+		authUserRepo,
+		userProfileRepo,
+		userAuthMethodRepo,
+		userSecurityStateRepo,
+		userRoleRepo,
+		userTenantRegRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		emailService,
+		auditLogger,
+	)
+	tenantRegistrationUsecase := tenantregistration.NewUsecase(
+		txManager,
+		cfg,
+		userTenantRegRepo,
+		productRegConfigRepo,
+		authUserRepo,
+		userRoleRepo,
+		participantRepo,
+		auditLogger,
+	)
+	roleAssignmentUsecase := roleassignment.NewUsecase(
+		txManager,
+		cfg,
+		userRoleRepo,
+		roleAssignmentQueueRepo,
+		roleRepo,
+		permissionRepo,
+		authUserRepo,
+		userTenantRegRepo,
+		productRepo,
+		branchRepo,
+		adminAuditLogRepo,
+		inMemoryStore,
+		auditLogger,
+	)
+	policyUsecase := policy.NewUsecase(
+		txManager,
+		cfg,
+		authorizationPolicyRepo,
+		tenantSettingsRepo,
+		adminAuditLogRepo,
+		auditLogger,
+	)
+	authzUsecase := authz.NewUsecase(
+		cfg,
+		userTenantRegRepo,
+		userRoleRepo,
+		roleRepo,
+		permissionRepo,
+		permissionCheckRepo,
+		policyUsecase,
 	)
 	masterdataUsecase := masterdata.NewUsecase(
 		cfg,
@@ -157,14 +315,46 @@ func NewServer(cfg *config.Config) *Server {
 		participantBeneficiaryRepo,
 		participantStatusHistoryRepo,
 		fileStorage,
+		userTenantRegRepo,
+		branchRepo,
+		policyUsecase,
+	)
+	dataSubjectUsecase := datasubject.NewUsecase(
+		txManager,
+		cfg,
+		dataSubjectRequestRepo,
+		personalDataRepo,
+		authUserRepo,
+		fileStorage,
+		auditLogger,
+	)
+	consentUsecase := consent.NewUsecase(
+		txManager,
+		cfg,
+		consentDocumentRepo,
+		userConsentRepo,
+		tenantRepo,
+		productRepo,
+		auditLogger,
 	)
 
 	healthController := controller.NewHealthController(cfg, healthUsecase)
 	authController := controller.NewRegistrationController(cfg, authUsecase)
 	roleController := controller.NewRoleController(cfg, roleUsecase)
+	permissionController := controller.NewPermissionController(cfg, permissionUsecase)
 	userController := controller.NewUserController(cfg, userUsecase)
+	apiKeyController := controller.NewAPIKeyController(cfg, apiKeyUsecase)
+	signingKeyController := controller.NewSigningKeyController(cfg, signingKeyUsecase)
+	passwordPolicyController := controller.NewPasswordPolicyController(cfg, passwordPolicyUsecase)
+	invitationController := controller.NewInvitationController(cfg, invitationUsecase)
+	tenantRegistrationController := controller.NewTenantRegistrationController(cfg, tenantRegistrationUsecase)
+	roleAssignmentController := controller.NewRoleAssignmentController(cfg, roleAssignmentUsecase)
+	policyController := controller.NewPolicyController(cfg, policyUsecase)
+	authzController := controller.NewAuthzController(cfg, authzUsecase)
 	masterdataController := controller.NewMasterdataController(cfg, masterdataUsecase)
 	participantController := controller.NewParticipantController(participantUsecase)
+	dataSubjectController := controller.NewDataSubjectController(cfg, dataSubjectUsecase)
+	consentController := controller.NewConsentController(cfg, consentUsecase)
 
 	server := &Server{
 		app:    app,
@@ -175,23 +365,148 @@ func NewServer(cfg *config.Config) *Server {
 	mw := middleware.New(cfg, zapLogger)
 	mw.Setup(app)
 
+	publickey.NewHandler(cfg).RegisterRoutes(app)
+
 	api := app.Group("/api")
 	v1 := api.Group("/v1")
 
+	tokenStore := middleware.WithPersonalAccessTokens(inMemoryStore, authUsecase)
+
 	router.SetupHealthRoutes(v1, healthController)
-	router.SetupMasterdataRoutes(v1, cfg, masterdataController, inMemoryStore)
+	router.SetupMasterdataRoutes(v1, cfg, masterdataController, tokenStore)
 
 	iam := v1.Group("/iam")
-	router.SetupAuthRoutes(iam, cfg, authController, inMemoryStore)
-	router.SetupRoleRoutes(iam, cfg, roleController, inMemoryStore)
-	router.SetupUserRoutes(iam, cfg, userController, inMemoryStore)
-
-	jwtMiddleware := middleware.JWTAuth(cfg, inMemoryStore)
-	router.SetupParticipantRoutes(iam, participantController, jwtMiddleware)
+	router.SetupAuthRoutes(iam, cfg, authController, tokenStore)
+	router.SetupRoleRoutes(iam, cfg, roleController, tokenStore)
+	router.SetupPermissionRoutes(iam, cfg, permissionController, tokenStore)
+	router.SetupUserRoutes(iam, cfg, userController, tokenStore)
+	router.SetupAPIKeyRoutes(iam, cfg, apiKeyController, tokenStore)
+	router.SetupSigningKeyRoutes(iam, cfg, signingKeyController, tokenStore)
+	router.SetupPasswordPolicyRoutes(iam, cfg, passwordPolicyController, tokenStore)
+	router.SetupInvitationRoutes(iam, cfg, invitationController, tokenStore)
+	router.SetupTenantRegistrationRoutes(iam, cfg, tenantRegistrationController, tokenStore)
+	router.SetupRoleAssignmentRoutes(iam, cfg, roleAssignmentController, tokenStore)
+	router.SetupPolicyRoutes(iam, cfg, policyController, tokenStore)
+	router.SetupAuthzRoutes(iam, cfg, authzController, middleware.APIKeyAuth(apiKeyUsecase), tokenStore)
+	router.SetupDataSubjectRoutes(iam, cfg, dataSubjectController, tokenStore)
+	router.SetupConsentRoutes(iam, cfg, consentController, tokenStore)
+
+	jwtMiddleware := middleware.JWTAuth(cfg, tokenStore)
+	router.SetupParticipantRoutes(iam, participantController, jwtMiddleware, policyUsecase)
+
+	jobsCtx, stopBackgroundJobs := context.WithCancel(context.Background())
+	server.stopBackgroundJobs = stopBackgroundJobs
+	if cfg.JWT.KeyRotationInterval > 0 {
+		go runSigningKeyRotation(jobsCtx, signingKeyUsecase, zapLogger)
+	}
+	go runDataSubjectJobs(jobsCtx, dataSubjectUsecase, zapLogger)
+	go runRoleAssignmentJobs(jobsCtx, roleAssignmentUsecase, zapLogger)
 
 	return server
 }
 
+// newJWTKeyRing signs through Vault Transit when configured. In development a
+// missing or unreachable Vault falls back to the local file keys.
+func newJWTKeyRing(cfg *config.Config, zapLogger *zap.Logger) (*jwtpkg.KeyRing, error) {
+	if !jwtpkg.IsAsymmetric(cfg.JWT.SigningMethod) || cfg.JWT.KeyBackend != config.JWTKeyBackendVaultTransit {
+		return infrastructure.NewJWTKeyRing(cfg.JWT)
+	}
+
+	keyRing, err := newTransitJWTKeyRing(cfg)
+	if err == nil {
+		return keyRing, nil
+	}
+	if !cfg.IsDevelopment() {
+		return nil, err
+	}
+
+	zapLogger.Warn("vault transit unavailable, falling back to file signing keys", zap.Error(err))
+	return infrastructure.NewJWTKeyRing(cfg.JWT)
+}
+
+func newTransitJWTKeyRing(cfg *config.Config) (*jwtpkg.KeyRing, error) {
+	vaultClient, err := infrastructure.NewVault(cfg.Infra.Vault)
+	if err != nil {
+		return nil, err
+	}
+
+	transitStore, err := hashivault.NewTransitKeyStore(hashivault.NewSecureVault(vaultClient), cfg.JWT.TransitKeyName, cfg.JWT.SigningMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return infrastructure.NewJWTKeyRingWithStore(cfg.JWT, transitStore)
+}
+
+func runSigningKeyRotation(ctx context.Context, signingKeyUsecase signingkey.Usecase, zapLogger *zap.Logger) {
+	ticker := time.NewTicker(signingKeyRotationCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rotated, err := signingKeyUsecase.RotateIfDue(ctx)
+			if err != nil {
+				zapLogger.Error("scheduled signing key rotation failed", zap.Error(err))
+				continue
+			}
+			if rotated {
+				zapLogger.Info("signing keys rotated")
+			}
+		}
+	}
+}
+
+// runDataSubjectJobs builds queued data exports and carries out erasures
+// whose grace period has ended.
+func runDataSubjectJobs(ctx context.Context, dataSubjectUsecase datasubject.Usecase, zapLogger *zap.Logger) {
+	ticker := time.NewTicker(dataSubjectJobInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			exported, err := dataSubjectUsecase.ProcessPendingExports(ctx)
+			if err != nil {
+				zapLogger.Error("data export processing failed", zap.Error(err))
+			} else if exported > 0 {
+				zapLogger.Info("data exports completed", zap.Int("count", exported))
+			}
+
+			erased, err := dataSubjectUsecase.ProcessDueErasures(ctx)
+			if err != nil {
+				zapLogger.Error("account erasure processing failed", zap.Error(err))
+			} else if erased > 0 {
+				zapLogger.Info("accounts erased", zap.Int("count", erased))
+			}
+		}
+	}
+}
+
+// runRoleAssignmentJobs applies role assignments queued by bulk grants.
+func runRoleAssignmentJobs(ctx context.Context, roleAssignmentUsecase roleassignment.Usecase, zapLogger *zap.Logger) {
+	ticker := time.NewTicker(roleAssignmentJobInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			assigned, err := roleAssignmentUsecase.ProcessPendingAssignments(ctx)
+			if err != nil {
+				zapLogger.Error("queued role assignment processing failed", zap.Error(err))
+			} else if assigned > 0 {
+				zapLogger.Info("queued role assignments applied", zap.Int("count", assigned))
+			}
+		}
+	}
+}
+
 func (s *Server) App() *fiber.App {
 	return s.app
 }
@@ -203,6 +518,9 @@ func (s *Server) Start() error {
 }
 
 func (s *Server) Shutdown(ctx context.Context) error {
+	if s.stopBackgroundJobs != nil {
+		s.stopBackgroundJobs()
+	}
 	return s.app.ShutdownWithContext(ctx)
 }
 
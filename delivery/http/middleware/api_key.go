@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net"
+
+	"iam-service/iam/apikey"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	APIKeyHeader = "X-API-Key"
+	APIKeyKey    = "api_key"
+)
+
+func APIKeyAuth(apiKeyUsecase apikey.Usecase) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		rawKey := c.Get(APIKeyHeader)
+		if rawKey == "" {
+			appErr := errors.ErrUnauthorized("missing api key header")
+			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+				"success": false,
+				"error":   appErr.Message,
+				"code":    appErr.Code,
+			})
+		}
+
+		clientIP, _ := c.Locals(ClientIPKey).(net.IP)
+		if clientIP == nil {
+			clientIP = extractClientIP(c)
+		}
+
+		key, err := apiKeyUsecase.Authenticate(c.UserContext(), rawKey, clientIP)
+		if err != nil {
+			var appErr *errors.AppError
+			if !errors.As(err, &appErr) || appErr.HTTPStatus >= fiber.StatusInternalServerError {
+				appErr = errors.ErrUnauthorized("invalid api key")
+			}
+			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+				"success": false,
+				"error":   appErr.Message,
+				"code":    appErr.Code,
+			})
+		}
+
+		c.Locals(APIKeyKey, key)
+		c.Locals("tenant_id", key.TenantID)
+
+		return c.Next()
+	}
+}
+
+func GetAPIKey(c *fiber.Ctx) (*apikeydto.AuthenticatedKey, error) {
+	key, ok := c.Locals(APIKeyKey).(*apikeydto.AuthenticatedKey)
+	if !ok || key == nil {
+		return nil, errors.ErrUnauthorized("api key not found in context")
+	}
+	return key, nil
+}
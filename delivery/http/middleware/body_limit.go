@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"iam-service/pkg/errors"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// DefaultBodyLimit applies to every route that does not accept file uploads.
+	DefaultBodyLimit = 256 * 1024
+	// UploadBodyLimit fits a 5MB file plus multipart overhead. fasthttp rejects
+	// bodies above fiber.Config.BodyLimit before routing, so the server-wide
+	// limit is set to this value and DefaultBodyLimit is enforced here.
+	UploadBodyLimit = 6 * 1024 * 1024
+)
+
+// uploadRoutes enforce UploadBodyLimit on the route itself and are skipped by
+// the default limit registered in Setup.
+var uploadRoutes = []string{
+	"PUT /api/v1/iam/users/me/avatar",
+	"POST /api/v1/iam/participants/:id/files",
+}
+
+// BodyLimit rejects requests whose body is larger than limit. Requests that
+// match one of the exempt "METHOD /path/:param" patterns are passed through.
+func BodyLimit(limit int, exempt ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		for _, route := range exempt {
+			if matchRoute(route, c.Method(), c.Path()) {
+				return c.Next()
+			}
+		}
+
+		if len(c.Body()) > limit {
+			return errors.New(errors.CodeBadRequest,
+				fmt.Sprintf("request body exceeds %d bytes", limit),
+				http.StatusRequestEntityTooLarge)
+		}
+
+		return c.Next()
+	}
+}
+
+func matchRoute(route, method, path string) bool {
+	routeMethod, routePath, ok := strings.Cut(route, " ")
+	if !ok || routeMethod != method {
+		return false
+	}
+
+	want := strings.Split(strings.Trim(routePath, "/"), "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(want) != len(got) {
+		return false
+	}
+	for i := range want {
+		if strings.HasPrefix(want[i], ":") {
+			if got[i] == "" {
+				return false
+			}
+			continue
+		}
+		if want[i] != got[i] {
+			return false
+		}
+	}
+	return true
+}
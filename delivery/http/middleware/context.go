@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 	jwtpkg "iam-service/pkg/jwt"
 	"net"
@@ -12,6 +14,8 @@ import (
 const (
 	UserClaimsKey         = "user_claims"
 	MultiTenantClaimsKey  = "multi_tenant_claims"
+	AccessScopeKey        = "access_scope"
+	PolicySubjectKey      = "policy_subject"
 )
 
 func GetUserClaims(c *fiber.Ctx) (*jwtpkg.JWTClaims, error) {
@@ -42,6 +46,21 @@ func GetMultiTenantClaims(c *fiber.Ctx) (*jwtpkg.MultiTenantClaims, error) {
 	return multiClaims, nil
 }
 
+// GetAccessScope returns the scope resolved by RequireTenantPermission for the
+// current route, or nil when the route is not permission guarded.
+func GetAccessScope(c *fiber.Ctx) *entity.AccessScope {
+	scope, _ := c.Locals(AccessScopeKey).(*entity.AccessScope)
+	return scope
+}
+
+// GetPolicySubject returns the subject attributes EnforcePolicies evaluated
+// for the current route, so usecases can check resource-bound policies
+// against the same subject.
+func GetPolicySubject(c *fiber.Ctx) abac.Attributes {
+	subject, _ := c.Locals(PolicySubjectKey).(abac.Attributes)
+	return subject
+}
+
 func GetUserID(c *fiber.Ctx) (uuid.UUID, error) {
 	claims, err := GetUserClaims(c)
 	if err != nil {
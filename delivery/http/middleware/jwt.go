@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"iam-service/config"
+	"iam-service/iam/auth"
 	"iam-service/iam/auth/contract"
 	"iam-service/pkg/errors"
 	jwtpkg "iam-service/pkg/jwt"
@@ -10,28 +11,74 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+const (
+	TokenTypeKey                 = "token_type"
+	TokenTypePersonalAccessToken = "personal_access_token"
+	TokenTypePasswordChange      = "password_change"
+	TokenTypeConsent             = "consent"
+)
+
+type personalAccessTokenStore struct {
+	contract.TokenBlacklistStore
+	contract.PersonalAccessTokenAuthenticator
+}
+
+// WithPersonalAccessTokens lets JWTAuth accept personal access tokens in the
+// Authorization header in addition to signed JWTs.
+func WithPersonalAccessTokens(store contract.TokenBlacklistStore, authenticator contract.PersonalAccessTokenAuthenticator) contract.TokenBlacklistStore {
+	return &personalAccessTokenStore{
+		TokenBlacklistStore:              store,
+		PersonalAccessTokenAuthenticator: authenticator,
+	}
+}
+
 func JWTAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore) fiber.Handler {
+	return jwtAuth(cfg, "", blacklistStore...)
+}
+
+// PasswordChangeAuth is JWTAuth that also accepts the restricted token issued
+// at login when the user's password has expired or an admin forced a change.
+// Every other route rejects that token.
+func PasswordChangeAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore) fiber.Handler {
+	return jwtAuth(cfg, jwtpkg.ScopePasswordChange, blacklistStore...)
+}
+
+// ConsentAuth is JWTAuth that also accepts the restricted token issued at
+// login when the user still has to accept a newly published terms or privacy
+// version.
+func ConsentAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore) fiber.Handler {
+	return jwtAuth(cfg, jwtpkg.ScopeConsent, blacklistStore...)
+}
+
+// jwtAuth validates the bearer token. Restricted tokens are only let through
+// when their scope matches allowedScope.
+func jwtAuth(cfg *config.Config, allowedScope string, blacklistStore ...contract.TokenBlacklistStore) fiber.Handler {
 	tokenConfig := &jwtpkg.TokenConfig{
 		AccessSecret:  cfg.JWT.AccessSecret,
 		RefreshSecret: cfg.JWT.RefreshSecret,
 		AccessExpiry:  cfg.JWT.AccessExpiry,
 		RefreshExpiry: cfg.JWT.RefreshExpiry,
 		Issuer:        cfg.JWT.Issuer,
+		KeyRing:       cfg.JWT.KeyRing,
 	}
 
-	if cfg.JWT.SigningMethod == "RS256" {
-		if privateKey, err := jwtpkg.LoadPrivateKeyFromFile(cfg.JWT.PrivateKeyPath); err == nil {
-			tokenConfig.PrivateKey = privateKey
-		}
-		if publicKey, err := jwtpkg.LoadPublicKeyFromFile(cfg.JWT.PublicKeyPath); err == nil {
-			tokenConfig.PublicKey = publicKey
+	if jwtpkg.IsAsymmetric(cfg.JWT.SigningMethod) {
+		if tokenConfig.KeyRing == nil {
+			if privateKey, err := jwtpkg.LoadPrivateKeyFromFile(cfg.JWT.PrivateKeyPath); err == nil {
+				tokenConfig.PrivateKey = privateKey
+			}
+			if publicKey, err := jwtpkg.LoadPublicKeyFromFile(cfg.JWT.PublicKeyPath); err == nil {
+				tokenConfig.PublicKey = publicKey
+			}
 		}
-		tokenConfig.SigningMethod = "RS256"
+		tokenConfig.SigningMethod = cfg.JWT.SigningMethod
 	}
 
 	var store contract.TokenBlacklistStore
+	var patAuthenticator contract.PersonalAccessTokenAuthenticator
 	if len(blacklistStore) > 0 {
 		store = blacklistStore[0]
+		patAuthenticator, _ = store.(contract.PersonalAccessTokenAuthenticator)
 	}
 
 	return func(c *fiber.Ctx) error {
@@ -58,12 +105,46 @@ func JWTAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore)
 
 		tokenString := parts[1]
 
+		if patAuthenticator != nil && strings.HasPrefix(tokenString, auth.PersonalAccessTokenPrefix) {
+			clientIP := ""
+			if ip := extractClientIP(c); ip != nil {
+				clientIP = ip.String()
+			}
+
+			patClaims, err := patAuthenticator.AuthenticatePersonalAccessToken(c.UserContext(), tokenString, clientIP)
+			if err != nil {
+				var appErr *errors.AppError
+				if !errors.As(err, &appErr) || appErr.HTTPStatus >= fiber.StatusInternalServerError {
+					appErr = errors.ErrTokenInvalid()
+				}
+				return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+					"success": false,
+					"error":   appErr.Message,
+					"code":    appErr.Code,
+				})
+			}
+
+			c.Locals(UserClaimsKey, &jwtpkg.JWTClaims{
+				UserID:           patClaims.UserID,
+				Email:            patClaims.Email,
+				RegisteredClaims: patClaims.RegisteredClaims,
+			})
+			c.Locals(MultiTenantClaimsKey, patClaims)
+			c.Locals(TokenTypeKey, TokenTypePersonalAccessToken)
+
+			c.Locals("userID", patClaims.UserID.String())
+			c.Locals("jti", patClaims.RegisteredClaims.ID)
+
+			return c.Next()
+		}
+
 		multiClaims, multiErr := jwtpkg.ParseMultiTenantAccessToken(tokenString, tokenConfig)
 		if multiErr == nil && len(multiClaims.Tenants) > 0 {
 			legacyClaims := &jwtpkg.JWTClaims{
 				UserID:           multiClaims.UserID,
 				Email:            multiClaims.Email,
 				SessionID:        multiClaims.SessionID,
+				Actor:            multiClaims.Actor,
 				RegisteredClaims: multiClaims.RegisteredClaims,
 			}
 			c.Locals(UserClaimsKey, legacyClaims)
@@ -100,6 +181,29 @@ func JWTAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore)
 			})
 		}
 
+		// Tokens minted by the token exchange endpoint are audience-restricted
+		// to a downstream service and must not be accepted by IAM itself.
+		if !hasAnyAudience(claims.Audience, cfg.JWT.Audience) {
+			appErr := errors.ErrTokenInvalid()
+			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+				"success": false,
+				"error":   appErr.Message,
+				"code":    appErr.Code,
+			})
+		}
+
+		if claims.IsRestricted() {
+			if claims.Scope != allowedScope {
+				appErr := restrictedTokenError(claims)
+				return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+					"success": false,
+					"error":   appErr.Message,
+					"code":    appErr.Code,
+				})
+			}
+			c.Locals(TokenTypeKey, claims.Scope)
+		}
+
 		c.Locals(UserClaimsKey, claims)
 
 		c.Locals("userID", claims.UserID.String())
@@ -114,3 +218,55 @@ func JWTAuth(cfg *config.Config, blacklistStore ...contract.TokenBlacklistStore)
 		return c.Next()
 	}
 }
+
+func restrictedTokenError(claims *jwtpkg.JWTClaims) *errors.AppError {
+	if claims.IsConsentOnly() {
+		return errors.New("CONSENT_REQUIRED", "consent to the current terms is required before accessing this resource", fiber.StatusForbidden)
+	}
+	return errors.New("PASSWORD_CHANGE_REQUIRED", "password change required before accessing this resource", fiber.StatusForbidden)
+}
+
+func RejectPersonalAccessToken() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if tokenType, _ := c.Locals(TokenTypeKey).(string); tokenType == TokenTypePersonalAccessToken {
+			appErr := errors.ErrForbidden("this operation requires an interactive session")
+			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+				"success": false,
+				"error":   appErr.Message,
+				"code":    appErr.Code,
+			})
+		}
+		return c.Next()
+	}
+}
+
+// RejectImpersonation blocks sensitive operations (credential management,
+// admin actions, starting another impersonation) while an admin is acting as
+// another user.
+func RejectImpersonation() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if claims, err := GetUserClaims(c); err == nil && claims.IsImpersonated() {
+			appErr := errors.ErrForbidden("this operation is not allowed while impersonating a user")
+			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
+				"success": false,
+				"error":   appErr.Message,
+				"code":    appErr.Code,
+			})
+		}
+		return c.Next()
+	}
+}
+
+func hasAnyAudience(tokenAudience []string, accepted []string) bool {
+	if len(accepted) == 0 {
+		return true
+	}
+	for _, aud := range tokenAudience {
+		for _, allowed := range accepted {
+			if aud == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -59,6 +59,8 @@ func (m *Middleware) Setup(app *fiber.App) {
 		},
 	}))
 
+	app.Use(BodyLimit(DefaultBodyLimit, uploadRoutes...))
+
 	app.Use(RequestContext())
 
 	app.Use(RequestLogger(m.logger))
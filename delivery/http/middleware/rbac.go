@@ -1,9 +1,14 @@
 package middleware
 
 import (
+	"slices"
+
+	"iam-service/entity"
 	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 func RequirePlatformAdmin() fiber.Handler {
@@ -173,19 +178,7 @@ func RequireTenantPermission(permissionCode string) fiber.Handler {
 			})
 		}
 
-		hasPermission := false
-		for _, product := range tenantClaim.Products {
-			for _, perm := range product.Permissions {
-				if perm == permissionCode {
-					hasPermission = true
-					break
-				}
-			}
-			if hasPermission {
-				break
-			}
-		}
-
+		scope, hasPermission := resolveAccessScope(multiClaims.UserID, tenantClaim, permissionCode)
 		if !hasPermission {
 			appErr := errors.ErrAccessForbidden("insufficient permissions for this operation")
 			return c.Status(appErr.HTTPStatus).JSON(fiber.Map{
@@ -195,6 +188,30 @@ func RequireTenantPermission(permissionCode string) fiber.Handler {
 			})
 		}
 
+		c.Locals(AccessScopeKey, scope)
 		return c.Next()
 	}
 }
+
+// resolveAccessScope merges the grants of permissionCode across the tenant's
+// products. A grant without a scope entry is tenant wide.
+func resolveAccessScope(userID uuid.UUID, tenantClaim *jwtpkg.TenantClaim, permissionCode string) (*entity.AccessScope, bool) {
+	var scope *entity.AccessScope
+	for _, product := range tenantClaim.Products {
+		if !slices.Contains(product.Permissions, permissionCode) {
+			continue
+		}
+
+		granted, ok := product.Scopes[permissionCode]
+		if !ok {
+			scope = entity.WidenScope(scope, userID, entity.ScopeLevelTenant, nil)
+			continue
+		}
+		level := entity.ScopeLevel(granted.Level)
+		scope = entity.WidenScope(scope, userID, level, nil)
+		for i := range granted.BranchIDs {
+			scope = entity.WidenScope(scope, userID, level, &granted.BranchIDs[i])
+		}
+	}
+	return scope, scope != nil
+}
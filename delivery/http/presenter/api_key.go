@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/apikey/apikeydto"
+)
+
+func ToAPIKeyResponse(resp *apikeydto.APIKeyResponse) *response.APIKeyResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.APIKeyResponse{
+		ID:          resp.ID,
+		TenantID:    resp.TenantID,
+		KeyName:     resp.KeyName,
+		KeyPrefix:   resp.KeyPrefix,
+		IPWhitelist: resp.IPWhitelist,
+		IsActive:    resp.IsActive,
+		ExpiresAt:   resp.ExpiresAt,
+		RevokedAt:   resp.RevokedAt,
+		LastUsedAt:  resp.LastUsedAt,
+		LastUsedIP:  resp.LastUsedIP,
+		CreatedBy:   resp.CreatedBy,
+		CreatedAt:   resp.CreatedAt,
+	}
+}
+
+func ToAPIKeyListResponse(items []apikeydto.APIKeyResponse) []*response.APIKeyResponse {
+	result := make([]*response.APIKeyResponse, len(items))
+	for i := range items {
+		result[i] = ToAPIKeyResponse(&items[i])
+	}
+	return result
+}
+
+func ToCreateAPIKeyResponse(resp *apikeydto.CreateResponse) *response.CreateAPIKeyResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.CreateAPIKeyResponse{
+		APIKeyResponse: *ToAPIKeyResponse(&resp.APIKeyResponse),
+		Key:            resp.Key,
+		Warning:        "Store this key securely. It will not be shown again.",
+	}
+}
@@ -80,6 +80,7 @@ func ToSetPasswordResponse(resp *authdto.SetPasswordResponse) *response.SetPassw
 			Endpoint:       resp.NextStep.Endpoint,
 			RequiredFields: resp.NextStep.RequiredFields,
 		},
+		Warnings: resp.Warnings,
 	}
 }
 
@@ -96,10 +97,11 @@ func ToCompleteProfileRegistrationResponse(resp *authdto.CompleteProfileRegistra
 			FirstName: resp.Profile.FirstName,
 			LastName:  resp.Profile.LastName,
 		},
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
-		TokenType:    resp.TokenType,
-		ExpiresIn:    resp.ExpiresIn,
+		TenantRegistration: toTenantRegistrationResponse(resp.TenantRegistration),
+		AccessToken:        resp.AccessToken,
+		RefreshToken:       resp.RefreshToken,
+		TokenType:          resp.TokenType,
+		ExpiresIn:          resp.ExpiresIn,
 	}
 }
 
@@ -116,9 +118,26 @@ func ToCompleteRegistrationResponse(resp *authdto.CompleteRegistrationResponse)
 			FirstName: resp.Profile.FirstName,
 			LastName:  resp.Profile.LastName,
 		},
-		AccessToken:  resp.AccessToken,
-		RefreshToken: resp.RefreshToken,
-		TokenType:    resp.TokenType,
-		ExpiresIn:    resp.ExpiresIn,
+		TenantRegistration: toTenantRegistrationResponse(resp.TenantRegistration),
+		AccessToken:        resp.AccessToken,
+		RefreshToken:       resp.RefreshToken,
+		TokenType:          resp.TokenType,
+		ExpiresIn:          resp.ExpiresIn,
+	}
+}
+
+func toTenantRegistrationResponse(resp *authdto.TenantRegistrationResponse) *response.TenantRegistrationResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.TenantRegistrationResponse{
+		ID:               resp.ID,
+		TenantID:         resp.TenantID,
+		ProductID:        resp.ProductID,
+		RegistrationType: resp.RegistrationType,
+		Status:           resp.Status,
+		GrantedRoleID:    resp.GrantedRoleID,
+		ParticipantID:    resp.ParticipantID,
+		ParticipantLink:  resp.ParticipantLink,
 	}
 }
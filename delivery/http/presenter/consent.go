@@ -0,0 +1,93 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/consent/consentdto"
+)
+
+func ToConsentDocumentResponse(resp *consentdto.DocumentResponse) *response.ConsentDocumentResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.ConsentDocumentResponse{
+		ID:           resp.ID,
+		TenantID:     resp.TenantID,
+		ProductID:    resp.ProductID,
+		DocumentType: resp.DocumentType,
+		Version:      resp.Version,
+		Title:        resp.Title,
+		ContentURL:   resp.ContentURL,
+		Summary:      resp.Summary,
+		IsRequired:   resp.IsRequired,
+		Status:       resp.Status,
+		PublishedAt:  resp.PublishedAt,
+		PublishedBy:  resp.PublishedBy,
+		CreatedBy:    resp.CreatedBy,
+		CreatedAt:    resp.CreatedAt,
+		UpdatedAt:    resp.UpdatedAt,
+	}
+}
+
+func ToConsentDocumentListResponse(items []consentdto.DocumentResponse) []*response.ConsentDocumentResponse {
+	result := make([]*response.ConsentDocumentResponse, len(items))
+	for i := range items {
+		result[i] = ToConsentDocumentResponse(&items[i])
+	}
+	return result
+}
+
+func ToMyConsentsResponse(resp *consentdto.MyConsentsResponse) *response.MyConsentsResponse {
+	if resp == nil {
+		return nil
+	}
+	documents := make([]*response.UserConsentStatusResponse, len(resp.Documents))
+	for i := range resp.Documents {
+		documents[i] = &response.UserConsentStatusResponse{
+			Document:   ToConsentDocumentResponse(&resp.Documents[i].Document),
+			Accepted:   resp.Documents[i].Accepted,
+			AcceptedAt: resp.Documents[i].AcceptedAt,
+		}
+	}
+	return &response.MyConsentsResponse{
+		Documents:       documents,
+		PendingRequired: resp.PendingRequired,
+	}
+}
+
+func ToConsentReportResponse(resp *consentdto.ReportResponse) *response.ConsentReportResponse {
+	if resp == nil {
+		return nil
+	}
+	documents := make([]*response.ConsentDocumentReportResponse, len(resp.Documents))
+	for i := range resp.Documents {
+		documents[i] = &response.ConsentDocumentReportResponse{
+			Document:      ToConsentDocumentResponse(&resp.Documents[i].Document),
+			TotalUsers:    resp.Documents[i].TotalUsers,
+			AcceptedUsers: resp.Documents[i].AcceptedUsers,
+			PendingUsers:  resp.Documents[i].PendingUsers,
+		}
+	}
+	return &response.ConsentReportResponse{
+		TenantID:  resp.TenantID,
+		Documents: documents,
+	}
+}
+
+func ToConsentAudienceResponse(resp *consentdto.AudienceListResponse) *response.ConsentAudienceResponse {
+	if resp == nil {
+		return nil
+	}
+	members := make([]*response.ConsentAudienceMemberResponse, len(resp.Members))
+	for i, member := range resp.Members {
+		members[i] = &response.ConsentAudienceMemberResponse{
+			UserID:     member.UserID,
+			Email:      member.Email,
+			Accepted:   member.Accepted,
+			AcceptedAt: member.AcceptedAt,
+		}
+	}
+	return &response.ConsentAudienceResponse{
+		Document: ToConsentDocumentResponse(&resp.Document),
+		Members:  members,
+	}
+}
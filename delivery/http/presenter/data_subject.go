@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/datasubject/datasubjectdto"
+)
+
+func ToDataSubjectRequestResponse(resp *datasubjectdto.RequestResponse) *response.DataSubjectRequestResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.DataSubjectRequestResponse{
+		ID:            resp.ID,
+		UserID:        resp.UserID,
+		Type:          resp.Type,
+		Status:        resp.Status,
+		Reason:        resp.Reason,
+		ExpiresAt:     resp.ExpiresAt,
+		ScheduledFor:  resp.ScheduledFor,
+		ReviewedBy:    resp.ReviewedBy,
+		ReviewedAt:    resp.ReviewedAt,
+		ReviewNote:    resp.ReviewNote,
+		FailureReason: resp.FailureReason,
+		CompletedAt:   resp.CompletedAt,
+		CreatedAt:     resp.CreatedAt,
+	}
+}
+
+func ToDataSubjectRequestListResponse(items []datasubjectdto.RequestResponse) []*response.DataSubjectRequestResponse {
+	result := make([]*response.DataSubjectRequestResponse, len(items))
+	for i := range items {
+		result[i] = ToDataSubjectRequestResponse(&items[i])
+	}
+	return result
+}
+
+func ToDataExportDownloadResponse(resp *datasubjectdto.DownloadResponse) *response.DataExportDownloadResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.DataExportDownloadResponse{
+		URL:       resp.URL,
+		ExpiresAt: resp.ExpiresAt,
+	}
+}
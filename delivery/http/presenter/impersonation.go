@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/auth/authdto"
+	jwtpkg "iam-service/pkg/jwt"
+)
+
+func ToStartImpersonationResponse(resp *authdto.StartImpersonationResponse) *response.StartImpersonationResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.StartImpersonationResponse{
+		AccessToken: resp.AccessToken,
+		TokenType:   resp.TokenType,
+		ExpiresIn:   resp.ExpiresIn,
+		ExpiresAt:   resp.ExpiresAt,
+		SessionID:   resp.SessionID,
+		UserID:      resp.UserID,
+		Email:       resp.Email,
+		ActorID:     resp.ActorID,
+	}
+}
+
+func ToImpersonationBanner(claims *jwtpkg.JWTClaims) *response.ImpersonationBanner {
+	if claims == nil || !claims.IsImpersonated() {
+		return nil
+	}
+	banner := &response.ImpersonationBanner{
+		Active:     true,
+		ActorID:    claims.Actor.Subject,
+		ActorEmail: claims.Actor.Email,
+	}
+	if claims.ExpiresAt != nil {
+		banner.ExpiresAt = &claims.ExpiresAt.Time
+	}
+	return banner
+}
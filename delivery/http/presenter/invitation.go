@@ -0,0 +1,48 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/invitation/invitationdto"
+)
+
+func ToInvitationResponse(resp *invitationdto.InvitationResponse) *response.InvitationResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.InvitationResponse{
+		ID:          resp.ID,
+		TenantID:    resp.TenantID,
+		BranchID:    resp.BranchID,
+		Email:       resp.Email,
+		RoleIDs:     resp.RoleIDs,
+		Status:      resp.Status,
+		ExpiresAt:   resp.ExpiresAt,
+		ResendCount: resp.ResendCount,
+		LastSentAt:  resp.LastSentAt,
+		AcceptedAt:  resp.AcceptedAt,
+		RevokedAt:   resp.RevokedAt,
+		InvitedBy:   resp.InvitedBy,
+		CreatedAt:   resp.CreatedAt,
+	}
+}
+
+func ToInvitationListResponse(items []invitationdto.InvitationResponse) []*response.InvitationResponse {
+	result := make([]*response.InvitationResponse, len(items))
+	for i := range items {
+		result[i] = ToInvitationResponse(&items[i])
+	}
+	return result
+}
+
+func ToAcceptInvitationResponse(resp *invitationdto.AcceptResponse) *response.AcceptInvitationResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.AcceptInvitationResponse{
+		UserID:   resp.UserID,
+		Email:    resp.Email,
+		TenantID: resp.TenantID,
+		RoleIDs:  resp.RoleIDs,
+		Warnings: resp.Warnings,
+	}
+}
@@ -41,9 +41,33 @@ func ToVerifyLoginOTPResponse(resp *authdto.VerifyLoginOTPResponse) *response.Ve
 		ExpiresIn:    resp.ExpiresIn,
 		TokenType:    resp.TokenType,
 		User:         *toLoginUserResponse(&resp.User),
+
+		PasswordChangeRequired: resp.PasswordChangeRequired,
+		PasswordChangeReason:   resp.PasswordChangeReason,
+		ConsentRequired:        resp.ConsentRequired,
+		PendingConsents:        toPendingConsents(resp.PendingConsents),
 	}
 }
 
+func toPendingConsents(items []authdto.PendingConsentResponse) []response.PendingConsent {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]response.PendingConsent, len(items))
+	for i, item := range items {
+		result[i] = response.PendingConsent{
+			ID:           item.ID,
+			TenantID:     item.TenantID,
+			ProductID:    item.ProductID,
+			DocumentType: item.DocumentType,
+			Version:      item.Version,
+			Title:        item.Title,
+			ContentURL:   item.ContentURL,
+		}
+	}
+	return result
+}
+
 func ToResendLoginOTPResponse2(resp *authdto.ResendLoginOTPResponse) *response.ResendLoginOTPResponse {
 	if resp == nil {
 		return nil
@@ -83,6 +107,11 @@ func ToRefreshTokenResponse(resp *authdto.RefreshTokenResponse) *response.Refres
 		ExpiresIn:    resp.ExpiresIn,
 		TokenType:    resp.TokenType,
 		User:         *toLoginUserResponse(&resp.User),
+
+		PasswordChangeRequired: resp.PasswordChangeRequired,
+		PasswordChangeReason:   resp.PasswordChangeReason,
+		ConsentRequired:        resp.ConsentRequired,
+		PendingConsents:        toPendingConsents(resp.PendingConsents),
 	}
 }
 
@@ -99,7 +128,8 @@ func toLoginUserResponse(user *authdto.LoginUserResponse) *response.LoginUserRes
 
 	for _, t := range user.Tenants {
 		tenant := response.LoginTenantResponse{
-			TenantID: t.TenantID,
+			TenantID:          t.TenantID,
+			RegistrationTypes: t.RegistrationTypes,
 		}
 		for _, p := range t.Products {
 			tenant.Products = append(tenant.Products, response.LoginProductResponse{
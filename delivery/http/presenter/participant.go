@@ -121,6 +121,7 @@ func MapParticipantResponse(dto *participantdto.ParticipantResponse) response.Pa
 		TenantID:        dto.TenantID,
 		ApplicationID:   dto.ApplicationID,
 		UserID:          dto.UserID,
+		BranchID:        dto.BranchID,
 		FullName:        dto.FullName,
 		Gender:          dto.Gender,
 		PlaceOfBirth:    dto.PlaceOfBirth,
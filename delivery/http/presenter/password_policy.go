@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+)
+
+func ToPasswordPolicyResponse(resp *passwordpolicydto.PasswordPolicyResponse) *response.PasswordPolicyResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.PasswordPolicyResponse{
+		ID:               resp.ID,
+		TenantID:         resp.TenantID,
+		ProductID:        resp.ProductID,
+		Scope:            resp.Scope,
+		MinLength:        resp.MinLength,
+		MaxLength:        resp.MaxLength,
+		RequireUppercase: resp.RequireUppercase,
+		RequireLowercase: resp.RequireLowercase,
+		RequireNumber:    resp.RequireNumber,
+		RequireSpecial:   resp.RequireSpecial,
+		MaxAgeDays:       resp.MaxAgeDays,
+		HistoryDepth:     resp.HistoryDepth,
+		BannedWords:      resp.BannedWords,
+		BreachCheck:      resp.BreachCheck,
+		UpdatedBy:        resp.UpdatedBy,
+		UpdatedAt:        resp.UpdatedAt,
+	}
+}
+
+func ToPasswordPolicyListResponse(items []passwordpolicydto.PasswordPolicyResponse) []*response.PasswordPolicyResponse {
+	result := make([]*response.PasswordPolicyResponse, len(items))
+	for i := range items {
+		result[i] = ToPasswordPolicyResponse(&items[i])
+	}
+	return result
+}
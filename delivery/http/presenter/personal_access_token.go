@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/auth/authdto"
+)
+
+func ToPersonalAccessTokenResponse(resp *authdto.PersonalAccessTokenResponse) *response.PersonalAccessTokenResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.PersonalAccessTokenResponse{
+		ID:          resp.ID,
+		TenantID:    resp.TenantID,
+		Name:        resp.Name,
+		TokenPrefix: resp.TokenPrefix,
+		Scopes:      resp.Scopes,
+		ExpiresAt:   resp.ExpiresAt,
+		LastUsedAt:  resp.LastUsedAt,
+		LastUsedIP:  resp.LastUsedIP,
+		RevokedAt:   resp.RevokedAt,
+		IsActive:    resp.IsActive,
+		CreatedAt:   resp.CreatedAt,
+	}
+}
+
+func ToPersonalAccessTokenListResponse(items []authdto.PersonalAccessTokenResponse) []*response.PersonalAccessTokenResponse {
+	result := make([]*response.PersonalAccessTokenResponse, len(items))
+	for i := range items {
+		result[i] = ToPersonalAccessTokenResponse(&items[i])
+	}
+	return result
+}
+
+func ToCreatePersonalAccessTokenResponse(resp *authdto.CreatePersonalAccessTokenResponse) *response.CreatePersonalAccessTokenResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: *ToPersonalAccessTokenResponse(&resp.PersonalAccessTokenResponse),
+		Token:                       resp.Token,
+		Warning:                     "Store this token securely. It will not be shown again.",
+	}
+}
@@ -0,0 +1,39 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/signingkey/signingkeydto"
+)
+
+func ToSigningKeyResponse(resp *signingkeydto.SigningKeyResponse) *response.SigningKeyResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.SigningKeyResponse{
+		Kid:         resp.Kid,
+		Algorithm:   resp.Algorithm,
+		Status:      resp.Status,
+		Published:   resp.Published,
+		CreatedAt:   resp.CreatedAt,
+		ActivatedAt: resp.ActivatedAt,
+		RetiredAt:   resp.RetiredAt,
+	}
+}
+
+func ToSigningKeyListResponse(items []signingkeydto.SigningKeyResponse) []*response.SigningKeyResponse {
+	result := make([]*response.SigningKeyResponse, len(items))
+	for i := range items {
+		result[i] = ToSigningKeyResponse(&items[i])
+	}
+	return result
+}
+
+func ToRotateSigningKeyResponse(resp *signingkeydto.RotateResponse) *response.RotateSigningKeyResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.RotateSigningKeyResponse{
+		ActiveKid: resp.ActiveKid,
+		Keys:      ToSigningKeyListResponse(resp.Keys),
+	}
+}
@@ -0,0 +1,36 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+)
+
+func ToTenantRegistrationDetailResponse(resp *tenantregistrationdto.RegistrationResponse) *response.TenantRegistrationDetailResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.TenantRegistrationDetailResponse{
+		ID:                   resp.ID,
+		UserID:               resp.UserID,
+		Email:                resp.Email,
+		TenantID:             resp.TenantID,
+		ProductID:            resp.ProductID,
+		RegistrationType:     resp.RegistrationType,
+		IdentificationNumber: resp.IdentificationNumber,
+		Status:               resp.Status,
+		ApprovedBy:           resp.ApprovedBy,
+		ApprovedAt:           resp.ApprovedAt,
+		GrantedRoleID:        resp.GrantedRoleID,
+		ParticipantID:        resp.ParticipantID,
+		ParticipantLink:      resp.ParticipantLink,
+		CreatedAt:            resp.CreatedAt,
+	}
+}
+
+func ToTenantRegistrationListResponse(items []tenantregistrationdto.RegistrationResponse) []*response.TenantRegistrationDetailResponse {
+	result := make([]*response.TenantRegistrationDetailResponse, len(items))
+	for i := range items {
+		result[i] = ToTenantRegistrationDetailResponse(&items[i])
+	}
+	return result
+}
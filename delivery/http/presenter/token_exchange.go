@@ -0,0 +1,21 @@
+package presenter
+
+import (
+	"iam-service/delivery/http/dto/response"
+	"iam-service/iam/auth/authdto"
+)
+
+func ToTokenExchangeResponse(resp *authdto.TokenExchangeResponse) *response.TokenExchangeResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.TokenExchangeResponse{
+		AccessToken:     resp.AccessToken,
+		IssuedTokenType: resp.IssuedTokenType,
+		TokenType:       resp.TokenType,
+		ExpiresIn:       resp.ExpiresIn,
+		Audience:        resp.Audience,
+		Roles:           resp.Roles,
+		Permissions:     resp.Permissions,
+	}
+}
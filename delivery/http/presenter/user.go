@@ -19,17 +19,19 @@ func ToUserResponse(resp *userdto.UserDetailResponse) *response.UserResponse {
 	}
 
 	return &response.UserResponse{
-		ID:          resp.ID,
-		Email:       resp.Email,
-		FirstName:   resp.FirstName,
-		LastName:    resp.LastName,
-		FullName:    resp.FullName,
-		PhoneNumber: resp.PhoneNumber,
-		DateOfBirth: resp.DateOfBirth,
-		Address:     resp.Address,
-		Status:      resp.Status,
-		IsActive:    resp.IsActive,
-		Roles:       roles,
+		ID:                resp.ID,
+		Email:             resp.Email,
+		FirstName:         resp.FirstName,
+		LastName:          resp.LastName,
+		FullName:          resp.FullName,
+		PhoneNumber:       resp.PhoneNumber,
+		DateOfBirth:       resp.DateOfBirth,
+		Address:           resp.Address,
+		ProfilePictureURL: resp.ProfilePictureURL,
+		ProfilePictures:   resp.ProfilePictures,
+		Status:            resp.Status,
+		IsActive:          resp.IsActive,
+		Roles:             roles,
 	}
 }
 
@@ -68,6 +70,7 @@ func ToCreateUserResponse(resp *userdto.CreateResponse) *response.CreateUserResp
 		Email:    resp.Email,
 		FullName: resp.FullName,
 		RoleCode: resp.RoleCode,
+		Warnings: resp.Warnings,
 	}
 }
 
@@ -141,3 +144,45 @@ func ToResetUserPINResponse(resp *userdto.ResetPINResponse) *response.ResetUserP
 		Message: resp.Message,
 	}
 }
+
+func ToResetUserPasswordResponse(resp *userdto.ResetPasswordResponse) *response.ResetUserPasswordResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.ResetUserPasswordResponse{
+		UserID:   resp.UserID,
+		Message:  resp.Message,
+		Warnings: resp.Warnings,
+	}
+}
+
+func ToChangePasswordResponse(resp *userdto.ChangePasswordResponse) *response.ChangePasswordResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.ChangePasswordResponse{
+		UserID:   resp.UserID,
+		Warnings: resp.Warnings,
+	}
+}
+
+func ToForcePasswordChangeResponse(resp *userdto.ForcePasswordChangeResponse) *response.ForcePasswordChangeResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.ForcePasswordChangeResponse{
+		UserID:  resp.UserID,
+		Message: resp.Message,
+	}
+}
+
+func ToForceTenantPasswordChangeResponse(resp *userdto.ForceTenantPasswordChangeResponse) *response.ForceTenantPasswordChangeResponse {
+	if resp == nil {
+		return nil
+	}
+	return &response.ForceTenantPasswordChangeResponse{
+		TenantID:      resp.TenantID,
+		AffectedUsers: resp.AffectedUsers,
+		Message:       resp.Message,
+	}
+}
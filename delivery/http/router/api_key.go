@@ -0,0 +1,34 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupAPIKeyRoutes(api fiber.Router, cfg *config.Config, apiKeyController *controller.APIKeyController, blacklistStore ...contract.TokenBlacklistStore) {
+	apiKeys := api.Group("/api-keys")
+
+	apiKeys.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	apiKeys.Use(middleware.RejectPersonalAccessToken())
+	apiKeys.Use(middleware.RejectImpersonation())
+	apiKeys.Use(middleware.ExtractTenantContext())
+
+	apiKeys.Post("/",
+		middleware.RequireTenantPermission("api_key:manage"),
+		apiKeyController.Create,
+	)
+
+	apiKeys.Get("/",
+		middleware.RequireTenantPermission("api_key:read"),
+		apiKeyController.List,
+	)
+
+	apiKeys.Post("/:id/revoke",
+		middleware.RequireTenantPermission("api_key:manage"),
+		apiKeyController.Revoke,
+	)
+}
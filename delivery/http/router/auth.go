@@ -16,7 +16,19 @@ func SetupAuthRoutes(api fiber.Router, cfg *config.Config, authController *contr
 	auth := api.Group("/auth")
 	auth.Use(middleware.JWTAuth(cfg, blacklistStore))
 	auth.Post("/logout", authController.Logout)
-	auth.Post("/logout-all", authController.LogoutAll)
+	auth.Post("/logout-all", middleware.RejectImpersonation(), authController.LogoutAll)
+	auth.Post("/token/exchange", middleware.RejectPersonalAccessToken(), middleware.RejectImpersonation(), authController.ExchangeToken)
+
+	tokens := auth.Group("/personal-access-tokens")
+	tokens.Use(middleware.RejectPersonalAccessToken())
+	tokens.Use(middleware.RejectImpersonation())
+	tokens.Post("/", authController.CreatePersonalAccessToken)
+	tokens.Get("/", authController.ListPersonalAccessTokens)
+	tokens.Delete("/:id", authController.RevokePersonalAccessToken)
+
+	impersonation := auth.Group("/impersonation")
+	impersonation.Post("/", middleware.RejectPersonalAccessToken(), middleware.RejectImpersonation(), authController.StartImpersonation)
+	impersonation.Post("/stop", authController.StopImpersonation)
 
 	refreshToken := api.Group("/auth")
 	if !cfg.IsDevelopment() {
@@ -0,0 +1,29 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupAuthzRoutes exposes authorization decisions to other services, which
+// authenticate with a tenant API key and can only ask about that tenant, and
+// the effective permission explainer to tenant admins.
+func SetupAuthzRoutes(api fiber.Router, cfg *config.Config, ctrl *controller.AuthzController, apiKeyMiddleware fiber.Handler, blacklistStore ...contract.TokenBlacklistStore) {
+	authz := api.Group("/authz")
+
+	authz.Post("/check", apiKeyMiddleware, ctrl.Check)
+	authz.Post("/check/batch", apiKeyMiddleware, ctrl.BatchCheck)
+
+	authz.Get("/effective-permissions",
+		middleware.JWTAuth(cfg, blacklistStore...),
+		middleware.RejectPersonalAccessToken(),
+		middleware.RejectImpersonation(),
+		middleware.ExtractTenantContext(),
+		middleware.RequireTenantPermission("role:read"),
+		ctrl.Explain,
+	)
+}
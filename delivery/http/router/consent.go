@@ -0,0 +1,38 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupConsentRoutes(api fiber.Router, cfg *config.Config, consentController *controller.ConsentController, blacklistStore ...contract.TokenBlacklistStore) {
+	consents := api.Group("/consents")
+	consents.Get("/current", consentController.GetCurrentDocuments)
+
+	// Accepts the restricted token issued at login when consent is pending as
+	// well as regular access tokens.
+	me := consents.Group("/me")
+	me.Use(middleware.ConsentAuth(cfg, blacklistStore...))
+	me.Use(middleware.RejectPersonalAccessToken())
+	me.Use(middleware.RejectImpersonation())
+	me.Get("", consentController.GetMyConsents)
+	me.Post("/accept", consentController.Accept)
+
+	documents := api.Group("/consent-documents")
+	documents.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	documents.Use(middleware.RejectPersonalAccessToken())
+	documents.Use(middleware.RejectImpersonation())
+	documents.Use(middleware.RequirePlatformAdmin())
+
+	documents.Post("/", consentController.CreateDocument)
+	documents.Get("/", consentController.ListDocuments)
+	documents.Get("/report", consentController.GetReport)
+	documents.Get("/:id", consentController.GetDocument)
+	documents.Put("/:id", consentController.UpdateDocument)
+	documents.Post("/:id/publish", consentController.PublishDocument)
+	documents.Get("/:id/audience", consentController.ListAudience)
+}
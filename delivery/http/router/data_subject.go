@@ -0,0 +1,30 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupDataSubjectRoutes(api fiber.Router, cfg *config.Config, dataSubjectController *controller.DataSubjectController, blacklistStore ...contract.TokenBlacklistStore) {
+	privacy := api.Group("/privacy")
+	privacy.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	privacy.Use(middleware.RejectPersonalAccessToken())
+	privacy.Use(middleware.RejectImpersonation())
+
+	privacy.Post("/exports", dataSubjectController.RequestExport)
+	privacy.Get("/exports/:id/download", dataSubjectController.GetExportDownload)
+	privacy.Post("/erasure", dataSubjectController.RequestErasure)
+	privacy.Get("/requests", dataSubjectController.ListMine)
+	privacy.Post("/requests/:id/cancel", dataSubjectController.Cancel)
+
+	admin := privacy.Group("/admin")
+	admin.Use(middleware.RequirePlatformAdmin())
+
+	admin.Get("/requests", dataSubjectController.List)
+	admin.Post("/requests/:id/approve", dataSubjectController.ApproveErasure)
+	admin.Post("/requests/:id/reject", dataSubjectController.RejectErasure)
+}
@@ -0,0 +1,49 @@
+package router
+
+import (
+	"time"
+
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+)
+
+func SetupInvitationRoutes(api fiber.Router, cfg *config.Config, invitationController *controller.InvitationController, blacklistStore ...contract.TokenBlacklistStore) {
+	invitations := api.Group("/invitations")
+
+	// Accepting is public and must be registered before the group's auth
+	// middleware so the invitee can reach it without a token.
+	accept := []fiber.Handler{}
+	if !cfg.IsDevelopment() {
+		accept = append(accept, limiter.New(limiter.Config{
+			Max:               10,
+			Expiration:        1 * time.Minute,
+			LimiterMiddleware: limiter.SlidingWindow{},
+			KeyGenerator: func(c *fiber.Ctx) string {
+				return c.IP()
+			},
+			LimitReached: func(c *fiber.Ctx) error {
+				return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+					"success": false,
+					"error":   "too many requests, please try again later",
+				})
+			},
+		}))
+	}
+	accept = append(accept, invitationController.Accept)
+	invitations.Post("/accept", accept...)
+
+	invitations.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	invitations.Use(middleware.RejectPersonalAccessToken())
+	invitations.Use(middleware.RejectImpersonation())
+	invitations.Use(middleware.RequirePlatformAdmin())
+
+	invitations.Post("/", invitationController.Create)
+	invitations.Get("/", invitationController.List)
+	invitations.Post("/:id/resend", invitationController.Resend)
+	invitations.Post("/:id/revoke", invitationController.Revoke)
+}
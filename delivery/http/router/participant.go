@@ -7,117 +7,152 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func SetupParticipantRoutes(api fiber.Router, ctrl *controller.ParticipantController, jwtMiddleware fiber.Handler) {
+func SetupParticipantRoutes(api fiber.Router, ctrl *controller.ParticipantController, jwtMiddleware fiber.Handler, policyAuthorizer middleware.PolicyAuthorizer) {
 	participants := api.Group("/participants")
 	participants.Use(jwtMiddleware)
 	participants.Use(middleware.ExtractTenantContext())
 
 	participants.Post("/",
 		middleware.RequireTenantPermission("participant:create"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:create"),
 		ctrl.Create,
 	)
 
 	participants.Get("/",
 		middleware.RequireTenantPermission("participant:read"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:read"),
 		ctrl.List,
 	)
 
 	participants.Get("/:id",
 		middleware.RequireTenantPermission("participant:read"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:read"),
 		ctrl.Get,
 	)
 
 	participants.Put("/:id/personal-data",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.UpdatePersonalData,
 	)
 
 	// Identity sub-resource
 	participants.Put("/:id/identities",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveIdentity,
 	)
 	participants.Delete("/:id/identities/:identityId",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.DeleteIdentity,
 	)
 
 	// Address sub-resource
 	participants.Put("/:id/addresses",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveAddress,
 	)
 	participants.Delete("/:id/addresses/:addressId",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.DeleteAddress,
 	)
 
 	// Bank account sub-resource
 	participants.Put("/:id/bank-accounts",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveBankAccount,
 	)
 	participants.Delete("/:id/bank-accounts/:accountId",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.DeleteBankAccount,
 	)
 
 	// Family member sub-resource
 	participants.Put("/:id/family-members",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveFamilyMember,
 	)
 	participants.Delete("/:id/family-members/:memberId",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.DeleteFamilyMember,
 	)
 
 	// Employment sub-resource
 	participants.Put("/:id/employment",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveEmployment,
 	)
 
 	// Beneficiary sub-resource
 	participants.Put("/:id/beneficiaries",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.SaveBeneficiary,
 	)
 	participants.Delete("/:id/beneficiaries/:beneficiaryId",
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.DeleteBeneficiary,
 	)
 
 	// File upload (5MB limit for this route)
 	participants.Post("/:id/files",
+		middleware.BodyLimit(middleware.UploadBodyLimit),
 		middleware.RequireTenantPermission("participant:update"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:update"),
 		ctrl.UploadFile,
 	)
 
 	// Status history
 	participants.Get("/:id/status-history",
 		middleware.RequireTenantPermission("participant:read"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:read"),
 		ctrl.GetStatusHistory,
 	)
 
 	// Workflow actions
 	participants.Post("/:id/submit",
 		middleware.RequireTenantPermission("participant:submit"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:submit"),
 		ctrl.Submit,
 	)
 
 	participants.Post("/:id/approve",
 		middleware.RequireTenantPermission("participant:approve"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:approve"),
 		ctrl.Approve,
 	)
 
 	participants.Post("/:id/reject",
 		middleware.RequireTenantPermission("participant:reject"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:reject"),
 		ctrl.Reject,
 	)
 
+	// User linking
+	participants.Post("/:id/link-user",
+		middleware.RequireTenantPermission("participant:link"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:link"),
+		ctrl.LinkUser,
+	)
+
+	participants.Post("/:id/unlink-user",
+		middleware.RequireTenantPermission("participant:link"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:link"),
+		ctrl.UnlinkUser,
+	)
+
 	participants.Delete("/:id",
 		middleware.RequireTenantPermission("participant:delete"),
+		middleware.EnforcePolicies(policyAuthorizer, "participant:delete"),
 		ctrl.Delete,
 	)
 }
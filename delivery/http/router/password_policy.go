@@ -0,0 +1,24 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupPasswordPolicyRoutes(api fiber.Router, cfg *config.Config, passwordPolicyController *controller.PasswordPolicyController, blacklistStore ...contract.TokenBlacklistStore) {
+	passwordPolicies := api.Group("/password-policies")
+
+	passwordPolicies.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	passwordPolicies.Use(middleware.RejectPersonalAccessToken())
+	passwordPolicies.Use(middleware.RejectImpersonation())
+	passwordPolicies.Use(middleware.RequirePlatformAdmin())
+
+	passwordPolicies.Get("/", passwordPolicyController.List)
+	passwordPolicies.Get("/effective", passwordPolicyController.GetEffective)
+	passwordPolicies.Put("/", passwordPolicyController.Upsert)
+	passwordPolicies.Delete("/:id", passwordPolicyController.Delete)
+}
@@ -0,0 +1,25 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupPermissionRoutes(api fiber.Router, cfg *config.Config, permissionController *controller.PermissionController, blacklistStore ...contract.TokenBlacklistStore) {
+	permissions := api.Group("/permissions")
+
+	permissions.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	permissions.Use(middleware.RejectImpersonation())
+	permissions.Use(middleware.RequirePlatformAdmin())
+
+	permissions.Post("/", permissionController.Create)
+	permissions.Post("/manifest", permissionController.ImportManifest)
+	permissions.Get("/", permissionController.List)
+	permissions.Get("/:id", permissionController.GetByID)
+	permissions.Put("/:id", permissionController.Update)
+	permissions.Delete("/:id", permissionController.Delete)
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupPolicyRoutes(api fiber.Router, cfg *config.Config, ctrl *controller.PolicyController, blacklistStore ...contract.TokenBlacklistStore) {
+	policies := api.Group("/policies")
+	policies.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	policies.Use(middleware.RejectPersonalAccessToken())
+	policies.Use(middleware.RejectImpersonation())
+	policies.Use(middleware.ExtractTenantContext())
+
+	policies.Get("/",
+		middleware.RequireTenantPermission("policy:read"),
+		ctrl.List,
+	)
+
+	policies.Get("/:id",
+		middleware.RequireTenantPermission("policy:read"),
+		ctrl.GetByID,
+	)
+
+	policies.Post("/",
+		middleware.RequireTenantPermission("policy:manage"),
+		ctrl.Create,
+	)
+
+	policies.Put("/:id",
+		middleware.RequireTenantPermission("policy:manage"),
+		ctrl.Update,
+	)
+
+	policies.Delete("/:id",
+		middleware.RequireTenantPermission("policy:manage"),
+		ctrl.Delete,
+	)
+}
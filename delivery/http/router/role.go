@@ -13,7 +13,47 @@ func SetupRoleRoutes(api fiber.Router, cfg *config.Config, roleController *contr
 	roles := api.Group("/roles")
 
 	roles.Use(middleware.JWTAuth(cfg, blacklistStore...))
-	roles.Use(middleware.RequirePlatformAdmin())
+	roles.Use(middleware.RejectPersonalAccessToken())
+	roles.Use(middleware.RejectImpersonation())
+	roles.Use(middleware.ExtractTenantContext())
 
-	roles.Post("/", roleController.Create)
+	roles.Post("/",
+		middleware.RequireTenantPermission("role:create"),
+		roleController.Create,
+	)
+
+	roles.Get("/",
+		middleware.RequireTenantPermission("role:read"),
+		roleController.List,
+	)
+
+	roles.Get("/:id",
+		middleware.RequireTenantPermission("role:read"),
+		roleController.GetByID,
+	)
+
+	roles.Get("/:id/effective-permissions",
+		middleware.RequireTenantPermission("role:read"),
+		roleController.GetEffectivePermissions,
+	)
+
+	roles.Put("/:id",
+		middleware.RequireTenantPermission("role:update"),
+		roleController.Update,
+	)
+
+	roles.Delete("/:id",
+		middleware.RequireTenantPermission("role:delete"),
+		roleController.Delete,
+	)
+
+	roles.Post("/:id/permissions",
+		middleware.RequireTenantPermission("role:update"),
+		roleController.AddPermissions,
+	)
+
+	roles.Delete("/:id/permissions",
+		middleware.RequireTenantPermission("role:update"),
+		roleController.RemovePermissions,
+	)
 }
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupRoleAssignmentRoutes(api fiber.Router, cfg *config.Config, ctrl *controller.RoleAssignmentController, blacklistStore ...contract.TokenBlacklistStore) {
+	assignments := api.Group("/role-assignments")
+	assignments.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	assignments.Use(middleware.RejectPersonalAccessToken())
+	assignments.Use(middleware.RejectImpersonation())
+	assignments.Use(middleware.ExtractTenantContext())
+
+	assignments.Get("/",
+		middleware.RequireTenantPermission("role:read"),
+		ctrl.List,
+	)
+
+	assignments.Post("/",
+		middleware.RequireTenantPermission("role:assign"),
+		ctrl.Assign,
+	)
+
+	assignments.Post("/bulk",
+		middleware.RequireTenantPermission("role:assign"),
+		ctrl.BulkAssign,
+	)
+
+	assignments.Get("/batches/:batchId",
+		middleware.RequireTenantPermission("role:assign"),
+		ctrl.GetBatch,
+	)
+
+	assignments.Delete("/:id",
+		middleware.RequireTenantPermission("role:assign"),
+		ctrl.Revoke,
+	)
+}
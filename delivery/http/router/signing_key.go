@@ -0,0 +1,21 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupSigningKeyRoutes(api fiber.Router, cfg *config.Config, signingKeyController *controller.SigningKeyController, blacklistStore ...contract.TokenBlacklistStore) {
+	signingKeys := api.Group("/signing-keys")
+
+	signingKeys.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	signingKeys.Use(middleware.RejectPersonalAccessToken())
+	signingKeys.Use(middleware.RequirePlatformAdmin())
+
+	signingKeys.Get("/", signingKeyController.List)
+	signingKeys.Post("/rotate", signingKeyController.Rotate)
+}
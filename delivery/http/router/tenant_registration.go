@@ -0,0 +1,33 @@
+package router
+
+import (
+	"iam-service/config"
+	"iam-service/delivery/http/controller"
+	"iam-service/delivery/http/middleware"
+	"iam-service/iam/auth/contract"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func SetupTenantRegistrationRoutes(api fiber.Router, cfg *config.Config, ctrl *controller.TenantRegistrationController, blacklistStore ...contract.TokenBlacklistStore) {
+	registrations := api.Group("/tenant-registrations")
+	registrations.Use(middleware.JWTAuth(cfg, blacklistStore...))
+	registrations.Use(middleware.RejectPersonalAccessToken())
+	registrations.Use(middleware.RejectImpersonation())
+	registrations.Use(middleware.ExtractTenantContext())
+
+	registrations.Get("/",
+		middleware.RequireTenantPermission("registration:read"),
+		ctrl.List,
+	)
+
+	registrations.Post("/:id/approve",
+		middleware.RequireTenantPermission("registration:approve"),
+		ctrl.Approve,
+	)
+
+	registrations.Post("/:id/reject",
+		middleware.RequireTenantPermission("registration:approve"),
+		ctrl.Reject,
+	)
+}
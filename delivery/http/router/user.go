@@ -11,15 +11,23 @@ import (
 
 func SetupUserRoutes(api fiber.Router, cfg *config.Config, userController *controller.UserController, blacklistStore ...contract.TokenBlacklistStore) {
 	users := api.Group("/users")
+
+	// Registered before the group's JWTAuth so the restricted token issued to
+	// users who must change their password reaches this handler only.
+	users.Put("/me/password", middleware.PasswordChangeAuth(cfg, blacklistStore...), middleware.RejectPersonalAccessToken(), middleware.RejectImpersonation(), userController.ChangePassword)
+
 	users.Use(middleware.JWTAuth(cfg, blacklistStore...))
 
 	users.Get("/me", userController.GetMe)
-	users.Put("/me", userController.UpdateMe)
+	users.Put("/me", middleware.RejectPersonalAccessToken(), middleware.RejectImpersonation(), userController.UpdateMe)
+	users.Put("/me/avatar", middleware.BodyLimit(middleware.UploadBodyLimit), middleware.RejectPersonalAccessToken(), middleware.RejectImpersonation(), userController.UploadAvatar)
 
 	adminUsers := users.Group("")
 	adminUsers.Use(middleware.RequirePlatformAdmin())
+	adminUsers.Use(middleware.RejectImpersonation())
 
 	adminUsers.Post("/", userController.Create)
+	adminUsers.Post("/force-password-change", userController.ForceTenantPasswordChange)
 	adminUsers.Get("/", userController.List)
 	adminUsers.Get("/:id", userController.GetByID)
 	adminUsers.Put("/:id", userController.Update)
@@ -28,4 +36,6 @@ func SetupUserRoutes(api fiber.Router, cfg *config.Config, userController *contr
 	adminUsers.Post("/:id/reject", userController.Reject)
 	adminUsers.Post("/:id/unlock", userController.Unlock)
 	adminUsers.Post("/:id/reset-pin", userController.ResetPIN)
+	adminUsers.Post("/:id/reset-password", userController.ResetPassword)
+	adminUsers.Post("/:id/force-password-change", userController.ForcePasswordChange)
 }
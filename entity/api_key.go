@@ -9,21 +9,21 @@ import (
 )
 
 type AdminAPIKey struct {
-	ID            uuid.UUID       `json:"id" db:"id"`
-	TenantID      uuid.UUID       `json:"tenant_id" db:"tenant_id"`
-	KeyName       string          `json:"key_name" db:"key_name"`
-	KeyHash       string          `json:"-" db:"key_hash"`
-	KeyPrefix     string          `json:"key_prefix" db:"key_prefix"`
-	CreatedBy     *uuid.UUID      `json:"created_by,omitempty" db:"created_by"`
-	CreatedAt     time.Time       `json:"created_at" db:"created_at"`
-	ExpiresAt     *time.Time      `json:"expires_at,omitempty" db:"expires_at"`
-	RevokedAt     *time.Time      `json:"revoked_at,omitempty" db:"revoked_at"`
-	RevokedBy     *uuid.UUID      `json:"revoked_by,omitempty" db:"revoked_by"`
-	RevokedReason *string         `json:"revoked_reason,omitempty" db:"revoked_reason"`
-	LastUsedAt    *time.Time      `json:"last_used_at,omitempty" db:"last_used_at"`
-	LastUsedIP    net.IP          `json:"last_used_ip,omitempty" db:"last_used_ip"`
-	IPWhitelist   json.RawMessage `json:"ip_whitelist,omitempty" db:"ip_whitelist"`
-	IsActive      bool            `json:"is_active" db:"is_active"`
+	ID            uuid.UUID       `json:"id" gorm:"column:id;primaryKey" db:"id"`
+	TenantID      uuid.UUID       `json:"tenant_id" gorm:"column:tenant_id;not null" db:"tenant_id"`
+	KeyName       string          `json:"key_name" gorm:"column:key_name;not null" db:"key_name"`
+	KeyHash       string          `json:"-" gorm:"column:key_hash;not null" db:"key_hash"`
+	KeyPrefix     string          `json:"key_prefix" gorm:"column:key_prefix;not null" db:"key_prefix"`
+	CreatedBy     *uuid.UUID      `json:"created_by,omitempty" gorm:"column:created_by" db:"created_by"`
+	CreatedAt     time.Time       `json:"created_at" gorm:"column:created_at" db:"created_at"`
+	ExpiresAt     *time.Time      `json:"expires_at,omitempty" gorm:"column:expires_at" db:"expires_at"`
+	RevokedAt     *time.Time      `json:"revoked_at,omitempty" gorm:"column:revoked_at" db:"revoked_at"`
+	RevokedBy     *uuid.UUID      `json:"revoked_by,omitempty" gorm:"column:revoked_by" db:"revoked_by"`
+	RevokedReason *string         `json:"revoked_reason,omitempty" gorm:"column:revoked_reason" db:"revoked_reason"`
+	LastUsedAt    *time.Time      `json:"last_used_at,omitempty" gorm:"column:last_used_at" db:"last_used_at"`
+	LastUsedIP    *string         `json:"last_used_ip,omitempty" gorm:"column:last_used_ip;type:inet" db:"last_used_ip"`
+	IPWhitelist   json.RawMessage `json:"ip_whitelist,omitempty" gorm:"column:ip_whitelist;type:jsonb" db:"ip_whitelist"`
+	IsActive      bool            `json:"is_active" gorm:"column:is_active;not null;default:true" db:"is_active"`
 }
 
 func (a *AdminAPIKey) IsExpired() bool {
@@ -92,8 +92,13 @@ func (a *AdminAPIKey) Revoke(revokedBy uuid.UUID, reason string) {
 
 func (a *AdminAPIKey) UpdateLastUsed(ip net.IP) {
 	now := time.Now()
+	ipStr := ip.String()
 	a.LastUsedAt = &now
-	a.LastUsedIP = ip
+	a.LastUsedIP = &ipStr
+}
+
+func (AdminAPIKey) TableName() string {
+	return "admin_api_keys"
 }
 
 func NewAdminAPIKey(tenantID uuid.UUID, keyName, keyHash, keyPrefix string, createdBy *uuid.UUID) *AdminAPIKey {
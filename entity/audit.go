@@ -38,16 +38,20 @@ type AuthLog struct {
 }
 
 type PermissionCheck struct {
-	ID             uuid.UUID       `json:"id" db:"id"`
-	TenantID       *uuid.UUID      `json:"tenant_id,omitempty" db:"tenant_id"`
-	UserID         *uuid.UUID      `json:"user_id,omitempty" db:"user_id"`
-	PermissionCode string          `json:"permission_code" db:"permission_code"`
-	ResourceID     *uuid.UUID      `json:"resource_id,omitempty" db:"resource_id"`
-	ResourceType   *string         `json:"resource_type,omitempty" db:"resource_type"`
-	BranchID       *uuid.UUID      `json:"branch_id,omitempty" db:"branch_id"`
-	Result         bool            `json:"result" db:"result"`
-	Metadata       json.RawMessage `json:"metadata,omitempty" db:"metadata"`
-	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	ID             uuid.UUID       `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID       *uuid.UUID      `json:"tenant_id,omitempty" gorm:"column:tenant_id;type:uuid" db:"tenant_id"`
+	UserID         *uuid.UUID      `json:"user_id,omitempty" gorm:"column:user_id;type:uuid" db:"user_id"`
+	PermissionCode string          `json:"permission_code" gorm:"column:permission_code;type:varchar(100);not null" db:"permission_code"`
+	ResourceID     *uuid.UUID      `json:"resource_id,omitempty" gorm:"column:resource_id;type:uuid" db:"resource_id"`
+	ResourceType   *string         `json:"resource_type,omitempty" gorm:"column:resource_type;type:varchar(50)" db:"resource_type"`
+	BranchID       *uuid.UUID      `json:"branch_id,omitempty" gorm:"column:branch_id;type:uuid" db:"branch_id"`
+	Result         bool            `json:"result" gorm:"column:result;not null" db:"result"`
+	Metadata       json.RawMessage `json:"metadata,omitempty" gorm:"column:metadata;type:jsonb" db:"metadata"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+}
+
+func (PermissionCheck) TableName() string {
+	return "permission_checks"
 }
 
 type AdminAction string
@@ -59,14 +63,26 @@ const (
 	AdminActionCreateRole       AdminAction = "create_role"
 	AdminActionUpdateRole       AdminAction = "update_role"
 	AdminActionDeleteRole       AdminAction = "delete_role"
+	AdminActionAddRolePermissions    AdminAction = "add_role_permissions"
+	AdminActionRemoveRolePermissions AdminAction = "remove_role_permissions"
 	AdminActionAssignRole       AdminAction = "assign_role"
 	AdminActionRevokeRole       AdminAction = "revoke_role"
+	AdminActionBulkAssignRole   AdminAction = "bulk_assign_role"
+	AdminActionCreatePermission AdminAction = "create_permission"
+	AdminActionUpdatePermission AdminAction = "update_permission"
+	AdminActionDeletePermission AdminAction = "delete_permission"
+	AdminActionImportPermission AdminAction = "import_permission_manifest"
+	AdminActionCreatePolicy     AdminAction = "create_policy"
+	AdminActionUpdatePolicy     AdminAction = "update_policy"
+	AdminActionDeletePolicy     AdminAction = "delete_policy"
 	AdminActionCreateBranch     AdminAction = "create_branch"
 	AdminActionUpdateBranch     AdminAction = "update_branch"
 	AdminActionDeleteBranch     AdminAction = "delete_branch"
 	AdminActionUpdateTenant     AdminAction = "update_tenant"
 	AdminActionResetUserPIN     AdminAction = "reset_user_pin"
 	AdminActionResetUserPassword AdminAction = "reset_user_password"
+	AdminActionStartImpersonation AdminAction = "start_impersonation"
+	AdminActionStopImpersonation  AdminAction = "stop_impersonation"
 )
 
 type EntityType string
@@ -77,18 +93,24 @@ const (
 	EntityTypePermission EntityType = "permission"
 	EntityTypeTenant     EntityType = "tenant"
 	EntityTypeBranch     EntityType = "branch"
+	EntityTypeUserRole   EntityType = "user_role"
+	EntityTypePolicy     EntityType = "authorization_policy"
 )
 
 type AdminAuditLog struct {
-	ID          uuid.UUID       `json:"id" db:"id"`
-	TenantID    *uuid.UUID      `json:"tenant_id,omitempty" db:"tenant_id"`
-	UserID      uuid.UUID       `json:"user_id" db:"user_id"`
-	Action      AdminAction     `json:"action" db:"action"`
-	EntityType  EntityType      `json:"entity_type" db:"entity_type"`
-	EntityID    *uuid.UUID      `json:"entity_id,omitempty" db:"entity_id"`
-	BeforeState json.RawMessage `json:"before_state,omitempty" db:"before_state"`
-	AfterState  json.RawMessage `json:"after_state,omitempty" db:"after_state"`
-	IPAddress   net.IP          `json:"ip_address,omitempty" db:"ip_address"`
-	UserAgent   string          `json:"user_agent,omitempty" db:"user_agent"`
-	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	ID          uuid.UUID       `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID    *uuid.UUID      `json:"tenant_id,omitempty" gorm:"column:tenant_id;type:uuid" db:"tenant_id"`
+	UserID      uuid.UUID       `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
+	Action      AdminAction     `json:"action" gorm:"column:action;type:varchar(50);not null" db:"action"`
+	EntityType  EntityType      `json:"entity_type" gorm:"column:entity_type;type:varchar(50);not null" db:"entity_type"`
+	EntityID    *uuid.UUID      `json:"entity_id,omitempty" gorm:"column:entity_id;type:uuid" db:"entity_id"`
+	BeforeState json.RawMessage `json:"before_state,omitempty" gorm:"column:before_state;type:jsonb" db:"before_state"`
+	AfterState  json.RawMessage `json:"after_state,omitempty" gorm:"column:after_state;type:jsonb" db:"after_state"`
+	IPAddress   *string         `json:"ip_address,omitempty" gorm:"column:ip_address;type:inet" db:"ip_address"`
+	UserAgent   string          `json:"user_agent,omitempty" gorm:"column:user_agent" db:"user_agent"`
+	CreatedAt   time.Time       `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+}
+
+func (AdminAuditLog) TableName() string {
+	return "admin_audit_logs"
 }
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PolicyMode string
+
+const (
+	PolicyModeEnforce PolicyMode = "ENFORCE"
+	PolicyModeDryRun  PolicyMode = "DRY_RUN"
+)
+
+type AuthorizationPolicy struct {
+	ID             uuid.UUID       `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id" gorm:"column:tenant_id;type:uuid;not null" db:"tenant_id"`
+	Name           string          `json:"name" gorm:"column:name;type:varchar(255);not null" db:"name"`
+	Description    *string         `json:"description,omitempty" gorm:"column:description" db:"description"`
+	PermissionCode string          `json:"permission_code" gorm:"column:permission_code;type:varchar(100);not null" db:"permission_code"`
+	ResourceType   *string         `json:"resource_type,omitempty" gorm:"column:resource_type;type:varchar(50)" db:"resource_type"`
+	Condition      json.RawMessage `json:"condition" gorm:"column:condition;type:jsonb;not null" db:"condition"`
+	Mode           PolicyMode      `json:"mode" gorm:"column:mode;type:varchar(20);not null;default:'ENFORCE'" db:"mode"`
+	IsActive       bool            `json:"is_active" gorm:"column:is_active;not null;default:true" db:"is_active"`
+	CreatedBy      uuid.UUID       `json:"created_by" gorm:"column:created_by;type:uuid;not null" db:"created_by"`
+	UpdatedBy      *uuid.UUID      `json:"updated_by,omitempty" gorm:"column:updated_by;type:uuid" db:"updated_by"`
+	CreatedAt      time.Time       `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" gorm:"column:updated_at;not null" db:"updated_at"`
+	DeletedAt      *time.Time      `json:"deleted_at,omitempty" gorm:"column:deleted_at" db:"deleted_at"`
+}
+
+func (AuthorizationPolicy) TableName() string {
+	return "authorization_policies"
+}
+
+func (p *AuthorizationPolicy) IsDryRun() bool {
+	return p.Mode == PolicyModeDryRun
+}
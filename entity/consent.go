@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ConsentDocumentType string
+
+const (
+	ConsentDocumentTerms   ConsentDocumentType = "TERMS_OF_SERVICE"
+	ConsentDocumentPrivacy ConsentDocumentType = "PRIVACY_POLICY"
+)
+
+type ConsentDocumentStatus string
+
+const (
+	ConsentDocumentStatusDraft      ConsentDocumentStatus = "DRAFT"
+	ConsentDocumentStatusPublished  ConsentDocumentStatus = "PUBLISHED"
+	ConsentDocumentStatusSuperseded ConsentDocumentStatus = "SUPERSEDED"
+)
+
+// ConsentDocument is one version of a terms or privacy document. Documents
+// without a tenant apply platform-wide; documents with a product apply only to
+// users registered for that product. At most one version per scope and type is
+// published at a time.
+type ConsentDocument struct {
+	ID           uuid.UUID             `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID     *uuid.UUID            `json:"tenant_id,omitempty" gorm:"column:tenant_id;type:uuid" db:"tenant_id"`
+	ProductID    *uuid.UUID            `json:"product_id,omitempty" gorm:"column:application_id;type:uuid" db:"application_id"`
+	DocumentType ConsentDocumentType   `json:"document_type" gorm:"column:document_type;type:varchar(30);not null" db:"document_type"`
+	Version      string                `json:"version" gorm:"column:version;type:varchar(50);not null" db:"version"`
+	Title        string                `json:"title" gorm:"column:title;type:varchar(255);not null" db:"title"`
+	ContentURL   string                `json:"content_url" gorm:"column:content_url;type:varchar(1000);not null" db:"content_url"`
+	Summary      *string               `json:"summary,omitempty" gorm:"column:summary;type:text" db:"summary"`
+	IsRequired   bool                  `json:"is_required" gorm:"column:is_required;not null;default:true" db:"is_required"`
+	Status       ConsentDocumentStatus `json:"status" gorm:"column:status;type:varchar(20);not null;default:DRAFT" db:"status"`
+	PublishedAt  *time.Time            `json:"published_at,omitempty" gorm:"column:published_at" db:"published_at"`
+	PublishedBy  *uuid.UUID            `json:"published_by,omitempty" gorm:"column:published_by;type:uuid" db:"published_by"`
+	CreatedBy    uuid.UUID             `json:"created_by" gorm:"column:created_by;type:uuid;not null" db:"created_by"`
+	CreatedAt    time.Time             `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at" gorm:"column:updated_at;not null" db:"updated_at"`
+}
+
+func (ConsentDocument) TableName() string {
+	return "consent_documents"
+}
+
+func (d *ConsentDocument) IsDraft() bool {
+	return d.Status == ConsentDocumentStatusDraft
+}
+
+func (d *ConsentDocument) IsPublished() bool {
+	return d.Status == ConsentDocumentStatusPublished
+}
+
+// UserConsent records a user accepting one document version. The scope, type
+// and version are copied from the document so the record stands on its own.
+type UserConsent struct {
+	ID           uuid.UUID           `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	UserID       uuid.UUID           `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
+	DocumentID   uuid.UUID           `json:"document_id" gorm:"column:document_id;type:uuid;not null" db:"document_id"`
+	TenantID     *uuid.UUID          `json:"tenant_id,omitempty" gorm:"column:tenant_id;type:uuid" db:"tenant_id"`
+	ProductID    *uuid.UUID          `json:"product_id,omitempty" gorm:"column:application_id;type:uuid" db:"application_id"`
+	DocumentType ConsentDocumentType `json:"document_type" gorm:"column:document_type;type:varchar(30);not null" db:"document_type"`
+	Version      string              `json:"version" gorm:"column:version;type:varchar(50);not null" db:"version"`
+	AcceptedAt   time.Time           `json:"accepted_at" gorm:"column:accepted_at;not null" db:"accepted_at"`
+	IPAddress    *string             `json:"ip_address,omitempty" gorm:"column:ip_address;type:inet" db:"ip_address"`
+	UserAgent    *string             `json:"user_agent,omitempty" gorm:"column:user_agent;type:text" db:"user_agent"`
+	CreatedAt    time.Time           `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+}
+
+func (UserConsent) TableName() string {
+	return "user_consents"
+}
+
+func NewUserConsent(userID uuid.UUID, document *ConsentDocument, acceptedAt time.Time, ipAddress, userAgent string) *UserConsent {
+	consent := &UserConsent{
+		UserID:       userID,
+		DocumentID:   document.ID,
+		TenantID:     document.TenantID,
+		ProductID:    document.ProductID,
+		DocumentType: document.DocumentType,
+		Version:      document.Version,
+		AcceptedAt:   acceptedAt,
+		CreatedAt:    acceptedAt,
+	}
+	if ipAddress != "" {
+		consent.IPAddress = &ipAddress
+	}
+	if userAgent != "" {
+		consent.UserAgent = &userAgent
+	}
+	return consent
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUserConsent(t *testing.T) {
+	tenantID := uuid.New()
+	productID := uuid.New()
+	document := &ConsentDocument{
+		ID:           uuid.New(),
+		TenantID:     &tenantID,
+		ProductID:    &productID,
+		DocumentType: ConsentDocumentTerms,
+		Version:      "2.1",
+	}
+	userID := uuid.New()
+	acceptedAt := time.Now()
+
+	t.Run("snapshots the accepted document version", func(t *testing.T) {
+		consent := NewUserConsent(userID, document, acceptedAt, "203.0.113.10", "Mozilla/5.0")
+
+		assert.Equal(t, userID, consent.UserID)
+		assert.Equal(t, document.ID, consent.DocumentID)
+		assert.Equal(t, &tenantID, consent.TenantID)
+		assert.Equal(t, &productID, consent.ProductID)
+		assert.Equal(t, ConsentDocumentTerms, consent.DocumentType)
+		assert.Equal(t, "2.1", consent.Version)
+		assert.Equal(t, acceptedAt, consent.AcceptedAt)
+		require.NotNil(t, consent.IPAddress)
+		assert.Equal(t, "203.0.113.10", *consent.IPAddress)
+		require.NotNil(t, consent.UserAgent)
+		assert.Equal(t, "Mozilla/5.0", *consent.UserAgent)
+	})
+
+	t.Run("leaves missing client details empty", func(t *testing.T) {
+		consent := NewUserConsent(userID, document, acceptedAt, "", "")
+
+		assert.Nil(t, consent.IPAddress)
+		assert.Nil(t, consent.UserAgent)
+	})
+}
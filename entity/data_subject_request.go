@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataSubjectRequestType string
+
+const (
+	DataSubjectRequestExport  DataSubjectRequestType = "EXPORT"
+	DataSubjectRequestErasure DataSubjectRequestType = "ERASURE"
+)
+
+type DataSubjectRequestStatus string
+
+const (
+	// DSRStatusPending is a queued export or an erasure awaiting admin review.
+	DSRStatusPending    DataSubjectRequestStatus = "PENDING"
+	DSRStatusProcessing DataSubjectRequestStatus = "PROCESSING"
+	// DSRStatusApproved is an erasure waiting out its grace period.
+	DSRStatusApproved  DataSubjectRequestStatus = "APPROVED"
+	DSRStatusRejected  DataSubjectRequestStatus = "REJECTED"
+	DSRStatusCancelled DataSubjectRequestStatus = "CANCELLED"
+	DSRStatusCompleted DataSubjectRequestStatus = "COMPLETED"
+	DSRStatusFailed    DataSubjectRequestStatus = "FAILED"
+)
+
+type DataSubjectRequest struct {
+	ID            uuid.UUID                `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	UserID        uuid.UUID                `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
+	Type          DataSubjectRequestType   `json:"type" gorm:"column:type;type:varchar(20);not null" db:"type"`
+	Status        DataSubjectRequestStatus `json:"status" gorm:"column:status;type:varchar(20);not null" db:"status"`
+	Reason        *string                  `json:"reason,omitempty" gorm:"column:reason;type:text" db:"reason"`
+	ObjectKey     *string                  `json:"-" gorm:"column:object_key;type:varchar(500)" db:"object_key"`
+	ExpiresAt     *time.Time               `json:"expires_at,omitempty" gorm:"column:expires_at" db:"expires_at"`
+	ScheduledFor  *time.Time               `json:"scheduled_for,omitempty" gorm:"column:scheduled_for" db:"scheduled_for"`
+	ReviewedBy    *uuid.UUID               `json:"reviewed_by,omitempty" gorm:"column:reviewed_by;type:uuid" db:"reviewed_by"`
+	ReviewedAt    *time.Time               `json:"reviewed_at,omitempty" gorm:"column:reviewed_at" db:"reviewed_at"`
+	ReviewNote    *string                  `json:"review_note,omitempty" gorm:"column:review_note;type:text" db:"review_note"`
+	Attempts      int                      `json:"attempts" gorm:"column:attempts;not null;default:0" db:"attempts"`
+	FailureReason *string                  `json:"failure_reason,omitempty" gorm:"column:failure_reason;type:text" db:"failure_reason"`
+	CompletedAt   *time.Time               `json:"completed_at,omitempty" gorm:"column:completed_at" db:"completed_at"`
+	CreatedAt     time.Time                `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+	UpdatedAt     time.Time                `json:"updated_at" gorm:"column:updated_at;not null" db:"updated_at"`
+}
+
+func (DataSubjectRequest) TableName() string {
+	return "data_subject_requests"
+}
+
+func (r *DataSubjectRequest) IsOpen() bool {
+	switch r.Status {
+	case DSRStatusPending, DSRStatusProcessing, DSRStatusApproved:
+		return true
+	}
+	return false
+}
+
+// CanBeCancelled reports whether the user may still withdraw the request.
+// Erasures can be withdrawn until the grace period has run out.
+func (r *DataSubjectRequest) CanBeCancelled() bool {
+	return r.Type == DataSubjectRequestErasure &&
+		(r.Status == DSRStatusPending || r.Status == DSRStatusApproved)
+}
+
+func (r *DataSubjectRequest) IsDownloadable(now time.Time) bool {
+	return r.Type == DataSubjectRequestExport &&
+		r.Status == DSRStatusCompleted &&
+		r.ObjectKey != nil &&
+		r.ExpiresAt != nil && now.Before(*r.ExpiresAt)
+}
+
+// PersonalDataSnapshot is everything held about a user, gathered for an
+// export.
+type PersonalDataSnapshot struct {
+	User                     *User
+	Profile                  *UserProfile
+	AuthMethods              []UserAuthMethod
+	SecurityState            *UserSecurityState
+	Sessions                 []UserSession
+	TenantRegistrations      []UserTenantRegistration
+	RoleAssignments          []UserRole
+	Consents                 []UserConsent
+	AuditLogs                []AdminAuditLog
+	Participants             []Participant
+	ParticipantIdentities    []ParticipantIdentity
+	ParticipantAddresses     []ParticipantAddress
+	ParticipantBankAccounts  []ParticipantBankAccount
+	ParticipantFamilyMembers []ParticipantFamilyMember
+	ParticipantEmployments   []ParticipantEmployment
+	ParticipantBeneficiaries []ParticipantBeneficiary
+}
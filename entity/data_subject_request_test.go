@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSubjectRequest_CanBeCancelled(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  *DataSubjectRequest
+		expected bool
+	}{
+		{
+			name:     "pending erasure can be cancelled",
+			request:  &DataSubjectRequest{Type: DataSubjectRequestErasure, Status: DSRStatusPending},
+			expected: true,
+		},
+		{
+			name:     "approved erasure in grace period can be cancelled",
+			request:  &DataSubjectRequest{Type: DataSubjectRequestErasure, Status: DSRStatusApproved},
+			expected: true,
+		},
+		{
+			name:    "erasure being processed cannot be cancelled",
+			request: &DataSubjectRequest{Type: DataSubjectRequestErasure, Status: DSRStatusProcessing},
+		},
+		{
+			name:    "exports cannot be cancelled",
+			request: &DataSubjectRequest{Type: DataSubjectRequestExport, Status: DSRStatusPending},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.request.CanBeCancelled())
+		})
+	}
+}
+
+func TestDataSubjectRequest_IsDownloadable(t *testing.T) {
+	now := time.Now()
+	key := "data-exports/user/request.zip"
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	completed := &DataSubjectRequest{Type: DataSubjectRequestExport, Status: DSRStatusCompleted, ObjectKey: &key, ExpiresAt: &future}
+	assert.True(t, completed.IsDownloadable(now))
+
+	expired := &DataSubjectRequest{Type: DataSubjectRequestExport, Status: DSRStatusCompleted, ObjectKey: &key, ExpiresAt: &past}
+	assert.False(t, expired.IsDownloadable(now))
+
+	purged := &DataSubjectRequest{Type: DataSubjectRequestExport, Status: DSRStatusCompleted, ExpiresAt: &future}
+	assert.False(t, purged.IsDownloadable(now))
+
+	processing := &DataSubjectRequest{Type: DataSubjectRequestExport, Status: DSRStatusProcessing}
+	assert.False(t, processing.IsDownloadable(now))
+}
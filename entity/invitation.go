@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "PENDING"
+	InvitationStatusAccepted InvitationStatus = "ACCEPTED"
+	InvitationStatusRevoked  InvitationStatus = "REVOKED"
+	InvitationStatusExpired  InvitationStatus = "EXPIRED"
+)
+
+const RegistrationSourceInvitation = "INVITATION"
+
+type Invitation struct {
+	ID             uuid.UUID        `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID       uuid.UUID        `json:"tenant_id" gorm:"column:tenant_id;type:uuid;not null" db:"tenant_id"`
+	BranchID       *uuid.UUID       `json:"branch_id,omitempty" gorm:"column:branch_id;type:uuid" db:"branch_id"`
+	Email          string           `json:"email" gorm:"column:email;type:varchar(255);not null" db:"email"`
+	RoleIDs        json.RawMessage  `json:"role_ids" gorm:"column:role_ids;type:jsonb;not null;default:'[]'" db:"role_ids"`
+	TokenHash      string           `json:"-" gorm:"column:token_hash;type:varchar(64);not null" db:"token_hash"`
+	Status         InvitationStatus `json:"status" gorm:"column:status;type:varchar(20);not null;default:PENDING" db:"status"`
+	ExpiresAt      time.Time        `json:"expires_at" gorm:"column:expires_at;not null" db:"expires_at"`
+	ResendCount    int              `json:"resend_count" gorm:"column:resend_count;not null;default:0" db:"resend_count"`
+	LastSentAt     time.Time        `json:"last_sent_at" gorm:"column:last_sent_at;not null" db:"last_sent_at"`
+	AcceptedAt     *time.Time       `json:"accepted_at,omitempty" gorm:"column:accepted_at" db:"accepted_at"`
+	AcceptedUserID *uuid.UUID       `json:"accepted_user_id,omitempty" gorm:"column:accepted_user_id;type:uuid" db:"accepted_user_id"`
+	RevokedAt      *time.Time       `json:"revoked_at,omitempty" gorm:"column:revoked_at" db:"revoked_at"`
+	RevokedBy      *uuid.UUID       `json:"revoked_by,omitempty" gorm:"column:revoked_by;type:uuid" db:"revoked_by"`
+	InvitedBy      uuid.UUID        `json:"invited_by" gorm:"column:invited_by;type:uuid;not null" db:"invited_by"`
+	CreatedAt      time.Time        `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at" gorm:"column:updated_at;not null" db:"updated_at"`
+}
+
+func (Invitation) TableName() string {
+	return "invitations"
+}
+
+func (i *Invitation) GetRoleIDs() []uuid.UUID {
+	var ids []uuid.UUID
+	if len(i.RoleIDs) == 0 {
+		return ids
+	}
+	_ = json.Unmarshal(i.RoleIDs, &ids)
+	return ids
+}
+
+func (i *Invitation) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
+
+// IsOpen reports whether the invitation can still be accepted, resent or revoked.
+func (i *Invitation) IsOpen() bool {
+	return i.Status == InvitationStatusPending && !i.IsExpired()
+}
+
+// EffectiveStatus reports pending invitations past their expiry as expired,
+// even before the stored status has been updated.
+func (i *Invitation) EffectiveStatus() InvitationStatus {
+	if i.Status == InvitationStatusPending && i.IsExpired() {
+		return InvitationStatusExpired
+	}
+	return i.Status
+}
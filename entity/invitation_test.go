@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitation_EffectiveStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		invitation *Invitation
+		expected   InvitationStatus
+		open       bool
+	}{
+		{
+			name:       "pending within expiry stays pending",
+			invitation: &Invitation{Status: InvitationStatusPending, ExpiresAt: time.Now().Add(time.Hour)},
+			expected:   InvitationStatusPending,
+			open:       true,
+		},
+		{
+			name:       "pending past expiry is reported as expired",
+			invitation: &Invitation{Status: InvitationStatusPending, ExpiresAt: time.Now().Add(-time.Minute)},
+			expected:   InvitationStatusExpired,
+		},
+		{
+			name:       "accepted is kept after expiry",
+			invitation: &Invitation{Status: InvitationStatusAccepted, ExpiresAt: time.Now().Add(-time.Minute)},
+			expected:   InvitationStatusAccepted,
+		},
+		{
+			name:       "revoked is never open",
+			invitation: &Invitation{Status: InvitationStatusRevoked, ExpiresAt: time.Now().Add(time.Hour)},
+			expected:   InvitationStatusRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.invitation.EffectiveStatus())
+			assert.Equal(t, tt.open, tt.invitation.IsOpen())
+		})
+	}
+}
+
+func TestInvitation_GetRoleIDs(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	raw, err := json.Marshal(ids)
+	require.NoError(t, err)
+
+	assert.Equal(t, ids, (&Invitation{RoleIDs: raw}).GetRoleIDs())
+	assert.Empty(t, (&Invitation{}).GetRoleIDs())
+}
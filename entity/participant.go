@@ -21,6 +21,7 @@ type Participant struct {
 	TenantID      uuid.UUID  `json:"tenant_id" gorm:"column:tenant_id;not null" db:"tenant_id"`
 	ApplicationID uuid.UUID  `json:"application_id" gorm:"column:application_id;not null" db:"application_id"`
 	UserID        *uuid.UUID `json:"user_id,omitempty" gorm:"column:user_id" db:"user_id"`
+	BranchID      *uuid.UUID `json:"branch_id,omitempty" gorm:"column:branch_id" db:"branch_id"`
 
 	// Personal Data
 	FullName      string  `json:"full_name" gorm:"column:full_name;not null" db:"full_name"`
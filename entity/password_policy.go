@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PasswordPolicy struct {
+	ID               uuid.UUID       `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	TenantID         *uuid.UUID      `json:"tenant_id,omitempty" gorm:"column:tenant_id;type:uuid" db:"tenant_id"`
+	ProductID        *uuid.UUID      `json:"product_id,omitempty" gorm:"column:application_id;type:uuid" db:"application_id"`
+	MinLength        int             `json:"min_length" gorm:"column:min_length;not null" db:"min_length"`
+	MaxLength        int             `json:"max_length" gorm:"column:max_length;not null" db:"max_length"`
+	RequireUppercase bool            `json:"require_uppercase" gorm:"column:require_uppercase;not null" db:"require_uppercase"`
+	RequireLowercase bool            `json:"require_lowercase" gorm:"column:require_lowercase;not null" db:"require_lowercase"`
+	RequireNumber    bool            `json:"require_number" gorm:"column:require_number;not null" db:"require_number"`
+	RequireSpecial   bool            `json:"require_special" gorm:"column:require_special;not null" db:"require_special"`
+	MaxAgeDays       int             `json:"max_age_days" gorm:"column:max_age_days;not null;default:0" db:"max_age_days"`
+	HistoryDepth     int             `json:"history_depth" gorm:"column:history_depth;not null;default:0" db:"history_depth"`
+	BannedWords      json.RawMessage `json:"banned_words" gorm:"column:banned_words;type:jsonb;not null;default:'[]'" db:"banned_words"`
+	BreachCheck      string          `json:"breach_check" gorm:"column:breach_check;type:varchar(10);not null;default:'BLOCK'" db:"breach_check"`
+	UpdatedBy        *uuid.UUID      `json:"updated_by,omitempty" gorm:"column:updated_by;type:uuid" db:"updated_by"`
+	CreatedAt        time.Time       `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+	UpdatedAt        time.Time       `json:"updated_at" gorm:"column:updated_at;not null" db:"updated_at"`
+}
+
+func (PasswordPolicy) TableName() string {
+	return "password_policies"
+}
+
+func (p *PasswordPolicy) GetBannedWords() []string {
+	var words []string
+	if len(p.BannedWords) == 0 {
+		return words
+	}
+	_ = json.Unmarshal(p.BannedWords, &words)
+	return words
+}
+
+type PasswordHistory struct {
+	ID           uuid.UUID `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	UserID       uuid.UUID `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
+	PasswordHash string    `json:"-" gorm:"column:password_hash;type:varchar(255);not null" db:"password_hash"`
+	CreatedAt    time.Time `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+}
+
+func (PasswordHistory) TableName() string {
+	return "password_history"
+}
@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PersonalAccessToken struct {
+	ID            uuid.UUID       `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	UserID        uuid.UUID       `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
+	TenantID      uuid.UUID       `json:"tenant_id" gorm:"column:tenant_id;type:uuid;not null" db:"tenant_id"`
+	Name          string          `json:"name" gorm:"column:name;type:varchar(100);not null" db:"name"`
+	TokenHash     string          `json:"-" gorm:"column:token_hash;type:varchar(64);uniqueIndex;not null" db:"token_hash"`
+	TokenPrefix   string          `json:"token_prefix" gorm:"column:token_prefix;type:varchar(20);not null" db:"token_prefix"`
+	Scopes        json.RawMessage `json:"scopes" gorm:"column:scopes;type:jsonb;not null" db:"scopes"`
+	ExpiresAt     time.Time       `json:"expires_at" gorm:"column:expires_at;not null" db:"expires_at"`
+	LastUsedAt    *time.Time      `json:"last_used_at,omitempty" gorm:"column:last_used_at" db:"last_used_at"`
+	LastUsedIP    *string         `json:"last_used_ip,omitempty" gorm:"column:last_used_ip;type:inet" db:"last_used_ip"`
+	RevokedAt     *time.Time      `json:"revoked_at,omitempty" gorm:"column:revoked_at" db:"revoked_at"`
+	RevokedReason *string         `json:"revoked_reason,omitempty" gorm:"column:revoked_reason;type:varchar(100)" db:"revoked_reason"`
+	CreatedAt     time.Time       `json:"created_at" gorm:"column:created_at;not null" db:"created_at"`
+}
+
+func (PersonalAccessToken) TableName() string {
+	return "personal_access_tokens"
+}
+
+func (p *PersonalAccessToken) IsExpired() bool {
+	return time.Now().After(p.ExpiresAt)
+}
+
+func (p *PersonalAccessToken) IsRevoked() bool {
+	return p.RevokedAt != nil
+}
+
+func (p *PersonalAccessToken) IsValid() bool {
+	return !p.IsExpired() && !p.IsRevoked()
+}
+
+func (p *PersonalAccessToken) GetScopes() ([]string, error) {
+	var scopes []string
+	if p.Scopes == nil {
+		return scopes, nil
+	}
+	if err := json.Unmarshal(p.Scopes, &scopes); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+func (p *PersonalAccessToken) SetScopes(scopes []string) error {
+	data, err := json.Marshal(scopes)
+	if err != nil {
+		return err
+	}
+	p.Scopes = data
+	return nil
+}
+
+func (p *PersonalAccessToken) HasScope(permissionCode string) bool {
+	scopes, err := p.GetScopes()
+	if err != nil {
+		return false
+	}
+	for _, s := range scopes {
+		if s == permissionCode {
+			return true
+		}
+	}
+	return false
+}
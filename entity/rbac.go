@@ -15,6 +15,10 @@ const (
 	ScopeLevelSelf   ScopeLevel = "self"
 )
 
+// MaxRoleHierarchyDepth bounds how many ancestors a role may have, which also
+// bounds the recursive permission lookups.
+const MaxRoleHierarchyDepth = 10
+
 type Product struct {
 	ID            uuid.UUID  `json:"id" db:"id"`
 	TenantID      uuid.UUID  `json:"tenant_id" db:"tenant_id"`
@@ -38,18 +42,24 @@ func (p *Product) IsLicensed() bool {
 }
 
 type Permission struct {
-	ID          uuid.UUID  `json:"id" db:"id"`
+	ID          uuid.UUID  `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
+	ProductID   uuid.UUID  `json:"product_id" gorm:"column:application_id;type:uuid;not null" db:"application_id"`
 	Code        string     `json:"code" db:"code"`
 	Name        string     `json:"name" db:"name"`
 	Description *string    `json:"description,omitempty" db:"description"`
 	Module      string     `json:"module" db:"module"`
-	Resource    string     `json:"resource" db:"resource"`
+	Resource    string     `json:"resource" gorm:"column:resource_type" db:"resource_type"`
 	Action      string     `json:"action" db:"action"`
 	ScopeLevel  ScopeLevel `json:"scope_level" db:"scope_level"`
 	IsSystem    bool       `json:"is_system" db:"is_system"`
 	Timestamps
 }
 
+// PermissionCode builds the resource:action code a permission is checked by.
+func PermissionCode(resource, action string) string {
+	return resource + ":" + action
+}
+
 type Role struct {
 	ID           uuid.UUID  `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
 	TenantID     *uuid.UUID `json:"tenant_id,omitempty" db:"tenant_id"`
@@ -76,6 +86,18 @@ func (r *Role) IsProductSpecific() bool {
 	return r.ProductID != nil
 }
 
+// AppliesToTenant reports whether the role, when held by a user, counts in
+// tenantID: it is a platform-wide role or one of that tenant's roles.
+func (r *Role) AppliesToTenant(tenantID uuid.UUID) bool {
+	return r.TenantID == nil || *r.TenantID == tenantID
+}
+
+// HasRestrictedScope reports whether the role only acts on resources in the
+// assignment's branch or on resources owned by the user.
+func (r *Role) HasRestrictedScope() bool {
+	return r.ScopeLevel == ScopeLevelBranch || r.ScopeLevel == ScopeLevelSelf
+}
+
 type RolePermission struct {
 	ID               uuid.UUID `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
 	RoleID           uuid.UUID `json:"role_id" gorm:"column:role_id;not null" db:"role_id"`
@@ -116,3 +138,50 @@ func (ur *UserRole) IsTenantWide() bool {
 func (ur *UserRole) IsProductSpecific() bool {
 	return ur.ProductID != nil
 }
+
+// AccessScope is the resolved reach of a permission for one request. Grants
+// held through a tenant wide role carry ScopeLevelTenant and are unrestricted.
+type AccessScope struct {
+	Level     ScopeLevel
+	UserID    uuid.UUID
+	BranchIDs []uuid.UUID
+}
+
+func (s *AccessScope) IsRestricted() bool {
+	return s != nil && (s.Level == ScopeLevelBranch || s.Level == ScopeLevelSelf)
+}
+
+func (s *AccessScope) AllowsBranch(branchID *uuid.UUID) bool {
+	if branchID == nil {
+		return false
+	}
+	for _, id := range s.BranchIDs {
+		if id == *branchID {
+			return true
+		}
+	}
+	return false
+}
+
+// WidenScope merges one grant of a permission into the scope already held for
+// it and returns the result; scope may be nil when nothing is held yet. Grants
+// at any level other than branch or self are tenant wide. Tenant scope is never
+// narrowed, branch scope wins over self scope and branches are merged.
+func WidenScope(scope *AccessScope, userID uuid.UUID, level ScopeLevel, branchID *uuid.UUID) *AccessScope {
+	if scope == nil {
+		scope = &AccessScope{Level: ScopeLevelSelf, UserID: userID}
+	}
+
+	switch {
+	case scope.Level == ScopeLevelTenant || level == ScopeLevelSelf:
+	case level == ScopeLevelBranch:
+		scope.Level = ScopeLevelBranch
+		if branchID != nil && !scope.AllowsBranch(branchID) {
+			scope.BranchIDs = append(scope.BranchIDs, *branchID)
+		}
+	default:
+		scope.Level = ScopeLevelTenant
+		scope.BranchIDs = nil
+	}
+	return scope
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWidenScope(t *testing.T) {
+	userID := uuid.New()
+	branchA := uuid.New()
+	branchB := uuid.New()
+
+	scope := WidenScope(nil, userID, ScopeLevelSelf, nil)
+	assert.Equal(t, ScopeLevelSelf, scope.Level)
+	assert.Equal(t, userID, scope.UserID)
+
+	scope = WidenScope(scope, userID, ScopeLevelBranch, &branchA)
+	scope = WidenScope(scope, userID, ScopeLevelBranch, &branchB)
+	scope = WidenScope(scope, userID, ScopeLevelBranch, &branchA)
+	scope = WidenScope(scope, userID, ScopeLevelSelf, nil)
+	assert.Equal(t, ScopeLevelBranch, scope.Level)
+	assert.Equal(t, []uuid.UUID{branchA, branchB}, scope.BranchIDs)
+
+	scope = WidenScope(scope, userID, ScopeLevelTenant, nil)
+	assert.Equal(t, ScopeLevelTenant, scope.Level)
+	assert.Empty(t, scope.BranchIDs)
+
+	scope = WidenScope(scope, userID, ScopeLevelBranch, &branchA)
+	assert.Equal(t, ScopeLevelTenant, scope.Level)
+	assert.Empty(t, scope.BranchIDs)
+
+	assert.Equal(t, ScopeLevelTenant, WidenScope(nil, userID, ScopeLevelSystem, nil).Level)
+}
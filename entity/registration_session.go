@@ -40,6 +40,11 @@ type RegistrationSession struct {
 
 	PasswordSetAt *time.Time `json:"password_set_at,omitempty"`
 
+	TenantID             *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID            *uuid.UUID `json:"product_id,omitempty"`
+	RegistrationType     string     `json:"registration_type,omitempty"`
+	IdentificationNumber *string    `json:"identification_number,omitempty"`
+
 	IPAddress string `json:"ip_address"`
 	UserAgent string `json:"user_agent"`
 
@@ -129,3 +134,9 @@ func (s *RegistrationSession) IsPasswordSet() bool {
 func (s *RegistrationSession) CanCompleteProfile() bool {
 	return s.IsPasswordSet() && !s.IsExpired()
 }
+
+// HasTenantTarget reports whether the registration joins a tenant product on
+// completion.
+func (s *RegistrationSession) HasTenantTarget() bool {
+	return s.TenantID != nil && s.ProductID != nil && s.RegistrationType != ""
+}
@@ -18,7 +18,7 @@ const (
 )
 
 type RoleAssignmentQueue struct {
-	QueueID uuid.UUID `json:"queue_id" gorm:"column:queue_id;primaryKey" db:"queue_id"`
+	QueueID uuid.UUID `json:"queue_id" gorm:"column:queue_id;primaryKey;type:uuid;default:uuidv7()" db:"queue_id"`
 
 	UserID   uuid.UUID `json:"user_id" gorm:"column:user_id;not null" db:"user_id"`
 	TenantID uuid.UUID `json:"tenant_id" gorm:"column:tenant_id;not null" db:"tenant_id"`
@@ -43,6 +43,7 @@ type RoleAssignmentQueue struct {
 	ProcessingStartedAt *time.Time `json:"processing_started_at,omitempty" gorm:"column:processing_started_at" db:"processing_started_at"`
 	FailureReason       *string    `json:"failure_reason,omitempty" gorm:"column:failure_reason" db:"failure_reason"`
 	RetryCount          int        `json:"retry_count" gorm:"column:retry_count;default:0" db:"retry_count"`
+	NextAttemptAt       *time.Time `json:"next_attempt_at,omitempty" gorm:"column:next_attempt_at" db:"next_attempt_at"`
 
 	UserRoleID *uuid.UUID `json:"user_role_id,omitempty" gorm:"column:user_role_id" db:"user_role_id"`
 
@@ -68,6 +68,7 @@ type UserProfile struct {
 	Address           *string         `json:"address,omitempty" gorm:"column:address" db:"address"`
 	IDNumber          *string         `json:"id_number,omitempty" gorm:"column:id_number" db:"id_number"`
 	ProfilePictureURL *string         `json:"profile_picture_url,omitempty" gorm:"column:profile_picture_url" db:"profile_picture_url"`
+	ProfilePictureKey *string         `json:"-" gorm:"column:profile_picture_key" db:"profile_picture_key"`
 	Metadata          json.RawMessage `json:"metadata,omitempty" gorm:"column:metadata;type:jsonb;not null;default:'{}'" db:"metadata"`
 	UpdatedAt         time.Time       `json:"updated_at" gorm:"column:updated_at" db:"updated_at"`
 }
@@ -89,8 +90,7 @@ const (
 )
 
 type PasswordCredentialData struct {
-	PasswordHash    string   `json:"password_hash"`
-	PasswordHistory []string `json:"password_history,omitempty"`
+	PasswordHash string `json:"password_hash"`
 }
 
 type UserAuthMethod struct {
@@ -109,8 +109,7 @@ func (UserAuthMethod) TableName() string {
 
 func NewPasswordAuthMethod(userID uuid.UUID, passwordHash string) *UserAuthMethod {
 	data := PasswordCredentialData{
-		PasswordHash:    passwordHash,
-		PasswordHistory: []string{},
+		PasswordHash: passwordHash,
 	}
 	credJSON, _ := json.Marshal(data)
 	now := time.Now()
@@ -132,6 +131,12 @@ func (m *UserAuthMethod) GetPasswordData() (*PasswordCredentialData, error) {
 	return &data, nil
 }
 
+func (m *UserAuthMethod) SetPasswordHash(passwordHash string) {
+	credJSON, _ := json.Marshal(PasswordCredentialData{PasswordHash: passwordHash})
+	m.CredentialData = credJSON
+	m.UpdatedAt = time.Now()
+}
+
 func (m *UserAuthMethod) GetPasswordHash() string {
 	data, err := m.GetPasswordData()
 	if err != nil {
@@ -151,6 +156,7 @@ type UserSecurityState struct {
 	EmailVerifiedAt     *time.Time `json:"email_verified_at,omitempty" gorm:"column:email_verified_at" db:"email_verified_at"`
 	PINVerified         bool       `json:"pin_verified" gorm:"column:pin_verified;default:false" db:"pin_verified"`
 	ForcePasswordChange bool       `json:"force_password_change" gorm:"column:force_password_change;default:false" db:"force_password_change"`
+	PasswordChangedAt   *time.Time `json:"password_changed_at,omitempty" gorm:"column:password_changed_at" db:"password_changed_at"`
 	UpdatedAt           time.Time  `json:"updated_at" gorm:"column:updated_at" db:"updated_at"`
 }
 
@@ -17,6 +17,20 @@ const (
 	UTRStatusInactive        UserTenantRegistrationStatus = "INACTIVE"
 )
 
+const (
+	RegistrationTypeParticipant = "PARTICIPANT"
+	RegistrationTypeMember      = "MEMBER"
+)
+
+// Outcome of matching a registration's identification number against the
+// tenant's approved participants.
+const (
+	ParticipantLinkLinked   = "LINKED"
+	ParticipantLinkMatched  = "MATCHED"
+	ParticipantLinkConflict = "CONFLICT"
+	ParticipantLinkNoMatch  = "NO_MATCH"
+)
+
 type UserTenantRegistration struct {
 	ID                   uuid.UUID                    `json:"id" gorm:"column:id;primaryKey;type:uuid;default:uuidv7()" db:"id"`
 	UserID               uuid.UUID                    `json:"user_id" gorm:"column:user_id;type:uuid;not null" db:"user_id"`
@@ -36,3 +50,57 @@ type UserTenantRegistration struct {
 func (UserTenantRegistration) TableName() string {
 	return "user_tenant_registrations"
 }
+
+func (r *UserTenantRegistration) IsPendingApproval() bool {
+	return r.Status == UTRStatusPendingApproval
+}
+
+// GetProductID returns the product the registration was submitted for, kept
+// in metadata because the registration itself is tenant-wide.
+func (r *UserTenantRegistration) GetProductID() *uuid.UUID {
+	var meta struct {
+		ProductID *uuid.UUID `json:"product_id"`
+	}
+	if len(r.Metadata) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Metadata, &meta); err != nil {
+		return nil
+	}
+	return meta.ProductID
+}
+
+// GetParticipantLink returns the participant matched at registration and the
+// outcome of the match, if any.
+func (r *UserTenantRegistration) GetParticipantLink() (*uuid.UUID, string) {
+	var meta struct {
+		ParticipantID *uuid.UUID `json:"participant_id"`
+		LinkStatus    string     `json:"participant_link_status"`
+	}
+	if len(r.Metadata) == 0 {
+		return nil, ""
+	}
+	if err := json.Unmarshal(r.Metadata, &meta); err != nil {
+		return nil, ""
+	}
+	return meta.ParticipantID, meta.LinkStatus
+}
+
+// SetMetadata merges the given values into the registration metadata.
+func (r *UserTenantRegistration) SetMetadata(values map[string]any) error {
+	metadata := map[string]any{}
+	if len(r.Metadata) > 0 {
+		if err := json.Unmarshal(r.Metadata, &metadata); err != nil {
+			return err
+		}
+	}
+	for key, value := range values {
+		metadata[key] = value
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+	r.Metadata = encoded
+	return nil
+}
@@ -0,0 +1,15 @@
+package apikeydto
+
+import (
+	"time"
+)
+
+type CreateRequest struct {
+	KeyName     string     `json:"key_name" validate:"required,min=3,max=100"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" validate:"omitempty"`
+	IPWhitelist []string   `json:"ip_whitelist,omitempty" validate:"omitempty,dive,required"`
+}
+
+type RevokeRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=255"`
+}
@@ -0,0 +1,33 @@
+package apikeydto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateResponse struct {
+	APIKeyResponse
+	Key string `json:"key"`
+}
+
+type APIKeyResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	TenantID    uuid.UUID  `json:"tenant_id"`
+	KeyName     string     `json:"key_name"`
+	KeyPrefix   string     `json:"key_prefix"`
+	IPWhitelist []string   `json:"ip_whitelist"`
+	IsActive    bool       `json:"is_active"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP  *string    `json:"last_used_ip,omitempty"`
+	CreatedBy   *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type AuthenticatedKey struct {
+	KeyID    uuid.UUID
+	TenantID uuid.UUID
+	KeyName  string
+}
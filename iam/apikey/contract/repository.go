@@ -0,0 +1,22 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type AdminAPIKeyRepository interface {
+	Create(ctx context.Context, key *entity.AdminAPIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.AdminAPIKey, error)
+	GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminAPIKey, error)
+	ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*entity.AdminAPIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedBy uuid.UUID, reason string) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error
+}
+
+type TenantRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error)
+}
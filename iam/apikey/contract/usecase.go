@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+	"net"
+
+	"iam-service/iam/apikey/apikeydto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, tenantID uuid.UUID, createdBy uuid.UUID, req *apikeydto.CreateRequest) (*apikeydto.CreateResponse, error)
+	List(ctx context.Context, tenantID uuid.UUID) ([]apikeydto.APIKeyResponse, error)
+	Revoke(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, revokedBy uuid.UUID, req *apikeydto.RevokeRequest) error
+	Authenticate(ctx context.Context, rawKey string, clientIP net.IP) (*apikeydto.AuthenticatedKey, error)
+}
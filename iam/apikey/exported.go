@@ -0,0 +1,24 @@
+package apikey
+
+import (
+	"iam-service/config"
+	"iam-service/iam/apikey/contract"
+	"iam-service/iam/apikey/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	cfg *config.Config,
+	tenantRepo contract.TenantRepository,
+	apiKeyRepo contract.AdminAPIKeyRepository,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		cfg,
+		tenantRepo,
+		apiKeyRepo,
+		auditLogger,
+	)
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Authenticate(ctx context.Context, rawKey string, clientIP net.IP) (*apikeydto.AuthenticatedKey, error) {
+	if !strings.HasPrefix(rawKey, APIKeyPrefix) {
+		return nil, errors.ErrUnauthorized("invalid api key")
+	}
+
+	key, err := uc.APIKeyRepo.GetByKeyHash(ctx, hashAPIKey(rawKey))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUnauthorized("invalid api key")
+		}
+		return nil, errors.ErrInternal("failed to verify api key").WithError(err)
+	}
+
+	if key.IsRevoked() || !key.IsActive {
+		return nil, errors.ErrUnauthorized("api key has been revoked")
+	}
+	if key.IsExpired() {
+		return nil, errors.ErrUnauthorized("api key has expired")
+	}
+	if clientIP == nil || !key.IsIPAllowed(clientIP) {
+		uc.AuditLogger.Log(ctx, logger.AuditEvent{
+			Domain:     "api_key",
+			Action:     "authenticate",
+			TargetID:   key.ID.String(),
+			TargetType: "admin_api_key",
+			TenantID:   key.TenantID.String(),
+			Success:    false,
+			Reason:     "ip_not_allowed",
+		})
+		return nil, errors.ErrForbidden("api key is not allowed from this IP address")
+	}
+
+	uc.updateLastUsedAsync(ctx, key.ID, clientIP)
+
+	return &apikeydto.AuthenticatedKey{
+		KeyID:    key.ID,
+		TenantID: key.TenantID,
+		KeyName:  key.KeyName,
+	}, nil
+}
+
+func (uc *usecase) updateLastUsedAsync(ctx context.Context, keyID uuid.UUID, clientIP net.IP) {
+	bgCtx := context.WithoutCancel(ctx)
+	ip := clientIP.String()
+	go func() {
+		updateCtx, cancel := context.WithTimeout(bgCtx, 5*time.Second)
+		defer cancel()
+		if err := uc.APIKeyRepo.UpdateLastUsed(updateCtx, keyID, ip); err != nil {
+			uc.AuditLogger.Log(updateCtx, logger.AuditEvent{
+				Domain:     "api_key",
+				Action:     "last_used_update_failed",
+				TargetID:   keyID.String(),
+				TargetType: "admin_api_key",
+				Success:    false,
+				Reason:     err.Error(),
+			})
+		}
+	}()
+}
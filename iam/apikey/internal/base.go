@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/apikey/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	Config      *config.Config
+	TenantRepo  contract.TenantRepository
+	APIKeyRepo  contract.AdminAPIKeyRepository
+	AuditLogger logger.AuditLogger
+}
+
+func NewUsecase(
+	cfg *config.Config,
+	tenantRepo contract.TenantRepository,
+	apiKeyRepo contract.AdminAPIKeyRepository,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		Config:      cfg,
+		TenantRepo:  tenantRepo,
+		APIKeyRepo:  apiKeyRepo,
+		AuditLogger: auditLogger,
+	}
+}
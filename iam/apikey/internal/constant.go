@@ -0,0 +1,7 @@
+package internal
+
+const (
+	APIKeyPrefix       = "iak_"
+	APIKeySecretBytes  = 32
+	APIKeyDisplayChars = 12
+)
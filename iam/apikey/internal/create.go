@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Create(ctx context.Context, tenantID uuid.UUID, createdBy uuid.UUID, req *apikeydto.CreateRequest) (*apikeydto.CreateResponse, error) {
+	tenant, err := uc.TenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrTenantNotFound()
+		}
+		return nil, errors.ErrInternal("failed to verify tenant").WithError(err)
+	}
+	if !tenant.IsActive() {
+		return nil, errors.ErrTenantInactive()
+	}
+
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, errors.ErrValidation("expires_at must be in the future")
+	}
+
+	if err := validateIPWhitelist(req.IPWhitelist); err != nil {
+		return nil, err
+	}
+
+	rawKey, keyHash, keyPrefix, err := generateAPIKey()
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate api key").WithError(err)
+	}
+
+	var key *entity.AdminAPIKey
+	if req.ExpiresAt != nil {
+		key = entity.NewAdminAPIKeyWithExpiry(tenantID, req.KeyName, keyHash, keyPrefix, &createdBy, *req.ExpiresAt)
+	} else {
+		key = entity.NewAdminAPIKey(tenantID, req.KeyName, keyHash, keyPrefix, &createdBy)
+	}
+
+	if len(req.IPWhitelist) > 0 {
+		if err := key.SetIPWhitelist(req.IPWhitelist); err != nil {
+			return nil, errors.ErrInternal("failed to encode ip whitelist").WithError(err)
+		}
+	}
+
+	if err := uc.APIKeyRepo.Create(ctx, key); err != nil {
+		return nil, errors.ErrInternal("failed to create api key").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "api_key",
+		Action:     "create",
+		ActorID:    createdBy.String(),
+		ActorType:  "user",
+		TargetID:   key.ID.String(),
+		TargetType: "admin_api_key",
+		TenantID:   key.TenantID.String(),
+		Success:    true,
+	})
+
+	return &apikeydto.CreateResponse{
+		APIKeyResponse: mapAPIKeyToResponse(key),
+		Key:            rawKey,
+	}, nil
+}
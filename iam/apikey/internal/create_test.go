@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	tenantID := uuid.New()
+	actorID := uuid.New()
+
+	tests := []struct {
+		name         string
+		status       entity.TenantStatus
+		expectedCode string
+	}{
+		{
+			name:   "success - key is bound to caller tenant",
+			status: entity.TenantStatusActive,
+		},
+		{
+			name:         "error - tenant inactive",
+			status:       entity.TenantStatusSuspended,
+			expectedCode: errors.CodeTenantInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantRepo := new(MockTenantRepository)
+			tenantRepo.On("GetByID", mock.Anything, tenantID).Return(&entity.Tenant{ID: tenantID, Status: tt.status}, nil)
+
+			apiKeyRepo := new(MockAdminAPIKeyRepository)
+			apiKeyRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.AdminAPIKey")).Return(nil)
+
+			uc := &usecase{
+				Config:      &config.Config{},
+				TenantRepo:  tenantRepo,
+				APIKeyRepo:  apiKeyRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Create(context.Background(), tenantID, actorID, &apikeydto.CreateRequest{KeyName: "ci pipeline"})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				apiKeyRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tenantID, resp.TenantID)
+			assert.NotEmpty(t, resp.Key)
+			apiKeyRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+
+	"iam-service/entity"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+)
+
+func generateAPIKey() (rawKey string, keyHash string, keyPrefix string, err error) {
+	secret := make([]byte, APIKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", "", err
+	}
+
+	rawKey = APIKeyPrefix + hex.EncodeToString(secret)
+	return rawKey, hashAPIKey(rawKey), rawKey[:APIKeyDisplayChars], nil
+}
+
+func hashAPIKey(rawKey string) string {
+	hash := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(hash[:])
+}
+
+func validateIPWhitelist(whitelist []string) error {
+	for _, entry := range whitelist {
+		if net.ParseIP(entry) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err == nil {
+			continue
+		}
+		return errors.ErrValidation("ip_whitelist contains an invalid IP address or CIDR: " + entry)
+	}
+	return nil
+}
+
+func mapAPIKeyToResponse(key *entity.AdminAPIKey) apikeydto.APIKeyResponse {
+	whitelist, _ := key.GetIPWhitelist()
+	if whitelist == nil {
+		whitelist = []string{}
+	}
+
+	return apikeydto.APIKeyResponse{
+		ID:          key.ID,
+		TenantID:    key.TenantID,
+		KeyName:     key.KeyName,
+		KeyPrefix:   key.KeyPrefix,
+		IPWhitelist: whitelist,
+		IsActive:    key.IsValid(),
+		ExpiresAt:   key.ExpiresAt,
+		RevokedAt:   key.RevokedAt,
+		LastUsedAt:  key.LastUsedAt,
+		LastUsedIP:  key.LastUsedIP,
+		CreatedBy:   key.CreatedBy,
+		CreatedAt:   key.CreatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID uuid.UUID) ([]apikeydto.APIKeyResponse, error) {
+	keys, err := uc.APIKeyRepo.ListByTenantID(ctx, tenantID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list api keys").WithError(err)
+	}
+
+	items := make([]apikeydto.APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		items = append(items, mapAPIKeyToResponse(key))
+	}
+
+	return items, nil
+}
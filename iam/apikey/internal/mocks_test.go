@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAdminAPIKeyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminAPIKeyRepository) Create(ctx context.Context, key *entity.AdminAPIKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockAdminAPIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.AdminAPIKey, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AdminAPIKey), args.Error(1)
+}
+
+func (m *MockAdminAPIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminAPIKey, error) {
+	args := m.Called(ctx, keyHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.AdminAPIKey), args.Error(1)
+}
+
+func (m *MockAdminAPIKeyRepository) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*entity.AdminAPIKey, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.AdminAPIKey), args.Error(1)
+}
+
+func (m *MockAdminAPIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, revokedBy uuid.UUID, reason string) error {
+	args := m.Called(ctx, id, revokedBy, reason)
+	return args.Error(0)
+}
+
+func (m *MockAdminAPIKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error {
+	args := m.Called(ctx, id, ip)
+	return args.Error(0)
+}
+
+type MockTenantRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Tenant), args.Error(1)
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Revoke(ctx context.Context, tenantID uuid.UUID, id uuid.UUID, revokedBy uuid.UUID, req *apikeydto.RevokeRequest) error {
+	key, err := uc.APIKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("API key not found")
+		}
+		return errors.ErrInternal("failed to get api key").WithError(err)
+	}
+
+	if key.TenantID != tenantID {
+		return errors.ErrNotFound("API key not found")
+	}
+
+	if key.IsRevoked() {
+		return errors.ErrConflict("API key is already revoked")
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "admin_revoke"
+	}
+
+	if err := uc.APIKeyRepo.Revoke(ctx, key.ID, revokedBy, reason); err != nil {
+		return errors.ErrInternal("failed to revoke api key").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "api_key",
+		Action:     "revoke",
+		ActorID:    revokedBy.String(),
+		ActorType:  "user",
+		TargetID:   key.ID.String(),
+		TargetType: "admin_api_key",
+		TenantID:   key.TenantID.String(),
+		Success:    true,
+		Reason:     reason,
+	})
+
+	return nil
+}
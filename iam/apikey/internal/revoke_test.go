@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/apikey/apikeydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevoke(t *testing.T) {
+	tenantID := uuid.New()
+	keyID := uuid.New()
+	actorID := uuid.New()
+
+	revokedKey := &entity.AdminAPIKey{ID: keyID, TenantID: tenantID}
+	revokedKey.Revoke(actorID, "rotated")
+
+	tests := []struct {
+		name         string
+		tenantID     uuid.UUID
+		key          *entity.AdminAPIKey
+		expectRevoke bool
+		expectedCode string
+	}{
+		{
+			name:         "success - key belongs to caller tenant",
+			tenantID:     tenantID,
+			key:          &entity.AdminAPIKey{ID: keyID, TenantID: tenantID},
+			expectRevoke: true,
+		},
+		{
+			name:         "error - key belongs to another tenant",
+			tenantID:     uuid.New(),
+			key:          &entity.AdminAPIKey{ID: keyID, TenantID: tenantID},
+			expectedCode: errors.CodeNotFound,
+		},
+		{
+			name:         "error - key already revoked",
+			tenantID:     tenantID,
+			key:          revokedKey,
+			expectedCode: errors.CodeConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyRepo := new(MockAdminAPIKeyRepository)
+			apiKeyRepo.On("GetByID", mock.Anything, keyID).Return(tt.key, nil)
+			if tt.expectRevoke {
+				apiKeyRepo.On("Revoke", mock.Anything, keyID, actorID, "admin_revoke").Return(nil)
+			}
+
+			uc := &usecase{
+				Config:      &config.Config{},
+				TenantRepo:  new(MockTenantRepository),
+				APIKeyRepo:  apiKeyRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			err := uc.Revoke(context.Background(), tt.tenantID, keyID, actorID, &apikeydto.RevokeRequest{})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				apiKeyRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			apiKeyRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package authdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StartImpersonationRequest struct {
+	ActorID         uuid.UUID  `json:"-"`
+	ActorEmail      string     `json:"-"`
+	IsPlatformAdmin bool       `json:"-"`
+	TenantID        *uuid.UUID `json:"-"`
+	TargetUserID    uuid.UUID  `json:"user_id" validate:"required"`
+	Reason          string     `json:"reason" validate:"required,min=5,max=500"`
+	IPAddress       string     `json:"-"`
+	UserAgent       string     `json:"-"`
+}
+
+type StartImpersonationResponse struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresIn   int       `json:"expires_in"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	SessionID   uuid.UUID `json:"session_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Email       string    `json:"email"`
+	ActorID     uuid.UUID `json:"actor_id"`
+}
+
+type StopImpersonationRequest struct {
+	ActorID      uuid.UUID  `json:"-"`
+	TargetUserID uuid.UUID  `json:"-"`
+	TenantID     *uuid.UUID `json:"-"`
+	SessionID    uuid.UUID  `json:"-"`
+	TokenJTI     string     `json:"-"`
+	TokenExp     time.Time  `json:"-"`
+	IPAddress    string     `json:"-"`
+	UserAgent    string     `json:"-"`
+}
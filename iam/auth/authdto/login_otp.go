@@ -46,6 +46,26 @@ type VerifyLoginOTPResponse struct {
 	ExpiresIn    int               `json:"expires_in"`
 	TokenType    string            `json:"token_type"`
 	User         LoginUserResponse `json:"user"`
+
+	// PasswordChangeRequired means AccessToken is a restricted token that is
+	// only accepted by the change-password endpoint and no refresh token is issued.
+	PasswordChangeRequired bool   `json:"password_change_required,omitempty"`
+	PasswordChangeReason   string `json:"password_change_reason,omitempty"`
+
+	// ConsentRequired means AccessToken is a restricted token that is only
+	// accepted by the consent endpoints until PendingConsents are accepted.
+	ConsentRequired bool                     `json:"consent_required,omitempty"`
+	PendingConsents []PendingConsentResponse `json:"pending_consents,omitempty"`
+}
+
+type PendingConsentResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty"`
+	DocumentType string     `json:"document_type"`
+	Version      string     `json:"version"`
+	Title        string     `json:"title"`
+	ContentURL   string     `json:"content_url"`
 }
 
 type ResendLoginOTPResponse struct {
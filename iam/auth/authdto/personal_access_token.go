@@ -0,0 +1,39 @@
+package authdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreatePersonalAccessTokenRequest struct {
+	UserID        uuid.UUID `json:"-"`
+	TenantID      uuid.UUID `json:"tenant_id" validate:"required"`
+	Name          string    `json:"name" validate:"required,min=3,max=100"`
+	Scopes        []string  `json:"scopes" validate:"required,min=1,dive,required"`
+	ExpiresInDays int       `json:"expires_in_days" validate:"required,min=1,max=365"`
+}
+
+type RevokePersonalAccessTokenRequest struct {
+	UserID  uuid.UUID `json:"-"`
+	TokenID uuid.UUID `json:"-"`
+}
+
+type PersonalAccessTokenResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	TenantID    uuid.UUID  `json:"tenant_id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP  *string    `json:"last_used_ip,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	IsActive    bool       `json:"is_active"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type CreatePersonalAccessTokenResponse struct {
+	PersonalAccessTokenResponse
+	Token string `json:"token"`
+}
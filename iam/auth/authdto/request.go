@@ -28,9 +28,13 @@ type RefreshTokenRequest struct {
 }
 
 type InitiateRegistrationRequest struct {
-	Email     string `json:"email" validate:"required,email,max=255"`
-	IPAddress string `json:"-"`
-	UserAgent string `json:"-"`
+	Email                string     `json:"email" validate:"required,email,max=255"`
+	TenantID             *uuid.UUID `json:"tenant_id,omitempty" validate:"required_with=ProductID RegistrationType"`
+	ProductID            *uuid.UUID `json:"product_id,omitempty" validate:"required_with=TenantID"`
+	RegistrationType     string     `json:"registration_type,omitempty" validate:"omitempty,oneof=PARTICIPANT MEMBER"`
+	IdentificationNumber *string    `json:"identification_number,omitempty" validate:"omitempty,max=100"`
+	IPAddress            string     `json:"-"`
+	UserAgent            string     `json:"-"`
 }
 
 type VerifyRegistrationOTPRequest struct {
@@ -52,14 +56,17 @@ type SetPasswordRequest struct {
 }
 
 type CompleteProfileRegistrationRequest struct {
-	RegistrationID    uuid.UUID `json:"-"`
-	RegistrationToken string    `json:"-"`
-	FullName          string    `json:"full_name" validate:"required,min=1,max=200"`
-	PhoneNumber       string    `json:"phone_number" validate:"required,e164"`
-	DateOfBirth       string    `json:"date_of_birth" validate:"required"`
-	Gender            string    `json:"gender" validate:"required,oneof=male female other"`
-	MaritalStatus     string    `json:"marital_status" validate:"required,oneof=single married divorced widowed"`
-	Address           string    `json:"address" validate:"required,min=10,max=500"`
+	RegistrationID     uuid.UUID   `json:"-"`
+	RegistrationToken  string      `json:"-"`
+	IPAddress          string      `json:"-"`
+	UserAgent          string      `json:"-"`
+	FullName           string      `json:"full_name" validate:"required,min=1,max=200"`
+	PhoneNumber        string      `json:"phone_number" validate:"required,e164"`
+	DateOfBirth        string      `json:"date_of_birth" validate:"required"`
+	Gender             string      `json:"gender" validate:"required,oneof=male female other"`
+	MaritalStatus      string      `json:"marital_status" validate:"required,oneof=single married divorced widowed"`
+	Address            string      `json:"address" validate:"required,min=10,max=500"`
+	AcceptedConsentIDs []uuid.UUID `json:"accepted_consent_ids,omitempty" validate:"omitempty,max=20"`
 }
 
 type CompleteRegistrationRequest struct {
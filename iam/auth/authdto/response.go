@@ -25,8 +25,9 @@ type ProductResponse struct {
 }
 
 type TenantResponse struct {
-	TenantID uuid.UUID         `json:"tenant_id"`
-	Products []ProductResponse `json:"products,omitempty"`
+	TenantID          uuid.UUID         `json:"tenant_id"`
+	RegistrationTypes []string          `json:"registration_types,omitempty"`
+	Products          []ProductResponse `json:"products,omitempty"`
 }
 
 type LoginUserResponse struct {
@@ -37,11 +38,15 @@ type LoginUserResponse struct {
 }
 
 type RefreshTokenResponse struct {
-	AccessToken  string            `json:"access_token"`
-	RefreshToken string            `json:"refresh_token"`
-	ExpiresIn    int               `json:"expires_in"`
-	TokenType    string            `json:"token_type"`
-	User         LoginUserResponse `json:"user"`
+	AccessToken            string                   `json:"access_token"`
+	RefreshToken           string                   `json:"refresh_token,omitempty"`
+	ExpiresIn              int                      `json:"expires_in"`
+	TokenType              string                   `json:"token_type"`
+	User                   LoginUserResponse        `json:"user"`
+	PasswordChangeRequired bool                     `json:"password_change_required,omitempty"`
+	PasswordChangeReason   string                   `json:"password_change_reason,omitempty"`
+	ConsentRequired        bool                     `json:"consent_required,omitempty"`
+	PendingConsents        []PendingConsentResponse `json:"pending_consents,omitempty"`
 }
 
 type OTPConfig struct {
@@ -82,18 +87,31 @@ type SetPasswordResponse struct {
 	Message           string   `json:"message"`
 	RegistrationToken string   `json:"registration_token"`
 	NextStep          NextStep `json:"next_step"`
+	Warnings          []string `json:"warnings,omitempty"`
 }
 
 type CompleteProfileRegistrationResponse struct {
-	UserID       uuid.UUID               `json:"user_id"`
-	Email        string                  `json:"email"`
-	Status       string                  `json:"status"`
-	Message      string                  `json:"message"`
-	Profile      RegistrationUserProfile `json:"profile"`
-	AccessToken  string                  `json:"access_token"`
-	RefreshToken string                  `json:"refresh_token"`
-	TokenType    string                  `json:"token_type"`
-	ExpiresIn    int                     `json:"expires_in"`
+	UserID             uuid.UUID                   `json:"user_id"`
+	Email              string                      `json:"email"`
+	Status             string                      `json:"status"`
+	Message            string                      `json:"message"`
+	Profile            RegistrationUserProfile     `json:"profile"`
+	TenantRegistration *TenantRegistrationResponse `json:"tenant_registration,omitempty"`
+	AccessToken        string                      `json:"access_token"`
+	RefreshToken       string                      `json:"refresh_token"`
+	TokenType          string                      `json:"token_type"`
+	ExpiresIn          int                         `json:"expires_in"`
+}
+
+type TenantRegistrationResponse struct {
+	ID               uuid.UUID  `json:"id"`
+	TenantID         uuid.UUID  `json:"tenant_id"`
+	ProductID        uuid.UUID  `json:"product_id"`
+	RegistrationType string     `json:"registration_type"`
+	Status           string     `json:"status"`
+	GrantedRoleID    *uuid.UUID `json:"granted_role_id,omitempty"`
+	ParticipantID    *uuid.UUID `json:"participant_id,omitempty"`
+	ParticipantLink  string     `json:"participant_link,omitempty"`
 }
 
 type ResendRegistrationOTPResponse struct {
@@ -116,6 +134,8 @@ type CompleteRegistrationResponse struct {
 	Message string                  `json:"message"`
 	Profile RegistrationUserProfile `json:"profile"`
 
+	TenantRegistration *TenantRegistrationResponse `json:"tenant_registration,omitempty"`
+
 	AccessToken  *string `json:"access_token,omitempty"`
 	RefreshToken *string `json:"refresh_token,omitempty"`
 	TokenType    *string `json:"token_type,omitempty"`
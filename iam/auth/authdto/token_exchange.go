@@ -0,0 +1,26 @@
+package authdto
+
+import (
+	"github.com/google/uuid"
+)
+
+type TokenExchangeRequest struct {
+	UserID           uuid.UUID `json:"-"`
+	GrantType        string    `json:"grant_type" validate:"required"`
+	SubjectToken     string    `json:"subject_token" validate:"required"`
+	SubjectTokenType string    `json:"subject_token_type" validate:"required"`
+	Audience         string    `json:"audience" validate:"omitempty,max=100"`
+	TenantID         uuid.UUID `json:"tenant_id" validate:"required"`
+	ProductID        uuid.UUID `json:"product_id" validate:"required"`
+	IPAddress        string    `json:"-"`
+}
+
+type TokenExchangeResponse struct {
+	AccessToken     string   `json:"access_token"`
+	IssuedTokenType string   `json:"issued_token_type"`
+	TokenType       string   `json:"token_type"`
+	ExpiresIn       int      `json:"expires_in"`
+	Audience        []string `json:"audience"`
+	Roles           []string `json:"roles"`
+	Permissions     []string `json:"permissions"`
+}
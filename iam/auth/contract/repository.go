@@ -99,13 +99,55 @@ type UserSessionRepository interface {
 }
 
 type UserTenantRegistrationRepository interface {
+	Create(ctx context.Context, registration *entity.UserTenantRegistration) error
 	ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error)
 }
 
+type ProductRegistrationConfigRepository interface {
+	GetByProductAndType(ctx context.Context, productID uuid.UUID, registrationType string) (*entity.ProductRegistrationConfig, error)
+}
+
 type ProductsByTenantRepository interface {
 	ListActiveByTenantID(ctx context.Context, tenantID uuid.UUID) ([]entity.Product, error)
 }
 
+type PersonalAccessTokenRepository interface {
+	Create(ctx context.Context, token *entity.PersonalAccessToken) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error)
+	Revoke(ctx context.Context, id uuid.UUID, reason string) error
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID, reason string) error
+	UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AdminAuditLog) error
+}
+
+type PasswordPolicyRepository interface {
+	Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+}
+
+type PasswordHistoryRepository interface {
+	Create(ctx context.Context, history *entity.PasswordHistory) error
+	ListRecentHashes(ctx context.Context, userID uuid.UUID, limit int) ([]string, error)
+}
+
+type BreachedPasswordChecker interface {
+	IsBreached(password string) bool
+}
+
+type ConsentDocumentRepository interface {
+	ListCurrentForRegistration(ctx context.Context, tenantID, productID *uuid.UUID) ([]entity.ConsentDocument, error)
+	ListCurrentForUser(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error)
+}
+
+type UserConsentRepository interface {
+	Create(ctx context.Context, consent *entity.UserConsent) error
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserConsent, error)
+}
+
 type RegistrationSessionStore interface {
 	CreateRegistrationSession(ctx context.Context, session *entity.RegistrationSession, ttl time.Duration) error
 	GetRegistrationSession(ctx context.Context, sessionID uuid.UUID) (*entity.RegistrationSession, error)
@@ -138,3 +180,8 @@ type InMemoryStore interface {
 	LoginSessionStore
 	TokenBlacklistStore
 }
+
+type ParticipantRepository interface {
+	ListApprovedByIdentificationNumber(ctx context.Context, tenantID uuid.UUID, applicationID *uuid.UUID, identificationNumber string) ([]entity.Participant, error)
+	LinkUser(ctx context.Context, participantID, userID uuid.UUID) error
+}
@@ -1 +1,11 @@
 package contract
+
+import (
+	"context"
+
+	jwtpkg "iam-service/pkg/jwt"
+)
+
+type PersonalAccessTokenAuthenticator interface {
+	AuthenticatePersonalAccessToken(ctx context.Context, rawToken string, clientIP string) (*jwtpkg.MultiTenantClaims, error)
+}
@@ -6,6 +6,7 @@ import (
 	"iam-service/iam/auth/authdto"
 	"iam-service/iam/auth/contract"
 	"iam-service/iam/auth/internal"
+	jwtpkg "iam-service/pkg/jwt"
 	"iam-service/pkg/logger"
 
 	"github.com/google/uuid"
@@ -15,6 +16,7 @@ type Usecase interface {
 	Logout(ctx context.Context, req *authdto.LogoutRequest) error
 	LogoutAll(ctx context.Context, req *authdto.LogoutAllRequest) error
 	RefreshToken(ctx context.Context, req *authdto.RefreshTokenRequest) (*authdto.RefreshTokenResponse, error)
+	ExchangeToken(ctx context.Context, req *authdto.TokenExchangeRequest) (*authdto.TokenExchangeResponse, error)
 
 	InitiateRegistration(ctx context.Context, req *authdto.InitiateRegistrationRequest) (*authdto.InitiateRegistrationResponse, error)
 	VerifyRegistrationOTP(ctx context.Context, req *authdto.VerifyRegistrationOTPRequest) (*authdto.VerifyRegistrationOTPResponse, error)
@@ -28,8 +30,18 @@ type Usecase interface {
 	VerifyLoginOTP(ctx context.Context, req *authdto.VerifyLoginOTPRequest) (*authdto.VerifyLoginOTPResponse, error)
 	ResendLoginOTP(ctx context.Context, req *authdto.ResendLoginOTPRequest) (*authdto.ResendLoginOTPResponse, error)
 	GetLoginStatus(ctx context.Context, req *authdto.GetLoginStatusRequest) (*authdto.LoginStatusResponse, error)
+
+	CreatePersonalAccessToken(ctx context.Context, req *authdto.CreatePersonalAccessTokenRequest) (*authdto.CreatePersonalAccessTokenResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]authdto.PersonalAccessTokenResponse, error)
+	RevokePersonalAccessToken(ctx context.Context, req *authdto.RevokePersonalAccessTokenRequest) error
+	AuthenticatePersonalAccessToken(ctx context.Context, rawToken string, clientIP string) (*jwtpkg.MultiTenantClaims, error)
+
+	StartImpersonation(ctx context.Context, req *authdto.StartImpersonationRequest) (*authdto.StartImpersonationResponse, error)
+	StopImpersonation(ctx context.Context, req *authdto.StopImpersonationRequest) error
 }
 
+const PersonalAccessTokenPrefix = internal.PersonalAccessTokenPrefix
+
 func NewUsecase(
 	txManager contract.TransactionManager,
 	cfg *config.Config,
@@ -48,6 +60,15 @@ func NewUsecase(
 	userSessionRepo contract.UserSessionRepository,
 	userTenantRegRepo contract.UserTenantRegistrationRepository,
 	productsByTenantRepo contract.ProductsByTenantRepository,
+	productRegConfigRepo contract.ProductRegistrationConfigRepository,
+	participantRepo contract.ParticipantRepository,
+	personalAccessTokenRepo contract.PersonalAccessTokenRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	consentDocumentRepo contract.ConsentDocumentRepository,
+	userConsentRepo contract.UserConsentRepository,
 	auditLogger logger.AuditLogger,
 ) Usecase {
 	return internal.NewUsecase(
@@ -68,6 +89,15 @@ func NewUsecase(
 		userSessionRepo,
 		userTenantRegRepo,
 		productsByTenantRepo,
+		productRegConfigRepo,
+		participantRepo,
+		personalAccessTokenRepo,
+		adminAuditLogRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		consentDocumentRepo,
+		userConsentRepo,
 		auditLogger,
 	)
 }
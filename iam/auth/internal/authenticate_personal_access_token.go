@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) AuthenticatePersonalAccessToken(ctx context.Context, rawToken string, clientIP string) (*jwtpkg.MultiTenantClaims, error) {
+	if !strings.HasPrefix(rawToken, PersonalAccessTokenPrefix) {
+		return nil, errors.ErrTokenInvalid()
+	}
+
+	token, err := uc.PersonalAccessTokenRepo.GetByTokenHash(ctx, hashToken(rawToken))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrTokenInvalid()
+		}
+		return nil, errors.ErrInternal("failed to verify personal access token").WithError(err)
+	}
+
+	if token.IsRevoked() {
+		return nil, errors.ErrTokenInvalid()
+	}
+	if token.IsExpired() {
+		return nil, errors.ErrTokenExpired()
+	}
+
+	user, err := uc.UserRepo.GetByID(ctx, token.UserID)
+	if err != nil {
+		return nil, errors.ErrTokenInvalid()
+	}
+	if user.Status != entity.UserStatusActive {
+		return nil, errors.ErrTokenInvalid()
+	}
+
+	// A token must not reach further than an interactive login would, so the
+	// password and consent gates of login apply on every request as well.
+	changeReason, err := uc.passwordChangeReason(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if changeReason != "" {
+		return nil, errors.New("PASSWORD_CHANGE_REQUIRED", "password change required before accessing this resource", http.StatusForbidden)
+	}
+
+	pendingConsents, err := uc.pendingRequiredConsents(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pendingConsents) > 0 {
+		return nil, errors.New("CONSENT_REQUIRED", "consent to the current terms is required before accessing this resource", http.StatusForbidden)
+	}
+
+	// Permissions are re-derived on every request so a token never outlives
+	// a role the owner has since lost.
+	tenantClaims, _, err := uc.buildMultiTenantClaims(ctx, token.UserID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
+	}
+
+	scopes, err := token.GetScopes()
+	if err != nil {
+		return nil, errors.ErrInternal("failed to decode token scopes").WithError(err)
+	}
+
+	scopedTenant := scopeTenantClaim(tenantClaims, token.TenantID, scopes)
+	if scopedTenant == nil {
+		return nil, errors.ErrTokenInvalid()
+	}
+
+	uc.updatePersonalAccessTokenLastUsedAsync(ctx, token.ID, clientIP)
+
+	return &jwtpkg.MultiTenantClaims{
+		UserID:  user.ID,
+		Email:   user.Email,
+		Tenants: []jwtpkg.TenantClaim{*scopedTenant},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        token.ID.String(),
+			Subject:   user.ID.String(),
+			Issuer:    uc.Config.JWT.Issuer,
+			IssuedAt:  jwt.NewNumericDate(token.CreatedAt),
+			ExpiresAt: jwt.NewNumericDate(token.ExpiresAt),
+		},
+	}, nil
+}
+
+func (uc *usecase) updatePersonalAccessTokenLastUsedAsync(ctx context.Context, tokenID uuid.UUID, clientIP string) {
+	bgCtx := context.WithoutCancel(ctx)
+	go func() {
+		updateCtx, cancel := context.WithTimeout(bgCtx, 5*time.Second)
+		defer cancel()
+		if err := uc.PersonalAccessTokenRepo.UpdateLastUsed(updateCtx, tokenID, clientIP); err != nil {
+			uc.AuditLogger.Log(updateCtx, logger.AuditEvent{
+				Domain:     "auth",
+				Action:     "personal_access_token_last_used_update_failed",
+				TargetID:   tokenID.String(),
+				TargetType: "personal_access_token",
+				Success:    false,
+				Reason:     err.Error(),
+			})
+		}
+	}()
+}
@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticatePersonalAccessToken_LoginGates(t *testing.T) {
+	userID := uuid.New()
+	rawToken := PersonalAccessTokenPrefix + "secret"
+
+	tests := []struct {
+		name     string
+		security *entity.UserSecurityState
+		pending  []entity.ConsentDocument
+		wantCode string
+	}{
+		{
+			name:     "forced password change",
+			security: &entity.UserSecurityState{UserID: userID, ForcePasswordChange: true},
+			wantCode: "PASSWORD_CHANGE_REQUIRED",
+		},
+		{
+			name:     "pending required consent",
+			security: &entity.UserSecurityState{UserID: userID},
+			pending: []entity.ConsentDocument{
+				{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "2.0", Title: "Terms", IsRequired: true},
+			},
+			wantCode: "CONSENT_REQUIRED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patRepo := new(MockPersonalAccessTokenRepository)
+			patRepo.On("GetByTokenHash", mock.Anything, hashToken(rawToken)).Return(&entity.PersonalAccessToken{
+				ID:        uuid.New(),
+				UserID:    userID,
+				TenantID:  uuid.New(),
+				ExpiresAt: time.Now().Add(time.Hour),
+				CreatedAt: time.Now(),
+			}, nil)
+
+			userRepo := new(MockUserRepository)
+			userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{
+				ID:     userID,
+				Email:  "test@example.com",
+				Status: entity.UserStatusActive,
+			}, nil)
+
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserAuthMethod{
+				UserID:     userID,
+				MethodType: string(entity.AuthMethodPassword),
+			}, nil)
+			securityRepo := new(MockUserSecurityStateRepository)
+			securityRepo.On("GetByUserID", mock.Anything, userID).Return(tt.security, nil)
+
+			consentDocRepo := new(MockConsentDocumentRepository)
+			consentDocRepo.On("ListCurrentForUser", mock.Anything, userID).Return(tt.pending, nil).Maybe()
+			userConsentRepo := new(MockUserConsentRepository)
+			userConsentRepo.On("ListByUserID", mock.Anything, userID).Return([]entity.UserConsent{}, nil).Maybe()
+
+			uc := &usecase{
+				PersonalAccessTokenRepo: patRepo,
+				UserRepo:                userRepo,
+				UserAuthMethodRepo:      authMethodRepo,
+				UserSecurityStateRepo:   securityRepo,
+				ConsentDocumentRepo:     consentDocRepo,
+				UserConsentRepo:         userConsentRepo,
+			}
+
+			claims, err := uc.AuthenticatePersonalAccessToken(context.Background(), rawToken, "10.0.0.1")
+			require.Error(t, err)
+			assert.Nil(t, claims)
+
+			var appErr *errors.AppError
+			require.True(t, errors.As(err, &appErr))
+			assert.Equal(t, tt.wantCode, appErr.Code)
+			assert.Equal(t, http.StatusForbidden, appErr.HTTPStatus)
+			patRepo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
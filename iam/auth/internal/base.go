@@ -24,6 +24,15 @@ type usecase struct {
 	UserSessionRepo      contract.UserSessionRepository
 	UserTenantRegRepo    contract.UserTenantRegistrationRepository
 	ProductsByTenantRepo contract.ProductsByTenantRepository
+	ProductRegConfigRepo contract.ProductRegistrationConfigRepository
+	ParticipantRepo      contract.ParticipantRepository
+	PersonalAccessTokenRepo contract.PersonalAccessTokenRepository
+	AdminAuditLogRepo    contract.AdminAuditLogRepository
+	PasswordPolicyRepo   contract.PasswordPolicyRepository
+	PasswordHistoryRepo  contract.PasswordHistoryRepository
+	BreachChecker        contract.BreachedPasswordChecker
+	ConsentDocumentRepo  contract.ConsentDocumentRepository
+	UserConsentRepo      contract.UserConsentRepository
 	AuditLogger          logger.AuditLogger
 }
 
@@ -45,6 +54,15 @@ func NewUsecase(
 	userSessionRepo contract.UserSessionRepository,
 	userTenantRegRepo contract.UserTenantRegistrationRepository,
 	productsByTenantRepo contract.ProductsByTenantRepository,
+	productRegConfigRepo contract.ProductRegistrationConfigRepository,
+	participantRepo contract.ParticipantRepository,
+	personalAccessTokenRepo contract.PersonalAccessTokenRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	consentDocumentRepo contract.ConsentDocumentRepository,
+	userConsentRepo contract.UserConsentRepository,
 	auditLogger logger.AuditLogger,
 ) *usecase {
 	return &usecase{
@@ -65,6 +83,15 @@ func NewUsecase(
 		UserSessionRepo:      userSessionRepo,
 		UserTenantRegRepo:    userTenantRegRepo,
 		ProductsByTenantRepo: productsByTenantRepo,
+		ProductRegConfigRepo: productRegConfigRepo,
+		ParticipantRepo:      participantRepo,
+		PersonalAccessTokenRepo: personalAccessTokenRepo,
+		AdminAuditLogRepo:    adminAuditLogRepo,
+		PasswordPolicyRepo:   passwordPolicyRepo,
+		PasswordHistoryRepo:  passwordHistoryRepo,
+		BreachChecker:        breachChecker,
+		ConsentDocumentRepo:  consentDocumentRepo,
+		UserConsentRepo:      userConsentRepo,
 		AuditLogger:          auditLogger,
 	}
 }
@@ -73,8 +73,22 @@ func (uc *usecase) CompleteProfileRegistration(
 		return nil, errors.ErrForbidden("Password has not been set")
 	}
 
+	var regConfig *entity.ProductRegistrationConfig
+	if session.HasTenantTarget() {
+		regConfig, err = uc.resolveRegistrationConfig(ctx, *session.TenantID, *session.ProductID, session.RegistrationType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	consentDocuments, err := uc.resolveRegistrationConsents(ctx, session, req.AcceptedConsentIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	var user *entity.User
+	var tenantRegistration *authdto.TenantRegistrationResponse
 
 	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		user = &entity.User{
@@ -92,6 +106,10 @@ func (uc *usecase) CompleteProfileRegistration(
 			return err
 		}
 
+		if err := uc.recordPasswordHistory(txCtx, user.ID, passwordHashStr); err != nil {
+			return err
+		}
+
 		profile := &entity.UserProfile{
 			UserID:        user.ID,
 			FirstName:     firstName,
@@ -108,15 +126,30 @@ func (uc *usecase) CompleteProfileRegistration(
 		}
 
 		securityState := &entity.UserSecurityState{
-			UserID:          user.ID,
-			EmailVerified:   true,
-			EmailVerifiedAt: &now,
-			UpdatedAt:       now,
+			UserID:            user.ID,
+			EmailVerified:     true,
+			EmailVerifiedAt:   &now,
+			PasswordChangedAt: &now,
+			UpdatedAt:         now,
 		}
 		if err := uc.UserSecurityStateRepo.Create(txCtx, securityState); err != nil {
 			return err
 		}
 
+		if regConfig != nil {
+			tenantRegistration, err = uc.registerTenantMembership(txCtx, user.ID, session, regConfig, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		for i := range consentDocuments {
+			consent := entity.NewUserConsent(user.ID, &consentDocuments[i], now, req.IPAddress, req.UserAgent)
+			if err := uc.UserConsentRepo.Create(txCtx, consent); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 
@@ -127,7 +160,7 @@ func (uc *usecase) CompleteProfileRegistration(
 	_ = uc.InMemoryStore.DeleteRegistrationSession(ctx, req.RegistrationID)
 	_ = uc.InMemoryStore.UnlockRegistrationEmail(ctx, session.Email)
 
-	accessToken, refreshToken, expiresIn, err := uc.generateAuthTokensForRegistration(ctx, user.ID, session.Email)
+	accessToken, refreshToken, expiresIn, err := uc.generateAuthTokensForRegistration(ctx, user.ID, session.Email, tenantRegistration != nil)
 	if err != nil {
 		return nil, errors.ErrInternal("failed to generate auth tokens").WithError(err)
 	}
@@ -145,10 +178,14 @@ func (uc *usecase) CompleteProfileRegistration(
 			FirstName: firstName,
 			LastName:  lastName,
 		},
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-		TokenType:    "Bearer",
-		ExpiresIn:    expiresIn,
+		TenantRegistration: tenantRegistration,
+		AccessToken:        accessToken,
+		RefreshToken:       refreshToken,
+		TokenType:          "Bearer",
+		ExpiresIn:          expiresIn,
+	}
+	if tenantRegistration != nil && tenantRegistration.Status == string(entity.UTRStatusPendingApproval) {
+		response.Message = "Registration completed successfully. Your tenant membership is pending approval."
 	}
 
 	return response, nil
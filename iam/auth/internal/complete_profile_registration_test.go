@@ -11,6 +11,7 @@ import (
 	"iam-service/entity"
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -348,6 +349,12 @@ func TestCompleteProfileRegistration(t *testing.T) {
 			}
 			tt.setupMocks(txManager, redis, userRepo, profileRepo, authMethodRepo, securityStateRepo, emailSvc, refreshTokenRepo, tokenHash)
 
+			passwordHistoryRepo := new(MockPasswordHistoryRepository)
+			passwordHistoryRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.PasswordHistory")).Return(nil).Maybe()
+
+			consentDocumentRepo := new(MockConsentDocumentRepository)
+			consentDocumentRepo.On("ListCurrentForRegistration", mock.Anything, mock.Anything, mock.Anything).Return([]entity.ConsentDocument{}, nil).Maybe()
+
 			cfg := &config.Config{
 				JWT: config.JWTConfig{
 					AccessSecret:  jwtSecret,
@@ -370,6 +377,8 @@ func TestCompleteProfileRegistration(t *testing.T) {
 				UserSecurityStateRepo: securityStateRepo,
 				EmailService:          emailSvc,
 				RefreshTokenRepo:      refreshTokenRepo,
+				PasswordHistoryRepo:   passwordHistoryRepo,
+				ConsentDocumentRepo:   consentDocumentRepo,
 			}
 
 			tt.req.RegistrationID = registrationID
@@ -398,3 +407,264 @@ func TestCompleteProfileRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestCompleteProfileRegistration_Consent(t *testing.T) {
+	registrationID := uuid.New()
+	email := "test@example.com"
+	jwtSecret := "test-secret-key-for-testing-purposes"
+
+	terms := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "1.0", Title: "Terms of Service", IsRequired: true}
+	notice := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentPrivacy, Version: "1.0", Title: "Marketing Notice"}
+
+	tests := []struct {
+		name            string
+		acceptedIDs     []uuid.UUID
+		expectedCode    string
+		expectedRecords int
+	}{
+		{
+			name:         "missing required document is rejected",
+			acceptedIDs:  []uuid.UUID{notice.ID},
+			expectedCode: "CONSENT_REQUIRED",
+		},
+		{
+			name:         "document that is not current is rejected",
+			acceptedIDs:  []uuid.UUID{terms.ID, uuid.New()},
+			expectedCode: errors.CodeBadRequest,
+		},
+		{
+			name:            "accepted documents are recorded",
+			acceptedIDs:     []uuid.UUID{terms.ID, notice.ID, terms.ID},
+			expectedRecords: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{
+				"registration_id": registrationID.String(),
+				"email":           email,
+				"purpose":         RegistrationCompleteTokenPurpose,
+				"exp":             time.Now().Add(15 * time.Minute).Unix(),
+				"iat":             time.Now().Unix(),
+				"jti":             uuid.New().String(),
+			}
+			tokenString, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSecret))
+			hash := sha256.Sum256([]byte(tokenString))
+			tokenHash := hex.EncodeToString(hash[:])
+
+			redis := &MockInMemoryStore{}
+			userRepo := &MockUserRepository{}
+			profileRepo := &MockUserProfileRepository{}
+			authMethodRepo := &MockUserAuthMethodRepository{}
+			securityStateRepo := &MockUserSecurityStateRepository{}
+			emailSvc := &MockEmailService{}
+			refreshTokenRepo := &MockRefreshTokenRepository{}
+			passwordHistoryRepo := &MockPasswordHistoryRepository{}
+			consentDocumentRepo := &MockConsentDocumentRepository{}
+			userConsentRepo := &MockUserConsentRepository{}
+
+			redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+				ID:                    registrationID,
+				Email:                 email,
+				Status:                entity.RegistrationSessionStatusPasswordSet,
+				RegistrationTokenHash: &tokenHash,
+				ExpiresAt:             time.Now().Add(10 * time.Minute),
+			}, nil)
+			redis.On("GetRegistrationPasswordHash", mock.Anything, registrationID).Return("$2a$10$hashedpassword", nil)
+			redis.On("DeleteRegistrationSession", mock.Anything, registrationID).Return(nil).Maybe()
+			redis.On("UnlockRegistrationEmail", mock.Anything, email).Return(nil).Maybe()
+			userRepo.On("EmailExists", mock.Anything, email).Return(false, nil)
+			userRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.User")).Return(nil).Maybe()
+			authMethodRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			profileRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			securityStateRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			refreshTokenRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			passwordHistoryRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			emailSvc.On("SendWelcome", mock.Anything, email, mock.Anything).Return(nil).Maybe()
+			consentDocumentRepo.On("ListCurrentForRegistration", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return([]entity.ConsentDocument{terms, notice}, nil)
+			userConsentRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserConsent")).Return(nil).Maybe()
+
+			uc := &usecase{
+				Config: &config.Config{JWT: config.JWTConfig{
+					AccessSecret:  jwtSecret,
+					RefreshSecret: "refresh-secret",
+					SigningMethod: "HS256",
+					AccessExpiry:  time.Hour,
+					RefreshExpiry: 24 * time.Hour,
+					Issuer:        "iam-service",
+				}},
+				TxManager:             NewMockTransactionManager(),
+				InMemoryStore:         redis,
+				UserRepo:              userRepo,
+				UserProfileRepo:       profileRepo,
+				UserAuthMethodRepo:    authMethodRepo,
+				UserSecurityStateRepo: securityStateRepo,
+				EmailService:          emailSvc,
+				RefreshTokenRepo:      refreshTokenRepo,
+				PasswordHistoryRepo:   passwordHistoryRepo,
+				ConsentDocumentRepo:   consentDocumentRepo,
+				UserConsentRepo:       userConsentRepo,
+			}
+
+			_, err := uc.CompleteProfileRegistration(context.Background(), &authdto.CompleteProfileRegistrationRequest{
+				RegistrationID:     registrationID,
+				RegistrationToken:  tokenString,
+				IPAddress:          "203.0.113.10",
+				FullName:           "John Smith",
+				PhoneNumber:        "+6281234567890",
+				DateOfBirth:        "1990-01-15",
+				Gender:             "male",
+				MaritalStatus:      "single",
+				Address:            "Jl. Sudirman No. 123, Jakarta Pusat",
+				AcceptedConsentIDs: tt.acceptedIDs,
+			})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				userRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			userConsentRepo.AssertNumberOfCalls(t, "Create", tt.expectedRecords)
+		})
+	}
+}
+
+func TestCompleteProfileRegistration_ParticipantLink(t *testing.T) {
+	registrationID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	participantID := uuid.New()
+	ktp := "3171000000000001"
+	email := "participant@example.com"
+	jwtSecret := "test-secret-key-for-testing-purposes"
+
+	claims := jwt.MapClaims{
+		"registration_id": registrationID.String(),
+		"email":           email,
+		"purpose":         RegistrationCompleteTokenPurpose,
+		"exp":             time.Now().Add(15 * time.Minute).Unix(),
+		"iat":             time.Now().Unix(),
+		"jti":             uuid.New().String(),
+	}
+	tokenString, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSecret))
+	hash := sha256.Sum256([]byte(tokenString))
+	tokenHash := hex.EncodeToString(hash[:])
+
+	redis := &MockInMemoryStore{}
+	redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+		ID:                    registrationID,
+		Email:                 email,
+		Status:                entity.RegistrationSessionStatusPasswordSet,
+		RegistrationTokenHash: &tokenHash,
+		TenantID:              &tenantID,
+		ProductID:             &productID,
+		RegistrationType:      entity.RegistrationTypeParticipant,
+		IdentificationNumber:  &ktp,
+		ExpiresAt:             time.Now().Add(10 * time.Minute),
+	}, nil)
+	redis.On("GetRegistrationPasswordHash", mock.Anything, registrationID).Return("$2a$10$hashedpassword", nil)
+	redis.On("DeleteRegistrationSession", mock.Anything, registrationID).Return(nil).Maybe()
+	redis.On("UnlockRegistrationEmail", mock.Anything, email).Return(nil).Maybe()
+
+	userRepo := &MockUserRepository{}
+	userRepo.On("EmailExists", mock.Anything, email).Return(false, nil)
+	userRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.User")).Return(nil)
+	authMethodRepo := &MockUserAuthMethodRepository{}
+	authMethodRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	profileRepo := &MockUserProfileRepository{}
+	profileRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	securityStateRepo := &MockUserSecurityStateRepository{}
+	securityStateRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	passwordHistoryRepo := &MockPasswordHistoryRepository{}
+	passwordHistoryRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	refreshTokenRepo := &MockRefreshTokenRepository{}
+	refreshTokenRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+	emailSvc := &MockEmailService{}
+	emailSvc.On("SendWelcome", mock.Anything, email, mock.Anything).Return(nil).Maybe()
+	consentDocumentRepo := &MockConsentDocumentRepository{}
+	consentDocumentRepo.On("ListCurrentForRegistration", mock.Anything, &tenantID, &productID).Return([]entity.ConsentDocument{}, nil)
+
+	tenantRepo := &MockTenantRepository{}
+	tenantRepo.On("GetByID", mock.Anything, tenantID).Return(&entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive}, nil)
+	productRepo := &MockProductRepository{}
+	productRepo.On("GetByIDAndTenant", mock.Anything, productID, tenantID).Return(&entity.Product{ID: productID, TenantID: tenantID, Code: "saving", IsActive: true}, nil)
+	regConfigRepo := &MockProductRegistrationConfigRepository{}
+	regConfigRepo.On("GetByProductAndType", mock.Anything, productID, entity.RegistrationTypeParticipant).Return(&entity.ProductRegistrationConfig{
+		ProductID:        productID,
+		RegistrationType: entity.RegistrationTypeParticipant,
+		IsActive:         true,
+	}, nil)
+
+	var created *entity.UserTenantRegistration
+	regRepo := &MockUserTenantRegistrationRepository{}
+	regRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserTenantRegistration")).
+		Run(func(args mock.Arguments) { created = args.Get(1).(*entity.UserTenantRegistration) }).
+		Return(nil)
+	regRepo.On("ListActiveByUserID", mock.Anything, mock.Anything).Return([]entity.UserTenantRegistration{
+		{TenantID: tenantID, RegistrationType: entity.RegistrationTypeParticipant, Status: entity.UTRStatusActive},
+	}, nil).Maybe()
+	userRoleRepo := &MockUserRoleRepository{}
+	userRoleRepo.On("ListActiveByUserID", mock.Anything, mock.Anything, mock.Anything).Return([]entity.UserRole{}, nil).Maybe()
+	productsRepo := &MockProductsByTenantRepository{}
+	productsRepo.On("ListActiveByTenantID", mock.Anything, tenantID).Return([]entity.Product{{ID: productID, Code: "saving"}}, nil).Maybe()
+
+	participantRepo := &MockParticipantRepository{}
+	participantRepo.On("ListApprovedByIdentificationNumber", mock.Anything, tenantID, &productID, ktp).Return([]entity.Participant{{ID: participantID}}, nil)
+	participantRepo.On("LinkUser", mock.Anything, participantID, mock.AnythingOfType("uuid.UUID")).Return(nil)
+
+	uc := &usecase{
+		Config: &config.Config{JWT: config.JWTConfig{
+			AccessSecret:  jwtSecret,
+			RefreshSecret: "refresh-secret",
+			SigningMethod: "HS256",
+			AccessExpiry:  time.Hour,
+			RefreshExpiry: 24 * time.Hour,
+			Issuer:        "iam-service",
+		}},
+		TxManager:             NewMockTransactionManager(),
+		InMemoryStore:         redis,
+		UserRepo:              userRepo,
+		UserProfileRepo:       profileRepo,
+		UserAuthMethodRepo:    authMethodRepo,
+		UserSecurityStateRepo: securityStateRepo,
+		TenantRepo:            tenantRepo,
+		ProductRepo:           productRepo,
+		ProductRegConfigRepo:  regConfigRepo,
+		UserTenantRegRepo:     regRepo,
+		UserRoleRepo:          userRoleRepo,
+		ProductsByTenantRepo:  productsRepo,
+		ParticipantRepo:       participantRepo,
+		EmailService:          emailSvc,
+		RefreshTokenRepo:      refreshTokenRepo,
+		PasswordHistoryRepo:   passwordHistoryRepo,
+		ConsentDocumentRepo:   consentDocumentRepo,
+		AuditLogger:           logger.NewNoopAuditLogger(),
+	}
+
+	resp, err := uc.CompleteProfileRegistration(context.Background(), &authdto.CompleteProfileRegistrationRequest{
+		RegistrationID:    registrationID,
+		RegistrationToken: tokenString,
+		FullName:          "Siti Rahayu",
+		PhoneNumber:       "+6281234567890",
+		DateOfBirth:       "1990-01-15",
+		Gender:            "female",
+		MaritalStatus:     "married",
+		Address:           "Jl. Sudirman No. 123, Jakarta Pusat",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.TenantRegistration)
+	require.NotNil(t, created)
+
+	assert.Equal(t, string(entity.UTRStatusActive), resp.TenantRegistration.Status)
+	assert.Equal(t, &participantID, resp.TenantRegistration.ParticipantID)
+	assert.Equal(t, entity.ParticipantLinkLinked, resp.TenantRegistration.ParticipantLink)
+	_, linkStatus := created.GetParticipantLink()
+	assert.Equal(t, entity.ParticipantLinkLinked, linkStatus)
+	participantRepo.AssertCalled(t, "LinkUser", mock.Anything, participantID, resp.UserID)
+}
@@ -44,8 +44,8 @@ func (uc *usecase) CompleteRegistration(
 		return nil, errors.ErrUnauthorized("Registration token has already been used or is invalid")
 	}
 
-	if err := uc.validatePassword(req.Password); err != nil {
-		return nil, errors.ErrValidation(err.Error())
+	if _, err := uc.validatePassword(ctx, session, req.Password); err != nil {
+		return nil, err
 	}
 
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
@@ -62,10 +62,18 @@ func (uc *usecase) CompleteRegistration(
 		return nil, errors.ErrConflict("This email has already been registered")
 	}
 
-	requiresApproval := false
+	var regConfig *entity.ProductRegistrationConfig
+	if session.HasTenantTarget() {
+		regConfig, err = uc.resolveRegistrationConfig(ctx, *session.TenantID, *session.ProductID, session.RegistrationType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now()
 
 	var user *entity.User
+	var tenantRegistration *authdto.TenantRegistrationResponse
 
 	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		user = &entity.User{
@@ -83,6 +91,10 @@ func (uc *usecase) CompleteRegistration(
 			return err
 		}
 
+		if err := uc.recordPasswordHistory(txCtx, user.ID, passwordHashStr); err != nil {
+			return err
+		}
+
 		profile := &entity.UserProfile{
 			UserID:    user.ID,
 			FirstName: req.FirstName,
@@ -100,12 +112,20 @@ func (uc *usecase) CompleteRegistration(
 			UserID:        user.ID,
 			EmailVerified: true,
 			EmailVerifiedAt: &now,
+			PasswordChangedAt: &now,
 			UpdatedAt:     now,
 		}
 		if err := uc.UserSecurityStateRepo.Create(txCtx, securityState); err != nil {
 			return err
 		}
 
+		if regConfig != nil {
+			tenantRegistration, err = uc.registerTenantMembership(txCtx, user.ID, session, regConfig, now)
+			if err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 
@@ -124,12 +144,15 @@ func (uc *usecase) CompleteRegistration(
 			FirstName: req.FirstName,
 			LastName:  req.LastName,
 		},
+		TenantRegistration: tenantRegistration,
 	}
 
+	requiresApproval := tenantRegistration != nil && tenantRegistration.Status == string(entity.UTRStatusPendingApproval)
+
 	if !requiresApproval {
 		response.Message = "Registration completed successfully. You are now logged in."
 
-		accessToken, refreshToken, expiresIn, err := uc.generateAuthTokensForRegistration(ctx, user.ID, session.Email)
+		accessToken, refreshToken, expiresIn, err := uc.generateAuthTokensForRegistration(ctx, user.ID, session.Email, tenantRegistration != nil)
 		if err != nil {
 			response.Message = "Registration completed successfully. Please login to continue."
 		} else {
@@ -150,7 +173,10 @@ func (uc *usecase) CompleteRegistration(
 	return response, nil
 }
 
-func (uc *usecase) generateAuthTokensForRegistration(ctx context.Context, userID uuid.UUID, email string) (string, string, int, error) {
+// generateAuthTokensForRegistration issues the first tokens for a new user.
+// Tenant claims are only looked up when registration joined a tenant, since a
+// brand-new user has no other memberships.
+func (uc *usecase) generateAuthTokensForRegistration(ctx context.Context, userID uuid.UUID, email string, joinedTenant bool) (string, string, int, error) {
 	sessionID := uuid.New()
 	tokenFamily := uuid.New()
 
@@ -162,9 +188,10 @@ func (uc *usecase) generateAuthTokensForRegistration(ctx context.Context, userID
 		RefreshExpiry: uc.Config.JWT.RefreshExpiry,
 		Issuer:        uc.Config.JWT.Issuer,
 		Audience:      uc.Config.JWT.Audience,
+		KeyRing:       uc.Config.JWT.KeyRing,
 	}
 
-	if uc.Config.JWT.SigningMethod == "RS256" {
+	if jwtpkg.IsAsymmetric(uc.Config.JWT.SigningMethod) && tokenConfig.KeyRing == nil {
 		privateKey, err := jwtpkg.LoadPrivateKeyFromFile(uc.Config.JWT.PrivateKeyPath)
 		if err != nil {
 			return "", "", 0, errors.ErrInternal("failed to load private key").WithError(err)
@@ -177,17 +204,28 @@ func (uc *usecase) generateAuthTokensForRegistration(ctx context.Context, userID
 		tokenConfig.PublicKey = publicKey
 	}
 
-	accessToken, err := jwtpkg.GenerateAccessToken(
-		userID,
-		email,
-		nil,
-		nil,
-		[]string{},
-		[]string{},
-		nil,
-		sessionID,
-		tokenConfig,
-	)
+	var accessToken string
+	var err error
+	if joinedTenant {
+		var tenantClaims []jwtpkg.TenantClaim
+		tenantClaims, _, err = uc.buildMultiTenantClaims(ctx, userID)
+		if err != nil {
+			return "", "", 0, errors.ErrInternal("failed to build tenant claims").WithError(err)
+		}
+		accessToken, err = jwtpkg.GenerateMultiTenantAccessToken(userID, email, tenantClaims, sessionID, tokenConfig)
+	} else {
+		accessToken, err = jwtpkg.GenerateAccessToken(
+			userID,
+			email,
+			nil,
+			nil,
+			[]string{},
+			[]string{},
+			nil,
+			sessionID,
+			tokenConfig,
+		)
+	}
 	if err != nil {
 		return "", "", 0, errors.ErrInternal("failed to generate access token").WithError(err)
 	}
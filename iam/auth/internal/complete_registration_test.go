@@ -11,6 +11,8 @@ import (
 	"iam-service/entity"
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -301,6 +303,12 @@ func TestCompleteRegistration(t *testing.T) {
 
 			refreshTokenRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.RefreshToken")).Return(nil)
 
+			passwordPolicyRepo := new(MockPasswordPolicyRepository)
+			passwordPolicyRepo.On("Resolve", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, errors.ErrNotFound("password policy not found")).Maybe()
+			passwordHistoryRepo := new(MockPasswordHistoryRepository)
+			passwordHistoryRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.PasswordHistory")).Return(nil).Maybe()
+
 			tt.req.RegistrationID = registrationID
 			tt.req.RegistrationToken = token
 
@@ -324,6 +332,8 @@ func TestCompleteRegistration(t *testing.T) {
 				UserSecurityStateRepo: securityStateRepo,
 				EmailService:          emailSvc,
 				RefreshTokenRepo:      refreshTokenRepo,
+				PasswordPolicyRepo:    passwordPolicyRepo,
+				PasswordHistoryRepo:   passwordHistoryRepo,
 			}
 
 			ctx := context.Background()
@@ -353,3 +363,354 @@ func TestCompleteRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestCompleteRegistration_TenantTarget(t *testing.T) {
+	registrationID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	roleID := uuid.New()
+	participantID := uuid.New()
+	ktp := "3171000000000001"
+	email := "member@example.com"
+	jwtSecret := "test-secret-key-for-testing-purposes"
+
+	tests := []struct {
+		name             string
+		registrationType string
+		regConfig        *entity.ProductRegistrationConfig
+		expectedStatus   entity.UserTenantRegistrationStatus
+		expectedLink     string
+		expectTokens     bool
+	}{
+		{
+			name:             "participant is auto-approved and granted the configured role",
+			registrationType: entity.RegistrationTypeParticipant,
+			regConfig: &entity.ProductRegistrationConfig{
+				ProductID:        productID,
+				RegistrationType: entity.RegistrationTypeParticipant,
+				AutoGrantRoleID:  &roleID,
+				RequiresApproval: false,
+				IsActive:         true,
+			},
+			expectedStatus: entity.UTRStatusActive,
+			expectedLink:   entity.ParticipantLinkLinked,
+			expectTokens:   true,
+		},
+		{
+			name:             "member waits for approval without a role",
+			registrationType: entity.RegistrationTypeMember,
+			regConfig: &entity.ProductRegistrationConfig{
+				ProductID:        productID,
+				RegistrationType: entity.RegistrationTypeMember,
+				AutoGrantRoleID:  &roleID,
+				RequiresApproval: true,
+				IsActive:         true,
+			},
+			expectedStatus: entity.UTRStatusPendingApproval,
+			expectedLink:   entity.ParticipantLinkMatched,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := jwt.MapClaims{
+				"registration_id": registrationID.String(),
+				"email":           email,
+				"purpose":         RegistrationCompleteTokenPurpose,
+				"exp":             time.Now().Add(15 * time.Minute).Unix(),
+				"iat":             time.Now().Unix(),
+				"jti":             uuid.New().String(),
+			}
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(jwtSecret))
+			require.NoError(t, err)
+			hash := sha256.Sum256([]byte(token))
+			tokenHash := hex.EncodeToString(hash[:])
+
+			redis := new(MockInMemoryStore)
+			redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+				ID:                    registrationID,
+				Email:                 email,
+				Status:                entity.RegistrationSessionStatusVerified,
+				RegistrationTokenHash: &tokenHash,
+				TenantID:              &tenantID,
+				ProductID:             &productID,
+				RegistrationType:      tt.registrationType,
+				IdentificationNumber:  &ktp,
+				ExpiresAt:             time.Now().Add(10 * time.Minute),
+			}, nil)
+			redis.On("DeleteRegistrationSession", mock.Anything, registrationID).Return(nil)
+			redis.On("UnlockRegistrationEmail", mock.Anything, email).Return(nil)
+
+			userRepo := new(MockUserRepository)
+			userRepo.On("EmailExists", mock.Anything, email).Return(false, nil)
+			userRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.User")).Return(nil)
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			authMethodRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			profileRepo := new(MockUserProfileRepository)
+			profileRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			securityStateRepo := new(MockUserSecurityStateRepository)
+			securityStateRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			passwordPolicyRepo := new(MockPasswordPolicyRepository)
+			passwordPolicyRepo.On("Resolve", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, errors.ErrNotFound("password policy not found")).Maybe()
+			passwordHistoryRepo := new(MockPasswordHistoryRepository)
+			passwordHistoryRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			refreshTokenRepo := new(MockRefreshTokenRepository)
+			refreshTokenRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			emailSvc := new(MockEmailService)
+			emailSvc.On("SendWelcome", mock.Anything, email, "Jane").Return(nil).Maybe()
+
+			tenantRepo := new(MockTenantRepository)
+			tenantRepo.On("GetByID", mock.Anything, tenantID).Return(&entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive}, nil)
+			productRepo := new(MockProductRepository)
+			productRepo.On("GetByIDAndTenant", mock.Anything, productID, tenantID).Return(&entity.Product{ID: productID, TenantID: tenantID, Code: "saving", IsActive: true}, nil)
+			regConfigRepo := new(MockProductRegistrationConfigRepository)
+			regConfigRepo.On("GetByProductAndType", mock.Anything, productID, tt.registrationType).Return(tt.regConfig, nil)
+
+			var created *entity.UserTenantRegistration
+			regRepo := new(MockUserTenantRegistrationRepository)
+			regRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserTenantRegistration")).
+				Run(func(args mock.Arguments) { created = args.Get(1).(*entity.UserTenantRegistration) }).
+				Return(nil)
+			regRepo.On("ListActiveByUserID", mock.Anything, mock.Anything).Return([]entity.UserTenantRegistration{
+				{TenantID: tenantID, RegistrationType: tt.registrationType, Status: entity.UTRStatusActive},
+			}, nil).Maybe()
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserRole")).Return(nil).Maybe()
+			userRoleRepo.On("ListActiveByUserID", mock.Anything, mock.Anything, &productID).Return([]entity.UserRole{{RoleID: roleID}}, nil).Maybe()
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{roleID}).Return([]*entity.Role{{ID: roleID, Code: "PARTICIPANT"}}, nil).Maybe()
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleID}).Return([]string{}, nil).Maybe()
+			participantRepo := new(MockParticipantRepository)
+			participantRepo.On("ListApprovedByIdentificationNumber", mock.Anything, tenantID, &productID, ktp).Return([]entity.Participant{{ID: participantID}}, nil)
+			participantRepo.On("LinkUser", mock.Anything, participantID, mock.AnythingOfType("uuid.UUID")).Return(nil).Maybe()
+			productsRepo := new(MockProductsByTenantRepository)
+			productsRepo.On("ListActiveByTenantID", mock.Anything, tenantID).Return([]entity.Product{{ID: productID, Code: "saving"}}, nil).Maybe()
+
+			uc := &usecase{
+				TxManager: NewMockTransactionManager(),
+				Config: &config.Config{
+					JWT: config.JWTConfig{
+						AccessSecret:  jwtSecret,
+						RefreshSecret: "refresh-secret",
+						SigningMethod: "HS256",
+						AccessExpiry:  time.Hour,
+						RefreshExpiry: 24 * time.Hour,
+						Issuer:        "iam-service",
+					},
+				},
+				InMemoryStore:         redis,
+				UserRepo:              userRepo,
+				UserProfileRepo:       profileRepo,
+				UserAuthMethodRepo:    authMethodRepo,
+				UserSecurityStateRepo: securityStateRepo,
+				TenantRepo:            tenantRepo,
+				ProductRepo:           productRepo,
+				ProductRegConfigRepo:  regConfigRepo,
+				UserTenantRegRepo:     regRepo,
+				UserRoleRepo:          userRoleRepo,
+				RoleRepo:              roleRepo,
+				PermissionRepo:        permissionRepo,
+				ProductsByTenantRepo:  productsRepo,
+				ParticipantRepo:       participantRepo,
+				RefreshTokenRepo:      refreshTokenRepo,
+				PasswordPolicyRepo:    passwordPolicyRepo,
+				PasswordHistoryRepo:   passwordHistoryRepo,
+				EmailService:          emailSvc,
+				AuditLogger:           logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.CompleteRegistration(context.Background(), &authdto.CompleteRegistrationRequest{
+				RegistrationID:       registrationID,
+				RegistrationToken:    token,
+				Password:             "SecureP@ssw0rd!",
+				PasswordConfirmation: "SecureP@ssw0rd!",
+				FirstName:            "Jane",
+				LastName:             "Doe",
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp.TenantRegistration)
+			require.NotNil(t, created)
+
+			assert.Equal(t, tenantID, created.TenantID)
+			assert.Equal(t, tt.registrationType, created.RegistrationType)
+			assert.Equal(t, tt.expectedStatus, created.Status)
+			assert.Equal(t, &productID, created.GetProductID())
+			assert.Equal(t, string(tt.expectedStatus), resp.TenantRegistration.Status)
+			assert.Equal(t, tt.expectedLink, resp.TenantRegistration.ParticipantLink)
+			assert.Equal(t, &participantID, resp.TenantRegistration.ParticipantID)
+			_, linkStatus := created.GetParticipantLink()
+			assert.Equal(t, tt.expectedLink, linkStatus)
+
+			if !tt.expectTokens {
+				assert.Nil(t, resp.AccessToken)
+				assert.Nil(t, resp.TenantRegistration.GrantedRoleID)
+				userRoleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				participantRepo.AssertNotCalled(t, "LinkUser", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NotNil(t, resp.AccessToken)
+			participantRepo.AssertCalled(t, "LinkUser", mock.Anything, participantID, resp.UserID)
+			assert.Equal(t, &roleID, resp.TenantRegistration.GrantedRoleID)
+			userRoleRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(ur *entity.UserRole) bool {
+				return ur.RoleID == roleID && ur.ProductID != nil && *ur.ProductID == productID
+			}))
+
+			parsed, err := jwtpkg.ParseMultiTenantAccessToken(*resp.AccessToken, &jwtpkg.TokenConfig{
+				SigningMethod: "HS256",
+				AccessSecret:  jwtSecret,
+				Issuer:        "iam-service",
+			})
+			require.NoError(t, err)
+			require.True(t, parsed.HasTenant(tenantID))
+		})
+	}
+}
+
+func TestMatchParticipant(t *testing.T) {
+	userID := uuid.New()
+	otherUserID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	participantID := uuid.New()
+	ktp := "3171000000000001"
+
+	tests := []struct {
+		name           string
+		candidates     []entity.Participant
+		expectedStatus string
+		expectedID     *uuid.UUID
+	}{
+		{
+			name:           "no approved participant matches",
+			candidates:     []entity.Participant{},
+			expectedStatus: entity.ParticipantLinkNoMatch,
+		},
+		{
+			name: "several participants share the number",
+			candidates: []entity.Participant{
+				{ID: participantID},
+				{ID: uuid.New()},
+			},
+			expectedStatus: entity.ParticipantLinkConflict,
+		},
+		{
+			name:           "participant already belongs to another user",
+			candidates:     []entity.Participant{{ID: participantID, UserID: &otherUserID}},
+			expectedStatus: entity.ParticipantLinkConflict,
+			expectedID:     &participantID,
+		},
+		{
+			name:           "unique match waits for admin confirmation",
+			candidates:     []entity.Participant{{ID: participantID}},
+			expectedStatus: entity.ParticipantLinkMatched,
+			expectedID:     &participantID,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			participantRepo := new(MockParticipantRepository)
+			participantRepo.On("ListApprovedByIdentificationNumber", mock.Anything, tenantID, &productID, ktp).Return(tt.candidates, nil)
+
+			uc := &usecase{
+				ParticipantRepo: participantRepo,
+				AuditLogger:     logger.NewNoopAuditLogger(),
+			}
+
+			session := &entity.RegistrationSession{
+				TenantID:             &tenantID,
+				ProductID:            &productID,
+				IdentificationNumber: &ktp,
+			}
+
+			id, status, err := uc.matchParticipant(context.Background(), userID, session)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
+
+func TestRegisterTenantMembership_ParticipantLink(t *testing.T) {
+	userID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	participantID := uuid.New()
+	ktp := "3171000000000001"
+
+	tests := []struct {
+		name             string
+		requiresApproval bool
+		linkErr          error
+		expectedLink     string
+		expectLinkCall   bool
+	}{
+		{
+			name:           "auto-approved registration links the match",
+			expectedLink:   entity.ParticipantLinkLinked,
+			expectLinkCall: true,
+		},
+		{
+			name:           "participant claimed in the meantime",
+			linkErr:        errors.ErrConflict("participant is already linked to a user"),
+			expectedLink:   entity.ParticipantLinkConflict,
+			expectLinkCall: true,
+		},
+		{
+			name:             "registration awaiting approval keeps the match for the approver",
+			requiresApproval: true,
+			expectedLink:     entity.ParticipantLinkMatched,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			participantRepo := new(MockParticipantRepository)
+			participantRepo.On("ListApprovedByIdentificationNumber", mock.Anything, tenantID, &productID, ktp).Return([]entity.Participant{{ID: participantID}}, nil)
+			participantRepo.On("LinkUser", mock.Anything, participantID, userID).Return(tt.linkErr).Maybe()
+
+			var created *entity.UserTenantRegistration
+			regRepo := new(MockUserTenantRegistrationRepository)
+			regRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserTenantRegistration")).
+				Run(func(args mock.Arguments) { created = args.Get(1).(*entity.UserTenantRegistration) }).
+				Return(nil)
+
+			uc := &usecase{
+				ParticipantRepo:   participantRepo,
+				UserTenantRegRepo: regRepo,
+				AuditLogger:       logger.NewNoopAuditLogger(),
+			}
+
+			session := &entity.RegistrationSession{
+				TenantID:             &tenantID,
+				ProductID:            &productID,
+				RegistrationType:     entity.RegistrationTypeParticipant,
+				IdentificationNumber: &ktp,
+			}
+			regConfig := &entity.ProductRegistrationConfig{
+				ProductID:        productID,
+				RegistrationType: entity.RegistrationTypeParticipant,
+				RequiresApproval: tt.requiresApproval,
+				IsActive:         true,
+			}
+
+			resp, err := uc.registerTenantMembership(context.Background(), userID, session, regConfig, time.Now())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLink, resp.ParticipantLink)
+			assert.Equal(t, &participantID, resp.ParticipantID)
+
+			storedID, storedLink := created.GetParticipantLink()
+			assert.Equal(t, &participantID, storedID)
+			assert.Equal(t, tt.expectedLink, storedLink)
+
+			if tt.expectLinkCall {
+				participantRepo.AssertCalled(t, "LinkUser", mock.Anything, participantID, userID)
+			} else {
+				participantRepo.AssertNotCalled(t, "LinkUser", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -1,9 +1,5 @@
 package internal
 
-const (
-	PasswordMinLength = 8
-)
-
 const (
 	OTPLength = 6
 )
@@ -33,3 +29,24 @@ const (
 	LoginRateLimitPerHour     = 5
 	LoginRateLimitWindow      = 60
 )
+
+const (
+	PasswordChangeReasonForced  = "FORCED"
+	PasswordChangeReasonExpired = "EXPIRED"
+)
+
+const (
+	PersonalAccessTokenPrefix       = "pat_"
+	PersonalAccessTokenSecretBytes  = 32
+	PersonalAccessTokenDisplayChars = 12
+)
+
+const (
+	TokenExchangeGrantType       = "urn:ietf:params:oauth:grant-type:token-exchange"
+	TokenExchangeAccessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+)
+
+const (
+	ImpersonatePermission = "user:impersonate"
+	PlatformAdminRole     = "PLATFORM_ADMIN"
+)
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	userID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	roleID := uuid.New()
+
+	setupClaims := func(regRepo *MockUserTenantRegistrationRepository, productsRepo *MockProductsByTenantRepository, userRoleRepo *MockUserRoleRepository, roleRepo *MockRoleRepository, permRepo *MockPermissionRepository) {
+		regRepo.On("ListActiveByUserID", mock.Anything, userID).Return([]entity.UserTenantRegistration{
+			{UserID: userID, TenantID: tenantID},
+		}, nil)
+		productsRepo.On("ListActiveByTenantID", mock.Anything, tenantID).Return([]entity.Product{
+			{ID: productID, Code: "frendz-saving"},
+		}, nil)
+		userRoleRepo.On("ListActiveByUserID", mock.Anything, userID, mock.Anything).Return([]entity.UserRole{
+			{UserID: userID, RoleID: roleID},
+		}, nil)
+		roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{roleID}).Return([]*entity.Role{
+			{ID: roleID, Code: "PARTICIPANT_CREATOR"},
+		}, nil)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleID}).Return([]string{
+			"participant:create", "participant:read",
+		}, nil)
+	}
+
+	tests := []struct {
+		name         string
+		req          *authdto.CreatePersonalAccessTokenRequest
+		expectCreate bool
+		expectedCode string
+	}{
+		{
+			name: "success - scopes within user permissions",
+			req: &authdto.CreatePersonalAccessTokenRequest{
+				UserID:        userID,
+				TenantID:      tenantID,
+				Name:          "ci pipeline",
+				Scopes:        []string{"participant:read", "participant:read"},
+				ExpiresInDays: 30,
+			},
+			expectCreate: true,
+		},
+		{
+			name: "error - scope exceeds user permissions",
+			req: &authdto.CreatePersonalAccessTokenRequest{
+				UserID:        userID,
+				TenantID:      tenantID,
+				Name:          "ci pipeline",
+				Scopes:        []string{"participant:approve"},
+				ExpiresInDays: 30,
+			},
+			expectedCode: errors.CodeForbidden,
+		},
+		{
+			name: "error - user has no access to tenant",
+			req: &authdto.CreatePersonalAccessTokenRequest{
+				UserID:        userID,
+				TenantID:      uuid.New(),
+				Name:          "ci pipeline",
+				Scopes:        []string{"participant:read"},
+				ExpiresInDays: 30,
+			},
+			expectedCode: errors.CodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regRepo := new(MockUserTenantRegistrationRepository)
+			productsRepo := new(MockProductsByTenantRepository)
+			userRoleRepo := new(MockUserRoleRepository)
+			roleRepo := new(MockRoleRepository)
+			permRepo := new(MockPermissionRepository)
+			patRepo := new(MockPersonalAccessTokenRepository)
+
+			setupClaims(regRepo, productsRepo, userRoleRepo, roleRepo, permRepo)
+			if tt.expectCreate {
+				patRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.PersonalAccessToken")).Return(nil)
+			}
+
+			uc := &usecase{
+				Config:                  &config.Config{},
+				UserTenantRegRepo:       regRepo,
+				ProductsByTenantRepo:    productsRepo,
+				UserRoleRepo:            userRoleRepo,
+				RoleRepo:                roleRepo,
+				PermissionRepo:          permRepo,
+				PersonalAccessTokenRepo: patRepo,
+				AuditLogger:             logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.CreatePersonalAccessToken(context.Background(), tt.req)
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				patRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Contains(t, resp.Token, PersonalAccessTokenPrefix)
+			assert.Equal(t, []string{"participant:read"}, resp.Scopes)
+			assert.Equal(t, resp.Token[:PersonalAccessTokenDisplayChars], resp.TokenPrefix)
+			patRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestScopeTenantClaim(t *testing.T) {
+	tenantID := uuid.New()
+	productID := uuid.New()
+
+	claims := scopeTenantClaim([]jwtpkg.TenantClaim{
+		{
+			TenantID: tenantID,
+			Products: []jwtpkg.ProductClaim{
+				{
+					ProductID:   productID,
+					ProductCode: "frendz-saving",
+					Roles:       []string{"PARTICIPANT_APPROVER"},
+					Permissions: []string{"participant:read", "participant:approve"},
+				},
+			},
+		},
+	}, tenantID, []string{"participant:read", "participant:delete"})
+
+	require.NotNil(t, claims)
+	require.Len(t, claims.Products, 1)
+	assert.Equal(t, []string{"participant:read"}, claims.Products[0].Permissions)
+	assert.Empty(t, claims.Products[0].Roles)
+
+	assert.Nil(t, scopeTenantClaim(nil, tenantID, []string{"participant:read"}))
+}
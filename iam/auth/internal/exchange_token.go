@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+)
+
+func (uc *usecase) ExchangeToken(ctx context.Context, req *authdto.TokenExchangeRequest) (*authdto.TokenExchangeResponse, error) {
+	if req.GrantType != TokenExchangeGrantType {
+		return nil, errors.New("UNSUPPORTED_GRANT_TYPE", "grant_type must be "+TokenExchangeGrantType, http.StatusBadRequest)
+	}
+	if req.SubjectTokenType != TokenExchangeAccessTokenType {
+		return nil, errors.New("INVALID_REQUEST", "subject_token_type must be "+TokenExchangeAccessTokenType, http.StatusBadRequest)
+	}
+
+	tokenConfig, err := uc.buildTokenConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	subject, err := jwtpkg.ParseMultiTenantAccessToken(req.SubjectToken, tokenConfig)
+	if err != nil || len(subject.Tenants) == 0 || subject.ExpiresAt == nil {
+		return nil, errors.ErrTokenInvalid()
+	}
+	if subject.IsImpersonated() {
+		return nil, errors.ErrForbidden("impersonation tokens cannot be exchanged")
+	}
+	if subject.UserID != req.UserID {
+		return nil, errors.ErrForbidden("subject token does not belong to the authenticated user")
+	}
+
+	blacklisted, err := uc.InMemoryStore.IsTokenBlacklisted(ctx, subject.ID)
+	if err == nil && blacklisted {
+		return nil, errors.ErrTokenInvalid()
+	}
+	blacklistedAt, err := uc.InMemoryStore.GetUserBlacklistTimestamp(ctx, subject.UserID)
+	if err == nil && blacklistedAt != nil && subject.IssuedAt != nil && subject.IssuedAt.Before(*blacklistedAt) {
+		return nil, errors.ErrTokenInvalid()
+	}
+
+	user, err := uc.UserRepo.GetByID(ctx, subject.UserID)
+	if err != nil || user.Status != entity.UserStatusActive {
+		return nil, errors.ErrTokenInvalid()
+	}
+
+	audience := req.Audience
+	// Exchanged tokens are meant for downstream services; issuing one for the
+	// IAM audiences would let a narrowed token be replayed against IAM itself.
+	if audience != "" && slices.Contains(uc.Config.JWT.Audience, audience) {
+		return nil, errors.ErrBadRequest("audience must identify a downstream service")
+	}
+
+	// Roles are re-derived rather than copied from the subject token so the
+	// exchanged token reflects the user's current grants.
+	tenantClaims, _, err := uc.buildMultiTenantClaims(ctx, subject.UserID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
+	}
+
+	product := findProductClaim(tenantClaims, req.TenantID, req.ProductID)
+	if product == nil {
+		uc.logTokenExchange(ctx, req, audience, false, "no access to the requested tenant product")
+		return nil, errors.ErrForbidden("no access to the requested tenant product")
+	}
+	if audience == "" {
+		audience = product.ProductCode
+	}
+
+	expiry := uc.Config.JWT.ExchangeTokenExpiry
+	if remaining := time.Until(subject.ExpiresAt.Time); remaining < expiry {
+		expiry = remaining
+	}
+	if expiry <= 0 {
+		return nil, errors.ErrTokenExpired()
+	}
+
+	exchangeConfig := *tokenConfig
+	exchangeConfig.Audience = []string{audience}
+	exchangeConfig.AccessExpiry = expiry
+
+	accessToken, err := jwtpkg.GenerateProductAccessToken(
+		subject.UserID,
+		subject.Email,
+		req.TenantID,
+		*product,
+		subject.SessionID,
+		&exchangeConfig,
+	)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate access token").WithError(err)
+	}
+
+	uc.logTokenExchange(ctx, req, audience, true, "")
+
+	return &authdto.TokenExchangeResponse{
+		AccessToken:     accessToken,
+		IssuedTokenType: TokenExchangeAccessTokenType,
+		TokenType:       "Bearer",
+		ExpiresIn:       int(expiry.Seconds()),
+		Audience:        exchangeConfig.Audience,
+		Roles:           product.Roles,
+		Permissions:     product.Permissions,
+	}, nil
+}
+
+func (uc *usecase) logTokenExchange(ctx context.Context, req *authdto.TokenExchangeRequest, audience string, success bool, reason string) {
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "token_exchanged",
+		ActorID:    req.UserID.String(),
+		ActorType:  "user",
+		TargetID:   req.ProductID.String(),
+		TargetType: "product",
+		TenantID:   req.TenantID.String(),
+		Success:    success,
+		Reason:     reason,
+		Metadata:   map[string]any{"audience": audience, "ip_address": req.IPAddress},
+	})
+}
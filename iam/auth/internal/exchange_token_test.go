@@ -0,0 +1,180 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeToken(t *testing.T) {
+	userID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	roleID := uuid.New()
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.ExchangeTokenExpiry = 5 * time.Minute
+
+	tokenConfig := &jwtpkg.TokenConfig{
+		SigningMethod: jwtCfg.SigningMethod,
+		AccessSecret:  jwtCfg.AccessSecret,
+		AccessExpiry:  jwtCfg.AccessExpiry,
+		Issuer:        jwtCfg.Issuer,
+		Audience:      jwtCfg.Audience,
+	}
+	subjectToken, err := jwtpkg.GenerateMultiTenantAccessToken(userID, "test@example.com", []jwtpkg.TenantClaim{
+		{TenantID: tenantID, Products: []jwtpkg.ProductClaim{{ProductID: productID, ProductCode: "frendz-saving"}}},
+	}, uuid.New(), tokenConfig)
+	require.NoError(t, err)
+
+	// Signed with the access secret but carries no exp claim.
+	noExpiryToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &jwtpkg.MultiTenantClaims{
+		UserID:  userID,
+		Email:   "test@example.com",
+		Tenants: []jwtpkg.TenantClaim{{TenantID: tenantID, Products: []jwtpkg.ProductClaim{{ProductID: productID, ProductCode: "frendz-saving"}}}},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       uuid.New().String(),
+			Issuer:   jwtCfg.Issuer,
+			Audience: jwtCfg.Audience,
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		},
+	}).SignedString([]byte(jwtCfg.AccessSecret))
+	require.NoError(t, err)
+
+	newRequest := func() *authdto.TokenExchangeRequest {
+		return &authdto.TokenExchangeRequest{
+			UserID:           userID,
+			GrantType:        TokenExchangeGrantType,
+			SubjectToken:     subjectToken,
+			SubjectTokenType: TokenExchangeAccessTokenType,
+			TenantID:         tenantID,
+			ProductID:        productID,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(req *authdto.TokenExchangeRequest)
+		expectedCode string
+		expectedAud  string
+	}{
+		{
+			name:        "success - defaults audience to product code",
+			modify:      func(req *authdto.TokenExchangeRequest) {},
+			expectedAud: "frendz-saving",
+		},
+		{
+			name:        "success - explicit audience",
+			modify:      func(req *authdto.TokenExchangeRequest) { req.Audience = "saving-service" },
+			expectedAud: "saving-service",
+		},
+		{
+			name:         "error - unsupported grant type",
+			modify:       func(req *authdto.TokenExchangeRequest) { req.GrantType = "refresh_token" },
+			expectedCode: "UNSUPPORTED_GRANT_TYPE",
+		},
+		{
+			name:         "error - iam audience",
+			modify:       func(req *authdto.TokenExchangeRequest) { req.Audience = "iam-service" },
+			expectedCode: errors.CodeBadRequest,
+		},
+		{
+			name:         "error - subject token of another user",
+			modify:       func(req *authdto.TokenExchangeRequest) { req.UserID = uuid.New() },
+			expectedCode: errors.CodeForbidden,
+		},
+		{
+			name:         "error - subject token without expiry",
+			modify:       func(req *authdto.TokenExchangeRequest) { req.SubjectToken = noExpiryToken },
+			expectedCode: errors.CodeTokenInvalid,
+		},
+		{
+			name:         "error - product not granted",
+			modify:       func(req *authdto.TokenExchangeRequest) { req.ProductID = uuid.New() },
+			expectedCode: errors.CodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := new(MockInMemoryStore)
+			userRepo := new(MockUserRepository)
+			regRepo := new(MockUserTenantRegistrationRepository)
+			productsRepo := new(MockProductsByTenantRepository)
+			userRoleRepo := new(MockUserRoleRepository)
+			roleRepo := new(MockRoleRepository)
+			permRepo := new(MockPermissionRepository)
+
+			store.On("IsTokenBlacklisted", mock.Anything, mock.Anything).Return(false, nil)
+			store.On("GetUserBlacklistTimestamp", mock.Anything, userID).Return(nil, nil)
+			userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{
+				ID:     userID,
+				Email:  "test@example.com",
+				Status: entity.UserStatusActive,
+			}, nil)
+			regRepo.On("ListActiveByUserID", mock.Anything, userID).Return([]entity.UserTenantRegistration{
+				{UserID: userID, TenantID: tenantID},
+			}, nil)
+			productsRepo.On("ListActiveByTenantID", mock.Anything, tenantID).Return([]entity.Product{
+				{ID: productID, Code: "frendz-saving"},
+			}, nil)
+			userRoleRepo.On("ListActiveByUserID", mock.Anything, userID, mock.Anything).Return([]entity.UserRole{
+				{UserID: userID, RoleID: roleID},
+			}, nil)
+			roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{roleID}).Return([]*entity.Role{
+				{ID: roleID, Code: "PARTICIPANT_VIEWER"},
+			}, nil)
+			permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleID}).Return([]string{"participant:read"}, nil)
+
+			uc := &usecase{
+				Config:               &config.Config{JWT: *jwtCfg},
+				InMemoryStore:        store,
+				UserRepo:             userRepo,
+				UserTenantRegRepo:    regRepo,
+				ProductsByTenantRepo: productsRepo,
+				UserRoleRepo:         userRoleRepo,
+				RoleRepo:             roleRepo,
+				PermissionRepo:       permRepo,
+				AuditLogger:          logger.NewNoopAuditLogger(),
+			}
+
+			req := newRequest()
+			tt.modify(req)
+
+			resp, err := uc.ExchangeToken(context.Background(), req)
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, TokenExchangeAccessTokenType, resp.IssuedTokenType)
+			assert.Equal(t, 300, resp.ExpiresIn)
+
+			claims, err := jwtpkg.ParseAccessToken(resp.AccessToken, tokenConfig)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.expectedAud}, []string(claims.Audience))
+			assert.Equal(t, tenantID, claims.GetTenantID())
+			assert.Equal(t, productID, claims.GetProductID())
+			assert.Equal(t, []string{"PARTICIPANT_VIEWER"}, claims.Roles)
+			assert.Equal(t, []string{"participant:read"}, claims.Permissions)
+		})
+	}
+}
@@ -6,12 +6,19 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"math/big"
+	"net/http"
+	"sort"
 	"strings"
 	"time"
 
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
 	"iam-service/pkg/logger"
+	"iam-service/pkg/password"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -31,43 +38,176 @@ func (uc *usecase) sendEmailAsync(ctx context.Context, fn func(ctx context.Conte
 	}()
 }
 
-func (uc *usecase) validatePassword(password string) error {
-	if len(password) < PasswordMinLength {
-		return errors.ErrValidation("Password must be at least 8 characters long")
+// validatePassword applies the policy of the tenant and product the user is
+// registering for, falling back to the platform-wide policy. A non-empty
+// warning means the password was accepted but found in the breach dataset.
+func (uc *usecase) validatePassword(ctx context.Context, session *entity.RegistrationSession, plain string) (string, error) {
+	policy, err := password.ResolvePolicy(ctx, uc.PasswordPolicyRepo, session.TenantID, session.ProductID)
+	if err != nil {
+		return "", err
+	}
+	if err := policy.Validate(plain, password.ContextWords(session.Email)...); err != nil {
+		return "", err
 	}
 
-	hasUpper := false
-	hasLower := false
-	hasNumber := false
-	hasSpecial := false
+	warning, err := policy.CheckBreached(uc.BreachChecker, plain)
+	if err != nil {
+		return "", err
+	}
+	if warning != "" {
+		uc.AuditLogger.Log(ctx, logger.AuditEvent{
+			Domain:     "auth",
+			Action:     "breached_password_accepted",
+			TargetID:   session.Email,
+			TargetType: "email",
+			Success:    true,
+			Reason:     warning,
+		})
+	}
+	return warning, nil
+}
 
-	for _, char := range password {
-		switch {
-		case char >= 'A' && char <= 'Z':
-			hasUpper = true
-		case char >= 'a' && char <= 'z':
-			hasLower = true
-		case char >= '0' && char <= '9':
-			hasNumber = true
-		case char >= '!' && char <= '/' || char >= ':' && char <= '@' || char >= '[' && char <= '`' || char >= '{' && char <= '~':
-			hasSpecial = true
+func (uc *usecase) recordPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	return uc.PasswordHistoryRepo.Create(ctx, &entity.PasswordHistory{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	})
+}
+
+// passwordChangeReason reports why the user has to change their password
+// before getting full access, or "" when no change is required. Users without
+// a password credential are never affected.
+func (uc *usecase) passwordChangeReason(ctx context.Context, userID uuid.UUID) (string, error) {
+	authMethod, err := uc.UserAuthMethodRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
 		}
+		return "", errors.ErrInternal("failed to get auth method").WithError(err)
+	}
+	if authMethod.MethodType != string(entity.AuthMethodPassword) {
+		return "", nil
 	}
 
-	if !hasUpper {
-		return errors.ErrValidation("Password must contain at least one uppercase letter")
+	securityState, err := uc.UserSecurityStateRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.ErrInternal("failed to get security state").WithError(err)
 	}
-	if !hasLower {
-		return errors.ErrValidation("Password must contain at least one lowercase letter")
+	if securityState.ForcePasswordChange {
+		return PasswordChangeReasonForced, nil
 	}
-	if !hasNumber {
-		return errors.ErrValidation("Password must contain at least one number")
+	if securityState.PasswordChangedAt == nil {
+		return "", nil
 	}
-	if !hasSpecial {
-		return errors.ErrValidation("Password must contain at least one special character")
+
+	maxAgeDays, err := uc.passwordMaxAgeDays(ctx, userID)
+	if err != nil {
+		return "", err
 	}
+	if maxAgeDays > 0 && time.Now().After(securityState.PasswordChangedAt.AddDate(0, 0, maxAgeDays)) {
+		return PasswordChangeReasonExpired, nil
+	}
+	return "", nil
+}
 
-	return nil
+// passwordMaxAgeDays returns the strictest max age across the platform policy
+// and the policies of every tenant and application the user belongs to. Zero
+// means no expiry.
+func (uc *usecase) passwordMaxAgeDays(ctx context.Context, userID uuid.UUID) (int, error) {
+	registrations, err := uc.UserTenantRegRepo.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return 0, errors.ErrInternal("failed to list tenant registrations").WithError(err)
+	}
+	userRoles, err := uc.UserRoleRepo.ListActiveByUserID(ctx, userID, nil)
+	if err != nil {
+		return 0, errors.ErrInternal("failed to list user roles").WithError(err)
+	}
+	tenantIDs, productIDs := password.UserScopes(registrations, userRoles)
+
+	policy, err := password.ResolveUserPolicy(ctx, uc.PasswordPolicyRepo, tenantIDs, productIDs)
+	if err != nil {
+		return 0, err
+	}
+	return policy.MaxAgeDays, nil
+}
+
+// pendingRequiredConsents returns the current required documents that apply to
+// the user but have not been accepted in their current version.
+func (uc *usecase) pendingRequiredConsents(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error) {
+	documents, err := uc.ConsentDocumentRepo.ListCurrentForUser(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+	if len(documents) == 0 {
+		return nil, nil
+	}
+
+	consents, err := uc.UserConsentRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list user consents").WithError(err)
+	}
+	accepted := make(map[uuid.UUID]struct{}, len(consents))
+	for _, consent := range consents {
+		accepted[consent.DocumentID] = struct{}{}
+	}
+
+	var pending []entity.ConsentDocument
+	for _, doc := range documents {
+		if !doc.IsRequired {
+			continue
+		}
+		if _, ok := accepted[doc.ID]; !ok {
+			pending = append(pending, doc)
+		}
+	}
+	return pending, nil
+}
+
+// resolveRegistrationConsents checks the accepted document IDs against the
+// documents currently published for the registration target. Every required
+// document must be accepted; IDs that do not apply are rejected.
+func (uc *usecase) resolveRegistrationConsents(
+	ctx context.Context,
+	session *entity.RegistrationSession,
+	acceptedIDs []uuid.UUID,
+) ([]entity.ConsentDocument, error) {
+	documents, err := uc.ConsentDocumentRepo.ListCurrentForRegistration(ctx, session.TenantID, session.ProductID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+
+	current := make(map[uuid.UUID]entity.ConsentDocument, len(documents))
+	for _, doc := range documents {
+		current[doc.ID] = doc
+	}
+
+	accepted := make([]entity.ConsentDocument, 0, len(acceptedIDs))
+	seen := make(map[uuid.UUID]struct{}, len(acceptedIDs))
+	for _, id := range acceptedIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		doc, ok := current[id]
+		if !ok {
+			return nil, errors.ErrBadRequest("Consent document " + id.String() + " is not current for this registration")
+		}
+		accepted = append(accepted, doc)
+	}
+
+	for _, doc := range documents {
+		if !doc.IsRequired {
+			continue
+		}
+		if _, ok := seen[doc.ID]; !ok {
+			return nil, errors.New("CONSENT_REQUIRED", "You must accept the current "+doc.Title+" to register", http.StatusBadRequest)
+		}
+	}
+	return accepted, nil
 }
 
 func (uc *usecase) generateOTP() (otp string, otpHash string, err error) {
@@ -120,3 +260,381 @@ func maskEmailForRegistration(email string) string {
 
 	return string(local[0]) + "***@" + domain
 }
+
+// resolvePermissions returns the permission codes granted by the user's roles.
+// Codes held only through branch or self scoped roles get a scope entry; when
+// several such roles grant a code, branch scope wins and branches are merged.
+func (uc *usecase) resolvePermissions(ctx context.Context, userRoles []entity.UserRole, roles []*entity.Role) ([]string, jwtpkg.Scopes, error) {
+	// roles holds only the active roles loaded for userRoles. An assignment of
+	// a deactivated role has no entry and must not count as tenant wide.
+	loaded := make(map[uuid.UUID]*entity.Role, len(roles))
+	restricted := make(map[uuid.UUID]entity.ScopeLevel)
+	for _, r := range roles {
+		loaded[r.ID] = r
+		if r.HasRestrictedScope() {
+			restricted[r.ID] = r.ScopeLevel
+		}
+	}
+
+	var wideRoleIDs []uuid.UUID
+	for _, ur := range userRoles {
+		if role, ok := loaded[ur.RoleID]; ok && !role.HasRestrictedScope() {
+			wideRoleIDs = append(wideRoleIDs, ur.RoleID)
+		}
+	}
+
+	var permissions []string
+	if len(wideRoleIDs) > 0 {
+		codes, err := uc.PermissionRepo.GetCodesByRoleIDs(ctx, wideRoleIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		permissions = codes
+	}
+	if len(restricted) == 0 {
+		return permissions, nil, nil
+	}
+
+	wide := make(map[string]struct{}, len(permissions))
+	for _, perm := range permissions {
+		wide[perm] = struct{}{}
+	}
+
+	granted := make(map[string]*entity.AccessScope)
+	for _, ur := range userRoles {
+		level, ok := restricted[ur.RoleID]
+		if !ok {
+			continue
+		}
+		codes, err := uc.PermissionRepo.GetCodesByRoleIDs(ctx, []uuid.UUID{ur.RoleID})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, code := range codes {
+			if _, ok := wide[code]; ok {
+				continue
+			}
+			if _, seen := granted[code]; !seen {
+				permissions = append(permissions, code)
+			}
+			granted[code] = entity.WidenScope(granted[code], ur.UserID, level, ur.BranchID)
+		}
+	}
+	sort.Strings(permissions)
+
+	if len(granted) == 0 {
+		return permissions, nil, nil
+	}
+	scopes := make(jwtpkg.Scopes, len(granted))
+	for code, scope := range granted {
+		scopes[code] = jwtpkg.PermissionScope{Level: string(scope.Level), BranchIDs: scope.BranchIDs}
+	}
+	return permissions, scopes, nil
+}
+
+func tenantPermissionSet(tenantClaims []jwtpkg.TenantClaim, tenantID uuid.UUID) map[string]struct{} {
+	for _, tc := range tenantClaims {
+		if tc.TenantID != tenantID {
+			continue
+		}
+		granted := make(map[string]struct{})
+		for _, product := range tc.Products {
+			for _, perm := range product.Permissions {
+				granted[perm] = struct{}{}
+			}
+		}
+		return granted
+	}
+	return nil
+}
+
+func scopeTenantClaim(tenantClaims []jwtpkg.TenantClaim, tenantID uuid.UUID, scopes []string) *jwtpkg.TenantClaim {
+	allowed := make(map[string]struct{}, len(scopes))
+	for _, s := range scopes {
+		allowed[s] = struct{}{}
+	}
+
+	for _, tc := range tenantClaims {
+		if tc.TenantID != tenantID {
+			continue
+		}
+		scoped := jwtpkg.TenantClaim{TenantID: tc.TenantID}
+		for _, product := range tc.Products {
+			var permissions []string
+			for _, perm := range product.Permissions {
+				if _, ok := allowed[perm]; ok {
+					permissions = append(permissions, perm)
+				}
+			}
+			var productScopes jwtpkg.Scopes
+			for _, perm := range permissions {
+				if scope, ok := product.Scopes[perm]; ok {
+					if productScopes == nil {
+						productScopes = make(jwtpkg.Scopes)
+					}
+					productScopes[perm] = scope
+				}
+			}
+			scoped.Products = append(scoped.Products, jwtpkg.ProductClaim{
+				ProductID:   product.ProductID,
+				ProductCode: product.ProductCode,
+				Permissions: permissions,
+				Scopes:      productScopes,
+			})
+		}
+		return &scoped
+	}
+	return nil
+}
+
+func findProductClaim(tenantClaims []jwtpkg.TenantClaim, tenantID, productID uuid.UUID) *jwtpkg.ProductClaim {
+	for _, tc := range tenantClaims {
+		if tc.TenantID != tenantID {
+			continue
+		}
+		for i := range tc.Products {
+			if tc.Products[i].ProductID == productID {
+				return &tc.Products[i]
+			}
+		}
+	}
+	return nil
+}
+
+func filterTenantClaims(tenantClaims []jwtpkg.TenantClaim, tenantID uuid.UUID) []jwtpkg.TenantClaim {
+	for _, tc := range tenantClaims {
+		if tc.TenantID == tenantID {
+			return []jwtpkg.TenantClaim{tc}
+		}
+	}
+	return nil
+}
+
+func hasRoleInAnyTenant(tenantClaims []jwtpkg.TenantClaim, roleCode string) bool {
+	for _, tc := range tenantClaims {
+		for _, product := range tc.Products {
+			for _, role := range product.Roles {
+				if role == roleCode {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+func mapPersonalAccessTokenToResponse(token *entity.PersonalAccessToken) authdto.PersonalAccessTokenResponse {
+	scopes, _ := token.GetScopes()
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return authdto.PersonalAccessTokenResponse{
+		ID:          token.ID,
+		TenantID:    token.TenantID,
+		Name:        token.Name,
+		TokenPrefix: token.TokenPrefix,
+		Scopes:      scopes,
+		ExpiresAt:   token.ExpiresAt,
+		LastUsedAt:  token.LastUsedAt,
+		LastUsedIP:  token.LastUsedIP,
+		RevokedAt:   token.RevokedAt,
+		IsActive:    token.IsValid(),
+		CreatedAt:   token.CreatedAt,
+	}
+}
+
+// resolveRegistrationConfig checks that the tenant product accepts
+// self-registration of the given type and returns its configuration.
+func (uc *usecase) resolveRegistrationConfig(ctx context.Context, tenantID, productID uuid.UUID, registrationType string) (*entity.ProductRegistrationConfig, error) {
+	tenant, err := uc.TenantRepo.GetByID(ctx, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrTenantNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get tenant").WithError(err)
+	}
+	if !tenant.IsActive() {
+		return nil, errors.ErrTenantInactive()
+	}
+
+	if _, err := uc.ProductRepo.GetByIDAndTenant(ctx, productID, tenantID); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Product not found")
+		}
+		return nil, errors.ErrInternal("failed to get product").WithError(err)
+	}
+
+	regConfig, err := uc.ProductRegConfigRepo.GetByProductAndType(ctx, productID, registrationType)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.ErrInternal("failed to get registration config").WithError(err)
+	}
+	if regConfig == nil || !regConfig.IsActive {
+		return nil, errors.ErrBadRequest("Registration is not open for this product")
+	}
+	return regConfig, nil
+}
+
+// registerTenantMembership links a newly registered user to the tenant chosen
+// at registration. Registrations that need no approval become active at once
+// and receive the configured auto-granted role.
+func (uc *usecase) registerTenantMembership(
+	ctx context.Context,
+	userID uuid.UUID,
+	session *entity.RegistrationSession,
+	regConfig *entity.ProductRegistrationConfig,
+	now time.Time,
+) (*authdto.TenantRegistrationResponse, error) {
+	registration := &entity.UserTenantRegistration{
+		UserID:               userID,
+		TenantID:             *session.TenantID,
+		RegistrationType:     session.RegistrationType,
+		IdentificationNumber: session.IdentificationNumber,
+		Status:               entity.UTRStatusPendingApproval,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if !regConfig.RequiresApproval {
+		registration.Status = entity.UTRStatusActive
+		registration.ApprovedAt = &now
+	}
+
+	metadata := map[string]any{"product_id": session.ProductID}
+	var participantID *uuid.UUID
+	var participantLink string
+	if session.IdentificationNumber != nil {
+		var err error
+		participantID, participantLink, err = uc.matchParticipant(ctx, userID, session)
+		if err != nil {
+			return nil, err
+		}
+		if registration.Status == entity.UTRStatusActive && participantLink == entity.ParticipantLinkMatched {
+			participantLink, err = uc.linkParticipant(ctx, userID, session, *participantID)
+			if err != nil {
+				return nil, err
+			}
+		}
+		metadata["participant_id"] = participantID
+		metadata["participant_link_status"] = participantLink
+	}
+	if err := registration.SetMetadata(metadata); err != nil {
+		return nil, err
+	}
+
+	if err := uc.UserTenantRegRepo.Create(ctx, registration); err != nil {
+		return nil, err
+	}
+
+	response := &authdto.TenantRegistrationResponse{
+		ID:               registration.ID,
+		TenantID:         registration.TenantID,
+		ProductID:        *session.ProductID,
+		RegistrationType: registration.RegistrationType,
+		Status:           string(registration.Status),
+		ParticipantID:    participantID,
+		ParticipantLink:  participantLink,
+	}
+
+	if registration.Status == entity.UTRStatusActive && regConfig.AutoGrantRoleID != nil {
+		userRole := &entity.UserRole{
+			UserID:        userID,
+			RoleID:        *regConfig.AutoGrantRoleID,
+			ProductID:     session.ProductID,
+			EffectiveFrom: now,
+			CreatedAt:     now,
+		}
+		if err := uc.UserRoleRepo.Create(ctx, userRole); err != nil {
+			return nil, err
+		}
+		response.GrantedRoleID = regConfig.AutoGrantRoleID
+	}
+
+	return response, nil
+}
+
+// matchParticipant matches the registrant's identification number against the
+// tenant's approved participants. Knowing the number is not proof of identity,
+// so an unambiguous match is only recorded as MATCHED and linked once an admin
+// confirms it when approving the registration. Ambiguous or already claimed
+// matches are left for an admin.
+func (uc *usecase) matchParticipant(ctx context.Context, userID uuid.UUID, session *entity.RegistrationSession) (*uuid.UUID, string, error) {
+	candidates, err := uc.ParticipantRepo.ListApprovedByIdentificationNumber(ctx, *session.TenantID, session.ProductID, *session.IdentificationNumber)
+	if err != nil {
+		return nil, "", err
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return nil, entity.ParticipantLinkNoMatch, nil
+	case len(candidates) > 1:
+		uc.logParticipantLinkConflict(ctx, userID, session, nil, "multiple participants match the identification number")
+		return nil, entity.ParticipantLinkConflict, nil
+	}
+
+	participant := candidates[0]
+	if participant.UserID != nil {
+		uc.logParticipantLinkConflict(ctx, userID, session, &participant.ID, "participant is already linked to another user")
+		return &participant.ID, entity.ParticipantLinkConflict, nil
+	}
+	return &participant.ID, entity.ParticipantLinkMatched, nil
+}
+
+// linkParticipant links the matched participant for registrations that need
+// no approval. The product owner opted out of reviewing registrations, so no
+// admin will ever confirm the match. A participant claimed by another user in
+// the meantime is recorded as a conflict.
+func (uc *usecase) linkParticipant(ctx context.Context, userID uuid.UUID, session *entity.RegistrationSession, participantID uuid.UUID) (string, error) {
+	if err := uc.ParticipantRepo.LinkUser(ctx, participantID, userID); err != nil {
+		if !errors.IsConflict(err) {
+			return "", err
+		}
+		uc.logParticipantLinkConflict(ctx, userID, session, &participantID, "participant is already linked to another user")
+		return entity.ParticipantLinkConflict, nil
+	}
+	return entity.ParticipantLinkLinked, nil
+}
+
+func (uc *usecase) logParticipantLinkConflict(ctx context.Context, userID uuid.UUID, session *entity.RegistrationSession, participantID *uuid.UUID, reason string) {
+	metadata := map[string]any{}
+	if participantID != nil {
+		metadata["participant_id"] = participantID.String()
+	}
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "participant_link_conflict",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   userID.String(),
+		TargetType: "user",
+		TenantID:   session.TenantID.String(),
+		Success:    false,
+		Reason:     reason,
+		Metadata:   metadata,
+	})
+}
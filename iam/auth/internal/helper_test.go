@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+	jwtpkg "iam-service/pkg/jwt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePermissions(t *testing.T) {
+	tenantRoleID := uuid.New()
+	branchRoleID := uuid.New()
+	selfRoleID := uuid.New()
+	branchA := uuid.New()
+	branchB := uuid.New()
+
+	roles := []*entity.Role{
+		{ID: tenantRoleID, ScopeLevel: entity.ScopeLevelTenant},
+		{ID: branchRoleID, ScopeLevel: entity.ScopeLevelBranch},
+		{ID: selfRoleID, ScopeLevel: entity.ScopeLevelSelf},
+	}
+
+	t.Run("tenant roles only carry no scopes", func(t *testing.T) {
+		permRepo := new(MockPermissionRepository)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{tenantRoleID}).Return([]string{"participant:read"}, nil)
+		uc := &usecase{PermissionRepo: permRepo}
+
+		permissions, scopes, err := uc.resolvePermissions(context.Background(), []entity.UserRole{{RoleID: tenantRoleID}}, roles)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"participant:read"}, permissions)
+		assert.Nil(t, scopes)
+	})
+
+	t.Run("restricted roles are scoped unless granted tenant wide", func(t *testing.T) {
+		permRepo := new(MockPermissionRepository)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{tenantRoleID}).Return([]string{"participant:read"}, nil)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{branchRoleID}).Return([]string{"participant:read", "participant:update"}, nil)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{selfRoleID}).Return([]string{"participant:update", "profile:update"}, nil)
+		uc := &usecase{PermissionRepo: permRepo}
+
+		userRoles := []entity.UserRole{
+			{RoleID: tenantRoleID},
+			{RoleID: branchRoleID, BranchID: &branchA},
+			{RoleID: selfRoleID},
+			{RoleID: branchRoleID, BranchID: &branchB},
+		}
+		permissions, scopes, err := uc.resolvePermissions(context.Background(), userRoles, roles)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"participant:read", "participant:update", "profile:update"}, permissions)
+		assert.Equal(t, jwtpkg.Scopes{
+			"participant:update": {Level: "branch", BranchIDs: []uuid.UUID{branchA, branchB}},
+			"profile:update":     {Level: "self"},
+		}, scopes)
+	})
+	t.Run("deactivated branch role grants nothing", func(t *testing.T) {
+		inactiveBranchRoleID := uuid.New()
+		permRepo := new(MockPermissionRepository)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{tenantRoleID}).Return([]string{"participant:read"}, nil)
+		uc := &usecase{PermissionRepo: permRepo}
+
+		// GetByIDs only loads active roles, so the deactivated role is
+		// missing from roles although the assignment is still in effect.
+		userRoles := []entity.UserRole{
+			{RoleID: tenantRoleID},
+			{RoleID: inactiveBranchRoleID, BranchID: &branchA},
+		}
+		permissions, scopes, err := uc.resolvePermissions(context.Background(), userRoles, roles)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"participant:read"}, permissions)
+		assert.Nil(t, scopes)
+		permRepo.AssertNotCalled(t, "GetCodesByRoleIDs", mock.Anything, []uuid.UUID{tenantRoleID, inactiveBranchRoleID})
+		permRepo.AssertNotCalled(t, "GetCodesByRoleIDs", mock.Anything, []uuid.UUID{inactiveBranchRoleID})
+	})
+}
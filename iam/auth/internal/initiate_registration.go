@@ -15,6 +15,15 @@ func (uc *usecase) InitiateRegistration(
 	ctx context.Context,
 	req *authdto.InitiateRegistrationRequest,
 ) (*authdto.InitiateRegistrationResponse, error) {
+	if req.TenantID != nil {
+		if req.ProductID == nil || req.RegistrationType == "" {
+			return nil, errors.ErrValidation("product_id and registration_type are required when registering into a tenant")
+		}
+		if _, err := uc.resolveRegistrationConfig(ctx, *req.TenantID, *req.ProductID, req.RegistrationType); err != nil {
+			return nil, err
+		}
+	}
+
 	emailExists, err := uc.UserRepo.EmailExists(ctx, req.Email)
 	if err != nil {
 		return nil, errors.ErrInternal("failed to check email").WithError(err)
@@ -75,6 +84,10 @@ func (uc *usecase) InitiateRegistration(
 		ResendCount:           0,
 		MaxResends:            RegistrationOTPMaxResends,
 		ResendCooldownSeconds: RegistrationOTPResendCooldown,
+		TenantID:              req.TenantID,
+		ProductID:             req.ProductID,
+		RegistrationType:      req.RegistrationType,
+		IdentificationNumber:  req.IdentificationNumber,
 		IPAddress:             req.IPAddress,
 		UserAgent:             req.UserAgent,
 		CreatedAt:             now,
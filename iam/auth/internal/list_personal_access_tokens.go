@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]authdto.PersonalAccessTokenResponse, error) {
+	tokens, err := uc.PersonalAccessTokenRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list personal access tokens").WithError(err)
+	}
+
+	items := make([]authdto.PersonalAccessTokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		items = append(items, mapPersonalAccessTokenToResponse(token))
+	}
+
+	return items, nil
+}
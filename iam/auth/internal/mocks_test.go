@@ -552,6 +552,11 @@ type MockUserTenantRegistrationRepository struct {
 	mock.Mock
 }
 
+func (m *MockUserTenantRegistrationRepository) Create(ctx context.Context, registration *entity.UserTenantRegistration) error {
+	args := m.Called(ctx, registration)
+	return args.Error(0)
+}
+
 func (m *MockUserTenantRegistrationRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error) {
 	args := m.Called(ctx, userID)
 	if args.Get(0) == nil {
@@ -560,6 +565,35 @@ func (m *MockUserTenantRegistrationRepository) ListActiveByUserID(ctx context.Co
 	return args.Get(0).([]entity.UserTenantRegistration), args.Error(1)
 }
 
+type MockProductRegistrationConfigRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductRegistrationConfigRepository) GetByProductAndType(ctx context.Context, productID uuid.UUID, registrationType string) (*entity.ProductRegistrationConfig, error) {
+	args := m.Called(ctx, productID, registrationType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ProductRegistrationConfig), args.Error(1)
+}
+
+type MockParticipantRepository struct {
+	mock.Mock
+}
+
+func (m *MockParticipantRepository) ListApprovedByIdentificationNumber(ctx context.Context, tenantID uuid.UUID, applicationID *uuid.UUID, identificationNumber string) ([]entity.Participant, error) {
+	args := m.Called(ctx, tenantID, applicationID, identificationNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Participant), args.Error(1)
+}
+
+func (m *MockParticipantRepository) LinkUser(ctx context.Context, participantID, userID uuid.UUID) error {
+	args := m.Called(ctx, participantID, userID)
+	return args.Error(0)
+}
+
 type MockProductsByTenantRepository struct {
 	mock.Mock
 }
@@ -571,3 +605,130 @@ func (m *MockProductsByTenantRepository) ListActiveByTenantID(ctx context.Contex
 	}
 	return args.Get(0).([]entity.Product), args.Error(1)
 }
+
+type MockPersonalAccessTokenRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	args := m.Called(ctx, token)
+
+	if token.ID == uuid.Nil {
+		token.ID = uuid.New()
+	}
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.PersonalAccessToken), args.Error(1)
+}
+
+func (m *MockPersonalAccessTokenRepository) Revoke(ctx context.Context, id uuid.UUID, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID, reason string) error {
+	args := m.Called(ctx, userID, reason)
+	return args.Error(0)
+}
+
+func (m *MockPersonalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error {
+	args := m.Called(ctx, id, ip)
+	return args.Error(0)
+}
+
+type MockAdminAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminAuditLogRepository) Create(ctx context.Context, log *entity.AdminAuditLog) error {
+	args := m.Called(ctx, log)
+	return args.Error(0)
+}
+
+type MockPasswordPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordPolicyRepository) Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error) {
+	args := m.Called(ctx, tenantID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasswordPolicy), args.Error(1)
+}
+
+type MockPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordHistoryRepository) Create(ctx context.Context, history *entity.PasswordHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
+func (m *MockPasswordHistoryRepository) ListRecentHashes(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockConsentDocumentRepository struct {
+	mock.Mock
+}
+
+func (m *MockConsentDocumentRepository) ListCurrentForRegistration(ctx context.Context, tenantID, productID *uuid.UUID) ([]entity.ConsentDocument, error) {
+	args := m.Called(ctx, tenantID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ConsentDocument), args.Error(1)
+}
+
+func (m *MockConsentDocumentRepository) ListCurrentForUser(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ConsentDocument), args.Error(1)
+}
+
+type MockUserConsentRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserConsentRepository) Create(ctx context.Context, consent *entity.UserConsent) error {
+	args := m.Called(ctx, consent)
+	return args.Error(0)
+}
+
+func (m *MockUserConsentRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserConsent, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserConsent), args.Error(1)
+}
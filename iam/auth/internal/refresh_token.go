@@ -67,6 +67,22 @@ func (uc *usecase) RefreshToken(
 		return nil, errors.New("USER_INACTIVE", "Invalid or expired refresh token", http.StatusForbidden)
 	}
 
+	changeReason, err := uc.passwordChangeReason(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if changeReason != "" {
+		return uc.refreshIntoPasswordChange(ctx, oldToken, user, changeReason)
+	}
+
+	pendingConsents, err := uc.pendingRequiredConsents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pendingConsents) > 0 {
+		return uc.refreshIntoConsent(ctx, oldToken, user, pendingConsents)
+	}
+
 	tenantClaims, userTenants, err := uc.buildMultiTenantClaims(ctx, userID)
 	if err != nil {
 		return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
@@ -148,3 +164,85 @@ func (uc *usecase) RefreshToken(
 		},
 	}, nil
 }
+
+// refreshIntoPasswordChange ends the session behind oldToken and returns the
+// restricted password-change token instead of a new pair, so a live session
+// cannot keep refreshing into full-scope tokens after the password expired or
+// an admin forced a change. The user logs in again once it is changed.
+func (uc *usecase) refreshIntoPasswordChange(
+	ctx context.Context,
+	oldToken *entity.RefreshToken,
+	user *entity.User,
+	reason string,
+) (*authdto.RefreshTokenResponse, error) {
+	if err := uc.endRefreshSession(ctx, oldToken, "Password change required"); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresIn, err := uc.passwordChangeAccessToken(ctx, user.ID, user.Email, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authdto.RefreshTokenResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   expiresIn,
+		TokenType:   "Bearer",
+		User: authdto.LoginUserResponse{
+			ID:    user.ID,
+			Email: user.Email,
+		},
+		PasswordChangeRequired: true,
+		PasswordChangeReason:   reason,
+	}, nil
+}
+
+// refreshIntoConsent ends the session behind oldToken and returns the
+// restricted consent token once a newly published required document is
+// pending, mirroring what login does.
+func (uc *usecase) refreshIntoConsent(
+	ctx context.Context,
+	oldToken *entity.RefreshToken,
+	user *entity.User,
+	pending []entity.ConsentDocument,
+) (*authdto.RefreshTokenResponse, error) {
+	if err := uc.endRefreshSession(ctx, oldToken, "Consent required"); err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresIn, err := uc.consentAccessToken(ctx, user.ID, user.Email, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authdto.RefreshTokenResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   expiresIn,
+		TokenType:   "Bearer",
+		User: authdto.LoginUserResponse{
+			ID:    user.ID,
+			Email: user.Email,
+		},
+		ConsentRequired: true,
+		PendingConsents: toPendingConsentResponses(pending),
+	}, nil
+}
+
+// endRefreshSession revokes the whole token family of oldToken together with
+// the session it belongs to.
+func (uc *usecase) endRefreshSession(ctx context.Context, oldToken *entity.RefreshToken, reason string) error {
+	session, _ := uc.UserSessionRepo.GetByRefreshTokenID(ctx, oldToken.ID)
+
+	if err := uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.RefreshTokenRepo.RevokeByFamily(txCtx, oldToken.TokenFamily, reason); err != nil {
+			return err
+		}
+		if session != nil && session.IsActive() {
+			return uc.UserSessionRepo.Revoke(txCtx, session.ID)
+		}
+		return nil
+	}); err != nil {
+		return errors.ErrInternal("failed to end session").WithError(err)
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
 	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -400,6 +401,10 @@ func TestRefreshToken(t *testing.T) {
 			mockUserRoleRepo := new(MockUserRoleRepository)
 			mockRoleRepo := new(MockRoleRepository)
 			mockPermRepo := new(MockPermissionRepository)
+			mockAuthMethodRepo := new(MockUserAuthMethodRepository)
+			mockAuthMethodRepo.On("GetByUserID", mock.Anything, userID).Return(nil, errors.ErrNotFound("auth method not found")).Maybe()
+			mockConsentDocRepo := new(MockConsentDocumentRepository)
+			mockConsentDocRepo.On("ListCurrentForUser", mock.Anything, userID).Return([]entity.ConsentDocument{}, nil).Maybe()
 
 			tt.setup(mockRefreshTokenRepo, mockSessionRepo, mockInMemory, mockTxMgr, mockUserRepo, mockProfileRepo, mockTenantRegRepo, mockProdByTenantRepo, mockUserRoleRepo, mockRoleRepo, mockPermRepo)
 
@@ -415,6 +420,8 @@ func TestRefreshToken(t *testing.T) {
 				UserRoleRepo:         mockUserRoleRepo,
 				RoleRepo:             mockRoleRepo,
 				PermissionRepo:       mockPermRepo,
+				UserAuthMethodRepo:   mockAuthMethodRepo,
+				ConsentDocumentRepo:  mockConsentDocRepo,
 				Config: &config.Config{
 					JWT: *jwtCfg,
 				},
@@ -447,3 +454,171 @@ func TestRefreshToken(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshToken_PasswordChangeRequired(t *testing.T) {
+	userID := uuid.New()
+	sessionRecordID := uuid.New()
+	refreshTokenID := uuid.New()
+	tokenFamily := uuid.New()
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.PasswordChangeTokenExpiry = 10 * time.Minute
+	refreshToken := generateTestRefreshToken(userID, uuid.New(), jwtCfg)
+
+	refreshTokenRepo := new(MockRefreshTokenRepository)
+	refreshTokenRepo.On("GetByTokenHash", mock.Anything, hashToken(refreshToken)).Return(&entity.RefreshToken{
+		ID:          refreshTokenID,
+		UserID:      userID,
+		TokenFamily: tokenFamily,
+		ExpiresAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+	}, nil)
+	refreshTokenRepo.On("RevokeByFamily", mock.Anything, tokenFamily, "Password change required").Return(nil)
+
+	sessionRepo := new(MockUserSessionRepository)
+	sessionRepo.On("GetByRefreshTokenID", mock.Anything, refreshTokenID).Return(&entity.UserSession{
+		ID:     sessionRecordID,
+		UserID: userID,
+		Status: entity.UserSessionStatusActive,
+	}, nil)
+	sessionRepo.On("Revoke", mock.Anything, sessionRecordID).Return(nil)
+
+	store := new(MockInMemoryStore)
+	store.On("GetUserBlacklistTimestamp", mock.Anything, userID).Return(nil, nil)
+
+	userRepo := new(MockUserRepository)
+	userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{
+		ID:     userID,
+		Email:  "test@example.com",
+		Status: entity.UserStatusActive,
+	}, nil)
+
+	authMethodRepo := new(MockUserAuthMethodRepository)
+	authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserAuthMethod{
+		UserID:     userID,
+		MethodType: string(entity.AuthMethodPassword),
+	}, nil)
+	securityRepo := new(MockUserSecurityStateRepository)
+	securityRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserSecurityState{
+		UserID:              userID,
+		ForcePasswordChange: true,
+	}, nil)
+
+	uc := &usecase{
+		TxManager:             NewMockTransactionManager(),
+		Config:                &config.Config{JWT: *jwtCfg},
+		RefreshTokenRepo:      refreshTokenRepo,
+		UserSessionRepo:       sessionRepo,
+		InMemoryStore:         store,
+		UserRepo:              userRepo,
+		UserAuthMethodRepo:    authMethodRepo,
+		UserSecurityStateRepo: securityRepo,
+		AuditLogger:           logger.NewNoopAuditLogger(),
+	}
+
+	resp, err := uc.RefreshToken(context.Background(), &authdto.RefreshTokenRequest{RefreshToken: refreshToken})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.True(t, resp.PasswordChangeRequired)
+	assert.Equal(t, PasswordChangeReasonForced, resp.PasswordChangeReason)
+	assert.Empty(t, resp.RefreshToken)
+	assert.Equal(t, int(jwtCfg.PasswordChangeTokenExpiry.Seconds()), resp.ExpiresIn)
+
+	claims, err := jwtpkg.ParseAccessToken(resp.AccessToken, &jwtpkg.TokenConfig{
+		SigningMethod: jwtCfg.SigningMethod,
+		AccessSecret:  jwtCfg.AccessSecret,
+		Issuer:        jwtCfg.Issuer,
+	})
+	require.NoError(t, err)
+	assert.True(t, claims.IsPasswordChangeOnly())
+
+	refreshTokenRepo.AssertExpectations(t)
+	refreshTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestRefreshToken_ConsentRequired(t *testing.T) {
+	userID := uuid.New()
+	sessionRecordID := uuid.New()
+	refreshTokenID := uuid.New()
+	tokenFamily := uuid.New()
+	documentID := uuid.New()
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.ConsentTokenExpiry = 10 * time.Minute
+	refreshToken := generateTestRefreshToken(userID, uuid.New(), jwtCfg)
+
+	refreshTokenRepo := new(MockRefreshTokenRepository)
+	refreshTokenRepo.On("GetByTokenHash", mock.Anything, hashToken(refreshToken)).Return(&entity.RefreshToken{
+		ID:          refreshTokenID,
+		UserID:      userID,
+		TokenFamily: tokenFamily,
+		ExpiresAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+	}, nil)
+	refreshTokenRepo.On("RevokeByFamily", mock.Anything, tokenFamily, "Consent required").Return(nil)
+
+	sessionRepo := new(MockUserSessionRepository)
+	sessionRepo.On("GetByRefreshTokenID", mock.Anything, refreshTokenID).Return(&entity.UserSession{
+		ID:     sessionRecordID,
+		UserID: userID,
+		Status: entity.UserSessionStatusActive,
+	}, nil)
+	sessionRepo.On("Revoke", mock.Anything, sessionRecordID).Return(nil)
+
+	store := new(MockInMemoryStore)
+	store.On("GetUserBlacklistTimestamp", mock.Anything, userID).Return(nil, nil)
+
+	userRepo := new(MockUserRepository)
+	userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{
+		ID:     userID,
+		Email:  "test@example.com",
+		Status: entity.UserStatusActive,
+	}, nil)
+
+	authMethodRepo := new(MockUserAuthMethodRepository)
+	authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(nil, errors.ErrNotFound("auth method not found"))
+
+	consentDocRepo := new(MockConsentDocumentRepository)
+	consentDocRepo.On("ListCurrentForUser", mock.Anything, userID).Return([]entity.ConsentDocument{
+		{ID: documentID, DocumentType: entity.ConsentDocumentTerms, Version: "2.0", Title: "Terms", IsRequired: true},
+	}, nil)
+	userConsentRepo := new(MockUserConsentRepository)
+	userConsentRepo.On("ListByUserID", mock.Anything, userID).Return([]entity.UserConsent{}, nil)
+
+	uc := &usecase{
+		TxManager:           NewMockTransactionManager(),
+		Config:              &config.Config{JWT: *jwtCfg},
+		RefreshTokenRepo:    refreshTokenRepo,
+		UserSessionRepo:     sessionRepo,
+		InMemoryStore:       store,
+		UserRepo:            userRepo,
+		UserAuthMethodRepo:  authMethodRepo,
+		ConsentDocumentRepo: consentDocRepo,
+		UserConsentRepo:     userConsentRepo,
+		AuditLogger:         logger.NewNoopAuditLogger(),
+	}
+
+	resp, err := uc.RefreshToken(context.Background(), &authdto.RefreshTokenRequest{RefreshToken: refreshToken})
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+
+	assert.True(t, resp.ConsentRequired)
+	require.Len(t, resp.PendingConsents, 1)
+	assert.Equal(t, documentID, resp.PendingConsents[0].ID)
+	assert.Empty(t, resp.RefreshToken)
+	assert.Equal(t, int(jwtCfg.ConsentTokenExpiry.Seconds()), resp.ExpiresIn)
+
+	claims, err := jwtpkg.ParseAccessToken(resp.AccessToken, &jwtpkg.TokenConfig{
+		SigningMethod: jwtCfg.SigningMethod,
+		AccessSecret:  jwtCfg.AccessSecret,
+		Issuer:        jwtCfg.Issuer,
+	})
+	require.NoError(t, err)
+	assert.True(t, claims.IsConsentOnly())
+
+	refreshTokenRepo.AssertExpectations(t)
+	refreshTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	sessionRepo.AssertExpectations(t)
+}
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+)
+
+func (uc *usecase) RevokePersonalAccessToken(ctx context.Context, req *authdto.RevokePersonalAccessTokenRequest) error {
+	token, err := uc.PersonalAccessTokenRepo.GetByID(ctx, req.TokenID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("Personal access token not found")
+		}
+		return errors.ErrInternal("failed to get personal access token").WithError(err)
+	}
+
+	if token.UserID != req.UserID {
+		return errors.ErrNotFound("Personal access token not found")
+	}
+
+	if token.IsRevoked() {
+		return errors.ErrConflict("Personal access token is already revoked")
+	}
+
+	if err := uc.PersonalAccessTokenRepo.Revoke(ctx, token.ID, "user_revoke"); err != nil {
+		return errors.ErrInternal("failed to revoke personal access token").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "personal_access_token_revoked",
+		ActorID:    req.UserID.String(),
+		ActorType:  "user",
+		TargetID:   token.ID.String(),
+		TargetType: "personal_access_token",
+		TenantID:   token.TenantID.String(),
+		Success:    true,
+	})
+
+	return nil
+}
@@ -36,8 +36,9 @@ func (uc *usecase) SetPassword(
 		return nil, errors.ErrValidation("Passwords do not match")
 	}
 
-	if err := uc.validatePassword(req.Password); err != nil {
-		return nil, errors.ErrValidation(err.Error())
+	warning, err := uc.validatePassword(ctx, session, req.Password)
+	if err != nil {
+		return nil, err
 	}
 
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
@@ -66,6 +67,9 @@ func (uc *usecase) SetPassword(
 			RequiredFields: []string{"full_name", "phone_number", "date_of_birth", "gender", "marital_status", "address", "place_of_birth"},
 		},
 	}
+	if warning != "" {
+		response.Warnings = []string{warning}
+	}
 
 	return response, nil
 }
@@ -2,8 +2,10 @@ package internal
 
 import (
 	"context"
+	"crypto/sha1"
 	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +13,8 @@ import (
 	"iam-service/entity"
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+	"iam-service/pkg/password"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
@@ -226,9 +230,14 @@ func TestSetPassword(t *testing.T) {
 				},
 			}
 
+			passwordPolicyRepo := new(MockPasswordPolicyRepository)
+			passwordPolicyRepo.On("Resolve", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, errors.ErrNotFound("password policy not found")).Maybe()
+
 			uc := &usecase{
 				Config: cfg,
 				InMemoryStore:  redis,
+				PasswordPolicyRepo: passwordPolicyRepo,
 			}
 
 			tt.req.RegistrationID = registrationID
@@ -261,3 +270,204 @@ func TestSetPassword(t *testing.T) {
 		})
 	}
 }
+
+func TestSetPassword_ConfiguredPolicy(t *testing.T) {
+	registrationID := uuid.New()
+	email := "test@example.com"
+	jwtSecret := "test-secret-key-for-testing-purposes"
+
+	tests := []struct {
+		name          string
+		password      string
+		expectedError string
+	}{
+		{
+			name:     "success - relaxed policy allows passphrase",
+			password: "correct horse battery staple",
+		},
+		{
+			name:          "error - banned word",
+			password:      "my frendz passphrase",
+			expectedError: "guessable",
+		},
+		{
+			name:          "error - shorter than policy minimum",
+			password:      "short passphrase",
+			expectedError: "at least 20 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{
+				Config: &config.Config{JWT: config.JWTConfig{AccessSecret: jwtSecret}},
+			}
+			tokenString, tokenHash, err := uc.generateRegistrationCompleteToken(registrationID, email)
+			require.NoError(t, err)
+
+			redis := new(MockInMemoryStore)
+			redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+				ID:                    registrationID,
+				Email:                 email,
+				Status:                entity.RegistrationSessionStatusVerified,
+				RegistrationTokenHash: &tokenHash,
+				ExpiresAt:             time.Now().Add(10 * time.Minute),
+			}, nil)
+			redis.On("MarkRegistrationPasswordSet", mock.Anything, registrationID, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Maybe()
+
+			passwordPolicyRepo := new(MockPasswordPolicyRepository)
+			passwordPolicyRepo.On("Resolve", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return(&entity.PasswordPolicy{
+				MinLength:   20,
+				MaxLength:   128,
+				BannedWords: []byte(`["frendz"]`),
+			}, nil)
+
+			uc.InMemoryStore = redis
+			uc.PasswordPolicyRepo = passwordPolicyRepo
+
+			_, err = uc.SetPassword(context.Background(), &authdto.SetPasswordRequest{
+				RegistrationID:       registrationID,
+				RegistrationToken:    tokenString,
+				Password:             tt.password,
+				ConfirmationPassword: tt.password,
+			})
+
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			appErr, ok := err.(*errors.AppError)
+			require.True(t, ok)
+			assert.Equal(t, errors.CodeValidation, appErr.Code)
+			assert.Contains(t, appErr.Message, tt.expectedError)
+		})
+	}
+}
+
+func TestSetPassword_BreachedPassword(t *testing.T) {
+	registrationID := uuid.New()
+	email := "test@example.com"
+	breached := "Summer2024!Pass"
+
+	sum := sha1.Sum([]byte(breached))
+	checker, err := password.ReadSHA1Prefixes(strings.NewReader(hex.EncodeToString(sum[:])))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		breachCheck     string
+		expectedCode    string
+		expectedWarning bool
+	}{
+		{name: "error - blocked", breachCheck: "BLOCK", expectedCode: errors.CodeValidation},
+		{name: "success - warned", breachCheck: "WARN", expectedWarning: true},
+		{name: "success - screening off", breachCheck: "OFF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{
+				Config:        &config.Config{JWT: config.JWTConfig{AccessSecret: "test-secret-key-for-testing-purposes"}},
+				BreachChecker: checker,
+				AuditLogger:   logger.NewNoopAuditLogger(),
+			}
+			tokenString, tokenHash, err := uc.generateRegistrationCompleteToken(registrationID, email)
+			require.NoError(t, err)
+
+			redis := new(MockInMemoryStore)
+			redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+				ID:                    registrationID,
+				Email:                 email,
+				Status:                entity.RegistrationSessionStatusVerified,
+				RegistrationTokenHash: &tokenHash,
+				ExpiresAt:             time.Now().Add(10 * time.Minute),
+			}, nil)
+			redis.On("MarkRegistrationPasswordSet", mock.Anything, registrationID, mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil).Maybe()
+
+			passwordPolicyRepo := new(MockPasswordPolicyRepository)
+			passwordPolicyRepo.On("Resolve", mock.Anything, mock.Anything, mock.Anything).Return(&entity.PasswordPolicy{
+				MinLength:        8,
+				MaxLength:        128,
+				RequireUppercase: true,
+				RequireNumber:    true,
+				BreachCheck:      tt.breachCheck,
+			}, nil)
+
+			uc.InMemoryStore = redis
+			uc.PasswordPolicyRepo = passwordPolicyRepo
+
+			resp, err := uc.SetPassword(context.Background(), &authdto.SetPasswordRequest{
+				RegistrationID:       registrationID,
+				RegistrationToken:    tokenString,
+				Password:             breached,
+				ConfirmationPassword: breached,
+			})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok)
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expectedWarning {
+				assert.Equal(t, []string{password.BreachedPasswordWarning}, resp.Warnings)
+			} else {
+				assert.Empty(t, resp.Warnings)
+			}
+		})
+	}
+}
+
+func TestSetPassword_RegistrationScopePolicy(t *testing.T) {
+	registrationID := uuid.New()
+	tenantID := uuid.New()
+	productID := uuid.New()
+	email := "test@example.com"
+	jwtSecret := "test-secret-key-for-testing-purposes"
+
+	uc := &usecase{
+		Config: &config.Config{JWT: config.JWTConfig{AccessSecret: jwtSecret}},
+	}
+	tokenString, tokenHash, err := uc.generateRegistrationCompleteToken(registrationID, email)
+	require.NoError(t, err)
+
+	redis := new(MockInMemoryStore)
+	redis.On("GetRegistrationSession", mock.Anything, registrationID).Return(&entity.RegistrationSession{
+		ID:                    registrationID,
+		Email:                 email,
+		Status:                entity.RegistrationSessionStatusVerified,
+		RegistrationTokenHash: &tokenHash,
+		TenantID:              &tenantID,
+		ProductID:             &productID,
+		ExpiresAt:             time.Now().Add(10 * time.Minute),
+	}, nil)
+
+	passwordPolicyRepo := new(MockPasswordPolicyRepository)
+	passwordPolicyRepo.On("Resolve", mock.Anything, &tenantID, &productID).Return(&entity.PasswordPolicy{
+		TenantID:  &tenantID,
+		ProductID: &productID,
+		MinLength: 20,
+		MaxLength: 128,
+	}, nil)
+
+	uc.InMemoryStore = redis
+	uc.PasswordPolicyRepo = passwordPolicyRepo
+
+	_, err = uc.SetPassword(context.Background(), &authdto.SetPasswordRequest{
+		RegistrationID:       registrationID,
+		RegistrationToken:    tokenString,
+		Password:             "short passphrase",
+		ConfirmationPassword: "short passphrase",
+	})
+
+	require.Error(t, err)
+	appErr, ok := err.(*errors.AppError)
+	require.True(t, ok)
+	assert.Equal(t, errors.CodeValidation, appErr.Code)
+	assert.Contains(t, appErr.Message, "at least 20 characters")
+	passwordPolicyRepo.AssertExpectations(t)
+	redis.AssertNotCalled(t, "MarkRegistrationPasswordSet", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) StartImpersonation(ctx context.Context, req *authdto.StartImpersonationRequest) (*authdto.StartImpersonationResponse, error) {
+	if req.TargetUserID == req.ActorID {
+		return nil, errors.ErrBadRequest("cannot impersonate yourself")
+	}
+
+	target, err := uc.UserRepo.GetByID(ctx, req.TargetUserID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get user").WithError(err)
+	}
+	if target.Status != entity.UserStatusActive {
+		return nil, errors.ErrBadRequest("only active users can be impersonated")
+	}
+
+	tenantClaims, _, err := uc.buildMultiTenantClaims(ctx, target.ID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
+	}
+	if hasRoleInAnyTenant(tenantClaims, PlatformAdminRole) {
+		return nil, errors.ErrForbidden("platform admins cannot be impersonated")
+	}
+
+	if !req.IsPlatformAdmin {
+		if req.TenantID == nil {
+			return nil, errors.ErrBadRequest("X-Tenant-ID header is required")
+		}
+		actorClaims, _, err := uc.buildMultiTenantClaims(ctx, req.ActorID)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
+		}
+		if _, ok := tenantPermissionSet(actorClaims, *req.TenantID)[ImpersonatePermission]; !ok {
+			uc.logImpersonationDenied(ctx, req, "missing impersonation permission")
+			return nil, errors.ErrForbidden("you are not allowed to impersonate users in this tenant")
+		}
+	}
+
+	// Tenant admins only ever see the target through their own tenant.
+	if req.TenantID != nil {
+		tenantClaims = filterTenantClaims(tenantClaims, *req.TenantID)
+		if len(tenantClaims) == 0 {
+			return nil, errors.ErrForbidden("user is not registered in this tenant")
+		}
+	}
+
+	tokenConfig, err := uc.buildTokenConfig()
+	if err != nil {
+		return nil, err
+	}
+	tokenConfig.AccessExpiry = uc.Config.JWT.ImpersonationExpiry
+
+	sessionID := uuid.New()
+	expiresAt := time.Now().Add(tokenConfig.AccessExpiry)
+
+	accessToken, err := jwtpkg.GenerateImpersonationAccessToken(
+		target.ID,
+		target.Email,
+		tenantClaims,
+		sessionID,
+		jwtpkg.ActorClaim{Subject: req.ActorID.String(), Email: req.ActorEmail},
+		tokenConfig,
+	)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate impersonation token").WithError(err)
+	}
+
+	afterState, _ := json.Marshal(map[string]any{
+		"session_id": sessionID,
+		"expires_at": expiresAt,
+		"reason":     req.Reason,
+	})
+	if err := uc.AdminAuditLogRepo.Create(ctx, &entity.AdminAuditLog{
+		TenantID:   req.TenantID,
+		UserID:     req.ActorID,
+		Action:     entity.AdminActionStartImpersonation,
+		EntityType: entity.EntityTypeUser,
+		EntityID:   &target.ID,
+		AfterState: afterState,
+		IPAddress:  optionalString(req.IPAddress),
+		UserAgent:  req.UserAgent,
+		CreatedAt:  time.Now(),
+	}); err != nil {
+		// Impersonation without an audit record is not allowed.
+		return nil, errors.ErrInternal("failed to record impersonation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "impersonation_started",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "admin",
+		TargetID:   target.ID.String(),
+		TargetType: "user",
+		TenantID:   optionalUUIDString(req.TenantID),
+		Success:    true,
+		Metadata:   map[string]any{"session_id": sessionID.String(), "reason": req.Reason},
+	})
+
+	return &authdto.StartImpersonationResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(tokenConfig.AccessExpiry.Seconds()),
+		ExpiresAt:   expiresAt,
+		SessionID:   sessionID,
+		UserID:      target.ID,
+		Email:       target.Email,
+		ActorID:     req.ActorID,
+	}, nil
+}
+
+func (uc *usecase) logImpersonationDenied(ctx context.Context, req *authdto.StartImpersonationRequest, reason string) {
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "impersonation_started",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "admin",
+		TargetID:   req.TargetUserID.String(),
+		TargetType: "user",
+		TenantID:   optionalUUIDString(req.TenantID),
+		Success:    false,
+		Reason:     reason,
+	})
+}
@@ -0,0 +1,170 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartImpersonation(t *testing.T) {
+	adminID := uuid.New()
+	targetID := uuid.New()
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	productID := uuid.New()
+	participantRoleID := uuid.New()
+	tenantAdminRoleID := uuid.New()
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.ImpersonationExpiry = 15 * time.Minute
+
+	setupClaims := func(regRepo *MockUserTenantRegistrationRepository, productsRepo *MockProductsByTenantRepository, userRoleRepo *MockUserRoleRepository, roleRepo *MockRoleRepository, permRepo *MockPermissionRepository, adminPermissions []string) {
+		regRepo.On("ListActiveByUserID", mock.Anything, targetID).Return([]entity.UserTenantRegistration{
+			{UserID: targetID, TenantID: tenantID},
+			{UserID: targetID, TenantID: otherTenantID},
+		}, nil)
+		regRepo.On("ListActiveByUserID", mock.Anything, adminID).Return([]entity.UserTenantRegistration{
+			{UserID: adminID, TenantID: tenantID},
+		}, nil)
+		productsRepo.On("ListActiveByTenantID", mock.Anything, mock.Anything).Return([]entity.Product{
+			{ID: productID, Code: "frendz-saving"},
+		}, nil)
+		userRoleRepo.On("ListActiveByUserID", mock.Anything, targetID, mock.Anything).Return([]entity.UserRole{
+			{UserID: targetID, RoleID: participantRoleID},
+		}, nil)
+		userRoleRepo.On("ListActiveByUserID", mock.Anything, adminID, mock.Anything).Return([]entity.UserRole{
+			{UserID: adminID, RoleID: tenantAdminRoleID},
+		}, nil)
+		roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{participantRoleID}).Return([]*entity.Role{
+			{ID: participantRoleID, Code: "PARTICIPANT"},
+		}, nil)
+		roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{tenantAdminRoleID}).Return([]*entity.Role{
+			{ID: tenantAdminRoleID, Code: "TENANT_ADMIN"},
+		}, nil)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{participantRoleID}).Return([]string{"participant:read"}, nil)
+		permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{tenantAdminRoleID}).Return(adminPermissions, nil)
+	}
+
+	tests := []struct {
+		name             string
+		req              *authdto.StartImpersonationRequest
+		adminPermissions []string
+		expectedCode     string
+		expectedTenants  int
+	}{
+		{
+			name: "success - platform admin sees every tenant of the user",
+			req: &authdto.StartImpersonationRequest{
+				ActorID:         adminID,
+				IsPlatformAdmin: true,
+				TargetUserID:    targetID,
+				Reason:          "support ticket 42",
+			},
+			expectedTenants: 2,
+		},
+		{
+			name: "success - tenant admin is limited to their tenant",
+			req: &authdto.StartImpersonationRequest{
+				ActorID:      adminID,
+				TenantID:     &tenantID,
+				TargetUserID: targetID,
+				Reason:       "support ticket 42",
+			},
+			adminPermissions: []string{ImpersonatePermission},
+			expectedTenants:  1,
+		},
+		{
+			name: "error - tenant admin without impersonation permission",
+			req: &authdto.StartImpersonationRequest{
+				ActorID:      adminID,
+				TenantID:     &tenantID,
+				TargetUserID: targetID,
+				Reason:       "support ticket 42",
+			},
+			adminPermissions: []string{"user:read"},
+			expectedCode:     errors.CodeForbidden,
+		},
+		{
+			name: "error - impersonating yourself",
+			req: &authdto.StartImpersonationRequest{
+				ActorID:         adminID,
+				IsPlatformAdmin: true,
+				TargetUserID:    adminID,
+				Reason:          "support ticket 42",
+			},
+			expectedCode: errors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			regRepo := new(MockUserTenantRegistrationRepository)
+			productsRepo := new(MockProductsByTenantRepository)
+			userRoleRepo := new(MockUserRoleRepository)
+			roleRepo := new(MockRoleRepository)
+			permRepo := new(MockPermissionRepository)
+			auditRepo := new(MockAdminAuditLogRepository)
+
+			userRepo.On("GetByID", mock.Anything, targetID).Return(&entity.User{
+				ID:     targetID,
+				Email:  "participant@example.com",
+				Status: entity.UserStatusActive,
+			}, nil)
+			setupClaims(regRepo, productsRepo, userRoleRepo, roleRepo, permRepo, tt.adminPermissions)
+			auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+				return log.Action == entity.AdminActionStartImpersonation && log.UserID == adminID && *log.EntityID == targetID
+			})).Return(nil)
+
+			uc := &usecase{
+				Config:               &config.Config{JWT: *jwtCfg},
+				UserRepo:             userRepo,
+				UserTenantRegRepo:    regRepo,
+				ProductsByTenantRepo: productsRepo,
+				UserRoleRepo:         userRoleRepo,
+				RoleRepo:             roleRepo,
+				PermissionRepo:       permRepo,
+				AdminAuditLogRepo:    auditRepo,
+				AuditLogger:          logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.StartImpersonation(context.Background(), tt.req)
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				auditRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 900, resp.ExpiresIn)
+			auditRepo.AssertExpectations(t)
+
+			claims, err := jwtpkg.ParseMultiTenantAccessToken(resp.AccessToken, &jwtpkg.TokenConfig{
+				SigningMethod: jwtCfg.SigningMethod,
+				AccessSecret:  jwtCfg.AccessSecret,
+				Issuer:        jwtCfg.Issuer,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, targetID, claims.UserID)
+			require.True(t, claims.IsImpersonated())
+			assert.Equal(t, adminID.String(), claims.Actor.Subject)
+			assert.Len(t, claims.Tenants, tt.expectedTenants)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+)
+
+func (uc *usecase) StopImpersonation(ctx context.Context, req *authdto.StopImpersonationRequest) error {
+	if req.TokenJTI != "" {
+		ttl := time.Until(req.TokenExp)
+		if ttl > 0 {
+			if err := uc.InMemoryStore.BlacklistToken(ctx, req.TokenJTI, ttl); err != nil {
+				return errors.ErrInternal("failed to revoke impersonation token").WithError(err)
+			}
+		}
+	}
+
+	afterState, _ := json.Marshal(map[string]any{
+		"session_id": req.SessionID,
+	})
+	if err := uc.AdminAuditLogRepo.Create(ctx, &entity.AdminAuditLog{
+		TenantID:   req.TenantID,
+		UserID:     req.ActorID,
+		Action:     entity.AdminActionStopImpersonation,
+		EntityType: entity.EntityTypeUser,
+		EntityID:   &req.TargetUserID,
+		AfterState: afterState,
+		IPAddress:  optionalString(req.IPAddress),
+		UserAgent:  req.UserAgent,
+		CreatedAt:  time.Now(),
+	}); err != nil {
+		return errors.ErrInternal("failed to record impersonation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "impersonation_stopped",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "admin",
+		TargetID:   req.TargetUserID.String(),
+		TargetType: "user",
+		TenantID:   optionalUUIDString(req.TenantID),
+		Success:    true,
+		Metadata:   map[string]any{"session_id": req.SessionID.String()},
+	})
+
+	return nil
+}
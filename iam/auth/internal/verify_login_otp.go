@@ -10,6 +10,7 @@ import (
 	"iam-service/iam/auth/authdto"
 	"iam-service/pkg/errors"
 	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -54,6 +55,22 @@ func (uc *usecase) VerifyLoginOTP(
 		return nil, errors.ErrInternal("failed to mark session verified").WithError(err)
 	}
 
+	changeReason, err := uc.passwordChangeReason(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if changeReason != "" {
+		return uc.issuePasswordChangeToken(ctx, session, changeReason)
+	}
+
+	pendingConsents, err := uc.pendingRequiredConsents(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pendingConsents) > 0 {
+		return uc.issueConsentToken(ctx, session, pendingConsents)
+	}
+
 	tenantClaims, userTenants, err := uc.buildMultiTenantClaims(ctx, session.UserID)
 	if err != nil {
 		return nil, errors.ErrInternal("failed to build tenant claims").WithError(err)
@@ -146,6 +163,143 @@ func (uc *usecase) VerifyLoginOTP(
 	}, nil
 }
 
+// issuePasswordChangeToken completes the login with a restricted token that
+// only allows changing the password. No session or refresh token is created,
+// so the user has to log in again once the password has been changed.
+func (uc *usecase) issuePasswordChangeToken(
+	ctx context.Context,
+	session *entity.LoginSession,
+	reason string,
+) (*authdto.VerifyLoginOTPResponse, error) {
+	accessToken, expiresIn, err := uc.passwordChangeAccessToken(ctx, session.UserID, session.Email, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = uc.InMemoryStore.DeleteLoginSession(ctx, session.ID)
+
+	return &authdto.VerifyLoginOTPResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   expiresIn,
+		TokenType:   "Bearer",
+		User: authdto.LoginUserResponse{
+			ID:    session.UserID,
+			Email: session.Email,
+		},
+		PasswordChangeRequired: true,
+		PasswordChangeReason:   reason,
+	}, nil
+}
+
+// passwordChangeAccessToken signs the restricted token that only allows
+// changing the password and records why it was issued. It returns the token
+// and its lifetime in seconds.
+func (uc *usecase) passwordChangeAccessToken(ctx context.Context, userID uuid.UUID, email, reason string) (string, int, error) {
+	tokenConfig, err := uc.buildTokenConfig()
+	if err != nil {
+		return "", 0, err
+	}
+	tokenConfig.AccessExpiry = uc.Config.JWT.PasswordChangeTokenExpiry
+
+	accessToken, err := jwtpkg.GeneratePasswordChangeToken(userID, email, uuid.New(), tokenConfig)
+	if err != nil {
+		return "", 0, errors.ErrInternal("failed to generate password change token").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "password_change_required",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   userID.String(),
+		TargetType: "user",
+		Success:    true,
+		Reason:     reason,
+	})
+
+	return accessToken, int(tokenConfig.AccessExpiry.Seconds()), nil
+}
+
+// issueConsentToken completes the login with a restricted token that only
+// allows reading and accepting consent documents. As with password changes,
+// the user logs in again once every pending document has been accepted.
+func (uc *usecase) issueConsentToken(
+	ctx context.Context,
+	session *entity.LoginSession,
+	pending []entity.ConsentDocument,
+) (*authdto.VerifyLoginOTPResponse, error) {
+	accessToken, expiresIn, err := uc.consentAccessToken(ctx, session.UserID, session.Email, pending)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = uc.InMemoryStore.DeleteLoginSession(ctx, session.ID)
+
+	return &authdto.VerifyLoginOTPResponse{
+		AccessToken: accessToken,
+		ExpiresIn:   expiresIn,
+		TokenType:   "Bearer",
+		User: authdto.LoginUserResponse{
+			ID:    session.UserID,
+			Email: session.Email,
+		},
+		ConsentRequired: true,
+		PendingConsents: toPendingConsentResponses(pending),
+	}, nil
+}
+
+// consentAccessToken signs the restricted token that only allows reading and
+// accepting consent documents and records which documents are pending. It
+// returns the token and its lifetime in seconds.
+func (uc *usecase) consentAccessToken(ctx context.Context, userID uuid.UUID, email string, pending []entity.ConsentDocument) (string, int, error) {
+	tokenConfig, err := uc.buildTokenConfig()
+	if err != nil {
+		return "", 0, err
+	}
+	tokenConfig.AccessExpiry = uc.Config.JWT.ConsentTokenExpiry
+
+	accessToken, err := jwtpkg.GenerateConsentToken(userID, email, uuid.New(), tokenConfig)
+	if err != nil {
+		return "", 0, errors.ErrInternal("failed to generate consent token").WithError(err)
+	}
+
+	documentIDs := make([]string, len(pending))
+	for i, doc := range pending {
+		documentIDs[i] = doc.ID.String()
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "auth",
+		Action:     "consent_required",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   userID.String(),
+		TargetType: "user",
+		Success:    true,
+		Metadata: map[string]any{
+			"document_ids": documentIDs,
+		},
+	})
+
+	return accessToken, int(tokenConfig.AccessExpiry.Seconds()), nil
+}
+
+func toPendingConsentResponses(pending []entity.ConsentDocument) []authdto.PendingConsentResponse {
+	responses := make([]authdto.PendingConsentResponse, len(pending))
+	for i, doc := range pending {
+		responses[i] = authdto.PendingConsentResponse{
+			ID:           doc.ID,
+			TenantID:     doc.TenantID,
+			ProductID:    doc.ProductID,
+			DocumentType: string(doc.DocumentType),
+			Version:      doc.Version,
+			Title:        doc.Title,
+			ContentURL:   doc.ContentURL,
+		}
+	}
+	return responses
+}
+
 func (uc *usecase) buildMultiTenantClaims(ctx context.Context, userID uuid.UUID) ([]jwtpkg.TenantClaim, []authdto.TenantResponse, error) {
 	registrations, err := uc.UserTenantRegRepo.ListActiveByUserID(ctx, userID)
 	if err != nil {
@@ -155,8 +309,19 @@ func (uc *usecase) buildMultiTenantClaims(ctx context.Context, userID uuid.UUID)
 	var jwtClaims []jwtpkg.TenantClaim
 	var dtoTenants []authdto.TenantResponse
 
+	// A user can hold both a PARTICIPANT and a MEMBER registration with the
+	// same tenant; they share one tenant claim.
+	var tenantIDs []uuid.UUID
+	registrationTypes := make(map[uuid.UUID][]string)
 	for _, reg := range registrations {
-		products, err := uc.ProductsByTenantRepo.ListActiveByTenantID(ctx, reg.TenantID)
+		if _, ok := registrationTypes[reg.TenantID]; !ok {
+			tenantIDs = append(tenantIDs, reg.TenantID)
+		}
+		registrationTypes[reg.TenantID] = append(registrationTypes[reg.TenantID], reg.RegistrationType)
+	}
+
+	for _, tenantID := range tenantIDs {
+		products, err := uc.ProductsByTenantRepo.ListActiveByTenantID(ctx, tenantID)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -176,19 +341,35 @@ func (uc *usecase) buildMultiTenantClaims(ctx context.Context, userID uuid.UUID)
 				roleIDs = append(roleIDs, ur.RoleID)
 			}
 
+			// Assignments are per user, not per tenant: roles of the user's
+			// other tenants must not leak into this tenant's claim.
+			var roles []*entity.Role
+			var tenantUserRoles []entity.UserRole
 			if len(roleIDs) > 0 {
-				roles, err := uc.RoleRepo.GetByIDs(ctx, roleIDs)
+				loaded, err := uc.RoleRepo.GetByIDs(ctx, roleIDs)
 				if err != nil {
 					return nil, nil, err
 				}
-				for _, r := range roles {
+				applicable := make(map[uuid.UUID]struct{}, len(loaded))
+				for _, r := range loaded {
+					if !r.AppliesToTenant(tenantID) {
+						continue
+					}
+					roles = append(roles, r)
 					roleNames = append(roleNames, r.Code)
+					applicable[r.ID] = struct{}{}
+				}
+				for _, ur := range userRoles {
+					if _, ok := applicable[ur.RoleID]; ok {
+						tenantUserRoles = append(tenantUserRoles, ur)
+					}
 				}
 			}
 
 			var permissions []string
-			if len(roleIDs) > 0 {
-				permissions, err = uc.PermissionRepo.GetCodesByRoleIDs(ctx, roleIDs)
+			var scopes jwtpkg.Scopes
+			if len(tenantUserRoles) > 0 {
+				permissions, scopes, err = uc.resolvePermissions(ctx, tenantUserRoles, roles)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -199,6 +380,7 @@ func (uc *usecase) buildMultiTenantClaims(ctx context.Context, userID uuid.UUID)
 				ProductCode: product.Code,
 				Roles:       roleNames,
 				Permissions: permissions,
+				Scopes:      scopes,
 			})
 
 			dtoProducts = append(dtoProducts, authdto.ProductResponse{
@@ -210,13 +392,15 @@ func (uc *usecase) buildMultiTenantClaims(ctx context.Context, userID uuid.UUID)
 		}
 
 		jwtClaims = append(jwtClaims, jwtpkg.TenantClaim{
-			TenantID: reg.TenantID,
-			Products: jwtProducts,
+			TenantID:          tenantID,
+			RegistrationTypes: registrationTypes[tenantID],
+			Products:          jwtProducts,
 		})
 
 		dtoTenants = append(dtoTenants, authdto.TenantResponse{
-			TenantID: reg.TenantID,
-			Products: dtoProducts,
+			TenantID:          tenantID,
+			RegistrationTypes: registrationTypes[tenantID],
+			Products:          dtoProducts,
 		})
 	}
 
@@ -232,9 +416,10 @@ func (uc *usecase) buildTokenConfig() (*jwtpkg.TokenConfig, error) {
 		RefreshExpiry: uc.Config.JWT.RefreshExpiry,
 		Issuer:        uc.Config.JWT.Issuer,
 		Audience:      uc.Config.JWT.Audience,
+		KeyRing:       uc.Config.JWT.KeyRing,
 	}
 
-	if uc.Config.JWT.SigningMethod == "RS256" {
+	if jwtpkg.IsAsymmetric(uc.Config.JWT.SigningMethod) && tokenConfig.KeyRing == nil {
 		privateKey, err := jwtpkg.LoadPrivateKeyFromFile(uc.Config.JWT.PrivateKeyPath)
 		if err != nil {
 			return nil, errors.ErrInternal("failed to load JWT private key").WithError(err)
@@ -0,0 +1,375 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/auth/authdto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifyLoginOTP_PasswordChange(t *testing.T) {
+	userID := uuid.New()
+	tenantID := uuid.New()
+	email := "user@example.com"
+	otp := "123456"
+
+	otpHash, err := bcrypt.GenerateFromPassword([]byte(otp), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.PasswordChangeTokenExpiry = 10 * time.Minute
+
+	daysAgo := func(days int) *time.Time {
+		t := time.Now().AddDate(0, 0, -days)
+		return &t
+	}
+
+	tests := []struct {
+		name             string
+		methodType       entity.AuthMethodType
+		securityState    *entity.UserSecurityState
+		tenantMaxAgeDays int
+		expectedReason   string
+	}{
+		{
+			name:           "forced change returns restricted token",
+			methodType:     entity.AuthMethodPassword,
+			securityState:  &entity.UserSecurityState{UserID: userID, ForcePasswordChange: true, PasswordChangedAt: daysAgo(1)},
+			expectedReason: PasswordChangeReasonForced,
+		},
+		{
+			name:             "expired under tenant policy returns restricted token",
+			methodType:       entity.AuthMethodPassword,
+			securityState:    &entity.UserSecurityState{UserID: userID, PasswordChangedAt: daysAgo(60)},
+			tenantMaxAgeDays: 30,
+			expectedReason:   PasswordChangeReasonExpired,
+		},
+		{
+			name:             "password within max age gets full access",
+			methodType:       entity.AuthMethodPassword,
+			securityState:    &entity.UserSecurityState{UserID: userID, PasswordChangedAt: daysAgo(10)},
+			tenantMaxAgeDays: 30,
+		},
+		{
+			name:          "unknown change date never expires",
+			methodType:    entity.AuthMethodPassword,
+			securityState: &entity.UserSecurityState{UserID: userID},
+		},
+		{
+			name:          "users without a password are not affected",
+			methodType:    entity.AuthMethodSSO,
+			securityState: &entity.UserSecurityState{UserID: userID, ForcePasswordChange: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loginSessionID := uuid.New()
+			now := time.Now()
+
+			store := new(MockInMemoryStore)
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			securityRepo := new(MockUserSecurityStateRepository)
+			policyRepo := new(MockPasswordPolicyRepository)
+			regRepo := new(MockUserTenantRegistrationRepository)
+			refreshTokenRepo := new(MockRefreshTokenRepository)
+			sessionRepo := new(MockUserSessionRepository)
+			profileRepo := new(MockUserProfileRepository)
+
+			store.On("GetLoginSession", mock.Anything, loginSessionID).Return(&entity.LoginSession{
+				ID:           loginSessionID,
+				UserID:       userID,
+				Email:        email,
+				Status:       entity.LoginSessionStatusPendingVerification,
+				OTPHash:      string(otpHash),
+				OTPExpiresAt: now.Add(5 * time.Minute),
+				MaxAttempts:  LoginOTPMaxAttempts,
+				ExpiresAt:    now.Add(10 * time.Minute),
+			}, nil)
+			store.On("MarkLoginVerified", mock.Anything, loginSessionID).Return(nil)
+			store.On("DeleteLoginSession", mock.Anything, loginSessionID).Return(nil)
+
+			authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserAuthMethod{
+				UserID:     userID,
+				MethodType: string(tt.methodType),
+			}, nil)
+			securityRepo.On("GetByUserID", mock.Anything, userID).Return(tt.securityState, nil)
+			policyRepo.On("Resolve", mock.Anything, (*uuid.UUID)(nil), (*uuid.UUID)(nil)).Return(nil, errors.ErrNotFound("password policy not found")).Maybe()
+			policyRepo.On("Resolve", mock.Anything, &tenantID, (*uuid.UUID)(nil)).Return(&entity.PasswordPolicy{
+				TenantID:    &tenantID,
+				MinLength:   8,
+				MaxAgeDays:  tt.tenantMaxAgeDays,
+				BreachCheck: "OFF",
+			}, nil).Maybe()
+			regRepo.On("ListActiveByUserID", mock.Anything, userID).Return([]entity.UserTenantRegistration{
+				{UserID: userID, TenantID: tenantID},
+			}, nil)
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("ListActiveByUserID", mock.Anything, userID, (*uuid.UUID)(nil)).Return([]entity.UserRole{}, nil).Maybe()
+			refreshTokenRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			sessionRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			profileRepo.On("GetByUserID", mock.Anything, userID).Return(nil, errors.ErrNotFound("user profile not found")).Maybe()
+
+			productsRepo := new(MockProductsByTenantRepository)
+			productsRepo.On("ListActiveByTenantID", mock.Anything, tenantID).Return([]entity.Product{}, nil).Maybe()
+
+			consentDocumentRepo := new(MockConsentDocumentRepository)
+			consentDocumentRepo.On("ListCurrentForUser", mock.Anything, userID).Return([]entity.ConsentDocument{}, nil).Maybe()
+
+			uc := &usecase{
+				TxManager:             NewMockTransactionManager(),
+				Config:                &config.Config{JWT: *jwtCfg},
+				InMemoryStore:         store,
+				UserAuthMethodRepo:    authMethodRepo,
+				UserSecurityStateRepo: securityRepo,
+				PasswordPolicyRepo:    policyRepo,
+				UserTenantRegRepo:     regRepo,
+				UserRoleRepo:          userRoleRepo,
+				ProductsByTenantRepo:  productsRepo,
+				RefreshTokenRepo:      refreshTokenRepo,
+				UserSessionRepo:       sessionRepo,
+				UserProfileRepo:       profileRepo,
+				ConsentDocumentRepo:   consentDocumentRepo,
+				AuditLogger:           logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.VerifyLoginOTP(context.Background(), &authdto.VerifyLoginOTPRequest{
+				LoginSessionID: loginSessionID,
+				Email:          email,
+				OTPCode:        otp,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			tokenConfig := &jwtpkg.TokenConfig{
+				SigningMethod: jwtCfg.SigningMethod,
+				AccessSecret:  jwtCfg.AccessSecret,
+				Issuer:        jwtCfg.Issuer,
+			}
+			claims, err := jwtpkg.ParseAccessToken(resp.AccessToken, tokenConfig)
+			require.NoError(t, err)
+
+			if tt.expectedReason == "" {
+				assert.False(t, resp.PasswordChangeRequired)
+				assert.NotEmpty(t, resp.RefreshToken)
+				assert.False(t, claims.IsPasswordChangeOnly())
+				refreshTokenRepo.AssertCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.True(t, resp.PasswordChangeRequired)
+			assert.Equal(t, tt.expectedReason, resp.PasswordChangeReason)
+			assert.Empty(t, resp.RefreshToken)
+			assert.Equal(t, int(jwtCfg.PasswordChangeTokenExpiry.Seconds()), resp.ExpiresIn)
+			assert.True(t, claims.IsPasswordChangeOnly())
+			assert.Equal(t, userID, claims.UserID)
+			refreshTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestVerifyLoginOTP_Consent(t *testing.T) {
+	userID := uuid.New()
+	email := "user@example.com"
+	otp := "123456"
+
+	otpHash, err := bcrypt.GenerateFromPassword([]byte(otp), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	jwtCfg := newTestJWTConfig()
+	jwtCfg.ConsentTokenExpiry = 10 * time.Minute
+
+	terms := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "2.0", Title: "Terms of Service", IsRequired: true}
+	privacy := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentPrivacy, Version: "1.0", Title: "Privacy Policy", IsRequired: true}
+	newsletter := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentPrivacy, Version: "1.0", Title: "Optional Notice"}
+
+	tests := []struct {
+		name            string
+		documents       []entity.ConsentDocument
+		consents        []entity.UserConsent
+		expectedPending []uuid.UUID
+	}{
+		{
+			name:            "new required version returns consent token",
+			documents:       []entity.ConsentDocument{terms, privacy},
+			consents:        []entity.UserConsent{{UserID: userID, DocumentID: privacy.ID}},
+			expectedPending: []uuid.UUID{terms.ID},
+		},
+		{
+			name:      "all required documents accepted gets full access",
+			documents: []entity.ConsentDocument{terms, privacy, newsletter},
+			consents: []entity.UserConsent{
+				{UserID: userID, DocumentID: terms.ID},
+				{UserID: userID, DocumentID: privacy.ID},
+			},
+		},
+		{
+			name: "no published documents gets full access",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loginSessionID := uuid.New()
+			now := time.Now()
+
+			store := new(MockInMemoryStore)
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			securityRepo := new(MockUserSecurityStateRepository)
+			regRepo := new(MockUserTenantRegistrationRepository)
+			refreshTokenRepo := new(MockRefreshTokenRepository)
+			sessionRepo := new(MockUserSessionRepository)
+			profileRepo := new(MockUserProfileRepository)
+			consentDocumentRepo := new(MockConsentDocumentRepository)
+			userConsentRepo := new(MockUserConsentRepository)
+
+			store.On("GetLoginSession", mock.Anything, loginSessionID).Return(&entity.LoginSession{
+				ID:           loginSessionID,
+				UserID:       userID,
+				Email:        email,
+				Status:       entity.LoginSessionStatusPendingVerification,
+				OTPHash:      string(otpHash),
+				OTPExpiresAt: now.Add(5 * time.Minute),
+				MaxAttempts:  LoginOTPMaxAttempts,
+				ExpiresAt:    now.Add(10 * time.Minute),
+			}, nil)
+			store.On("MarkLoginVerified", mock.Anything, loginSessionID).Return(nil)
+			store.On("DeleteLoginSession", mock.Anything, loginSessionID).Return(nil)
+
+			authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserAuthMethod{
+				UserID:     userID,
+				MethodType: string(entity.AuthMethodSSO),
+			}, nil)
+			securityRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserSecurityState{UserID: userID}, nil).Maybe()
+			regRepo.On("ListActiveByUserID", mock.Anything, userID).Return([]entity.UserTenantRegistration{}, nil).Maybe()
+			refreshTokenRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			sessionRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			profileRepo.On("GetByUserID", mock.Anything, userID).Return(nil, errors.ErrNotFound("user profile not found")).Maybe()
+			consentDocumentRepo.On("ListCurrentForUser", mock.Anything, userID).Return(tt.documents, nil)
+			userConsentRepo.On("ListByUserID", mock.Anything, userID).Return(tt.consents, nil).Maybe()
+
+			uc := &usecase{
+				TxManager:             NewMockTransactionManager(),
+				Config:                &config.Config{JWT: *jwtCfg},
+				InMemoryStore:         store,
+				UserAuthMethodRepo:    authMethodRepo,
+				UserSecurityStateRepo: securityRepo,
+				UserTenantRegRepo:     regRepo,
+				RefreshTokenRepo:      refreshTokenRepo,
+				UserSessionRepo:       sessionRepo,
+				UserProfileRepo:       profileRepo,
+				ConsentDocumentRepo:   consentDocumentRepo,
+				UserConsentRepo:       userConsentRepo,
+				AuditLogger:           logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.VerifyLoginOTP(context.Background(), &authdto.VerifyLoginOTPRequest{
+				LoginSessionID: loginSessionID,
+				Email:          email,
+				OTPCode:        otp,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			claims, err := jwtpkg.ParseAccessToken(resp.AccessToken, &jwtpkg.TokenConfig{
+				SigningMethod: jwtCfg.SigningMethod,
+				AccessSecret:  jwtCfg.AccessSecret,
+				Issuer:        jwtCfg.Issuer,
+			})
+			require.NoError(t, err)
+
+			if len(tt.expectedPending) == 0 {
+				assert.False(t, resp.ConsentRequired)
+				assert.NotEmpty(t, resp.RefreshToken)
+				assert.False(t, claims.IsRestricted())
+				return
+			}
+
+			assert.True(t, resp.ConsentRequired)
+			assert.Empty(t, resp.RefreshToken)
+			assert.Equal(t, int(jwtCfg.ConsentTokenExpiry.Seconds()), resp.ExpiresIn)
+			assert.True(t, claims.IsConsentOnly())
+			require.Len(t, resp.PendingConsents, len(tt.expectedPending))
+			for i, id := range tt.expectedPending {
+				assert.Equal(t, id, resp.PendingConsents[i].ID)
+			}
+			refreshTokenRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			sessionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestBuildMultiTenantClaims_RolesStayInTheirTenant(t *testing.T) {
+	userID := uuid.New()
+	tenantA := uuid.New()
+	tenantB := uuid.New()
+	productA := entity.Product{ID: uuid.New(), Code: "PORTAL_A"}
+	productB := entity.Product{ID: uuid.New(), Code: "PORTAL_B"}
+	roleA := &entity.Role{ID: uuid.New(), TenantID: &tenantA, Code: "ADMIN_A", ScopeLevel: entity.ScopeLevelTenant, IsActive: true}
+	roleB := &entity.Role{ID: uuid.New(), TenantID: &tenantB, Code: "ADMIN_B", ScopeLevel: entity.ScopeLevelTenant, IsActive: true}
+	platformRole := &entity.Role{ID: uuid.New(), Code: "AUDITOR", ScopeLevel: entity.ScopeLevelTenant, IsSystem: true, IsActive: true}
+
+	// Tenant-wide assignments have no product, so both tenants' products see
+	// every one of them.
+	userRoles := []entity.UserRole{
+		{UserID: userID, RoleID: roleA.ID},
+		{UserID: userID, RoleID: roleB.ID},
+		{UserID: userID, RoleID: platformRole.ID},
+	}
+	allRoleIDs := []uuid.UUID{roleA.ID, roleB.ID, platformRole.ID}
+
+	userTenantRegRepo := new(MockUserTenantRegistrationRepository)
+	userTenantRegRepo.On("ListActiveByUserID", mock.Anything, userID).Return([]entity.UserTenantRegistration{
+		{UserID: userID, TenantID: tenantA, RegistrationType: "MEMBER"},
+		{UserID: userID, TenantID: tenantB, RegistrationType: "MEMBER"},
+	}, nil)
+	productsRepo := new(MockProductsByTenantRepository)
+	productsRepo.On("ListActiveByTenantID", mock.Anything, tenantA).Return([]entity.Product{productA}, nil)
+	productsRepo.On("ListActiveByTenantID", mock.Anything, tenantB).Return([]entity.Product{productB}, nil)
+	userRoleRepo := new(MockUserRoleRepository)
+	userRoleRepo.On("ListActiveByUserID", mock.Anything, userID, mock.Anything).Return(userRoles, nil)
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByIDs", mock.Anything, allRoleIDs).Return([]*entity.Role{roleA, roleB, platformRole}, nil)
+	permRepo := new(MockPermissionRepository)
+	permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleA.ID, platformRole.ID}).Return([]string{"audit:read", "role:update"}, nil)
+	permRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleB.ID, platformRole.ID}).Return([]string{"audit:read", "user:delete"}, nil)
+
+	uc := &usecase{
+		UserTenantRegRepo:    userTenantRegRepo,
+		ProductsByTenantRepo: productsRepo,
+		UserRoleRepo:         userRoleRepo,
+		RoleRepo:             roleRepo,
+		PermissionRepo:       permRepo,
+	}
+
+	claims, tenants, err := uc.buildMultiTenantClaims(context.Background(), userID)
+
+	require.NoError(t, err)
+	require.Len(t, claims, 2)
+	require.Len(t, tenants, 2)
+
+	claimA, claimB := claims[0], claims[1]
+	assert.Equal(t, tenantA, claimA.TenantID)
+	require.Len(t, claimA.Products, 1)
+	assert.Equal(t, []string{"ADMIN_A", "AUDITOR"}, claimA.Products[0].Roles)
+	assert.Equal(t, []string{"audit:read", "role:update"}, claimA.Products[0].Permissions)
+
+	assert.Equal(t, tenantB, claimB.TenantID)
+	require.Len(t, claimB.Products, 1)
+	assert.Equal(t, []string{"ADMIN_B", "AUDITOR"}, claimB.Products[0].Roles)
+	assert.Equal(t, []string{"audit:read", "user:delete"}, claimB.Products[0].Permissions)
+	permRepo.AssertExpectations(t)
+}
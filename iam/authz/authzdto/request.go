@@ -0,0 +1,38 @@
+package authzdto
+
+import "github.com/google/uuid"
+
+// CheckRequest asks whether UserID may use Permission, optionally on one
+// resource. BranchID and OwnerID describe the resource and are needed to
+// answer for branch and self scoped grants; Attributes and Environment feed
+// the tenant's authorization policies. Environment keys the server computes,
+// such as the time of day, cannot be overridden; ip_address should carry the
+// end user's address and falls back to the caller's when omitted.
+type CheckRequest struct {
+	TenantID     *uuid.UUID     `json:"tenant_id,omitempty" validate:"omitempty"`
+	UserID       uuid.UUID      `json:"user_id" validate:"required"`
+	Permission   string         `json:"permission" validate:"required,min=3,max=100"`
+	ProductID    *uuid.UUID     `json:"product_id,omitempty" validate:"omitempty"`
+	ResourceType *string        `json:"resource_type,omitempty" validate:"omitempty,min=2,max=50"`
+	ResourceID   *uuid.UUID     `json:"resource_id,omitempty" validate:"omitempty"`
+	BranchID     *uuid.UUID     `json:"branch_id,omitempty" validate:"omitempty"`
+	OwnerID      *uuid.UUID     `json:"owner_id,omitempty" validate:"omitempty"`
+	Attributes   map[string]any `json:"attributes,omitempty"`
+	Environment  map[string]any `json:"environment,omitempty"`
+
+	CallerKeyID uuid.UUID `json:"-"`
+}
+
+// ExplainRequest lists the effective permissions of a user. With Permission
+// set, the response also explains why that permission is or is not held.
+type ExplainRequest struct {
+	UserID     uuid.UUID  `query:"user_id" validate:"required"`
+	ProductID  *uuid.UUID `query:"product_id" validate:"omitempty"`
+	Permission string     `query:"permission" validate:"omitempty,min=3,max=100"`
+}
+
+type BatchCheckRequest struct {
+	Checks []CheckRequest `json:"checks" validate:"required,min=1,max=100,dive"`
+
+	CallerKeyID uuid.UUID `json:"-"`
+}
@@ -0,0 +1,69 @@
+package authzdto
+
+import (
+	"time"
+
+	"iam-service/pkg/abac"
+
+	"github.com/google/uuid"
+)
+
+type CheckResponse struct {
+	CheckID    uuid.UUID        `json:"check_id"`
+	UserID     uuid.UUID        `json:"user_id"`
+	Permission string           `json:"permission"`
+	ResourceID *uuid.UUID       `json:"resource_id,omitempty"`
+	Allowed    bool             `json:"allowed"`
+	Reason     string           `json:"reason"`
+	Scope      string           `json:"scope,omitempty"`
+	Violations []abac.Violation `json:"violations,omitempty"`
+}
+
+type BatchCheckResponse struct {
+	Results []CheckResponse `json:"results"`
+}
+
+type ExplainResponse struct {
+	UserID      uuid.UUID              `json:"user_id"`
+	TenantID    uuid.UUID              `json:"tenant_id"`
+	ProductID   *uuid.UUID             `json:"product_id,omitempty"`
+	Member      bool                   `json:"member"`
+	Permissions []EffectivePermission  `json:"permissions"`
+	Explanation *PermissionExplanation `json:"explanation,omitempty"`
+}
+
+// EffectivePermission is a permission the user holds right now, with every
+// assignment that grants it.
+type EffectivePermission struct {
+	Code      string            `json:"code"`
+	Scope     string            `json:"scope"`
+	BranchIDs []uuid.UUID       `json:"branch_ids,omitempty"`
+	Grants    []PermissionGrant `json:"grants"`
+}
+
+// PermissionGrant is one path to a permission: the assignment, and the role
+// chain from the assigned role up to the role holding the permission.
+type PermissionGrant struct {
+	AssignmentID  uuid.UUID  `json:"assignment_id"`
+	Chain         []RoleRef  `json:"chain"`
+	ScopeLevel    string     `json:"scope_level"`
+	BranchID      *uuid.UUID `json:"branch_id,omitempty"`
+	ProductID     *uuid.UUID `json:"product_id,omitempty"`
+	Status        string     `json:"status"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty"`
+}
+
+type RoleRef struct {
+	ID       uuid.UUID `json:"id"`
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	IsActive bool      `json:"is_active"`
+}
+
+type PermissionExplanation struct {
+	Code    string            `json:"code"`
+	Granted bool              `json:"granted"`
+	Reasons []string          `json:"reasons"`
+	Grants  []PermissionGrant `json:"grants,omitempty"`
+}
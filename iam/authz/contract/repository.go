@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/pkg/abac"
+
+	"github.com/google/uuid"
+)
+
+type UserTenantRegistrationRepository interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error)
+}
+
+type UserRoleRepository interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+	ListByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+}
+
+type RoleRepository interface {
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
+	GetByIDsIncludingInactive(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
+	ListAncestors(ctx context.Context, id uuid.UUID) ([]*entity.Role, error)
+}
+
+type PermissionRepository interface {
+	GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error)
+	ListByRoleID(ctx context.Context, roleID uuid.UUID) ([]entity.Permission, error)
+}
+
+type PermissionCheckRepository interface {
+	CreateBatch(ctx context.Context, checks []*entity.PermissionCheck) error
+}
+
+type PolicyAuthorizer interface {
+	Authorize(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string, input abac.Input) (*abac.Decision, error)
+}
@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/authz/authzdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Check(ctx context.Context, tenantID uuid.UUID, req *authzdto.CheckRequest) (*authzdto.CheckResponse, error)
+	BatchCheck(ctx context.Context, tenantID uuid.UUID, req *authzdto.BatchCheckRequest) (*authzdto.BatchCheckResponse, error)
+	Explain(ctx context.Context, tenantID uuid.UUID, req *authzdto.ExplainRequest) (*authzdto.ExplainResponse, error)
+}
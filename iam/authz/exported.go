@@ -0,0 +1,29 @@
+package authz
+
+import (
+	"iam-service/config"
+	"iam-service/iam/authz/contract"
+	"iam-service/iam/authz/internal"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	cfg *config.Config,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	userRoleRepo contract.UserRoleRepository,
+	roleRepo contract.RoleRepository,
+	permissionRepo contract.PermissionRepository,
+	permissionCheckRepo contract.PermissionCheckRepository,
+	policyAuthorizer contract.PolicyAuthorizer,
+) Usecase {
+	return internal.NewUsecase(
+		cfg,
+		userTenantRegRepo,
+		userRoleRepo,
+		roleRepo,
+		permissionRepo,
+		permissionCheckRepo,
+		policyAuthorizer,
+	)
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/authz/contract"
+)
+
+type usecase struct {
+	Config              *config.Config
+	UserTenantRegRepo   contract.UserTenantRegistrationRepository
+	UserRoleRepo        contract.UserRoleRepository
+	RoleRepo            contract.RoleRepository
+	PermissionRepo      contract.PermissionRepository
+	PermissionCheckRepo contract.PermissionCheckRepository
+	PolicyAuthorizer    contract.PolicyAuthorizer
+}
+
+func NewUsecase(
+	cfg *config.Config,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	userRoleRepo contract.UserRoleRepository,
+	roleRepo contract.RoleRepository,
+	permissionRepo contract.PermissionRepository,
+	permissionCheckRepo contract.PermissionCheckRepository,
+	policyAuthorizer contract.PolicyAuthorizer,
+) *usecase {
+	return &usecase{
+		Config:              cfg,
+		UserTenantRegRepo:   userTenantRegRepo,
+		UserRoleRepo:        userRoleRepo,
+		RoleRepo:            roleRepo,
+		PermissionRepo:      permissionRepo,
+		PermissionCheckRepo: permissionCheckRepo,
+		PolicyAuthorizer:    policyAuthorizer,
+	}
+}
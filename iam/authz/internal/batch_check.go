@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) BatchCheck(ctx context.Context, tenantID uuid.UUID, req *authzdto.BatchCheckRequest) (*authzdto.BatchCheckResponse, error) {
+	cache := make(map[grantKey]*grants)
+	results := make([]authzdto.CheckResponse, len(req.Checks))
+	records := make([]*entity.PermissionCheck, len(req.Checks))
+
+	for i := range req.Checks {
+		check := req.Checks[i]
+		check.CallerKeyID = req.CallerKeyID
+
+		resp, record, err := uc.evaluate(ctx, tenantID, &check, cache)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = *resp
+		records[i] = record
+	}
+
+	if err := uc.PermissionCheckRepo.CreateBatch(ctx, records); err != nil {
+		return nil, errors.ErrInternal("failed to record permission checks").WithError(err)
+	}
+
+	for i, record := range records {
+		results[i].CheckID = record.ID
+	}
+	return &authzdto.BatchCheckResponse{Results: results}, nil
+}
@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Check(ctx context.Context, tenantID uuid.UUID, req *authzdto.CheckRequest) (*authzdto.CheckResponse, error) {
+	resp, record, err := uc.evaluate(ctx, tenantID, req, make(map[grantKey]*grants))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.PermissionCheckRepo.CreateBatch(ctx, []*entity.PermissionCheck{record}); err != nil {
+		return nil, errors.ErrInternal("failed to record permission check").WithError(err)
+	}
+
+	resp.CheckID = record.ID
+	return resp, nil
+}
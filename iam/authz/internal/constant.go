@@ -0,0 +1,17 @@
+package internal
+
+const (
+	ReasonGranted        = "granted"
+	ReasonNotMember      = "not_tenant_member"
+	ReasonNotGranted     = "permission_not_granted"
+	ReasonBranchRequired = "branch_required"
+	ReasonOutsideBranch  = "outside_branch_scope"
+	ReasonNotOwner       = "not_resource_owner"
+	ReasonDeniedByPolicy = "denied_by_policy"
+)
+
+const (
+	AssignmentStatusActive    = "ACTIVE"
+	AssignmentStatusScheduled = "SCHEDULED"
+	AssignmentStatusExpired   = "EXPIRED"
+)
@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// grantPath is one way an assignment leads to a permission. A path with a
+// blocker does not grant anything; the blocker says why.
+type grantPath struct {
+	code    string
+	role    *entity.Role
+	grant   authzdto.PermissionGrant
+	blocker string
+}
+
+func (uc *usecase) Explain(ctx context.Context, tenantID uuid.UUID, req *authzdto.ExplainRequest) (*authzdto.ExplainResponse, error) {
+	member, err := uc.isMember(ctx, tenantID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := uc.collectGrantPaths(ctx, tenantID, req.UserID, req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &authzdto.ExplainResponse{
+		UserID:      req.UserID,
+		TenantID:    tenantID,
+		ProductID:   req.ProductID,
+		Member:      member,
+		Permissions: []authzdto.EffectivePermission{},
+	}
+
+	scopes := make(map[string]*entity.AccessScope)
+	effective := make(map[string]*authzdto.EffectivePermission)
+	if member {
+		for _, path := range paths {
+			if path.blocker != "" {
+				continue
+			}
+			scopes[path.code] = entity.WidenScope(scopes[path.code], req.UserID, path.role.ScopeLevel, path.grant.BranchID)
+
+			permission, ok := effective[path.code]
+			if !ok {
+				permission = &authzdto.EffectivePermission{Code: path.code}
+				effective[path.code] = permission
+			}
+			permission.Grants = append(permission.Grants, path.grant)
+		}
+	}
+
+	for code, permission := range effective {
+		permission.Scope = string(scopes[code].Level)
+		permission.BranchIDs = scopes[code].BranchIDs
+		resp.Permissions = append(resp.Permissions, *permission)
+	}
+	sort.Slice(resp.Permissions, func(i, j int) bool {
+		return resp.Permissions[i].Code < resp.Permissions[j].Code
+	})
+
+	if req.Permission != "" {
+		resp.Explanation = explainPermission(req.Permission, member, scopes[req.Permission], paths)
+	}
+	return resp, nil
+}
+
+// collectGrantPaths expands every assignment of the user into the permissions
+// its role chain holds, marking paths that currently grant nothing.
+func (uc *usecase) collectGrantPaths(ctx context.Context, tenantID, userID uuid.UUID, productID *uuid.UUID) ([]grantPath, error) {
+	assignments, err := uc.UserRoleRepo.ListByUserID(ctx, userID, productID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get user roles").WithError(err)
+	}
+	if len(assignments) == 0 {
+		return nil, nil
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(assignments))
+	for _, assignment := range assignments {
+		if !slices.Contains(roleIDs, assignment.RoleID) {
+			roleIDs = append(roleIDs, assignment.RoleID)
+		}
+	}
+	// Inactive roles are loaded too so their assignments can be reported as
+	// blocked rather than silently left out.
+	roles, err := uc.RoleRepo.GetByIDsIncludingInactive(ctx, roleIDs)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get roles").WithError(err)
+	}
+	roleByID := make(map[uuid.UUID]*entity.Role, len(roles))
+	for _, role := range roles {
+		roleByID[role.ID] = role
+	}
+
+	now := time.Now()
+	chains := make(map[uuid.UUID][]*entity.Role)
+	codes := make(map[uuid.UUID][]string)
+
+	var paths []grantPath
+	for _, assignment := range assignments {
+		role, ok := roleByID[assignment.RoleID]
+		if !ok || !role.AppliesToTenant(tenantID) {
+			continue
+		}
+
+		chain, ok := chains[role.ID]
+		if !ok {
+			ancestors, err := uc.RoleRepo.ListAncestors(ctx, role.ID)
+			if err != nil {
+				return nil, errors.ErrInternal("failed to get parent roles").WithError(err)
+			}
+			chain = append([]*entity.Role{role}, ancestors...)
+			chains[role.ID] = chain
+		}
+
+		status := assignmentStatus(&assignment, now)
+		blocker := assignmentBlocker(&assignment, role, status)
+		for i, holder := range chain {
+			if blocker == "" && i > 0 && !holder.IsActive {
+				blocker = fmt.Sprintf("Role %s inherits through inactive parent role %s", role.Code, holder.Code)
+			}
+
+			held, ok := codes[holder.ID]
+			if !ok {
+				permissions, err := uc.PermissionRepo.ListByRoleID(ctx, holder.ID)
+				if err != nil {
+					return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+				}
+				held = make([]string, len(permissions))
+				for j, permission := range permissions {
+					held[j] = permission.Code
+				}
+				codes[holder.ID] = held
+			}
+
+			for _, code := range held {
+				paths = append(paths, grantPath{
+					code:    code,
+					role:    role,
+					grant:   mapGrant(&assignment, role, chain[:i+1], status),
+					blocker: blocker,
+				})
+			}
+		}
+	}
+	return paths, nil
+}
+
+func assignmentStatus(assignment *entity.UserRole, now time.Time) string {
+	if now.Before(assignment.EffectiveFrom) {
+		return AssignmentStatusScheduled
+	}
+	if assignment.EffectiveTo != nil && !now.Before(*assignment.EffectiveTo) {
+		return AssignmentStatusExpired
+	}
+	return AssignmentStatusActive
+}
+
+func assignmentBlocker(assignment *entity.UserRole, role *entity.Role, status string) string {
+	switch {
+	case status == AssignmentStatusScheduled:
+		return fmt.Sprintf("Assignment of role %s starts at %s", role.Code, assignment.EffectiveFrom.Format(time.RFC3339))
+	case status == AssignmentStatusExpired:
+		return fmt.Sprintf("Assignment of role %s expired at %s", role.Code, assignment.EffectiveTo.Format(time.RFC3339))
+	case !role.IsActive:
+		return fmt.Sprintf("Role %s is inactive", role.Code)
+	}
+	return ""
+}
+
+func explainPermission(code string, member bool, scope *entity.AccessScope, paths []grantPath) *authzdto.PermissionExplanation {
+	explanation := &authzdto.PermissionExplanation{
+		Code:    code,
+		Granted: member && scope != nil,
+		Reasons: []string{},
+	}
+	for _, path := range paths {
+		if path.code != code {
+			continue
+		}
+		explanation.Grants = append(explanation.Grants, path.grant)
+		if path.blocker != "" && !slices.Contains(explanation.Reasons, path.blocker) {
+			explanation.Reasons = append(explanation.Reasons, path.blocker)
+		}
+	}
+
+	if !member {
+		explanation.Reasons = append([]string{"User is not an active member of this tenant"}, explanation.Reasons...)
+		return explanation
+	}
+	if len(explanation.Grants) == 0 {
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("No role assigned to the user grants %s", code))
+		return explanation
+	}
+	if scope == nil {
+		return explanation
+	}
+
+	// The permission is held; describe how far it reaches instead.
+	explanation.Reasons = explanation.Reasons[:0]
+	switch scope.Level {
+	case entity.ScopeLevelBranch:
+		if len(scope.BranchIDs) == 0 {
+			explanation.Reasons = append(explanation.Reasons, "Granted with branch scope, but no assignment names a branch")
+			break
+		}
+		branches := make([]string, len(scope.BranchIDs))
+		for i, id := range scope.BranchIDs {
+			branches[i] = id.String()
+		}
+		explanation.Reasons = append(explanation.Reasons, fmt.Sprintf("Granted only in branches %s", strings.Join(branches, ", ")))
+	case entity.ScopeLevelSelf:
+		explanation.Reasons = append(explanation.Reasons, "Granted only for resources the user owns")
+	default:
+		explanation.Reasons = append(explanation.Reasons, "Granted tenant wide")
+	}
+	return explanation
+}
+
+func mapGrant(assignment *entity.UserRole, role *entity.Role, chain []*entity.Role, status string) authzdto.PermissionGrant {
+	refs := make([]authzdto.RoleRef, len(chain))
+	for i, r := range chain {
+		refs[i] = authzdto.RoleRef{ID: r.ID, Code: r.Code, Name: r.Name, IsActive: r.IsActive}
+	}
+	return authzdto.PermissionGrant{
+		AssignmentID:  assignment.ID,
+		Chain:         refs,
+		ScopeLevel:    string(role.ScopeLevel),
+		BranchID:      assignment.BranchID,
+		ProductID:     assignment.ProductID,
+		Status:        status,
+		EffectiveFrom: assignment.EffectiveFrom,
+		EffectiveTo:   assignment.EffectiveTo,
+	}
+}
@@ -0,0 +1,231 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRegistrationRepository struct {
+	registrations []entity.UserTenantRegistration
+}
+
+func (r *stubRegistrationRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error) {
+	return r.registrations, nil
+}
+
+type stubUserRoleRepository struct {
+	assignments []entity.UserRole
+}
+
+func (r *stubUserRoleRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error) {
+	var active []entity.UserRole
+	for _, assignment := range r.assignments {
+		if assignment.IsActive() {
+			active = append(active, assignment)
+		}
+	}
+	return active, nil
+}
+
+func (r *stubUserRoleRepository) ListByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error) {
+	return r.assignments, nil
+}
+
+type stubRoleRepository struct {
+	roles map[uuid.UUID]*entity.Role
+}
+
+// GetByIDs skips inactive roles like the postgres repository does.
+func (r *stubRoleRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	for _, id := range ids {
+		if role, ok := r.roles[id]; ok && role.IsActive {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (r *stubRoleRepository) GetByIDsIncludingInactive(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	for _, id := range ids {
+		if role, ok := r.roles[id]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (r *stubRoleRepository) ListAncestors(ctx context.Context, id uuid.UUID) ([]*entity.Role, error) {
+	var ancestors []*entity.Role
+	for role := r.roles[id]; role.ParentRoleID != nil; {
+		role = r.roles[*role.ParentRoleID]
+		ancestors = append(ancestors, role)
+	}
+	return ancestors, nil
+}
+
+type stubPermissionRepository struct {
+	codes map[uuid.UUID][]string
+}
+
+func (r *stubPermissionRepository) GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error) {
+	var codes []string
+	for _, id := range roleIDs {
+		codes = append(codes, r.codes[id]...)
+	}
+	return codes, nil
+}
+
+func (r *stubPermissionRepository) ListByRoleID(ctx context.Context, roleID uuid.UUID) ([]entity.Permission, error) {
+	var permissions []entity.Permission
+	for _, code := range r.codes[roleID] {
+		permissions = append(permissions, entity.Permission{Code: code})
+	}
+	return permissions, nil
+}
+
+func TestExplain(t *testing.T) {
+	tenantID := uuid.New()
+	userID := uuid.New()
+	branchID := uuid.New()
+	now := time.Now()
+	tomorrow := now.Add(24 * time.Hour)
+	yesterday := now.Add(-24 * time.Hour)
+
+	base := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "VIEWER", ScopeLevel: entity.ScopeLevelTenant, IsActive: true}
+	retired := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "RETIRED", ScopeLevel: entity.ScopeLevelTenant, IsActive: false}
+	operator := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "OPERATOR", ScopeLevel: entity.ScopeLevelTenant, ParentRoleID: &base.ID, IsActive: true}
+	approver := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "APPROVER", ScopeLevel: entity.ScopeLevelBranch, IsActive: true}
+	auditor := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "AUDITOR", ScopeLevel: entity.ScopeLevelTenant, ParentRoleID: &retired.ID, IsActive: true}
+	manager := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "MANAGER", ScopeLevel: entity.ScopeLevelTenant, IsActive: true}
+	suspended := &entity.Role{ID: uuid.New(), TenantID: &tenantID, Code: "SUSPENDED", ScopeLevel: entity.ScopeLevelTenant, IsActive: false}
+
+	roles := map[uuid.UUID]*entity.Role{}
+	for _, role := range []*entity.Role{base, retired, operator, approver, auditor, manager, suspended} {
+		roles[role.ID] = role
+	}
+
+	newUsecase := func(member bool) *usecase {
+		registrations := &stubRegistrationRepository{}
+		if member {
+			registrations.registrations = []entity.UserTenantRegistration{{UserID: userID, TenantID: tenantID}}
+		}
+		return &usecase{
+			UserTenantRegRepo: registrations,
+			UserRoleRepo: &stubUserRoleRepository{assignments: []entity.UserRole{
+				{ID: uuid.New(), UserID: userID, RoleID: operator.ID, EffectiveFrom: yesterday},
+				{ID: uuid.New(), UserID: userID, RoleID: approver.ID, BranchID: &branchID, EffectiveFrom: yesterday},
+				{ID: uuid.New(), UserID: userID, RoleID: auditor.ID, EffectiveFrom: yesterday},
+				{ID: uuid.New(), UserID: userID, RoleID: manager.ID, EffectiveFrom: tomorrow},
+				{ID: uuid.New(), UserID: userID, RoleID: suspended.ID, EffectiveFrom: yesterday},
+			}},
+			RoleRepo: &stubRoleRepository{roles: roles},
+			PermissionRepo: &stubPermissionRepository{codes: map[uuid.UUID][]string{
+				base.ID:      {"participant:read"},
+				retired.ID:   {"report:export"},
+				operator.ID:  {"participant:update"},
+				approver.ID:  {"participant:approve"},
+				manager.ID:   {"participant:delete"},
+				suspended.ID: {"report:view"},
+			}},
+		}
+	}
+
+	t.Run("lists effective permissions with their chain", func(t *testing.T) {
+		resp, err := newUsecase(true).Explain(context.Background(), tenantID, &authzdto.ExplainRequest{UserID: userID})
+		require.NoError(t, err)
+
+		codes := make([]string, len(resp.Permissions))
+		for i, permission := range resp.Permissions {
+			codes[i] = permission.Code
+		}
+		assert.Equal(t, []string{"participant:approve", "participant:read", "participant:update"}, codes)
+
+		read := resp.Permissions[1]
+		assert.Equal(t, string(entity.ScopeLevelTenant), read.Scope)
+		require.Len(t, read.Grants, 1)
+		require.Len(t, read.Grants[0].Chain, 2)
+		assert.Equal(t, "OPERATOR", read.Grants[0].Chain[0].Code)
+		assert.Equal(t, "VIEWER", read.Grants[0].Chain[1].Code)
+
+		approve := resp.Permissions[0]
+		assert.Equal(t, string(entity.ScopeLevelBranch), approve.Scope)
+		assert.Equal(t, []uuid.UUID{branchID}, approve.BranchIDs)
+	})
+
+	tests := []struct {
+		name        string
+		member      bool
+		permission  string
+		wantGranted bool
+		wantReason  string
+	}{
+		{
+			name:        "granted tenant wide",
+			member:      true,
+			permission:  "participant:read",
+			wantGranted: true,
+			wantReason:  "Granted tenant wide",
+		},
+		{
+			name:        "granted in branch",
+			member:      true,
+			permission:  "participant:approve",
+			wantGranted: true,
+			wantReason:  "Granted only in branches " + branchID.String(),
+		},
+		{
+			name:       "scheduled assignment",
+			member:     true,
+			permission: "participant:delete",
+			wantReason: "Assignment of role MANAGER starts at",
+		},
+		{
+			name:       "inactive parent role",
+			member:     true,
+			permission: "report:export",
+			wantReason: "Role AUDITOR inherits through inactive parent role RETIRED",
+		},
+		{
+			name:       "directly assigned inactive role",
+			member:     true,
+			permission: "report:view",
+			wantReason: "Role SUSPENDED is inactive",
+		},
+		{
+			name:       "no role grants it",
+			member:     true,
+			permission: "tenant:manage",
+			wantReason: "No role assigned to the user grants tenant:manage",
+		},
+		{
+			name:       "not a member",
+			member:     false,
+			permission: "participant:read",
+			wantReason: "User is not an active member of this tenant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := newUsecase(tt.member).Explain(context.Background(), tenantID, &authzdto.ExplainRequest{
+				UserID:     userID,
+				Permission: tt.permission,
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp.Explanation)
+			assert.Equal(t, tt.wantGranted, resp.Explanation.Granted)
+			require.NotEmpty(t, resp.Explanation.Reasons)
+			assert.Contains(t, resp.Explanation.Reasons[0], tt.wantReason)
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+	"iam-service/pkg/abac"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// grants is what a user currently holds in a tenant, resolved from role
+// assignments in the database rather than from token claims.
+type grants struct {
+	member bool
+	roles  []string
+	scopes map[string]*entity.AccessScope
+}
+
+type grantKey struct {
+	userID    uuid.UUID
+	productID uuid.UUID
+}
+
+func (uc *usecase) isMember(ctx context.Context, tenantID, userID uuid.UUID) (bool, error) {
+	registrations, err := uc.UserTenantRegRepo.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return false, errors.ErrInternal("failed to get tenant registrations").WithError(err)
+	}
+	for _, registration := range registrations {
+		if registration.TenantID == tenantID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (uc *usecase) loadGrants(ctx context.Context, tenantID, userID uuid.UUID, productID *uuid.UUID) (*grants, error) {
+	member, err := uc.isMember(ctx, tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+	result := &grants{member: member, scopes: make(map[string]*entity.AccessScope)}
+	if !result.member {
+		return result, nil
+	}
+
+	userRoles, err := uc.UserRoleRepo.ListActiveByUserID(ctx, userID, productID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get user roles").WithError(err)
+	}
+	if len(userRoles) == 0 {
+		return result, nil
+	}
+
+	roleIDs := make([]uuid.UUID, len(userRoles))
+	for i, userRole := range userRoles {
+		roleIDs[i] = userRole.RoleID
+	}
+	roles, err := uc.RoleRepo.GetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get roles").WithError(err)
+	}
+
+	applicable := make(map[uuid.UUID]*entity.Role, len(roles))
+	for _, role := range roles {
+		if !role.IsActive || !role.AppliesToTenant(tenantID) {
+			continue
+		}
+		applicable[role.ID] = role
+		result.roles = append(result.roles, role.Code)
+	}
+
+	var wideRoleIDs []uuid.UUID
+	for _, userRole := range userRoles {
+		if role, ok := applicable[userRole.RoleID]; ok && !role.HasRestrictedScope() {
+			wideRoleIDs = append(wideRoleIDs, role.ID)
+		}
+	}
+	if len(wideRoleIDs) > 0 {
+		codes, err := uc.PermissionRepo.GetCodesByRoleIDs(ctx, wideRoleIDs)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+		}
+		for _, code := range codes {
+			result.scopes[code] = entity.WidenScope(result.scopes[code], userID, entity.ScopeLevelTenant, nil)
+		}
+	}
+
+	for _, userRole := range userRoles {
+		role, ok := applicable[userRole.RoleID]
+		if !ok || !role.HasRestrictedScope() {
+			continue
+		}
+		codes, err := uc.PermissionRepo.GetCodesByRoleIDs(ctx, []uuid.UUID{role.ID})
+		if err != nil {
+			return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+		}
+		for _, code := range codes {
+			result.scopes[code] = entity.WidenScope(result.scopes[code], userID, role.ScopeLevel, userRole.BranchID)
+		}
+	}
+
+	return result, nil
+}
+
+// decide answers a check from the user's grants alone.
+func decide(g *grants, req *authzdto.CheckRequest) (bool, string) {
+	if !g.member {
+		return false, ReasonNotMember
+	}
+	scope, ok := g.scopes[req.Permission]
+	if !ok {
+		return false, ReasonNotGranted
+	}
+
+	switch scope.Level {
+	case entity.ScopeLevelBranch:
+		if req.BranchID == nil {
+			return false, ReasonBranchRequired
+		}
+		if !scope.AllowsBranch(req.BranchID) {
+			return false, ReasonOutsideBranch
+		}
+	case entity.ScopeLevelSelf:
+		if req.OwnerID == nil || *req.OwnerID != req.UserID {
+			return false, ReasonNotOwner
+		}
+	}
+	return true, ReasonGranted
+}
+
+// evaluate answers one check and builds the record logged for it. Grants are
+// cached per user and product so a batch resolves each user once.
+func (uc *usecase) evaluate(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	req *authzdto.CheckRequest,
+	cache map[grantKey]*grants,
+) (*authzdto.CheckResponse, *entity.PermissionCheck, error) {
+	if req.TenantID != nil && *req.TenantID != tenantID {
+		return nil, nil, errors.ErrForbidden("API key is not valid for the requested tenant")
+	}
+
+	key := grantKey{userID: req.UserID}
+	if req.ProductID != nil {
+		key.productID = *req.ProductID
+	}
+	g, ok := cache[key]
+	if !ok {
+		var err error
+		if g, err = uc.loadGrants(ctx, tenantID, req.UserID, req.ProductID); err != nil {
+			return nil, nil, err
+		}
+		cache[key] = g
+	}
+
+	allowed, reason := decide(g, req)
+	scope := g.scopes[req.Permission]
+
+	var violations []abac.Violation
+	if allowed {
+		decision, err := uc.authorizePolicies(ctx, tenantID, req, g, scope)
+		if err != nil {
+			return nil, nil, err
+		}
+		violations = decision.Violations
+		if !decision.Allowed {
+			allowed, reason = false, ReasonDeniedByPolicy
+		}
+	}
+
+	resp := &authzdto.CheckResponse{
+		UserID:     req.UserID,
+		Permission: req.Permission,
+		ResourceID: req.ResourceID,
+		Allowed:    allowed,
+		Reason:     reason,
+		Violations: violations,
+	}
+	metadata := map[string]any{
+		"reason":     reason,
+		"roles":      g.roles,
+		"api_key_id": req.CallerKeyID,
+	}
+	if scope != nil {
+		resp.Scope = string(scope.Level)
+		metadata["scope"] = string(scope.Level)
+		if len(scope.BranchIDs) > 0 {
+			metadata["branch_ids"] = scope.BranchIDs
+		}
+	}
+	if req.ProductID != nil {
+		metadata["product_id"] = *req.ProductID
+	}
+	if len(violations) > 0 {
+		metadata["violations"] = violations
+	}
+
+	record := &entity.PermissionCheck{
+		TenantID:       &tenantID,
+		UserID:         &req.UserID,
+		PermissionCode: req.Permission,
+		ResourceID:     req.ResourceID,
+		ResourceType:   req.ResourceType,
+		BranchID:       req.BranchID,
+		Result:         allowed,
+		CreatedAt:      time.Now(),
+	}
+	record.Metadata, _ = json.Marshal(metadata)
+
+	return resp, record, nil
+}
+
+// authorizePolicies evaluates the tenant policies guarding the permission,
+// and those bound to the resource type when the check names one.
+func (uc *usecase) authorizePolicies(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	req *authzdto.CheckRequest,
+	g *grants,
+	scope *entity.AccessScope,
+) (*abac.Decision, error) {
+	input := abac.Input{
+		Subject: abac.Attributes{
+			"id":    req.UserID,
+			"roles": g.roles,
+			"scope": string(scope.Level),
+		},
+		Resource:    abac.Attributes{},
+		Environment: abac.Attributes(req.Environment),
+	}
+	if len(scope.BranchIDs) > 0 {
+		input.Subject["branch_ids"] = scope.BranchIDs
+	}
+	for key, value := range req.Attributes {
+		input.Resource[key] = value
+	}
+	if req.ResourceID != nil {
+		input.Resource["id"] = *req.ResourceID
+	}
+	if req.BranchID != nil {
+		input.Resource["branch_id"] = *req.BranchID
+	}
+	if req.OwnerID != nil {
+		input.Resource["owner_id"] = *req.OwnerID
+	}
+
+	decision, err := uc.PolicyAuthorizer.Authorize(ctx, tenantID, req.Permission, "", input)
+	if err != nil {
+		return nil, err
+	}
+	if req.ResourceType == nil {
+		return decision, nil
+	}
+
+	bound, err := uc.PolicyAuthorizer.Authorize(ctx, tenantID, req.Permission, *req.ResourceType, input)
+	if err != nil {
+		return nil, err
+	}
+	return &abac.Decision{
+		Allowed:    decision.Allowed && bound.Allowed,
+		Violations: append(decision.Violations, bound.Violations...),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/authz/authzdto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecide(t *testing.T) {
+	userID := uuid.New()
+	branchID := uuid.New()
+	otherBranchID := uuid.New()
+	otherUserID := uuid.New()
+
+	g := &grants{
+		member: true,
+		scopes: map[string]*entity.AccessScope{
+			"participant:read":    {Level: entity.ScopeLevelTenant, UserID: userID},
+			"participant:approve": {Level: entity.ScopeLevelBranch, UserID: userID, BranchIDs: []uuid.UUID{branchID}},
+			"participant:update":  {Level: entity.ScopeLevelSelf, UserID: userID},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		grants      *grants
+		req         authzdto.CheckRequest
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:       "not a member",
+			grants:     &grants{},
+			req:        authzdto.CheckRequest{UserID: userID, Permission: "participant:read"},
+			wantReason: ReasonNotMember,
+		},
+		{
+			name:       "permission not granted",
+			grants:     g,
+			req:        authzdto.CheckRequest{UserID: userID, Permission: "participant:delete"},
+			wantReason: ReasonNotGranted,
+		},
+		{
+			name:        "tenant wide grant",
+			grants:      g,
+			req:         authzdto.CheckRequest{UserID: userID, Permission: "participant:read"},
+			wantAllowed: true,
+			wantReason:  ReasonGranted,
+		},
+		{
+			name:       "branch grant without branch",
+			grants:     g,
+			req:        authzdto.CheckRequest{UserID: userID, Permission: "participant:approve"},
+			wantReason: ReasonBranchRequired,
+		},
+		{
+			name:       "branch grant on other branch",
+			grants:     g,
+			req:        authzdto.CheckRequest{UserID: userID, Permission: "participant:approve", BranchID: &otherBranchID},
+			wantReason: ReasonOutsideBranch,
+		},
+		{
+			name:        "branch grant on own branch",
+			grants:      g,
+			req:         authzdto.CheckRequest{UserID: userID, Permission: "participant:approve", BranchID: &branchID},
+			wantAllowed: true,
+			wantReason:  ReasonGranted,
+		},
+		{
+			name:       "self grant on resource owned by someone else",
+			grants:     g,
+			req:        authzdto.CheckRequest{UserID: userID, Permission: "participant:update", OwnerID: &otherUserID},
+			wantReason: ReasonNotOwner,
+		},
+		{
+			name:        "self grant on own resource",
+			grants:      g,
+			req:         authzdto.CheckRequest{UserID: userID, Permission: "participant:update", OwnerID: &userID},
+			wantAllowed: true,
+			wantReason:  ReasonGranted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, reason := decide(tt.grants, &tt.req)
+			assert.Equal(t, tt.wantAllowed, allowed)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package consentdto
+
+import "github.com/google/uuid"
+
+type CreateDocumentRequest struct {
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty" validate:"omitempty,excluded_without=TenantID"`
+	DocumentType string     `json:"document_type" validate:"required,oneof=TERMS_OF_SERVICE PRIVACY_POLICY"`
+	Version      string     `json:"version" validate:"required,min=1,max=50"`
+	Title        string     `json:"title" validate:"required,min=1,max=255"`
+	ContentURL   string     `json:"content_url" validate:"required,url,max=1000"`
+	Summary      *string    `json:"summary,omitempty" validate:"omitempty,max=2000"`
+	IsRequired   *bool      `json:"is_required,omitempty"`
+}
+
+type UpdateDocumentRequest struct {
+	Version    *string `json:"version,omitempty" validate:"omitempty,min=1,max=50"`
+	Title      *string `json:"title,omitempty" validate:"omitempty,min=1,max=255"`
+	ContentURL *string `json:"content_url,omitempty" validate:"omitempty,url,max=1000"`
+	Summary    *string `json:"summary,omitempty" validate:"omitempty,max=2000"`
+	IsRequired *bool   `json:"is_required,omitempty"`
+}
+
+type ListDocumentsRequest struct {
+	TenantID     *uuid.UUID `query:"tenant_id" validate:"omitempty"`
+	ProductID    *uuid.UUID `query:"product_id" validate:"omitempty"`
+	PlatformOnly bool       `query:"platform_only"`
+	DocumentType string     `query:"document_type" validate:"omitempty,oneof=TERMS_OF_SERVICE PRIVACY_POLICY"`
+	Status       string     `query:"status" validate:"omitempty,oneof=DRAFT PUBLISHED SUPERSEDED"`
+	Page         int        `query:"page" validate:"omitempty,min=1"`
+	PerPage      int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListDocumentsRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type CurrentDocumentsRequest struct {
+	TenantID  *uuid.UUID `query:"tenant_id" validate:"required_with=ProductID"`
+	ProductID *uuid.UUID `query:"product_id" validate:"omitempty"`
+}
+
+type AcceptRequest struct {
+	UserID      uuid.UUID   `json:"-"`
+	IPAddress   string      `json:"-"`
+	UserAgent   string      `json:"-"`
+	DocumentIDs []uuid.UUID `json:"document_ids" validate:"required,min=1,max=20"`
+}
+
+type ReportRequest struct {
+	TenantID *uuid.UUID `query:"tenant_id" validate:"omitempty"`
+}
+
+type AudienceListRequest struct {
+	TenantID *uuid.UUID `query:"tenant_id" validate:"omitempty"`
+	Status   string     `query:"status" validate:"omitempty,oneof=ACCEPTED PENDING"`
+	Page     int        `query:"page" validate:"omitempty,min=1"`
+	PerPage  int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *AudienceListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
@@ -0,0 +1,73 @@
+package consentdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DocumentResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty"`
+	DocumentType string     `json:"document_type"`
+	Version      string     `json:"version"`
+	Title        string     `json:"title"`
+	ContentURL   string     `json:"content_url"`
+	Summary      *string    `json:"summary,omitempty"`
+	IsRequired   bool       `json:"is_required"`
+	Status       string     `json:"status"`
+	PublishedAt  *time.Time `json:"published_at,omitempty"`
+	PublishedBy  *uuid.UUID `json:"published_by,omitempty"`
+	CreatedBy    uuid.UUID  `json:"created_by"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ListDocumentsResponse struct {
+	Documents  []DocumentResponse `json:"documents"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+type UserDocumentStatus struct {
+	Document   DocumentResponse `json:"document"`
+	Accepted   bool             `json:"accepted"`
+	AcceptedAt *time.Time       `json:"accepted_at,omitempty"`
+}
+
+type MyConsentsResponse struct {
+	Documents       []UserDocumentStatus `json:"documents"`
+	PendingRequired bool                 `json:"pending_required"`
+}
+
+type DocumentReport struct {
+	Document      DocumentResponse `json:"document"`
+	TotalUsers    int64            `json:"total_users"`
+	AcceptedUsers int64            `json:"accepted_users"`
+	PendingUsers  int64            `json:"pending_users"`
+}
+
+type ReportResponse struct {
+	TenantID  *uuid.UUID       `json:"tenant_id,omitempty"`
+	Documents []DocumentReport `json:"documents"`
+}
+
+type AudienceMember struct {
+	UserID     uuid.UUID  `json:"user_id"`
+	Email      string     `json:"email"`
+	Accepted   bool       `json:"accepted"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+}
+
+type AudienceListResponse struct {
+	Document   DocumentResponse `json:"document"`
+	Members    []AudienceMember `json:"members"`
+	Pagination Pagination       `json:"pagination"`
+}
@@ -0,0 +1,62 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type ConsentDocumentListFilter struct {
+	TenantID     *uuid.UUID
+	ProductID    *uuid.UUID
+	PlatformOnly bool
+	DocumentType *entity.ConsentDocumentType
+	Status       *entity.ConsentDocumentStatus
+	Page         int
+	PerPage      int
+}
+
+type ConsentDocumentRepository interface {
+	Create(ctx context.Context, document *entity.ConsentDocument) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.ConsentDocument, error)
+	List(ctx context.Context, filter *ConsentDocumentListFilter) ([]*entity.ConsentDocument, int64, error)
+	Update(ctx context.Context, document *entity.ConsentDocument) error
+	SupersedePublished(ctx context.Context, tenantID, productID *uuid.UUID, documentType entity.ConsentDocumentType) error
+	ListCurrentForRegistration(ctx context.Context, tenantID, productID *uuid.UUID) ([]entity.ConsentDocument, error)
+	ListCurrentForUser(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error)
+	ListCurrentForTenant(ctx context.Context, tenantID *uuid.UUID) ([]entity.ConsentDocument, error)
+}
+
+// ConsentAudienceFilter selects the users a document applies to. TenantID
+// narrows a platform-wide document down to one tenant's members.
+type ConsentAudienceFilter struct {
+	Document *entity.ConsentDocument
+	TenantID *uuid.UUID
+	Accepted *bool
+	Page     int
+	PerPage  int
+}
+
+type ConsentAudienceMember struct {
+	UserID     uuid.UUID
+	Email      string
+	AcceptedAt *time.Time
+}
+
+type UserConsentRepository interface {
+	Create(ctx context.Context, consent *entity.UserConsent) error
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserConsent, error)
+	CountAudience(ctx context.Context, filter *ConsentAudienceFilter) (total int64, accepted int64, err error)
+	ListAudience(ctx context.Context, filter *ConsentAudienceFilter) ([]ConsentAudienceMember, int64, error)
+}
+
+type TenantRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error)
+}
+
+type ProductRepository interface {
+	GetByIDAndTenant(ctx context.Context, productID, tenantID uuid.UUID) (*entity.Product, error)
+}
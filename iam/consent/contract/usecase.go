@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	CreateDocument(ctx context.Context, createdBy uuid.UUID, req *consentdto.CreateDocumentRequest) (*consentdto.DocumentResponse, error)
+	UpdateDocument(ctx context.Context, id uuid.UUID, req *consentdto.UpdateDocumentRequest) (*consentdto.DocumentResponse, error)
+	GetDocument(ctx context.Context, id uuid.UUID) (*consentdto.DocumentResponse, error)
+	ListDocuments(ctx context.Context, req *consentdto.ListDocumentsRequest) (*consentdto.ListDocumentsResponse, error)
+	PublishDocument(ctx context.Context, id, publishedBy uuid.UUID) (*consentdto.DocumentResponse, error)
+
+	GetCurrentDocuments(ctx context.Context, req *consentdto.CurrentDocumentsRequest) ([]consentdto.DocumentResponse, error)
+	GetMyConsents(ctx context.Context, userID uuid.UUID) (*consentdto.MyConsentsResponse, error)
+	Accept(ctx context.Context, req *consentdto.AcceptRequest) (*consentdto.MyConsentsResponse, error)
+
+	GetReport(ctx context.Context, req *consentdto.ReportRequest) (*consentdto.ReportResponse, error)
+	ListAudience(ctx context.Context, id uuid.UUID, req *consentdto.AudienceListRequest) (*consentdto.AudienceListResponse, error)
+}
@@ -0,0 +1,30 @@
+package consent
+
+import (
+	"iam-service/config"
+	"iam-service/iam/consent/contract"
+	"iam-service/iam/consent/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	documentRepo contract.ConsentDocumentRepository,
+	userConsentRepo contract.UserConsentRepository,
+	tenantRepo contract.TenantRepository,
+	productRepo contract.ProductRepository,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		documentRepo,
+		userConsentRepo,
+		tenantRepo,
+		productRepo,
+		auditLogger,
+	)
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Accept records the user's acceptance of current document versions. Only
+// versions that currently apply to the user can be accepted; accepting one
+// twice is a no-op.
+func (uc *usecase) Accept(ctx context.Context, req *consentdto.AcceptRequest) (*consentdto.MyConsentsResponse, error) {
+	documents, accepted, err := uc.currentConsents(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	applicable := make(map[uuid.UUID]*entity.ConsentDocument, len(documents))
+	for i := range documents {
+		applicable[documents[i].ID] = &documents[i]
+	}
+
+	var toAccept []*entity.ConsentDocument
+	seen := make(map[uuid.UUID]struct{}, len(req.DocumentIDs))
+	for _, id := range req.DocumentIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		document, ok := applicable[id]
+		if !ok {
+			return nil, errors.ErrBadRequest("Document " + id.String() + " is not a current version that applies to you")
+		}
+		if _, ok := accepted[id]; !ok {
+			toAccept = append(toAccept, document)
+		}
+	}
+
+	now := time.Now()
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		for _, document := range toAccept {
+			consent := entity.NewUserConsent(req.UserID, document, now, req.IPAddress, req.UserAgent)
+			if err := uc.UserConsentRepo.Create(txCtx, consent); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to record consent").WithError(err)
+	}
+
+	for _, document := range toAccept {
+		uc.AuditLogger.Log(ctx, logger.AuditEvent{
+			Domain:     "consent",
+			Action:     "consent_accepted",
+			ActorID:    req.UserID.String(),
+			ActorType:  "user",
+			TargetID:   document.ID.String(),
+			TargetType: "consent_document",
+			TenantID:   tenantIDString(document.TenantID),
+			Success:    true,
+			Metadata: map[string]any{
+				"document_type": string(document.DocumentType),
+				"version":       document.Version,
+				"ip_address":    req.IPAddress,
+			},
+		})
+	}
+
+	return uc.buildUserStatus(ctx, req.UserID)
+}
@@ -0,0 +1,106 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccept(t *testing.T) {
+	userID := uuid.New()
+	tenantID := uuid.New()
+	termsV1 := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "1.0", IsRequired: true}
+	termsV2 := entity.ConsentDocument{ID: uuid.New(), TenantID: &tenantID, DocumentType: entity.ConsentDocumentTerms, Version: "2.0", IsRequired: true}
+	privacy := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentPrivacy, Version: "1.0", IsRequired: true}
+	acceptedPrivacy := entity.UserConsent{UserID: userID, DocumentID: privacy.ID, DocumentType: entity.ConsentDocumentPrivacy, Version: "1.0", AcceptedAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name        string
+		documentIDs []uuid.UUID
+		wantStatus  int
+		wantCreated []uuid.UUID
+	}{
+		{
+			name:        "records the new version once",
+			documentIDs: []uuid.UUID{termsV2.ID, termsV2.ID},
+			wantCreated: []uuid.UUID{termsV2.ID},
+		},
+		{
+			name:        "already accepted version is not recorded again",
+			documentIDs: []uuid.UUID{privacy.ID, termsV2.ID},
+			wantCreated: []uuid.UUID{termsV2.ID},
+		},
+		{
+			name:        "superseded version cannot be accepted",
+			documentIDs: []uuid.UUID{termsV1.ID},
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documentRepo := new(MockConsentDocumentRepository)
+			documentRepo.On("ListCurrentForUser", mock.Anything, userID).Return([]entity.ConsentDocument{termsV2, privacy}, nil)
+
+			var created []entity.UserConsent
+			userConsentRepo := new(MockUserConsentRepository)
+			userConsentRepo.On("ListByUserID", mock.Anything, userID).Return([]entity.UserConsent{acceptedPrivacy}, nil).Once()
+			userConsentRepo.On("ListByUserID", mock.Anything, userID).Return([]entity.UserConsent{
+				acceptedPrivacy,
+				{UserID: userID, DocumentID: termsV2.ID, DocumentType: entity.ConsentDocumentTerms, Version: "2.0", AcceptedAt: time.Now()},
+			}, nil).Maybe()
+			userConsentRepo.On("Create", mock.Anything, mock.AnythingOfType("*entity.UserConsent")).
+				Run(func(args mock.Arguments) { created = append(created, *args.Get(1).(*entity.UserConsent)) }).
+				Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:       NewMockTransactionManager(),
+				DocumentRepo:    documentRepo,
+				UserConsentRepo: userConsentRepo,
+				AuditLogger:     logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Accept(context.Background(), &consentdto.AcceptRequest{
+				UserID:      userID,
+				IPAddress:   "203.0.113.10",
+				UserAgent:   "Mozilla/5.0",
+				DocumentIDs: tt.documentIDs,
+			})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				userConsentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, created, len(tt.wantCreated))
+			for i, documentID := range tt.wantCreated {
+				assert.Equal(t, documentID, created[i].DocumentID)
+				assert.Equal(t, "2.0", created[i].Version)
+				assert.Equal(t, &tenantID, created[i].TenantID)
+				require.NotNil(t, created[i].IPAddress)
+				assert.Equal(t, "203.0.113.10", *created[i].IPAddress)
+			}
+			assert.False(t, resp.PendingRequired)
+			for _, status := range resp.Documents {
+				assert.True(t, status.Accepted, "document %s should be accepted", status.Document.Version)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/consent/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager       contract.TransactionManager
+	Config          *config.Config
+	DocumentRepo    contract.ConsentDocumentRepository
+	UserConsentRepo contract.UserConsentRepository
+	TenantRepo      contract.TenantRepository
+	ProductRepo     contract.ProductRepository
+	AuditLogger     logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	documentRepo contract.ConsentDocumentRepository,
+	userConsentRepo contract.UserConsentRepository,
+	tenantRepo contract.TenantRepository,
+	productRepo contract.ProductRepository,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:       txManager,
+		Config:          cfg,
+		DocumentRepo:    documentRepo,
+		UserConsentRepo: userConsentRepo,
+		TenantRepo:      tenantRepo,
+		ProductRepo:     productRepo,
+		AuditLogger:     auditLogger,
+	}
+}
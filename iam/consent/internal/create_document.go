@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) CreateDocument(ctx context.Context, createdBy uuid.UUID, req *consentdto.CreateDocumentRequest) (*consentdto.DocumentResponse, error) {
+	if err := uc.verifyScope(ctx, req.TenantID, req.ProductID); err != nil {
+		return nil, err
+	}
+
+	isRequired := true
+	if req.IsRequired != nil {
+		isRequired = *req.IsRequired
+	}
+
+	now := time.Now()
+	document := &entity.ConsentDocument{
+		ID:           uuid.New(),
+		TenantID:     req.TenantID,
+		ProductID:    req.ProductID,
+		DocumentType: entity.ConsentDocumentType(req.DocumentType),
+		Version:      req.Version,
+		Title:        req.Title,
+		ContentURL:   req.ContentURL,
+		Summary:      req.Summary,
+		IsRequired:   isRequired,
+		Status:       entity.ConsentDocumentStatusDraft,
+		CreatedBy:    createdBy,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	if err := uc.DocumentRepo.Create(ctx, document); err != nil {
+		if errors.IsConflict(err) {
+			return nil, errors.ErrConflict("Version " + req.Version + " already exists for this document")
+		}
+		return nil, errors.ErrInternal("failed to create consent document").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "consent",
+		Action:     "consent_document_created",
+		ActorID:    createdBy.String(),
+		ActorType:  "user",
+		TargetID:   document.ID.String(),
+		TargetType: "consent_document",
+		TenantID:   tenantIDString(document.TenantID),
+		Success:    true,
+		Metadata: map[string]any{
+			"document_type": string(document.DocumentType),
+			"version":       document.Version,
+		},
+	})
+
+	response := mapDocumentToResponse(document)
+	return &response, nil
+}
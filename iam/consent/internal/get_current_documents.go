@@ -0,0 +1,18 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+)
+
+// GetCurrentDocuments lists the versions a new user registering for the given
+// tenant and product is asked to accept.
+func (uc *usecase) GetCurrentDocuments(ctx context.Context, req *consentdto.CurrentDocumentsRequest) ([]consentdto.DocumentResponse, error) {
+	documents, err := uc.DocumentRepo.ListCurrentForRegistration(ctx, req.TenantID, req.ProductID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+	return mapDocumentsToResponse(documents), nil
+}
@@ -0,0 +1,18 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetDocument(ctx context.Context, id uuid.UUID) (*consentdto.DocumentResponse, error) {
+	document, err := uc.getDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	response := mapDocumentToResponse(document)
+	return &response, nil
+}
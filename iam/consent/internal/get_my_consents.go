@@ -0,0 +1,13 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetMyConsents(ctx context.Context, userID uuid.UUID) (*consentdto.MyConsentsResponse, error) {
+	return uc.buildUserStatus(ctx, userID)
+}
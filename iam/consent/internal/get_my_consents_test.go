@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMyConsents_VersionBump(t *testing.T) {
+	userID := uuid.New()
+	termsV1 := entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "1.0", IsRequired: true}
+	acceptedV1 := []entity.UserConsent{
+		{UserID: userID, DocumentID: termsV1.ID, DocumentType: entity.ConsentDocumentTerms, Version: "1.0", AcceptedAt: time.Now().Add(-30 * 24 * time.Hour)},
+	}
+
+	tests := []struct {
+		name        string
+		current     entity.ConsentDocument
+		wantPending bool
+		wantAccept  bool
+	}{
+		{
+			name:       "accepted version is still current",
+			current:    termsV1,
+			wantAccept: true,
+		},
+		{
+			name:        "required new version is pending",
+			current:     entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "2.0", IsRequired: true},
+			wantPending: true,
+		},
+		{
+			name:    "optional new version is not blocking",
+			current: entity.ConsentDocument{ID: uuid.New(), DocumentType: entity.ConsentDocumentTerms, Version: "2.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			documentRepo := new(MockConsentDocumentRepository)
+			documentRepo.On("ListCurrentForUser", mock.Anything, userID).Return([]entity.ConsentDocument{tt.current}, nil)
+			userConsentRepo := new(MockUserConsentRepository)
+			userConsentRepo.On("ListByUserID", mock.Anything, userID).Return(acceptedV1, nil)
+
+			uc := &usecase{
+				DocumentRepo:    documentRepo,
+				UserConsentRepo: userConsentRepo,
+			}
+
+			resp, err := uc.GetMyConsents(context.Background(), userID)
+			require.NoError(t, err)
+			require.Len(t, resp.Documents, 1)
+			assert.Equal(t, tt.current.Version, resp.Documents[0].Document.Version)
+			assert.Equal(t, tt.wantAccept, resp.Documents[0].Accepted)
+			assert.Equal(t, tt.wantPending, resp.PendingRequired)
+		})
+	}
+}
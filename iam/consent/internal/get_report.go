@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+	"iam-service/iam/consent/contract"
+	"iam-service/pkg/errors"
+)
+
+// GetReport summarises how many users have accepted each current document
+// version. With a tenant, only that tenant's members and documents count.
+func (uc *usecase) GetReport(ctx context.Context, req *consentdto.ReportRequest) (*consentdto.ReportResponse, error) {
+	if err := uc.verifyScope(ctx, req.TenantID, nil); err != nil {
+		return nil, err
+	}
+
+	documents, err := uc.DocumentRepo.ListCurrentForTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+
+	report := &consentdto.ReportResponse{
+		TenantID:  req.TenantID,
+		Documents: make([]consentdto.DocumentReport, 0, len(documents)),
+	}
+	for i := range documents {
+		total, accepted, err := uc.UserConsentRepo.CountAudience(ctx, &contract.ConsentAudienceFilter{
+			Document: &documents[i],
+			TenantID: req.TenantID,
+		})
+		if err != nil {
+			return nil, errors.ErrInternal("failed to count consent audience").WithError(err)
+		}
+		report.Documents = append(report.Documents, consentdto.DocumentReport{
+			Document:      mapDocumentToResponse(&documents[i]),
+			TotalUsers:    total,
+			AcceptedUsers: accepted,
+			PendingUsers:  total - accepted,
+		})
+	}
+	return report, nil
+}
@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) getDocument(ctx context.Context, id uuid.UUID) (*entity.ConsentDocument, error) {
+	document, err := uc.DocumentRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Consent document not found")
+		}
+		return nil, errors.ErrInternal("failed to get consent document").WithError(err)
+	}
+	return document, nil
+}
+
+// verifyScope checks that the tenant exists and that the product, if any,
+// belongs to it.
+func (uc *usecase) verifyScope(ctx context.Context, tenantID, productID *uuid.UUID) error {
+	if tenantID == nil {
+		return nil
+	}
+	if _, err := uc.TenantRepo.GetByID(ctx, *tenantID); err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrTenantNotFound()
+		}
+		return errors.ErrInternal("failed to get tenant").WithError(err)
+	}
+	if productID == nil {
+		return nil
+	}
+	if _, err := uc.ProductRepo.GetByIDAndTenant(ctx, *productID, *tenantID); err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("Product not found in this tenant")
+		}
+		return errors.ErrInternal("failed to get product").WithError(err)
+	}
+	return nil
+}
+
+// currentConsents returns the document versions that currently apply to the
+// user, and the user's acceptances keyed by document.
+func (uc *usecase) currentConsents(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, map[uuid.UUID]entity.UserConsent, error) {
+	documents, err := uc.DocumentRepo.ListCurrentForUser(ctx, userID)
+	if err != nil {
+		return nil, nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+
+	consents, err := uc.UserConsentRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, nil, errors.ErrInternal("failed to list user consents").WithError(err)
+	}
+	accepted := make(map[uuid.UUID]entity.UserConsent, len(consents))
+	for _, consent := range consents {
+		accepted[consent.DocumentID] = consent
+	}
+	return documents, accepted, nil
+}
+
+func (uc *usecase) buildUserStatus(ctx context.Context, userID uuid.UUID) (*consentdto.MyConsentsResponse, error) {
+	documents, accepted, err := uc.currentConsents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &consentdto.MyConsentsResponse{
+		Documents: make([]consentdto.UserDocumentStatus, 0, len(documents)),
+	}
+	for i := range documents {
+		status := consentdto.UserDocumentStatus{
+			Document: mapDocumentToResponse(&documents[i]),
+		}
+		if consent, ok := accepted[documents[i].ID]; ok {
+			acceptedAt := consent.AcceptedAt
+			status.Accepted = true
+			status.AcceptedAt = &acceptedAt
+		} else if documents[i].IsRequired {
+			response.PendingRequired = true
+		}
+		response.Documents = append(response.Documents, status)
+	}
+	return response, nil
+}
+
+func mapDocumentToResponse(document *entity.ConsentDocument) consentdto.DocumentResponse {
+	return consentdto.DocumentResponse{
+		ID:           document.ID,
+		TenantID:     document.TenantID,
+		ProductID:    document.ProductID,
+		DocumentType: string(document.DocumentType),
+		Version:      document.Version,
+		Title:        document.Title,
+		ContentURL:   document.ContentURL,
+		Summary:      document.Summary,
+		IsRequired:   document.IsRequired,
+		Status:       string(document.Status),
+		PublishedAt:  document.PublishedAt,
+		PublishedBy:  document.PublishedBy,
+		CreatedBy:    document.CreatedBy,
+		CreatedAt:    document.CreatedAt,
+		UpdatedAt:    document.UpdatedAt,
+	}
+}
+
+func mapDocumentsToResponse(documents []entity.ConsentDocument) []consentdto.DocumentResponse {
+	responses := make([]consentdto.DocumentResponse, 0, len(documents))
+	for i := range documents {
+		responses = append(responses, mapDocumentToResponse(&documents[i]))
+	}
+	return responses
+}
+
+func tenantIDString(tenantID *uuid.UUID) string {
+	if tenantID == nil {
+		return ""
+	}
+	return tenantID.String()
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/consent/consentdto"
+	"iam-service/iam/consent/contract"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// ListAudience lists the users a published document applies to and whether
+// each of them has accepted it.
+func (uc *usecase) ListAudience(ctx context.Context, id uuid.UUID, req *consentdto.AudienceListRequest) (*consentdto.AudienceListResponse, error) {
+	req.SetDefaults()
+
+	document, err := uc.getDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !document.IsPublished() {
+		return nil, errors.ErrBadRequest("Only the published version has an audience")
+	}
+
+	filter := &contract.ConsentAudienceFilter{
+		Document: document,
+		TenantID: req.TenantID,
+		Page:     req.Page,
+		PerPage:  req.PerPage,
+	}
+	if req.Status != "" {
+		accepted := req.Status == "ACCEPTED"
+		filter.Accepted = &accepted
+	}
+
+	members, total, err := uc.UserConsentRepo.ListAudience(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent audience").WithError(err)
+	}
+
+	responses := make([]consentdto.AudienceMember, 0, len(members))
+	for _, member := range members {
+		responses = append(responses, consentdto.AudienceMember{
+			UserID:     member.UserID,
+			Email:      member.Email,
+			Accepted:   member.AcceptedAt != nil,
+			AcceptedAt: member.AcceptedAt,
+		})
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &consentdto.AudienceListResponse{
+		Document: mapDocumentToResponse(document),
+		Members:  responses,
+		Pagination: consentdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
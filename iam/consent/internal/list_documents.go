@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/iam/consent/contract"
+	"iam-service/pkg/errors"
+)
+
+func (uc *usecase) ListDocuments(ctx context.Context, req *consentdto.ListDocumentsRequest) (*consentdto.ListDocumentsResponse, error) {
+	req.SetDefaults()
+
+	filter := &contract.ConsentDocumentListFilter{
+		TenantID:     req.TenantID,
+		ProductID:    req.ProductID,
+		PlatformOnly: req.PlatformOnly,
+		Page:         req.Page,
+		PerPage:      req.PerPage,
+	}
+	if req.DocumentType != "" {
+		documentType := entity.ConsentDocumentType(req.DocumentType)
+		filter.DocumentType = &documentType
+	}
+	if req.Status != "" {
+		status := entity.ConsentDocumentStatus(req.Status)
+		filter.Status = &status
+	}
+
+	documents, total, err := uc.DocumentRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list consent documents").WithError(err)
+	}
+
+	responses := make([]consentdto.DocumentResponse, 0, len(documents))
+	for _, document := range documents {
+		responses = append(responses, mapDocumentToResponse(document))
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &consentdto.ListDocumentsResponse{
+		Documents: responses,
+		Pagination: consentdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,118 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+	if args.Get(0) == nil {
+		return fn(ctx)
+	}
+	return args.Error(0)
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	m := &MockTransactionManager{}
+	m.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return m
+}
+
+type MockConsentDocumentRepository struct {
+	mock.Mock
+}
+
+func (m *MockConsentDocumentRepository) Create(ctx context.Context, document *entity.ConsentDocument) error {
+	args := m.Called(ctx, document)
+	return args.Error(0)
+}
+
+func (m *MockConsentDocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ConsentDocument, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.ConsentDocument), args.Error(1)
+}
+
+func (m *MockConsentDocumentRepository) List(ctx context.Context, filter *contract.ConsentDocumentListFilter) ([]*entity.ConsentDocument, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entity.ConsentDocument), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockConsentDocumentRepository) Update(ctx context.Context, document *entity.ConsentDocument) error {
+	args := m.Called(ctx, document)
+	return args.Error(0)
+}
+
+func (m *MockConsentDocumentRepository) SupersedePublished(ctx context.Context, tenantID, productID *uuid.UUID, documentType entity.ConsentDocumentType) error {
+	args := m.Called(ctx, tenantID, productID, documentType)
+	return args.Error(0)
+}
+
+func (m *MockConsentDocumentRepository) ListCurrentForRegistration(ctx context.Context, tenantID, productID *uuid.UUID) ([]entity.ConsentDocument, error) {
+	args := m.Called(ctx, tenantID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ConsentDocument), args.Error(1)
+}
+
+func (m *MockConsentDocumentRepository) ListCurrentForUser(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ConsentDocument), args.Error(1)
+}
+
+func (m *MockConsentDocumentRepository) ListCurrentForTenant(ctx context.Context, tenantID *uuid.UUID) ([]entity.ConsentDocument, error) {
+	args := m.Called(ctx, tenantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ConsentDocument), args.Error(1)
+}
+
+type MockUserConsentRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserConsentRepository) Create(ctx context.Context, consent *entity.UserConsent) error {
+	args := m.Called(ctx, consent)
+	return args.Error(0)
+}
+
+func (m *MockUserConsentRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserConsent, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserConsent), args.Error(1)
+}
+
+func (m *MockUserConsentRepository) CountAudience(ctx context.Context, filter *contract.ConsentAudienceFilter) (int64, int64, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).(int64), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockUserConsentRepository) ListAudience(ctx context.Context, filter *contract.ConsentAudienceFilter) ([]contract.ConsentAudienceMember, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]contract.ConsentAudienceMember), args.Get(1).(int64), args.Error(2)
+}
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// PublishDocument makes the draft the current version for its scope and type,
+// superseding the previous one. Users who accepted the previous version are
+// asked to accept this one at their next login.
+func (uc *usecase) PublishDocument(ctx context.Context, id, publishedBy uuid.UUID) (*consentdto.DocumentResponse, error) {
+	document, err := uc.getDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !document.IsDraft() {
+		return nil, errors.ErrBadRequest("Only draft documents can be published")
+	}
+
+	now := time.Now()
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.DocumentRepo.SupersedePublished(txCtx, document.TenantID, document.ProductID, document.DocumentType); err != nil {
+			return err
+		}
+
+		document.Status = entity.ConsentDocumentStatusPublished
+		document.PublishedAt = &now
+		document.PublishedBy = &publishedBy
+		document.UpdatedAt = now
+		return uc.DocumentRepo.Update(txCtx, document)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to publish consent document").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "consent",
+		Action:     "consent_document_published",
+		ActorID:    publishedBy.String(),
+		ActorType:  "user",
+		TargetID:   document.ID.String(),
+		TargetType: "consent_document",
+		TenantID:   tenantIDString(document.TenantID),
+		Success:    true,
+		Metadata: map[string]any{
+			"document_type": string(document.DocumentType),
+			"version":       document.Version,
+			"is_required":   document.IsRequired,
+		},
+	})
+
+	response := mapDocumentToResponse(document)
+	return &response, nil
+}
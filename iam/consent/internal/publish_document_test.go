@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishDocument(t *testing.T) {
+	tenantID := uuid.New()
+	publishedBy := uuid.New()
+
+	tests := []struct {
+		name       string
+		status     entity.ConsentDocumentStatus
+		wantStatus int
+	}{
+		{
+			name:   "draft becomes the current version",
+			status: entity.ConsentDocumentStatusDraft,
+		},
+		{
+			name:       "published version cannot be published again",
+			status:     entity.ConsentDocumentStatusPublished,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "superseded version cannot be republished",
+			status:     entity.ConsentDocumentStatusSuperseded,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document := &entity.ConsentDocument{
+				ID:           uuid.New(),
+				TenantID:     &tenantID,
+				DocumentType: entity.ConsentDocumentTerms,
+				Version:      "2.0",
+				Title:        "Terms of Service",
+				IsRequired:   true,
+				Status:       tt.status,
+			}
+
+			documentRepo := new(MockConsentDocumentRepository)
+			documentRepo.On("GetByID", mock.Anything, document.ID).Return(document, nil)
+			documentRepo.On("SupersedePublished", mock.Anything, &tenantID, (*uuid.UUID)(nil), entity.ConsentDocumentTerms).Return(nil).Maybe()
+			documentRepo.On("Update", mock.Anything, document).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:    NewMockTransactionManager(),
+				DocumentRepo: documentRepo,
+				AuditLogger:  logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.PublishDocument(context.Background(), document.ID, publishedBy)
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				documentRepo.AssertNotCalled(t, "SupersedePublished", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				documentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(entity.ConsentDocumentStatusPublished), resp.Status)
+			assert.Equal(t, &publishedBy, resp.PublishedBy)
+			assert.NotNil(t, resp.PublishedAt)
+			documentRepo.AssertCalled(t, "SupersedePublished", mock.Anything, &tenantID, (*uuid.UUID)(nil), entity.ConsentDocumentTerms)
+			documentRepo.AssertCalled(t, "Update", mock.Anything, document)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/iam/consent/consentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UpdateDocument edits a draft. Published versions are immutable; a change
+// to the text means publishing a new version.
+func (uc *usecase) UpdateDocument(ctx context.Context, id uuid.UUID, req *consentdto.UpdateDocumentRequest) (*consentdto.DocumentResponse, error) {
+	document, err := uc.getDocument(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !document.IsDraft() {
+		return nil, errors.ErrBadRequest("Only draft documents can be edited")
+	}
+
+	if req.Version != nil {
+		document.Version = *req.Version
+	}
+	if req.Title != nil {
+		document.Title = *req.Title
+	}
+	if req.ContentURL != nil {
+		document.ContentURL = *req.ContentURL
+	}
+	if req.Summary != nil {
+		document.Summary = req.Summary
+	}
+	if req.IsRequired != nil {
+		document.IsRequired = *req.IsRequired
+	}
+	document.UpdatedAt = time.Now()
+
+	if err := uc.DocumentRepo.Update(ctx, document); err != nil {
+		if errors.IsConflict(err) {
+			return nil, errors.ErrConflict("Version " + document.Version + " already exists for this document")
+		}
+		return nil, errors.ErrInternal("failed to update consent document").WithError(err)
+	}
+
+	response := mapDocumentToResponse(document)
+	return &response, nil
+}
@@ -0,0 +1,46 @@
+package contract
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type DataSubjectRequestListFilter struct {
+	UserID  *uuid.UUID
+	Type    *entity.DataSubjectRequestType
+	Status  *entity.DataSubjectRequestStatus
+	Page    int
+	PerPage int
+}
+
+type DataSubjectRequestRepository interface {
+	Create(ctx context.Context, request *entity.DataSubjectRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.DataSubjectRequest, error)
+	GetOpenByUserAndType(ctx context.Context, userID uuid.UUID, requestType entity.DataSubjectRequestType) (*entity.DataSubjectRequest, error)
+	List(ctx context.Context, filter *DataSubjectRequestListFilter) ([]*entity.DataSubjectRequest, int64, error)
+	Update(ctx context.Context, request *entity.DataSubjectRequest) error
+	ClaimPendingExports(ctx context.Context, limit int) ([]*entity.DataSubjectRequest, error)
+	ListDueErasures(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error)
+	ListExpiredExports(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error)
+}
+
+type PersonalDataRepository interface {
+	Collect(ctx context.Context, userID uuid.UUID) (*entity.PersonalDataSnapshot, error)
+	Anonymize(ctx context.Context, userID uuid.UUID, placeholderEmail string) error
+}
+
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+}
+
+type FileStorage interface {
+	UploadFile(ctx context.Context, bucket, objectKey string, data io.Reader, size int64, contentType string) (string, error)
+	DeleteFile(ctx context.Context, bucket, objectKey string) error
+	DeletePrefix(ctx context.Context, bucket, prefix string) error
+	GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error)
+}
@@ -0,0 +1,24 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/datasubject/datasubjectdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	RequestExport(ctx context.Context, userID uuid.UUID) (*datasubjectdto.RequestResponse, error)
+	RequestErasure(ctx context.Context, userID uuid.UUID, req *datasubjectdto.ErasureRequest) (*datasubjectdto.RequestResponse, error)
+	ListMine(ctx context.Context, userID uuid.UUID) ([]datasubjectdto.RequestResponse, error)
+	GetExportDownload(ctx context.Context, userID, id uuid.UUID) (*datasubjectdto.DownloadResponse, error)
+	Cancel(ctx context.Context, userID, id uuid.UUID) (*datasubjectdto.RequestResponse, error)
+
+	List(ctx context.Context, req *datasubjectdto.ListRequest) (*datasubjectdto.ListResponse, error)
+	ApproveErasure(ctx context.Context, id, reviewerID uuid.UUID, req *datasubjectdto.ReviewRequest) (*datasubjectdto.RequestResponse, error)
+	RejectErasure(ctx context.Context, id, reviewerID uuid.UUID, req *datasubjectdto.ReviewRequest) (*datasubjectdto.RequestResponse, error)
+
+	ProcessPendingExports(ctx context.Context) (int, error)
+	ProcessDueErasures(ctx context.Context) (int, error)
+}
@@ -0,0 +1,31 @@
+package datasubjectdto
+
+import "github.com/google/uuid"
+
+type ErasureRequest struct {
+	Reason string `json:"reason" validate:"omitempty,max=1000"`
+}
+
+type ListRequest struct {
+	UserID  *uuid.UUID `query:"user_id" validate:"omitempty"`
+	Type    string     `query:"type" validate:"omitempty,oneof=EXPORT ERASURE"`
+	Status  string     `query:"status" validate:"omitempty,oneof=PENDING PROCESSING APPROVED REJECTED CANCELLED COMPLETED FAILED"`
+	Page    int        `query:"page" validate:"omitempty,min=1"`
+	PerPage int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type ReviewRequest struct {
+	Note string `json:"note" validate:"omitempty,max=1000"`
+}
@@ -0,0 +1,30 @@
+package datasubject
+
+import (
+	"iam-service/config"
+	"iam-service/iam/datasubject/contract"
+	"iam-service/iam/datasubject/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	requestRepo contract.DataSubjectRequestRepository,
+	personalDataRepo contract.PersonalDataRepository,
+	userRepo contract.UserRepository,
+	fileStorage contract.FileStorage,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		requestRepo,
+		personalDataRepo,
+		userRepo,
+		fileStorage,
+		auditLogger,
+	)
+}
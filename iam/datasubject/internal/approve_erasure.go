@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// ApproveErasure schedules the erasure to run once the grace period has
+// passed, giving the user a window to change their mind.
+func (uc *usecase) ApproveErasure(ctx context.Context, id, reviewerID uuid.UUID, req *datasubjectdto.ReviewRequest) (*datasubjectdto.RequestResponse, error) {
+	request, err := uc.getPendingErasure(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	scheduledFor := now.Add(ErasureGracePeriod)
+	request.Status = entity.DSRStatusApproved
+	request.ScheduledFor = &scheduledFor
+	request.ReviewedBy = &reviewerID
+	request.ReviewedAt = &now
+	request.ReviewNote = optionalString(req.Note)
+	request.UpdatedAt = now
+
+	if err := uc.RequestRepo.Update(ctx, request); err != nil {
+		return nil, errors.ErrInternal("failed to approve erasure request").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "erasure_approved",
+		ActorID:    reviewerID.String(),
+		ActorType:  "user",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+		Metadata: map[string]any{
+			"user_id":       request.UserID.String(),
+			"scheduled_for": scheduledFor,
+		},
+	})
+
+	response := mapRequestToResponse(request)
+	return &response, nil
+}
+
+func (uc *usecase) getPendingErasure(ctx context.Context, id uuid.UUID) (*entity.DataSubjectRequest, error) {
+	request, err := uc.getRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Type != entity.DataSubjectRequestErasure {
+		return nil, errors.ErrBadRequest("Request is not an erasure request")
+	}
+	if request.Status != entity.DSRStatusPending {
+		return nil, errors.ErrBadRequest("Erasure request is not pending review")
+	}
+	return request, nil
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveErasure(t *testing.T) {
+	reviewerID := uuid.New()
+
+	tests := []struct {
+		name        string
+		requestType entity.DataSubjectRequestType
+		status      entity.DataSubjectRequestStatus
+		wantStatus  int
+	}{
+		{
+			name:        "schedules the erasure after the grace period",
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusPending,
+		},
+		{
+			name:        "export requests need no review",
+			requestType: entity.DataSubjectRequestExport,
+			status:      entity.DSRStatusPending,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "erasure withdrawn by the user",
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusCancelled,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &entity.DataSubjectRequest{
+				ID:     uuid.New(),
+				UserID: uuid.New(),
+				Type:   tt.requestType,
+				Status: tt.status,
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("GetByID", mock.Anything, request.ID).Return(request, nil)
+			requestRepo.On("Update", mock.Anything, request).Return(nil).Maybe()
+
+			uc := &usecase{
+				RequestRepo: requestRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			before := time.Now()
+			resp, err := uc.ApproveErasure(context.Background(), request.ID, reviewerID, &datasubjectdto.ReviewRequest{Note: "verified by phone"})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				requestRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(entity.DSRStatusApproved), resp.Status)
+			require.NotNil(t, resp.ScheduledFor)
+			assert.False(t, resp.ScheduledFor.Before(before.Add(ErasureGracePeriod)))
+			assert.Equal(t, &reviewerID, resp.ReviewedBy)
+			require.NotNil(t, resp.ReviewNote)
+			assert.Equal(t, "verified by phone", *resp.ReviewNote)
+		})
+	}
+}
+
+func TestRejectErasure(t *testing.T) {
+	reviewerID := uuid.New()
+
+	tests := []struct {
+		name       string
+		note       string
+		wantStatus int
+	}{
+		{
+			name: "rejects with a note",
+			note: "  account is under legal hold  ",
+		},
+		{
+			name:       "note is required",
+			note:       "   ",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &entity.DataSubjectRequest{
+				ID:     uuid.New(),
+				UserID: uuid.New(),
+				Type:   entity.DataSubjectRequestErasure,
+				Status: entity.DSRStatusPending,
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("GetByID", mock.Anything, request.ID).Return(request, nil).Maybe()
+			requestRepo.On("Update", mock.Anything, request).Return(nil).Maybe()
+
+			uc := &usecase{
+				RequestRepo: requestRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.RejectErasure(context.Background(), request.ID, reviewerID, &datasubjectdto.ReviewRequest{Note: tt.note})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.Equal(t, entity.DSRStatusPending, request.Status)
+				requestRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(entity.DSRStatusRejected), resp.Status)
+			require.NotNil(t, resp.ReviewNote)
+			assert.Equal(t, "account is under legal hold", *resp.ReviewNote)
+			assert.Nil(t, resp.ScheduledFor)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/datasubject/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager        contract.TransactionManager
+	Config           *config.Config
+	RequestRepo      contract.DataSubjectRequestRepository
+	PersonalDataRepo contract.PersonalDataRepository
+	UserRepo         contract.UserRepository
+	FileStorage      contract.FileStorage
+	AuditLogger      logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	requestRepo contract.DataSubjectRequestRepository,
+	personalDataRepo contract.PersonalDataRepository,
+	userRepo contract.UserRepository,
+	fileStorage contract.FileStorage,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:        txManager,
+		Config:           cfg,
+		RequestRepo:      requestRepo,
+		PersonalDataRepo: personalDataRepo,
+		UserRepo:         userRepo,
+		FileStorage:      fileStorage,
+		AuditLogger:      auditLogger,
+	}
+}
@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Cancel(ctx context.Context, userID, id uuid.UUID) (*datasubjectdto.RequestResponse, error) {
+	request, err := uc.getOwnRequest(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.CanBeCancelled() {
+		return nil, errors.ErrBadRequest("Request can no longer be cancelled")
+	}
+
+	request.Status = entity.DSRStatusCancelled
+	request.UpdatedAt = time.Now()
+	if err := uc.RequestRepo.Update(ctx, request); err != nil {
+		return nil, errors.ErrInternal("failed to cancel data subject request").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "erasure_cancelled",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+	})
+
+	response := mapRequestToResponse(request)
+	return &response, nil
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancel(t *testing.T) {
+	userID := uuid.New()
+	otherUserID := uuid.New()
+
+	tests := []struct {
+		name        string
+		ownerID     uuid.UUID
+		requestType entity.DataSubjectRequestType
+		status      entity.DataSubjectRequestStatus
+		wantStatus  int
+	}{
+		{
+			name:        "withdraws a pending erasure",
+			ownerID:     userID,
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusPending,
+		},
+		{
+			name:        "withdraws an approved erasure within the grace period",
+			ownerID:     userID,
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusApproved,
+		},
+		{
+			name:        "erasure filed by another user",
+			ownerID:     otherUserID,
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusPending,
+			wantStatus:  http.StatusNotFound,
+		},
+		{
+			name:        "erasure already running",
+			ownerID:     userID,
+			requestType: entity.DataSubjectRequestErasure,
+			status:      entity.DSRStatusProcessing,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:        "exports cannot be cancelled",
+			ownerID:     userID,
+			requestType: entity.DataSubjectRequestExport,
+			status:      entity.DSRStatusPending,
+			wantStatus:  http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &entity.DataSubjectRequest{
+				ID:     uuid.New(),
+				UserID: tt.ownerID,
+				Type:   tt.requestType,
+				Status: tt.status,
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("GetByID", mock.Anything, request.ID).Return(request, nil)
+			requestRepo.On("Update", mock.Anything, request).Return(nil).Maybe()
+
+			uc := &usecase{
+				RequestRepo: requestRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Cancel(context.Background(), userID, request.ID)
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.Equal(t, tt.status, request.Status)
+				requestRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(entity.DSRStatusCancelled), resp.Status)
+			requestRepo.AssertCalled(t, "Update", mock.Anything, request)
+		})
+	}
+}
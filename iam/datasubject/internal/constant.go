@@ -0,0 +1,13 @@
+package internal
+
+import "time"
+
+const (
+	ExportLinkExpiry        = 7 * 24 * time.Hour
+	ExportDownloadURLExpiry = 15 * time.Minute
+	ExportMaxAttempts       = 3
+	ExportObjectPrefix      = "data-exports"
+	ErasureGracePeriod      = 14 * 24 * time.Hour
+	ErasedEmailDomain       = "erased.invalid"
+	WorkerBatchSize         = 10
+)
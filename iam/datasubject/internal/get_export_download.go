@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetExportDownload(ctx context.Context, userID, id uuid.UUID) (*datasubjectdto.DownloadResponse, error) {
+	request, err := uc.getOwnRequest(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Type != entity.DataSubjectRequestExport {
+		return nil, errors.ErrBadRequest("Request is not a data export")
+	}
+
+	now := time.Now()
+	if !request.IsDownloadable(now) {
+		if request.Status == entity.DSRStatusCompleted {
+			return nil, errors.New("EXPORT_EXPIRED", "Data export has expired", 410)
+		}
+		return nil, errors.ErrBadRequest("Data export is not ready yet")
+	}
+
+	url, err := uc.FileStorage.GetPresignedURL(ctx, uc.Config.Infra.Minio.Bucket, *request.ObjectKey, ExportDownloadURLExpiry)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate download url").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "data_export_downloaded",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+	})
+
+	return &datasubjectdto.DownloadResponse{
+		URL:       url,
+		ExpiresAt: now.Add(ExportDownloadURLExpiry),
+	}, nil
+}
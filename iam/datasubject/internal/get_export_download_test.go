@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig() *config.Config {
+	return &config.Config{Infra: config.InfraConfig{Minio: config.MinioConfig{Bucket: "iam-test"}}}
+}
+
+func TestGetExportDownload(t *testing.T) {
+	userID := uuid.New()
+	objectKey := "data-exports/archive.zip"
+	validUntil := time.Now().Add(time.Hour)
+	expiredAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		ownerID    uuid.UUID
+		status     entity.DataSubjectRequestStatus
+		expiresAt  *time.Time
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:      "presigns the archive for its owner",
+			ownerID:   userID,
+			status:    entity.DSRStatusCompleted,
+			expiresAt: &validUntil,
+		},
+		{
+			name:       "archive of another user",
+			ownerID:    uuid.New(),
+			status:     entity.DSRStatusCompleted,
+			expiresAt:  &validUntil,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "download window closed",
+			ownerID:    userID,
+			status:     entity.DSRStatusCompleted,
+			expiresAt:  &expiredAt,
+			wantStatus: http.StatusGone,
+			wantCode:   "EXPORT_EXPIRED",
+		},
+		{
+			name:       "export still queued",
+			ownerID:    userID,
+			status:     entity.DSRStatusPending,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &entity.DataSubjectRequest{
+				ID:        uuid.New(),
+				UserID:    tt.ownerID,
+				Type:      entity.DataSubjectRequestExport,
+				Status:    tt.status,
+				ExpiresAt: tt.expiresAt,
+			}
+			if tt.status == entity.DSRStatusCompleted {
+				request.ObjectKey = &objectKey
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("GetByID", mock.Anything, request.ID).Return(request, nil)
+			storage := new(MockFileStorage)
+			storage.On("GetPresignedURL", mock.Anything, "iam-test", objectKey, ExportDownloadURLExpiry).Return("https://storage.example.com/signed", nil).Maybe()
+
+			uc := &usecase{
+				Config:      newTestConfig(),
+				RequestRepo: requestRepo,
+				FileStorage: storage,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.GetExportDownload(context.Background(), userID, request.ID)
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				if tt.wantCode != "" {
+					assert.Equal(t, tt.wantCode, appErr.Code)
+				}
+				storage.AssertNotCalled(t, "GetPresignedURL", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "https://storage.example.com/signed", resp.URL)
+		})
+	}
+}
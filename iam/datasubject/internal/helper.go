@@ -0,0 +1,222 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// getOwnRequest loads a request on behalf of its owner. Requests belonging to
+// someone else are reported as not found.
+func (uc *usecase) getOwnRequest(ctx context.Context, userID, id uuid.UUID) (*entity.DataSubjectRequest, error) {
+	request, err := uc.getRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.UserID != userID {
+		return nil, errors.ErrNotFound("Data subject request not found")
+	}
+	return request, nil
+}
+
+func (uc *usecase) getRequest(ctx context.Context, id uuid.UUID) (*entity.DataSubjectRequest, error) {
+	request, err := uc.RequestRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Data subject request not found")
+		}
+		return nil, errors.ErrInternal("failed to get data subject request").WithError(err)
+	}
+	return request, nil
+}
+
+// createRequest opens a new request unless the user already has one of the
+// same type in flight.
+func (uc *usecase) createRequest(ctx context.Context, userID uuid.UUID, requestType entity.DataSubjectRequestType, reason *string) (*entity.DataSubjectRequest, error) {
+	user, err := uc.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get user").WithError(err)
+	}
+	if user.DeletedAt.Valid {
+		return nil, errors.ErrUserNotFound()
+	}
+
+	if _, err := uc.RequestRepo.GetOpenByUserAndType(ctx, userID, requestType); err == nil {
+		return nil, errors.ErrConflict("A " + string(requestType) + " request is already in progress")
+	} else if !errors.IsNotFound(err) {
+		return nil, errors.ErrInternal("failed to check open requests").WithError(err)
+	}
+
+	now := time.Now()
+	request := &entity.DataSubjectRequest{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Type:      requestType,
+		Status:    entity.DSRStatusPending,
+		Reason:    reason,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.RequestRepo.Create(ctx, request); err != nil {
+		if errors.IsConflict(err) {
+			return nil, errors.ErrConflict("A " + string(requestType) + " request is already in progress")
+		}
+		return nil, errors.ErrInternal("failed to create data subject request").WithError(err)
+	}
+	return request, nil
+}
+
+// markFailedAttempt puts a request back in the queue, or fails it for good
+// once it has used up its attempts.
+func (uc *usecase) markFailedAttempt(ctx context.Context, request *entity.DataSubjectRequest, retryStatus entity.DataSubjectRequestStatus, cause error) {
+	reason := cause.Error()
+	request.FailureReason = &reason
+	request.UpdatedAt = time.Now()
+	if request.Attempts >= ExportMaxAttempts {
+		request.Status = entity.DSRStatusFailed
+	} else {
+		request.Status = retryStatus
+	}
+	_ = uc.RequestRepo.Update(ctx, request)
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "data_subject_request_attempt_failed",
+		ActorType:  "system",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    false,
+		Reason:     reason,
+		Metadata: map[string]any{
+			"user_id":  request.UserID.String(),
+			"type":     string(request.Type),
+			"attempts": request.Attempts,
+			"status":   string(request.Status),
+		},
+	})
+}
+
+func exportObjectKey(request *entity.DataSubjectRequest) string {
+	return fmt.Sprintf("%s/%s/%s.zip", ExportObjectPrefix, request.UserID, request.ID)
+}
+
+func erasedEmail(userID uuid.UUID) string {
+	return fmt.Sprintf("erased-%s@%s", userID, ErasedEmailDomain)
+}
+
+type exportAuthMethod struct {
+	MethodType string    `json:"method_type"`
+	IsActive   bool      `json:"is_active"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// buildExportArchive writes each part of the snapshot as its own JSON file in
+// a zip archive. Credential material is never included.
+func buildExportArchive(snapshot *entity.PersonalDataSnapshot, generatedAt time.Time) ([]byte, error) {
+	authMethods := make([]exportAuthMethod, 0, len(snapshot.AuthMethods))
+	for _, method := range snapshot.AuthMethods {
+		authMethods = append(authMethods, exportAuthMethod{
+			MethodType: method.MethodType,
+			IsActive:   method.IsActive,
+			CreatedAt:  method.CreatedAt,
+			UpdatedAt:  method.UpdatedAt,
+		})
+	}
+
+	sections := []struct {
+		name string
+		data any
+	}{
+		{"manifest.json", map[string]any{
+			"user_id":      snapshot.User.ID,
+			"generated_at": generatedAt,
+		}},
+		{"account.json", snapshot.User},
+		{"profile.json", snapshot.Profile},
+		{"auth_methods.json", authMethods},
+		{"security_state.json", snapshot.SecurityState},
+		{"sessions.json", snapshot.Sessions},
+		{"tenant_registrations.json", snapshot.TenantRegistrations},
+		{"role_assignments.json", snapshot.RoleAssignments},
+		{"consents.json", snapshot.Consents},
+		{"auth_logs.json", snapshot.AuditLogs},
+		{"participants/participants.json", snapshot.Participants},
+		{"participants/identities.json", snapshot.ParticipantIdentities},
+		{"participants/addresses.json", snapshot.ParticipantAddresses},
+		{"participants/bank_accounts.json", snapshot.ParticipantBankAccounts},
+		{"participants/family_members.json", snapshot.ParticipantFamilyMembers},
+		{"participants/employments.json", snapshot.ParticipantEmployments},
+		{"participants/beneficiaries.json", snapshot.ParticipantBeneficiaries},
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, section := range sections {
+		w, err := archive.Create(section.name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(section.data); err != nil {
+			return nil, fmt.Errorf("encode %s: %w", section.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func mapRequestToResponse(request *entity.DataSubjectRequest) datasubjectdto.RequestResponse {
+	return datasubjectdto.RequestResponse{
+		ID:            request.ID,
+		UserID:        request.UserID,
+		Type:          string(request.Type),
+		Status:        string(request.Status),
+		Reason:        request.Reason,
+		ExpiresAt:     request.ExpiresAt,
+		ScheduledFor:  request.ScheduledFor,
+		ReviewedBy:    request.ReviewedBy,
+		ReviewedAt:    request.ReviewedAt,
+		ReviewNote:    request.ReviewNote,
+		FailureReason: request.FailureReason,
+		CompletedAt:   request.CompletedAt,
+		CreatedAt:     request.CreatedAt,
+	}
+}
+
+func mapRequestsToResponse(requests []*entity.DataSubjectRequest) []datasubjectdto.RequestResponse {
+	responses := make([]datasubjectdto.RequestResponse, 0, len(requests))
+	for _, request := range requests {
+		responses = append(responses, mapRequestToResponse(request))
+	}
+	return responses
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// userAvatarPrefix is the storage prefix under which the user module keeps
+// every uploaded avatar rendition of userID.
+func userAvatarPrefix(userID uuid.UUID) string {
+	return fmt.Sprintf("users/%s/avatar/", userID)
+}
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+)
+
+func (uc *usecase) List(ctx context.Context, req *datasubjectdto.ListRequest) (*datasubjectdto.ListResponse, error) {
+	req.SetDefaults()
+
+	filter := &contract.DataSubjectRequestListFilter{
+		UserID:  req.UserID,
+		Page:    req.Page,
+		PerPage: req.PerPage,
+	}
+	if req.Type != "" {
+		requestType := entity.DataSubjectRequestType(req.Type)
+		filter.Type = &requestType
+	}
+	if req.Status != "" {
+		status := entity.DataSubjectRequestStatus(req.Status)
+		filter.Status = &status
+	}
+
+	requests, total, err := uc.RequestRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list data subject requests").WithError(err)
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &datasubjectdto.ListResponse{
+		Requests: mapRequestsToResponse(requests),
+		Pagination: datasubjectdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
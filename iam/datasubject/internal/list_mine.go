@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/datasubject/contract"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+const listMineLimit = 50
+
+func (uc *usecase) ListMine(ctx context.Context, userID uuid.UUID) ([]datasubjectdto.RequestResponse, error) {
+	requests, _, err := uc.RequestRepo.List(ctx, &contract.DataSubjectRequestListFilter{
+		UserID:  &userID,
+		Page:    1,
+		PerPage: listMineLimit,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list data subject requests").WithError(err)
+	}
+	return mapRequestsToResponse(requests), nil
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+	if args.Get(0) == nil {
+		return fn(ctx)
+	}
+	return args.Error(0)
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	m := &MockTransactionManager{}
+	m.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return m
+}
+
+type MockDataSubjectRequestRepository struct {
+	mock.Mock
+}
+
+func (m *MockDataSubjectRequestRepository) Create(ctx context.Context, request *entity.DataSubjectRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockDataSubjectRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.DataSubjectRequest, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataSubjectRequest), args.Error(1)
+}
+
+func (m *MockDataSubjectRequestRepository) GetOpenByUserAndType(ctx context.Context, userID uuid.UUID, requestType entity.DataSubjectRequestType) (*entity.DataSubjectRequest, error) {
+	args := m.Called(ctx, userID, requestType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.DataSubjectRequest), args.Error(1)
+}
+
+func (m *MockDataSubjectRequestRepository) List(ctx context.Context, filter *contract.DataSubjectRequestListFilter) ([]*entity.DataSubjectRequest, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entity.DataSubjectRequest), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockDataSubjectRequestRepository) Update(ctx context.Context, request *entity.DataSubjectRequest) error {
+	args := m.Called(ctx, request)
+	return args.Error(0)
+}
+
+func (m *MockDataSubjectRequestRepository) ClaimPendingExports(ctx context.Context, limit int) ([]*entity.DataSubjectRequest, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.DataSubjectRequest), args.Error(1)
+}
+
+func (m *MockDataSubjectRequestRepository) ListDueErasures(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.DataSubjectRequest), args.Error(1)
+}
+
+func (m *MockDataSubjectRequestRepository) ListExpiredExports(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.DataSubjectRequest), args.Error(1)
+}
+
+type MockPersonalDataRepository struct {
+	mock.Mock
+}
+
+func (m *MockPersonalDataRepository) Collect(ctx context.Context, userID uuid.UUID) (*entity.PersonalDataSnapshot, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PersonalDataSnapshot), args.Error(1)
+}
+
+func (m *MockPersonalDataRepository) Anonymize(ctx context.Context, userID uuid.UUID, placeholderEmail string) error {
+	args := m.Called(ctx, userID, placeholderEmail)
+	return args.Error(0)
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+type MockFileStorage struct {
+	mock.Mock
+}
+
+func (m *MockFileStorage) UploadFile(ctx context.Context, bucket, objectKey string, data io.Reader, size int64, contentType string) (string, error) {
+	args := m.Called(ctx, bucket, objectKey, data, size, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockFileStorage) DeleteFile(ctx context.Context, bucket, objectKey string) error {
+	args := m.Called(ctx, bucket, objectKey)
+	return args.Error(0)
+}
+
+func (m *MockFileStorage) DeletePrefix(ctx context.Context, bucket, prefix string) error {
+	args := m.Called(ctx, bucket, prefix)
+	return args.Error(0)
+}
+
+func (m *MockFileStorage) GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, objectKey, expiry)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// ProcessDueErasures anonymizes the accounts whose erasure grace period has
+// run out. Audit records are kept; they only reference the user by ID, which
+// no longer resolves to anyone once the account is anonymized.
+func (uc *usecase) ProcessDueErasures(ctx context.Context) (int, error) {
+	requests, err := uc.RequestRepo.ListDueErasures(ctx, time.Now(), WorkerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, request := range requests {
+		request.Status = entity.DSRStatusProcessing
+		request.Attempts++
+		request.UpdatedAt = time.Now()
+		if err := uc.RequestRepo.Update(ctx, request); err != nil {
+			continue
+		}
+
+		if err := uc.eraseUser(ctx, request); err != nil {
+			uc.markFailedAttempt(ctx, request, entity.DSRStatusApproved, err)
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+func (uc *usecase) eraseUser(ctx context.Context, request *entity.DataSubjectRequest) error {
+	// Avatars go first so a storage outage fails the attempt and it is retried,
+	// rather than completing the erasure with the images left behind.
+	if err := uc.FileStorage.DeletePrefix(ctx, uc.Config.Infra.Minio.Bucket, userAvatarPrefix(request.UserID)); err != nil {
+		return fmt.Errorf("delete avatars: %w", err)
+	}
+
+	err := uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.PersonalDataRepo.Anonymize(txCtx, request.UserID, erasedEmail(request.UserID)); err != nil {
+			return fmt.Errorf("anonymize user: %w", err)
+		}
+
+		now := time.Now()
+		request.Status = entity.DSRStatusCompleted
+		request.CompletedAt = &now
+		request.FailureReason = nil
+		request.Reason = nil
+		request.UpdatedAt = now
+		return uc.RequestRepo.Update(txCtx, request)
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.deleteUserExports(ctx, request.UserID)
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "erasure_completed",
+		ActorType:  "system",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+		Metadata: map[string]any{
+			"user_id": request.UserID.String(),
+		},
+	})
+	return nil
+}
+
+// deleteUserExports removes any export archives the user still has in
+// storage.
+func (uc *usecase) deleteUserExports(ctx context.Context, userID uuid.UUID) {
+	exportType := entity.DataSubjectRequestExport
+	requests, _, err := uc.RequestRepo.List(ctx, &contract.DataSubjectRequestListFilter{
+		UserID:  &userID,
+		Type:    &exportType,
+		Page:    1,
+		PerPage: listMineLimit,
+	})
+	if err != nil {
+		return
+	}
+	for _, request := range requests {
+		uc.deleteExportArchive(ctx, request)
+	}
+}
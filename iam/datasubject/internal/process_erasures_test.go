@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessDueErasures(t *testing.T) {
+	userID := uuid.New()
+	exportKey := "data-exports/old.zip"
+
+	tests := []struct {
+		name          string
+		storageErr    error
+		wantCompleted int
+		wantStatus    entity.DataSubjectRequestStatus
+	}{
+		{
+			name:          "anonymizes the account and removes stored files",
+			wantCompleted: 1,
+			wantStatus:    entity.DSRStatusCompleted,
+		},
+		{
+			name:       "storage outage is retried before anonymizing",
+			storageErr: fmt.Errorf("storage unavailable"),
+			wantStatus: entity.DSRStatusApproved,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := "closing my account"
+			request := &entity.DataSubjectRequest{
+				ID:     uuid.New(),
+				UserID: userID,
+				Type:   entity.DataSubjectRequestErasure,
+				Status: entity.DSRStatusApproved,
+				Reason: &reason,
+			}
+			export := &entity.DataSubjectRequest{
+				ID:        uuid.New(),
+				UserID:    userID,
+				Type:      entity.DataSubjectRequestExport,
+				Status:    entity.DSRStatusCompleted,
+				ObjectKey: &exportKey,
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("ListDueErasures", mock.Anything, mock.Anything, WorkerBatchSize).Return([]*entity.DataSubjectRequest{request}, nil)
+			requestRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			requestRepo.On("List", mock.Anything, mock.MatchedBy(func(filter *contract.DataSubjectRequestListFilter) bool {
+				return *filter.UserID == userID && *filter.Type == entity.DataSubjectRequestExport
+			})).Return([]*entity.DataSubjectRequest{export}, int64(1), nil).Maybe()
+
+			personalDataRepo := new(MockPersonalDataRepository)
+			personalDataRepo.On("Anonymize", mock.Anything, userID, erasedEmail(userID)).Return(nil).Maybe()
+
+			storage := new(MockFileStorage)
+			storage.On("DeletePrefix", mock.Anything, "iam-test", userAvatarPrefix(userID)).Return(tt.storageErr)
+			storage.On("DeleteFile", mock.Anything, "iam-test", exportKey).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:        NewMockTransactionManager(),
+				Config:           newTestConfig(),
+				RequestRepo:      requestRepo,
+				PersonalDataRepo: personalDataRepo,
+				FileStorage:      storage,
+				AuditLogger:      logger.NewNoopAuditLogger(),
+			}
+
+			completed, err := uc.ProcessDueErasures(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCompleted, completed)
+			assert.Equal(t, tt.wantStatus, request.Status)
+			assert.Equal(t, 1, request.Attempts)
+
+			if tt.storageErr != nil {
+				personalDataRepo.AssertNotCalled(t, "Anonymize", mock.Anything, mock.Anything, mock.Anything)
+				storage.AssertNotCalled(t, "DeleteFile", mock.Anything, mock.Anything, mock.Anything)
+				require.NotNil(t, request.FailureReason)
+				return
+			}
+
+			personalDataRepo.AssertCalled(t, "Anonymize", mock.Anything, userID, erasedEmail(userID))
+			assert.Nil(t, request.Reason, "the user's free-text reason is erased with the account")
+			assert.NotNil(t, request.CompletedAt)
+			storage.AssertCalled(t, "DeleteFile", mock.Anything, "iam-test", exportKey)
+			assert.Nil(t, export.ObjectKey)
+		})
+	}
+}
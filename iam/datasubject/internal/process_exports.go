@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/logger"
+)
+
+// ProcessPendingExports builds the archive for each queued export and drops
+// archives whose download window has closed. It returns the number of
+// exports completed.
+func (uc *usecase) ProcessPendingExports(ctx context.Context) (int, error) {
+	uc.purgeExpiredExports(ctx)
+
+	requests, err := uc.RequestRepo.ClaimPendingExports(ctx, WorkerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, request := range requests {
+		if err := uc.generateExport(ctx, request); err != nil {
+			uc.markFailedAttempt(ctx, request, entity.DSRStatusPending, err)
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+func (uc *usecase) generateExport(ctx context.Context, request *entity.DataSubjectRequest) error {
+	snapshot, err := uc.PersonalDataRepo.Collect(ctx, request.UserID)
+	if err != nil {
+		return fmt.Errorf("collect personal data: %w", err)
+	}
+
+	now := time.Now()
+	archive, err := buildExportArchive(snapshot, now)
+	if err != nil {
+		return fmt.Errorf("build archive: %w", err)
+	}
+
+	objectKey := exportObjectKey(request)
+	if _, err := uc.FileStorage.UploadFile(ctx, uc.Config.Infra.Minio.Bucket, objectKey, bytes.NewReader(archive), int64(len(archive)), "application/zip"); err != nil {
+		return fmt.Errorf("upload archive: %w", err)
+	}
+
+	expiresAt := now.Add(ExportLinkExpiry)
+	request.Status = entity.DSRStatusCompleted
+	request.ObjectKey = &objectKey
+	request.ExpiresAt = &expiresAt
+	request.CompletedAt = &now
+	request.FailureReason = nil
+	request.UpdatedAt = now
+	if err := uc.RequestRepo.Update(ctx, request); err != nil {
+		_ = uc.FileStorage.DeleteFile(ctx, uc.Config.Infra.Minio.Bucket, objectKey)
+		return fmt.Errorf("update request: %w", err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "data_export_completed",
+		ActorType:  "system",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+		Metadata: map[string]any{
+			"user_id":    request.UserID.String(),
+			"size_bytes": len(archive),
+		},
+	})
+	return nil
+}
+
+func (uc *usecase) purgeExpiredExports(ctx context.Context) {
+	requests, err := uc.RequestRepo.ListExpiredExports(ctx, time.Now(), WorkerBatchSize)
+	if err != nil {
+		return
+	}
+	for _, request := range requests {
+		uc.deleteExportArchive(ctx, request)
+	}
+}
+
+func (uc *usecase) deleteExportArchive(ctx context.Context, request *entity.DataSubjectRequest) {
+	if request.ObjectKey == nil {
+		return
+	}
+	if err := uc.FileStorage.DeleteFile(ctx, uc.Config.Infra.Minio.Bucket, *request.ObjectKey); err != nil {
+		return
+	}
+	request.ObjectKey = nil
+	request.UpdatedAt = time.Now()
+	_ = uc.RequestRepo.Update(ctx, request)
+}
@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessPendingExports(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name          string
+		attempts      int
+		collectErr    error
+		wantCompleted int
+		wantStatus    entity.DataSubjectRequestStatus
+	}{
+		{
+			name:          "uploads the archive and completes the request",
+			attempts:      1,
+			wantCompleted: 1,
+			wantStatus:    entity.DSRStatusCompleted,
+		},
+		{
+			name:       "failed attempt is queued again",
+			attempts:   1,
+			collectErr: fmt.Errorf("connection reset"),
+			wantStatus: entity.DSRStatusPending,
+		},
+		{
+			name:       "last attempt fails the request",
+			attempts:   ExportMaxAttempts,
+			collectErr: fmt.Errorf("connection reset"),
+			wantStatus: entity.DSRStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &entity.DataSubjectRequest{
+				ID:       uuid.New(),
+				UserID:   userID,
+				Type:     entity.DataSubjectRequestExport,
+				Status:   entity.DSRStatusProcessing,
+				Attempts: tt.attempts,
+			}
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			requestRepo.On("ListExpiredExports", mock.Anything, mock.Anything, WorkerBatchSize).Return([]*entity.DataSubjectRequest{}, nil)
+			requestRepo.On("ClaimPendingExports", mock.Anything, WorkerBatchSize).Return([]*entity.DataSubjectRequest{request}, nil)
+			requestRepo.On("Update", mock.Anything, request).Return(nil)
+
+			personalDataRepo := new(MockPersonalDataRepository)
+			if tt.collectErr != nil {
+				personalDataRepo.On("Collect", mock.Anything, userID).Return(nil, tt.collectErr)
+			} else {
+				personalDataRepo.On("Collect", mock.Anything, userID).Return(&entity.PersonalDataSnapshot{
+					User: &entity.User{ID: userID, Email: "subject@example.com"},
+					AuthMethods: []entity.UserAuthMethod{
+						{MethodType: string(entity.AuthMethodPassword), CredentialData: []byte(`{"password_hash":"$2a$10$secret"}`)},
+					},
+					Consents: []entity.UserConsent{
+						{UserID: userID, DocumentType: entity.ConsentDocumentTerms, Version: "2.0"},
+					},
+				}, nil)
+			}
+
+			var uploaded []byte
+			storage := new(MockFileStorage)
+			storage.On("UploadFile", mock.Anything, "iam-test", exportObjectKey(request), mock.Anything, mock.Anything, "application/zip").
+				Run(func(args mock.Arguments) {
+					data, err := io.ReadAll(args.Get(3).(io.Reader))
+					require.NoError(t, err)
+					uploaded = data
+				}).
+				Return("", nil).Maybe()
+
+			uc := &usecase{
+				Config:           newTestConfig(),
+				RequestRepo:      requestRepo,
+				PersonalDataRepo: personalDataRepo,
+				FileStorage:      storage,
+				AuditLogger:      logger.NewNoopAuditLogger(),
+			}
+
+			completed, err := uc.ProcessPendingExports(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantCompleted, completed)
+			assert.Equal(t, tt.wantStatus, request.Status)
+
+			if tt.collectErr != nil {
+				require.NotNil(t, request.FailureReason)
+				assert.Contains(t, *request.FailureReason, "connection reset")
+				storage.AssertNotCalled(t, "UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NotNil(t, request.ObjectKey)
+			require.NotNil(t, request.ExpiresAt)
+			archive, err := zip.NewReader(bytes.NewReader(uploaded), int64(len(uploaded)))
+			require.NoError(t, err)
+			files := make(map[string]string, len(archive.File))
+			for _, file := range archive.File {
+				rc, err := file.Open()
+				require.NoError(t, err)
+				content, err := io.ReadAll(rc)
+				require.NoError(t, err)
+				require.NoError(t, rc.Close())
+				files[file.Name] = string(content)
+			}
+			assert.Contains(t, files["account.json"], "subject@example.com")
+			assert.Contains(t, files["auth_methods.json"], string(entity.AuthMethodPassword))
+			assert.Contains(t, files["consents.json"], `"version": "2.0"`)
+			assert.NotContains(t, files["auth_methods.json"], "$2a$10$secret", "credential material must not be exported")
+		})
+	}
+}
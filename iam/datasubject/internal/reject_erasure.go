@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) RejectErasure(ctx context.Context, id, reviewerID uuid.UUID, req *datasubjectdto.ReviewRequest) (*datasubjectdto.RequestResponse, error) {
+	note := strings.TrimSpace(req.Note)
+	if note == "" {
+		return nil, errors.ErrBadRequest("A note explaining the rejection is required")
+	}
+
+	request, err := uc.getPendingErasure(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	request.Status = entity.DSRStatusRejected
+	request.ReviewedBy = &reviewerID
+	request.ReviewedAt = &now
+	request.ReviewNote = &note
+	request.UpdatedAt = now
+
+	if err := uc.RequestRepo.Update(ctx, request); err != nil {
+		return nil, errors.ErrInternal("failed to reject erasure request").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "erasure_rejected",
+		ActorID:    reviewerID.String(),
+		ActorType:  "user",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+		Reason:     note,
+		Metadata: map[string]any{
+			"user_id": request.UserID.String(),
+		},
+	})
+
+	response := mapRequestToResponse(request)
+	return &response, nil
+}
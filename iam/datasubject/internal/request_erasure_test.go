@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestErasure(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		user       *entity.User
+		userErr    error
+		open       *entity.DataSubjectRequest
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name: "opens a pending erasure for the caller",
+			user: &entity.User{ID: userID},
+		},
+		{
+			name:       "erasure already in progress",
+			user:       &entity.User{ID: userID},
+			open:       &entity.DataSubjectRequest{ID: uuid.New(), UserID: userID, Type: entity.DataSubjectRequestErasure, Status: entity.DSRStatusApproved},
+			wantStatus: http.StatusConflict,
+			wantCode:   errors.CodeConflict,
+		},
+		{
+			name:       "account already erased",
+			user:       &entity.User{ID: userID, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}},
+			wantStatus: http.StatusNotFound,
+			wantCode:   errors.CodeUserNotFound,
+		},
+		{
+			name:       "unknown user",
+			userErr:    errors.ErrNotFound("user not found"),
+			wantStatus: http.StatusNotFound,
+			wantCode:   errors.CodeUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			userRepo.On("GetByID", mock.Anything, userID).Return(tt.user, tt.userErr)
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			if tt.open != nil {
+				requestRepo.On("GetOpenByUserAndType", mock.Anything, userID, entity.DataSubjectRequestErasure).Return(tt.open, nil)
+			} else {
+				requestRepo.On("GetOpenByUserAndType", mock.Anything, userID, entity.DataSubjectRequestErasure).Return(nil, errors.ErrNotFound("not found")).Maybe()
+			}
+			requestRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			uc := &usecase{
+				RequestRepo: requestRepo,
+				UserRepo:    userRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.RequestErasure(context.Background(), userID, &datasubjectdto.ErasureRequest{Reason: "closing my account"})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				requestRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, userID, resp.UserID, "an erasure is always filed for the caller's own account")
+			assert.Equal(t, string(entity.DataSubjectRequestErasure), resp.Type)
+			assert.Equal(t, string(entity.DSRStatusPending), resp.Status)
+			require.NotNil(t, resp.Reason)
+			assert.Equal(t, "closing my account", *resp.Reason)
+			assert.Nil(t, resp.ScheduledFor, "erasure waits for review before it is scheduled")
+			requestRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(request *entity.DataSubjectRequest) bool {
+				return request.UserID == userID && request.Type == entity.DataSubjectRequestErasure
+			}))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/datasubjectdto"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) RequestExport(ctx context.Context, userID uuid.UUID) (*datasubjectdto.RequestResponse, error) {
+	request, err := uc.createRequest(ctx, userID, entity.DataSubjectRequestExport, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "data_subject",
+		Action:     "data_export_requested",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   request.ID.String(),
+		TargetType: "data_subject_request",
+		Success:    true,
+	})
+
+	response := mapRequestToResponse(request)
+	return &response, nil
+}
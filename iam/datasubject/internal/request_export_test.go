@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestExport(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name       string
+		open       *entity.DataSubjectRequest
+		createErr  error
+		wantStatus int
+	}{
+		{
+			name: "queues an export for the caller",
+		},
+		{
+			name:       "export already in progress",
+			open:       &entity.DataSubjectRequest{ID: uuid.New(), UserID: userID, Type: entity.DataSubjectRequestExport, Status: entity.DSRStatusProcessing},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:       "concurrent request wins the unique index",
+			createErr:  errors.ErrConflict("duplicate"),
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := new(MockUserRepository)
+			userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{ID: userID}, nil)
+
+			requestRepo := new(MockDataSubjectRequestRepository)
+			if tt.open != nil {
+				requestRepo.On("GetOpenByUserAndType", mock.Anything, userID, entity.DataSubjectRequestExport).Return(tt.open, nil)
+			} else {
+				requestRepo.On("GetOpenByUserAndType", mock.Anything, userID, entity.DataSubjectRequestExport).Return(nil, errors.ErrNotFound("not found"))
+			}
+			requestRepo.On("Create", mock.Anything, mock.Anything).Return(tt.createErr).Maybe()
+
+			uc := &usecase{
+				RequestRepo: requestRepo,
+				UserRepo:    userRepo,
+				AuditLogger: logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.RequestExport(context.Background(), userID)
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, userID, resp.UserID)
+			assert.Equal(t, string(entity.DataSubjectRequestExport), resp.Type)
+			assert.Equal(t, string(entity.DSRStatusPending), resp.Status)
+		})
+	}
+}
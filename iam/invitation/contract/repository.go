@@ -0,0 +1,79 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type InvitationListFilter struct {
+	TenantID *uuid.UUID
+	Status   *entity.InvitationStatus
+	Email    string
+	Page     int
+	PerPage  int
+}
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *entity.Invitation) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error)
+	GetByTokenHash(ctx context.Context, tokenHash string) (*entity.Invitation, error)
+	GetPendingByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*entity.Invitation, error)
+	List(ctx context.Context, filter *InvitationListFilter) ([]*entity.Invitation, int64, error)
+	Update(ctx context.Context, invitation *entity.Invitation) error
+}
+
+type TenantRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error)
+}
+
+type BranchRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error)
+}
+
+type RoleRepository interface {
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
+}
+
+type UserRepository interface {
+	Create(ctx context.Context, user *entity.User) error
+	EmailExists(ctx context.Context, email string) (bool, error)
+}
+
+type UserProfileRepository interface {
+	Create(ctx context.Context, profile *entity.UserProfile) error
+}
+
+type UserAuthMethodRepository interface {
+	Create(ctx context.Context, authMethod *entity.UserAuthMethod) error
+}
+
+type UserSecurityStateRepository interface {
+	Create(ctx context.Context, securityState *entity.UserSecurityState) error
+}
+
+type UserRoleRepository interface {
+	Create(ctx context.Context, userRole *entity.UserRole) error
+}
+
+type UserTenantRegistrationRepository interface {
+	Create(ctx context.Context, registration *entity.UserTenantRegistration) error
+}
+
+type PasswordPolicyRepository interface {
+	Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+}
+
+type PasswordHistoryRepository interface {
+	Create(ctx context.Context, history *entity.PasswordHistory) error
+}
+
+type BreachedPasswordChecker interface {
+	IsBreached(password string) bool
+}
+
+type EmailService interface {
+	SendAdminInvitation(ctx context.Context, email, token string, expiryMinutes int) error
+}
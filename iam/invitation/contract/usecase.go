@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/invitation/invitationdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, invitedBy uuid.UUID, req *invitationdto.CreateRequest) (*invitationdto.InvitationResponse, error)
+	List(ctx context.Context, req *invitationdto.ListRequest) (*invitationdto.ListResponse, error)
+	Resend(ctx context.Context, id uuid.UUID, resentBy uuid.UUID) (*invitationdto.InvitationResponse, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedBy uuid.UUID) (*invitationdto.InvitationResponse, error)
+	Accept(ctx context.Context, req *invitationdto.AcceptRequest) (*invitationdto.AcceptResponse, error)
+}
@@ -0,0 +1,50 @@
+package invitation
+
+import (
+	"iam-service/config"
+	"iam-service/iam/invitation/contract"
+	"iam-service/iam/invitation/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	invitationRepo contract.InvitationRepository,
+	tenantRepo contract.TenantRepository,
+	branchRepo contract.BranchRepository,
+	roleRepo contract.RoleRepository,
+	userRepo contract.UserRepository,
+	userProfileRepo contract.UserProfileRepository,
+	userAuthMethodRepo contract.UserAuthMethodRepository,
+	userSecurityStateRepo contract.UserSecurityStateRepository,
+	userRoleRepo contract.UserRoleRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	emailService contract.EmailService,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		invitationRepo,
+		tenantRepo,
+		branchRepo,
+		roleRepo,
+		userRepo,
+		userProfileRepo,
+		userAuthMethodRepo,
+		userSecurityStateRepo,
+		userRoleRepo,
+		userTenantRegRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		emailService,
+		auditLogger,
+	)
+}
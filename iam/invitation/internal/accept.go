@@ -0,0 +1,172 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+	"iam-service/pkg/password"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Accept creates the invitee's account with the password they chose and
+// applies the tenant registration, roles and branch set on the invitation.
+func (uc *usecase) Accept(ctx context.Context, req *invitationdto.AcceptRequest) (*invitationdto.AcceptResponse, error) {
+	if err := uc.validateInvitationToken(req.Token); err != nil {
+		return nil, err
+	}
+
+	invitation, err := uc.InvitationRepo.GetByTokenHash(ctx, hashToken(req.Token))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUnauthorized("Invitation token is invalid")
+		}
+		return nil, errors.ErrInternal("failed to get invitation").WithError(err)
+	}
+	if err := uc.ensureOpen(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	emailExists, err := uc.UserRepo.EmailExists(ctx, invitation.Email)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to check email").WithError(err)
+	}
+	if emailExists {
+		return nil, errors.ErrUserAlreadyExists()
+	}
+
+	roles, err := uc.resolveRoles(ctx, invitation.TenantID, invitation.GetRoleIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := password.ResolveTenantPolicy(ctx, uc.PasswordPolicyRepo, invitation.TenantID, roleProductIDs(roles))
+	if err != nil {
+		return nil, err
+	}
+	warning, err := uc.checkPassword(policy, req.Password, password.ContextWords(invitation.Email, req.FirstName, req.LastName)...)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to hash password").WithError(err)
+	}
+	passwordHashStr := string(passwordHash)
+
+	now := time.Now()
+	var userID uuid.UUID
+	roleIDs := make([]uuid.UUID, 0, len(roles))
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		user := &entity.User{
+			Email:              invitation.Email,
+			Status:             entity.UserStatusActive,
+			StatusChangedAt:    &now,
+			RegistrationSource: entity.RegistrationSourceInvitation,
+		}
+		if err := uc.UserRepo.Create(txCtx, user); err != nil {
+			return err
+		}
+		userID = user.ID
+
+		authMethod := entity.NewPasswordAuthMethod(user.ID, passwordHashStr)
+		if err := uc.UserAuthMethodRepo.Create(txCtx, authMethod); err != nil {
+			return err
+		}
+
+		if err := uc.PasswordHistoryRepo.Create(txCtx, &entity.PasswordHistory{
+			UserID:       user.ID,
+			PasswordHash: passwordHashStr,
+			CreatedAt:    now,
+		}); err != nil {
+			return err
+		}
+
+		profile := &entity.UserProfile{
+			UserID:    user.ID,
+			FirstName: req.FirstName,
+			LastName:  req.LastName,
+			UpdatedAt: now,
+		}
+		if err := uc.UserProfileRepo.Create(txCtx, profile); err != nil {
+			return err
+		}
+
+		securityState := &entity.UserSecurityState{
+			UserID:            user.ID,
+			EmailVerified:     true,
+			EmailVerifiedAt:   &now,
+			PasswordChangedAt: &now,
+			UpdatedAt:         now,
+		}
+		if err := uc.UserSecurityStateRepo.Create(txCtx, securityState); err != nil {
+			return err
+		}
+
+		registration := &entity.UserTenantRegistration{
+			UserID:           user.ID,
+			TenantID:         invitation.TenantID,
+			RegistrationType: entity.RegistrationTypeMember,
+			Status:           entity.UTRStatusActive,
+			ApprovedBy:       &invitation.InvitedBy,
+			ApprovedAt:       &now,
+			Metadata:         []byte(`{}`),
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		if err := uc.UserTenantRegRepo.Create(txCtx, registration); err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			userRole := &entity.UserRole{
+				UserID:        user.ID,
+				RoleID:        role.ID,
+				ProductID:     role.ProductID,
+				BranchID:      invitation.BranchID,
+				EffectiveFrom: now,
+				CreatedAt:     now,
+			}
+			if err := uc.UserRoleRepo.Create(txCtx, userRole); err != nil {
+				return err
+			}
+			roleIDs = append(roleIDs, role.ID)
+		}
+
+		invitation.Status = entity.InvitationStatusAccepted
+		invitation.AcceptedAt = &now
+		invitation.AcceptedUserID = &user.ID
+		invitation.UpdatedAt = now
+		return uc.InvitationRepo.Update(txCtx, invitation)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to accept invitation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "invitation",
+		Action:     "invitation_accepted",
+		ActorID:    userID.String(),
+		ActorType:  "user",
+		TargetID:   invitation.ID.String(),
+		TargetType: "invitation",
+		TenantID:   invitation.TenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"role_ids": roleIDs},
+	})
+
+	return &invitationdto.AcceptResponse{
+		UserID:   userID,
+		Email:    invitation.Email,
+		TenantID: invitation.TenantID,
+		RoleIDs:  roleIDs,
+		Warnings: passwordWarnings(warning),
+	}, nil
+}
@@ -0,0 +1,206 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+	"iam-service/pkg/password"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccept(t *testing.T) {
+	tenantID := uuid.New()
+	productID := uuid.New()
+	roleID := uuid.New()
+	invitedBy := uuid.New()
+
+	strictAppPolicy := &entity.PasswordPolicy{
+		TenantID:    &tenantID,
+		ProductID:   &productID,
+		MinLength:   16,
+		MaxLength:   128,
+		BreachCheck: string(password.BreachModeOff),
+	}
+
+	tests := []struct {
+		name       string
+		status     entity.InvitationStatus
+		expiresAt  time.Time
+		tokenTTL   time.Duration
+		password   string
+		appPolicy  *entity.PasswordPolicy
+		wantStatus int
+		wantCode   string
+		wantUpdate entity.InvitationStatus
+	}{
+		{
+			name:       "accepts pending invitation",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(time.Hour),
+			password:   "Corr3ct-Horse-Battery",
+			wantUpdate: entity.InvitationStatusAccepted,
+		},
+		{
+			name:       "expired token",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(-time.Minute),
+			password:   "Corr3ct-Horse-Battery",
+			wantStatus: http.StatusGone,
+			wantCode:   "INVITATION_EXPIRED",
+		},
+		{
+			name:       "invitation expired before its token",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(-time.Minute),
+			tokenTTL:   time.Hour,
+			password:   "Corr3ct-Horse-Battery",
+			wantStatus: http.StatusGone,
+			wantCode:   "INVITATION_EXPIRED",
+			wantUpdate: entity.InvitationStatusExpired,
+		},
+		{
+			name:       "revoked invitation",
+			status:     entity.InvitationStatusRevoked,
+			expiresAt:  time.Now().Add(time.Hour),
+			password:   "Corr3ct-Horse-Battery",
+			wantStatus: http.StatusGone,
+			wantCode:   "INVITATION_REVOKED",
+		},
+		{
+			name:       "already accepted invitation",
+			status:     entity.InvitationStatusAccepted,
+			expiresAt:  time.Now().Add(time.Hour),
+			password:   "Corr3ct-Horse-Battery",
+			wantStatus: http.StatusConflict,
+			wantCode:   errors.CodeConflict,
+		},
+		{
+			name:       "password below the application policy",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(time.Hour),
+			password:   "Corr3ct-Horse",
+			appPolicy:  strictAppPolicy,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   errors.CodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{Config: newTestConfig()}
+
+			invitation := &entity.Invitation{
+				ID:        uuid.New(),
+				TenantID:  tenantID,
+				Email:     "new.admin@example.com",
+				RoleIDs:   []byte(`["` + roleID.String() + `"]`),
+				Status:    tt.status,
+				ExpiresAt: tt.expiresAt,
+				InvitedBy: invitedBy,
+			}
+			tokenSource := *invitation
+			if tt.tokenTTL != 0 {
+				tokenSource.ExpiresAt = time.Now().Add(tt.tokenTTL)
+			}
+			token, tokenHash, err := uc.generateInvitationToken(&tokenSource)
+			require.NoError(t, err)
+
+			invitationRepo := new(MockInvitationRepository)
+			invitationRepo.On("GetByTokenHash", mock.Anything, tokenHash).Return(invitation, nil).Maybe()
+			invitationRepo.On("Update", mock.Anything, invitation).Return(nil).Maybe()
+
+			userRepo := new(MockUserRepository)
+			userRepo.On("EmailExists", mock.Anything, invitation.Email).Return(false, nil).Maybe()
+			userRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{roleID}).Return([]*entity.Role{
+				{ID: roleID, TenantID: &tenantID, ProductID: &productID, Code: "cashier", IsActive: true},
+			}, nil).Maybe()
+
+			policyRepo := new(MockPasswordPolicyRepository)
+			policyRepo.On("Resolve", mock.Anything, &tenantID, (*uuid.UUID)(nil)).Return(nil, errors.ErrNotFound("not found")).Maybe()
+			if tt.appPolicy != nil {
+				policyRepo.On("Resolve", mock.Anything, &tenantID, &productID).Return(tt.appPolicy, nil).Maybe()
+			} else {
+				policyRepo.On("Resolve", mock.Anything, &tenantID, &productID).Return(nil, errors.ErrNotFound("not found")).Maybe()
+			}
+
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			authMethodRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			historyRepo := new(MockPasswordHistoryRepository)
+			historyRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			profileRepo := new(MockUserProfileRepository)
+			profileRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			securityRepo := new(MockUserSecurityStateRepository)
+			securityRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			regRepo := new(MockUserTenantRegistrationRepository)
+			regRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			uc.TxManager = NewMockTransactionManager()
+			uc.InvitationRepo = invitationRepo
+			uc.RoleRepo = roleRepo
+			uc.UserRepo = userRepo
+			uc.UserProfileRepo = profileRepo
+			uc.UserAuthMethodRepo = authMethodRepo
+			uc.UserSecurityStateRepo = securityRepo
+			uc.UserRoleRepo = userRoleRepo
+			uc.UserTenantRegRepo = regRepo
+			uc.PasswordPolicyRepo = policyRepo
+			uc.PasswordHistoryRepo = historyRepo
+			uc.BreachChecker = password.NoopBreachChecker{}
+			uc.AuditLogger = logger.NewNoopAuditLogger()
+
+			resp, err := uc.Accept(context.Background(), &invitationdto.AcceptRequest{
+				Token:                token,
+				FirstName:            "New",
+				LastName:             "Admin",
+				Password:             tt.password,
+				ConfirmationPassword: tt.password,
+			})
+
+			switch tt.wantUpdate {
+			case "":
+				invitationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			default:
+				invitationRepo.AssertCalled(t, "Update", mock.Anything, invitation)
+				assert.Equal(t, tt.wantUpdate, invitation.Status)
+			}
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				userRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, invitation.Email, resp.Email)
+			assert.Equal(t, tenantID, resp.TenantID)
+			assert.Equal(t, []uuid.UUID{roleID}, resp.RoleIDs)
+			assert.Equal(t, &resp.UserID, invitation.AcceptedUserID)
+			regRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(reg *entity.UserTenantRegistration) bool {
+				return reg.TenantID == tenantID && reg.Status == entity.UTRStatusActive && *reg.ApprovedBy == invitedBy
+			}))
+			userRoleRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(userRole *entity.UserRole) bool {
+				return userRole.RoleID == roleID && *userRole.ProductID == productID
+			}))
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/invitation/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager             contract.TransactionManager
+	Config                *config.Config
+	InvitationRepo        contract.InvitationRepository
+	TenantRepo            contract.TenantRepository
+	BranchRepo            contract.BranchRepository
+	RoleRepo              contract.RoleRepository
+	UserRepo              contract.UserRepository
+	UserProfileRepo       contract.UserProfileRepository
+	UserAuthMethodRepo    contract.UserAuthMethodRepository
+	UserSecurityStateRepo contract.UserSecurityStateRepository
+	UserRoleRepo          contract.UserRoleRepository
+	UserTenantRegRepo     contract.UserTenantRegistrationRepository
+	PasswordPolicyRepo    contract.PasswordPolicyRepository
+	PasswordHistoryRepo   contract.PasswordHistoryRepository
+	BreachChecker         contract.BreachedPasswordChecker
+	EmailService          contract.EmailService
+	AuditLogger           logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	invitationRepo contract.InvitationRepository,
+	tenantRepo contract.TenantRepository,
+	branchRepo contract.BranchRepository,
+	roleRepo contract.RoleRepository,
+	userRepo contract.UserRepository,
+	userProfileRepo contract.UserProfileRepository,
+	userAuthMethodRepo contract.UserAuthMethodRepository,
+	userSecurityStateRepo contract.UserSecurityStateRepository,
+	userRoleRepo contract.UserRoleRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	emailService contract.EmailService,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:             txManager,
+		Config:                cfg,
+		InvitationRepo:        invitationRepo,
+		TenantRepo:            tenantRepo,
+		BranchRepo:            branchRepo,
+		RoleRepo:              roleRepo,
+		UserRepo:              userRepo,
+		UserProfileRepo:       userProfileRepo,
+		UserAuthMethodRepo:    userAuthMethodRepo,
+		UserSecurityStateRepo: userSecurityStateRepo,
+		UserRoleRepo:          userRoleRepo,
+		UserTenantRegRepo:     userTenantRegRepo,
+		PasswordPolicyRepo:    passwordPolicyRepo,
+		PasswordHistoryRepo:   passwordHistoryRepo,
+		BreachChecker:         breachChecker,
+		EmailService:          emailService,
+		AuditLogger:           auditLogger,
+	}
+}
@@ -0,0 +1,7 @@
+package internal
+
+const (
+	InvitationExpiryMinutes = 72 * 60
+	InvitationMaxResends    = 5
+	InvitationTokenPurpose  = "invitation"
+)
@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Create(ctx context.Context, invitedBy uuid.UUID, req *invitationdto.CreateRequest) (*invitationdto.InvitationResponse, error) {
+	tenant, err := uc.TenantRepo.GetByID(ctx, req.TenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrTenantNotFound()
+		}
+		return nil, errors.ErrInternal("failed to verify tenant").WithError(err)
+	}
+	if !tenant.IsActive() {
+		return nil, errors.ErrTenantInactive()
+	}
+
+	email := normalizeEmail(req.Email)
+	emailExists, err := uc.UserRepo.EmailExists(ctx, email)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to check email").WithError(err)
+	}
+	if emailExists {
+		return nil, errors.ErrUserAlreadyExists()
+	}
+
+	roles, err := uc.resolveRoles(ctx, req.TenantID, req.RoleIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verifyBranch(ctx, req.TenantID, req.BranchID); err != nil {
+		return nil, err
+	}
+
+	pending, err := uc.InvitationRepo.GetPendingByEmail(ctx, req.TenantID, email)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.ErrInternal("failed to check pending invitations").WithError(err)
+	}
+	if pending != nil {
+		if !pending.IsExpired() {
+			return nil, errors.ErrConflict("An invitation for this email is already pending")
+		}
+		uc.markExpired(ctx, pending)
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(roles))
+	for _, role := range roles {
+		roleIDs = append(roleIDs, role.ID)
+	}
+	roleIDsJSON, err := json.Marshal(roleIDs)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to encode roles").WithError(err)
+	}
+
+	now := time.Now()
+	invitation := &entity.Invitation{
+		TenantID:   req.TenantID,
+		BranchID:   req.BranchID,
+		Email:      email,
+		RoleIDs:    roleIDsJSON,
+		Status:     entity.InvitationStatusPending,
+		ExpiresAt:  now.Add(InvitationExpiryMinutes * time.Minute),
+		LastSentAt: now,
+		InvitedBy:  invitedBy,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	token, tokenHash, err := uc.generateInvitationToken(invitation)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate invitation token").WithError(err)
+	}
+	invitation.TokenHash = tokenHash
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.InvitationRepo.Create(txCtx, invitation); err != nil {
+			return err
+		}
+		return uc.EmailService.SendAdminInvitation(txCtx, email, token, InvitationExpiryMinutes)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to create invitation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "invitation",
+		Action:     "invitation_created",
+		ActorID:    invitedBy.String(),
+		ActorType:  "user",
+		TargetID:   invitation.ID.String(),
+		TargetType: "invitation",
+		TenantID:   invitation.TenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"email": email, "role_ids": roleIDs},
+	})
+
+	response := mapInvitationToResponse(invitation)
+	return &response, nil
+}
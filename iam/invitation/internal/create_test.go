@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestConfig() *config.Config {
+	return &config.Config{JWT: config.JWTConfig{RegistrationSecret: "invitation-test-secret"}}
+}
+
+func TestCreate(t *testing.T) {
+	tenantID := uuid.New()
+	invitedBy := uuid.New()
+	roleID := uuid.New()
+	otherTenantID := uuid.New()
+
+	tests := []struct {
+		name           string
+		tenant         *entity.Tenant
+		emailExists    bool
+		role           *entity.Role
+		pending        *entity.Invitation
+		wantStatus     int
+		wantCode       string
+		wantCreated    bool
+		wantMarkExpire bool
+	}{
+		{
+			name:        "creates pending invitation and sends email",
+			tenant:      &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive},
+			role:        &entity.Role{ID: roleID, TenantID: &tenantID, Code: "staff", IsActive: true},
+			wantCreated: true,
+		},
+		{
+			name:       "inactive tenant",
+			tenant:     &entity.Tenant{ID: tenantID, Status: entity.TenantStatusSuspended},
+			wantStatus: http.StatusForbidden,
+			wantCode:   errors.CodeTenantInactive,
+		},
+		{
+			name:        "email already registered",
+			tenant:      &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive},
+			emailExists: true,
+			wantStatus:  http.StatusConflict,
+			wantCode:    errors.CodeUserAlreadyExists,
+		},
+		{
+			name:       "role of another tenant",
+			tenant:     &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive},
+			role:       &entity.Role{ID: roleID, TenantID: &otherTenantID, Code: "staff", IsActive: true},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "pending invitation already open",
+			tenant:     &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive},
+			role:       &entity.Role{ID: roleID, TenantID: &tenantID, Code: "staff", IsActive: true},
+			pending:    &entity.Invitation{ID: uuid.New(), Status: entity.InvitationStatusPending, ExpiresAt: time.Now().Add(time.Hour)},
+			wantStatus: http.StatusConflict,
+			wantCode:   errors.CodeConflict,
+		},
+		{
+			name:           "expired pending invitation is replaced",
+			tenant:         &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive},
+			role:           &entity.Role{ID: roleID, TenantID: &tenantID, Code: "staff", IsActive: true},
+			pending:        &entity.Invitation{ID: uuid.New(), Status: entity.InvitationStatusPending, ExpiresAt: time.Now().Add(-time.Hour)},
+			wantCreated:    true,
+			wantMarkExpire: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantRepo := new(MockTenantRepository)
+			tenantRepo.On("GetByID", mock.Anything, tenantID).Return(tt.tenant, nil)
+
+			userRepo := new(MockUserRepository)
+			userRepo.On("EmailExists", mock.Anything, "new.admin@example.com").Return(tt.emailExists, nil).Maybe()
+
+			roleRepo := new(MockRoleRepository)
+			if tt.role != nil {
+				roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{roleID}).Return([]*entity.Role{tt.role}, nil)
+			}
+
+			invitationRepo := new(MockInvitationRepository)
+			if tt.pending != nil {
+				invitationRepo.On("GetPendingByEmail", mock.Anything, tenantID, "new.admin@example.com").Return(tt.pending, nil)
+			} else {
+				invitationRepo.On("GetPendingByEmail", mock.Anything, tenantID, "new.admin@example.com").Return(nil, errors.ErrNotFound("not found")).Maybe()
+			}
+			invitationRepo.On("Update", mock.Anything, mock.Anything).Return(nil).Maybe()
+			invitationRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			emailService := new(MockEmailService)
+			emailService.On("SendAdminInvitation", mock.Anything, "new.admin@example.com", mock.AnythingOfType("string"), InvitationExpiryMinutes).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:      NewMockTransactionManager(),
+				Config:         newTestConfig(),
+				InvitationRepo: invitationRepo,
+				TenantRepo:     tenantRepo,
+				RoleRepo:       roleRepo,
+				UserRepo:       userRepo,
+				EmailService:   emailService,
+				AuditLogger:    logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Create(context.Background(), invitedBy, &invitationdto.CreateRequest{
+				Email:    "  New.Admin@Example.com ",
+				TenantID: tenantID,
+				RoleIDs:  []uuid.UUID{roleID},
+			})
+
+			if !tt.wantCreated {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				if tt.wantCode != "" {
+					assert.Equal(t, tt.wantCode, appErr.Code)
+				}
+				invitationRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				emailService.AssertNotCalled(t, "SendAdminInvitation", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "new.admin@example.com", resp.Email)
+			assert.Equal(t, string(entity.InvitationStatusPending), resp.Status)
+			assert.Equal(t, []uuid.UUID{roleID}, resp.RoleIDs)
+			assert.Equal(t, invitedBy, resp.InvitedBy)
+
+			invitationRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(invitation *entity.Invitation) bool {
+				return invitation.TokenHash != "" && invitation.Status == entity.InvitationStatusPending
+			}))
+			emailService.AssertExpectations(t)
+
+			sentToken := emailService.Calls[0].Arguments.String(2)
+			created := invitationRepo.Calls[len(invitationRepo.Calls)-1].Arguments.Get(1).(*entity.Invitation)
+			assert.Equal(t, hashToken(sentToken), created.TokenHash, "only the hash of the mailed token is stored")
+
+			if tt.wantMarkExpire {
+				assert.Equal(t, entity.InvitationStatusExpired, tt.pending.Status)
+				invitationRepo.AssertCalled(t, "Update", mock.Anything, tt.pending)
+			} else {
+				invitationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/password"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) signingSecret() string {
+	if uc.Config.JWT.RegistrationSecret != "" {
+		return uc.Config.JWT.RegistrationSecret
+	}
+	return uc.Config.JWT.AccessSecret
+}
+
+// generateInvitationToken signs a token for the invitation. Only its hash is
+// stored, so issuing a new token on resend invalidates the previous one.
+func (uc *usecase) generateInvitationToken(invitation *entity.Invitation) (string, string, error) {
+	claims := jwt.MapClaims{
+		"tenant_id": invitation.TenantID.String(),
+		"email":     invitation.Email,
+		"purpose":   InvitationTokenPurpose,
+		"exp":       invitation.ExpiresAt.Unix(),
+		"iat":       time.Now().Unix(),
+		"jti":       uuid.New().String(),
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(uc.signingSecret()))
+	if err != nil {
+		return "", "", err
+	}
+
+	return tokenString, hashToken(tokenString), nil
+}
+
+func (uc *usecase) validateInvitationToken(tokenString string) error {
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.ErrTokenInvalid()
+		}
+		return []byte(uc.signingSecret()), nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return errors.New("INVITATION_EXPIRED", "Invitation has expired", 410)
+		}
+		return errors.ErrUnauthorized("Invitation token is invalid")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return errors.ErrUnauthorized("Invitation token is invalid")
+	}
+	if purpose, ok := claims["purpose"].(string); !ok || purpose != InvitationTokenPurpose {
+		return errors.ErrUnauthorized("Token is not an invitation token")
+	}
+	return nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// getOpenInvitation loads an invitation that can still be acted on. Pending
+// invitations found past their expiry are marked expired on the way.
+func (uc *usecase) getOpenInvitation(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	invitation, err := uc.InvitationRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Invitation not found")
+		}
+		return nil, errors.ErrInternal("failed to get invitation").WithError(err)
+	}
+	if err := uc.ensureOpen(ctx, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+func (uc *usecase) ensureOpen(ctx context.Context, invitation *entity.Invitation) error {
+	switch invitation.EffectiveStatus() {
+	case entity.InvitationStatusPending:
+		return nil
+	case entity.InvitationStatusExpired:
+		if invitation.Status == entity.InvitationStatusPending {
+			uc.markExpired(ctx, invitation)
+		}
+		return errors.New("INVITATION_EXPIRED", "Invitation has expired", 410)
+	case entity.InvitationStatusAccepted:
+		return errors.ErrConflict("Invitation has already been accepted")
+	default:
+		return errors.New("INVITATION_REVOKED", "Invitation has been revoked", 410)
+	}
+}
+
+func (uc *usecase) markExpired(ctx context.Context, invitation *entity.Invitation) {
+	invitation.Status = entity.InvitationStatusExpired
+	invitation.UpdatedAt = time.Now()
+	_ = uc.InvitationRepo.Update(ctx, invitation)
+}
+
+// resolveRoles checks that every role exists, is active and is either a
+// system role or belongs to the tenant.
+func (uc *usecase) resolveRoles(ctx context.Context, tenantID uuid.UUID, roleIDs []uuid.UUID) ([]*entity.Role, error) {
+	unique := make([]uuid.UUID, 0, len(roleIDs))
+	seen := make(map[uuid.UUID]struct{}, len(roleIDs))
+	for _, id := range roleIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	roles, err := uc.RoleRepo.GetByIDs(ctx, unique)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get roles").WithError(err)
+	}
+	if len(roles) != len(unique) {
+		return nil, errors.ErrRoleNotFound()
+	}
+	for _, role := range roles {
+		if !role.IsActive {
+			return nil, errors.ErrBadRequest("Role " + role.Code + " is inactive")
+		}
+		if role.TenantID != nil && *role.TenantID != tenantID {
+			return nil, errors.ErrBadRequest("Role " + role.Code + " does not belong to this tenant")
+		}
+	}
+	return roles, nil
+}
+
+// roleProductIDs returns the distinct products the invited roles grant
+// access to, whose password policies the invitee has to satisfy.
+func roleProductIDs(roles []*entity.Role) []uuid.UUID {
+	var productIDs []uuid.UUID
+	for _, role := range roles {
+		if role.ProductID != nil && !slices.Contains(productIDs, *role.ProductID) {
+			productIDs = append(productIDs, *role.ProductID)
+		}
+	}
+	return productIDs
+}
+
+func (uc *usecase) verifyBranch(ctx context.Context, tenantID uuid.UUID, branchID *uuid.UUID) error {
+	if branchID == nil {
+		return nil
+	}
+	branch, err := uc.BranchRepo.GetByID(ctx, *branchID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("Branch not found")
+		}
+		return errors.ErrInternal("failed to get branch").WithError(err)
+	}
+	if branch.TenantID != tenantID {
+		return errors.ErrBadRequest("Branch does not belong to this tenant")
+	}
+	if !branch.IsActive {
+		return errors.ErrBadRequest("Branch is inactive")
+	}
+	return nil
+}
+
+func mapInvitationToResponse(invitation *entity.Invitation) invitationdto.InvitationResponse {
+	roleIDs := invitation.GetRoleIDs()
+	if roleIDs == nil {
+		roleIDs = []uuid.UUID{}
+	}
+	return invitationdto.InvitationResponse{
+		ID:          invitation.ID,
+		TenantID:    invitation.TenantID,
+		BranchID:    invitation.BranchID,
+		Email:       invitation.Email,
+		RoleIDs:     roleIDs,
+		Status:      string(invitation.EffectiveStatus()),
+		ExpiresAt:   invitation.ExpiresAt,
+		ResendCount: invitation.ResendCount,
+		LastSentAt:  invitation.LastSentAt,
+		AcceptedAt:  invitation.AcceptedAt,
+		RevokedAt:   invitation.RevokedAt,
+		InvitedBy:   invitation.InvitedBy,
+		CreatedAt:   invitation.CreatedAt,
+	}
+}
+
+func (uc *usecase) checkPassword(policy *password.Policy, plain string, contextWords ...string) (string, error) {
+	if err := policy.Validate(plain, contextWords...); err != nil {
+		return "", err
+	}
+	return policy.CheckBreached(uc.BreachChecker, plain)
+}
+
+func passwordWarnings(warning string) []string {
+	if warning == "" {
+		return nil
+	}
+	return []string{warning}
+}
@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+	"strings"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/contract"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+)
+
+func (uc *usecase) List(ctx context.Context, req *invitationdto.ListRequest) (*invitationdto.ListResponse, error) {
+	req.SetDefaults()
+
+	filter := &contract.InvitationListFilter{
+		TenantID: req.TenantID,
+		Email:    strings.TrimSpace(req.Email),
+		Page:     req.Page,
+		PerPage:  req.PerPage,
+	}
+	if req.Status != "" {
+		status := entity.InvitationStatus(req.Status)
+		filter.Status = &status
+	}
+
+	invitations, total, err := uc.InvitationRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list invitations").WithError(err)
+	}
+
+	items := make([]invitationdto.InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		items = append(items, mapInvitationToResponse(invitation))
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &invitationdto.ListResponse{
+		Invitations: items,
+		Pagination: invitationdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+	if args.Get(0) == nil {
+		return fn(ctx)
+	}
+	return args.Error(0)
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	m := &MockTransactionManager{}
+	m.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return m
+}
+
+type MockInvitationRepository struct {
+	mock.Mock
+}
+
+func (m *MockInvitationRepository) Create(ctx context.Context, invitation *entity.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+func (m *MockInvitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.Invitation, error) {
+	args := m.Called(ctx, tokenHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) GetPendingByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*entity.Invitation, error) {
+	args := m.Called(ctx, tenantID, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Invitation), args.Error(1)
+}
+
+func (m *MockInvitationRepository) List(ctx context.Context, filter *contract.InvitationListFilter) ([]*entity.Invitation, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entity.Invitation), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockInvitationRepository) Update(ctx context.Context, invitation *entity.Invitation) error {
+	args := m.Called(ctx, invitation)
+	return args.Error(0)
+}
+
+type MockTenantRepository struct {
+	mock.Mock
+}
+
+func (m *MockTenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Tenant), args.Error(1)
+}
+
+type MockBranchRepository struct {
+	mock.Mock
+}
+
+func (m *MockBranchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Branch), args.Error(1)
+}
+
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockUserProfileRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserProfileRepository) Create(ctx context.Context, profile *entity.UserProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+type MockUserAuthMethodRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserAuthMethodRepository) Create(ctx context.Context, authMethod *entity.UserAuthMethod) error {
+	args := m.Called(ctx, authMethod)
+	return args.Error(0)
+}
+
+type MockUserSecurityStateRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserSecurityStateRepository) Create(ctx context.Context, securityState *entity.UserSecurityState) error {
+	args := m.Called(ctx, securityState)
+	return args.Error(0)
+}
+
+type MockUserRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRoleRepository) Create(ctx context.Context, userRole *entity.UserRole) error {
+	args := m.Called(ctx, userRole)
+	return args.Error(0)
+}
+
+type MockUserTenantRegistrationRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserTenantRegistrationRepository) Create(ctx context.Context, registration *entity.UserTenantRegistration) error {
+	args := m.Called(ctx, registration)
+	return args.Error(0)
+}
+
+type MockPasswordPolicyRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordPolicyRepository) Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error) {
+	args := m.Called(ctx, tenantID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.PasswordPolicy), args.Error(1)
+}
+
+type MockPasswordHistoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPasswordHistoryRepository) Create(ctx context.Context, history *entity.PasswordHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
+type MockEmailService struct {
+	mock.Mock
+}
+
+func (m *MockEmailService) SendAdminInvitation(ctx context.Context, email, token string, expiryMinutes int) error {
+	args := m.Called(ctx, email, token, expiryMinutes)
+	return args.Error(0)
+}
@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Resend issues a fresh token with a new expiry. The previous token stops
+// working because only the latest token hash is stored.
+func (uc *usecase) Resend(ctx context.Context, id uuid.UUID, resentBy uuid.UUID) (*invitationdto.InvitationResponse, error) {
+	invitation, err := uc.getOpenInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.ResendCount >= InvitationMaxResends {
+		return nil, errors.ErrBadRequest("Invitation has reached the maximum number of resends")
+	}
+
+	now := time.Now()
+	invitation.ExpiresAt = now.Add(InvitationExpiryMinutes * time.Minute)
+	invitation.LastSentAt = now
+	invitation.ResendCount++
+	invitation.UpdatedAt = now
+
+	token, tokenHash, err := uc.generateInvitationToken(invitation)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to generate invitation token").WithError(err)
+	}
+	invitation.TokenHash = tokenHash
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.InvitationRepo.Update(txCtx, invitation); err != nil {
+			return err
+		}
+		return uc.EmailService.SendAdminInvitation(txCtx, invitation.Email, token, InvitationExpiryMinutes)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to resend invitation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "invitation",
+		Action:     "invitation_resent",
+		ActorID:    resentBy.String(),
+		ActorType:  "user",
+		TargetID:   invitation.ID.String(),
+		TargetType: "invitation",
+		TenantID:   invitation.TenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"resend_count": invitation.ResendCount},
+	})
+
+	response := mapInvitationToResponse(invitation)
+	return &response, nil
+}
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/invitationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Revoke(ctx context.Context, id uuid.UUID, revokedBy uuid.UUID) (*invitationdto.InvitationResponse, error) {
+	invitation, err := uc.getOpenInvitation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation.Status = entity.InvitationStatusRevoked
+	invitation.RevokedAt = &now
+	invitation.RevokedBy = &revokedBy
+	invitation.UpdatedAt = now
+
+	if err := uc.InvitationRepo.Update(ctx, invitation); err != nil {
+		return nil, errors.ErrInternal("failed to revoke invitation").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "invitation",
+		Action:     "invitation_revoked",
+		ActorID:    revokedBy.String(),
+		ActorType:  "user",
+		TargetID:   invitation.ID.String(),
+		TargetType: "invitation",
+		TenantID:   invitation.TenantID.String(),
+		Success:    true,
+	})
+
+	response := mapInvitationToResponse(invitation)
+	return &response, nil
+}
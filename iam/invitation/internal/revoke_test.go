@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevoke(t *testing.T) {
+	revokedBy := uuid.New()
+
+	tests := []struct {
+		name       string
+		status     entity.InvitationStatus
+		expiresAt  time.Time
+		wantStatus int
+		wantCode   string
+		wantUpdate entity.InvitationStatus
+	}{
+		{
+			name:       "revokes pending invitation",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(time.Hour),
+			wantUpdate: entity.InvitationStatusRevoked,
+		},
+		{
+			name:       "expired pending invitation",
+			status:     entity.InvitationStatusPending,
+			expiresAt:  time.Now().Add(-time.Hour),
+			wantStatus: http.StatusGone,
+			wantCode:   "INVITATION_EXPIRED",
+			wantUpdate: entity.InvitationStatusExpired,
+		},
+		{
+			name:       "already accepted invitation",
+			status:     entity.InvitationStatusAccepted,
+			expiresAt:  time.Now().Add(time.Hour),
+			wantStatus: http.StatusConflict,
+			wantCode:   errors.CodeConflict,
+		},
+		{
+			name:       "already revoked invitation",
+			status:     entity.InvitationStatusRevoked,
+			expiresAt:  time.Now().Add(time.Hour),
+			wantStatus: http.StatusGone,
+			wantCode:   "INVITATION_REVOKED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invitation := &entity.Invitation{
+				ID:        uuid.New(),
+				TenantID:  uuid.New(),
+				Email:     "new.admin@example.com",
+				Status:    tt.status,
+				ExpiresAt: tt.expiresAt,
+			}
+
+			invitationRepo := new(MockInvitationRepository)
+			invitationRepo.On("GetByID", mock.Anything, invitation.ID).Return(invitation, nil)
+			invitationRepo.On("Update", mock.Anything, invitation).Return(nil).Maybe()
+
+			uc := &usecase{
+				InvitationRepo: invitationRepo,
+				AuditLogger:    logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Revoke(context.Background(), invitation.ID, revokedBy)
+
+			if tt.wantUpdate == "" {
+				invitationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+			} else {
+				invitationRepo.AssertCalled(t, "Update", mock.Anything, invitation)
+				assert.Equal(t, tt.wantUpdate, invitation.Status)
+			}
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.Equal(t, tt.wantCode, appErr.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, string(entity.InvitationStatusRevoked), resp.Status)
+			require.NotNil(t, invitation.RevokedBy)
+			assert.Equal(t, revokedBy, *invitation.RevokedBy)
+			assert.NotNil(t, resp.RevokedAt)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package invitationdto
+
+import "github.com/google/uuid"
+
+type CreateRequest struct {
+	Email    string      `json:"email" validate:"required,email,max=255"`
+	TenantID uuid.UUID   `json:"tenant_id" validate:"required"`
+	RoleIDs  []uuid.UUID `json:"role_ids" validate:"required,min=1,max=20,dive,required"`
+	BranchID *uuid.UUID  `json:"branch_id,omitempty" validate:"omitempty"`
+}
+
+type ListRequest struct {
+	TenantID *uuid.UUID `query:"tenant_id" validate:"omitempty"`
+	Status   string     `query:"status" validate:"omitempty,oneof=PENDING ACCEPTED REVOKED EXPIRED"`
+	Email    string     `query:"email" validate:"omitempty,max=255"`
+	Page     int        `query:"page" validate:"omitempty,min=1"`
+	PerPage  int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type AcceptRequest struct {
+	Token                string `json:"token" validate:"required"`
+	FirstName            string `json:"first_name" validate:"required,min=1,max=100"`
+	LastName             string `json:"last_name" validate:"omitempty,max=100"`
+	Password             string `json:"password" validate:"required,min=8,max=256"`
+	ConfirmationPassword string `json:"confirmation_password" validate:"required,eqfield=Password"`
+}
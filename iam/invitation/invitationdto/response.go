@@ -0,0 +1,43 @@
+package invitationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationResponse struct {
+	ID          uuid.UUID   `json:"id"`
+	TenantID    uuid.UUID   `json:"tenant_id"`
+	BranchID    *uuid.UUID  `json:"branch_id,omitempty"`
+	Email       string      `json:"email"`
+	RoleIDs     []uuid.UUID `json:"role_ids"`
+	Status      string      `json:"status"`
+	ExpiresAt   time.Time   `json:"expires_at"`
+	ResendCount int         `json:"resend_count"`
+	LastSentAt  time.Time   `json:"last_sent_at"`
+	AcceptedAt  *time.Time  `json:"accepted_at,omitempty"`
+	RevokedAt   *time.Time  `json:"revoked_at,omitempty"`
+	InvitedBy   uuid.UUID   `json:"invited_by"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ListResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+	Pagination  Pagination           `json:"pagination"`
+}
+
+type AcceptResponse struct {
+	UserID   uuid.UUID   `json:"user_id"`
+	Email    string      `json:"email"`
+	TenantID uuid.UUID   `json:"tenant_id"`
+	RoleIDs  []uuid.UUID `json:"role_ids"`
+	Warnings []string    `json:"warnings,omitempty"`
+}
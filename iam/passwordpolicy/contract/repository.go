@@ -0,0 +1,23 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type PasswordPolicyRepository interface {
+	Create(ctx context.Context, policy *entity.PasswordPolicy) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.PasswordPolicy, error)
+	GetByScope(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+	Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+	List(ctx context.Context, tenantID *uuid.UUID) ([]*entity.PasswordPolicy, error)
+	Update(ctx context.Context, policy *entity.PasswordPolicy) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type TenantRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error)
+}
@@ -0,0 +1,16 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	List(ctx context.Context, tenantID *uuid.UUID) ([]passwordpolicydto.PasswordPolicyResponse, error)
+	GetEffective(ctx context.Context, tenantID, productID *uuid.UUID) (*passwordpolicydto.PasswordPolicyResponse, error)
+	Upsert(ctx context.Context, updatedBy uuid.UUID, req *passwordpolicydto.UpsertRequest) (*passwordpolicydto.PasswordPolicyResponse, error)
+	Delete(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID) error
+}
@@ -0,0 +1,24 @@
+package passwordpolicy
+
+import (
+	"iam-service/config"
+	"iam-service/iam/passwordpolicy/contract"
+	"iam-service/iam/passwordpolicy/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	cfg *config.Config,
+	tenantRepo contract.TenantRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		cfg,
+		tenantRepo,
+		passwordPolicyRepo,
+		auditLogger,
+	)
+}
@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/passwordpolicy/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	Config             *config.Config
+	TenantRepo         contract.TenantRepository
+	PasswordPolicyRepo contract.PasswordPolicyRepository
+	AuditLogger        logger.AuditLogger
+}
+
+func NewUsecase(
+	cfg *config.Config,
+	tenantRepo contract.TenantRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		Config:             cfg,
+		TenantRepo:         tenantRepo,
+		PasswordPolicyRepo: passwordPolicyRepo,
+		AuditLogger:        auditLogger,
+	}
+}
@@ -0,0 +1,8 @@
+package internal
+
+const (
+	ScopeDefault  = "default"
+	ScopePlatform = "platform"
+	ScopeTenant   = "tenant"
+	ScopeProduct  = "product"
+)
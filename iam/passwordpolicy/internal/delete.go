@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Delete(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID) error {
+	policy, err := uc.PasswordPolicyRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("password policy not found")
+		}
+		return errors.ErrInternal("failed to get password policy").WithError(err)
+	}
+
+	if err := uc.PasswordPolicyRepo.Delete(ctx, policy.ID); err != nil {
+		return errors.ErrInternal("failed to delete password policy").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "password_policy",
+		Action:     "delete",
+		ActorID:    deletedBy.String(),
+		ActorType:  "user",
+		TargetID:   policy.ID.String(),
+		TargetType: "password_policy",
+		TenantID:   optionalUUIDString(policy.TenantID),
+		Success:    true,
+	})
+
+	return nil
+}
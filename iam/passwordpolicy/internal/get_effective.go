@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetEffective(ctx context.Context, tenantID, productID *uuid.UUID) (*passwordpolicydto.PasswordPolicyResponse, error) {
+	if productID != nil && tenantID == nil {
+		return nil, errors.ErrBadRequest("tenant_id is required when product_id is set")
+	}
+
+	policy, err := uc.PasswordPolicyRepo.Resolve(ctx, tenantID, productID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			resp := mapDefaultPolicyToResponse()
+			return &resp, nil
+		}
+		return nil, errors.ErrInternal("failed to resolve password policy").WithError(err)
+	}
+
+	resp := mapPolicyToResponse(policy)
+	return &resp, nil
+}
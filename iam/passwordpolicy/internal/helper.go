@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"iam-service/entity"
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+	"iam-service/pkg/password"
+
+	"github.com/google/uuid"
+)
+
+func policyScope(tenantID, productID *uuid.UUID) string {
+	switch {
+	case productID != nil:
+		return ScopeProduct
+	case tenantID != nil:
+		return ScopeTenant
+	default:
+		return ScopePlatform
+	}
+}
+
+func mapPolicyToResponse(policy *entity.PasswordPolicy) passwordpolicydto.PasswordPolicyResponse {
+	updatedAt := policy.UpdatedAt
+	bannedWords := policy.GetBannedWords()
+	if bannedWords == nil {
+		bannedWords = []string{}
+	}
+	return passwordpolicydto.PasswordPolicyResponse{
+		ID:               &policy.ID,
+		TenantID:         policy.TenantID,
+		ProductID:        policy.ProductID,
+		Scope:            policyScope(policy.TenantID, policy.ProductID),
+		MinLength:        policy.MinLength,
+		MaxLength:        policy.MaxLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireNumber:    policy.RequireNumber,
+		RequireSpecial:   policy.RequireSpecial,
+		MaxAgeDays:       policy.MaxAgeDays,
+		HistoryDepth:     policy.HistoryDepth,
+		BannedWords:      bannedWords,
+		BreachCheck:      policy.BreachCheck,
+		UpdatedBy:        policy.UpdatedBy,
+		UpdatedAt:        &updatedAt,
+	}
+}
+
+func mapDefaultPolicyToResponse() passwordpolicydto.PasswordPolicyResponse {
+	policy := password.DefaultPolicy()
+	return passwordpolicydto.PasswordPolicyResponse{
+		Scope:            ScopeDefault,
+		MinLength:        policy.MinLength,
+		MaxLength:        policy.MaxLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireNumber:    policy.RequireNumber,
+		RequireSpecial:   policy.RequireSpecial,
+		MaxAgeDays:       policy.MaxAgeDays,
+		HistoryDepth:     policy.HistoryDepth,
+		BannedWords:      []string{},
+		BreachCheck:      string(policy.BreachMode),
+	}
+}
+
+func optionalUUIDString(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,23 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID *uuid.UUID) ([]passwordpolicydto.PasswordPolicyResponse, error) {
+	policies, err := uc.PasswordPolicyRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list password policies").WithError(err)
+	}
+
+	result := make([]passwordpolicydto.PasswordPolicyResponse, len(policies))
+	for i, policy := range policies {
+		result[i] = mapPolicyToResponse(policy)
+	}
+	return result, nil
+}
@@ -0,0 +1,99 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/passwordpolicy/passwordpolicydto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+	"iam-service/pkg/password"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Upsert(ctx context.Context, updatedBy uuid.UUID, req *passwordpolicydto.UpsertRequest) (*passwordpolicydto.PasswordPolicyResponse, error) {
+	if req.ProductID != nil && req.TenantID == nil {
+		return nil, errors.ErrBadRequest("tenant_id is required when product_id is set")
+	}
+	if req.MaxLength < req.MinLength {
+		return nil, errors.ErrValidation("max_length must be greater than or equal to min_length")
+	}
+
+	if req.TenantID != nil {
+		if _, err := uc.TenantRepo.GetByID(ctx, *req.TenantID); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, errors.ErrTenantNotFound()
+			}
+			return nil, errors.ErrInternal("failed to verify tenant").WithError(err)
+		}
+	}
+
+	bannedWords := make([]string, 0, len(req.BannedWords))
+	for _, word := range req.BannedWords {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			bannedWords = append(bannedWords, word)
+		}
+	}
+	bannedWordsJSON, err := json.Marshal(bannedWords)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to encode banned words").WithError(err)
+	}
+
+	policy, err := uc.PasswordPolicyRepo.GetByScope(ctx, req.TenantID, req.ProductID)
+	isNew := false
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, errors.ErrInternal("failed to get password policy").WithError(err)
+		}
+		isNew = true
+		policy = &entity.PasswordPolicy{
+			TenantID:  req.TenantID,
+			ProductID: req.ProductID,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	policy.MinLength = req.MinLength
+	policy.MaxLength = req.MaxLength
+	policy.RequireUppercase = req.RequireUppercase
+	policy.RequireLowercase = req.RequireLowercase
+	policy.RequireNumber = req.RequireNumber
+	policy.RequireSpecial = req.RequireSpecial
+	policy.MaxAgeDays = req.MaxAgeDays
+	policy.HistoryDepth = req.HistoryDepth
+	policy.BannedWords = bannedWordsJSON
+	policy.BreachCheck = req.BreachCheck
+	if policy.BreachCheck == "" {
+		policy.BreachCheck = string(password.BreachModeBlock)
+	}
+	policy.UpdatedBy = &updatedBy
+	policy.UpdatedAt = time.Now()
+
+	if isNew {
+		err = uc.PasswordPolicyRepo.Create(ctx, policy)
+	} else {
+		err = uc.PasswordPolicyRepo.Update(ctx, policy)
+	}
+	if err != nil {
+		return nil, errors.ErrInternal("failed to save password policy").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "password_policy",
+		Action:     "upsert",
+		ActorID:    updatedBy.String(),
+		ActorType:  "user",
+		TargetID:   policy.ID.String(),
+		TargetType: "password_policy",
+		TenantID:   optionalUUIDString(policy.TenantID),
+		Success:    true,
+		Metadata:   map[string]any{"scope": policyScope(policy.TenantID, policy.ProductID)},
+	})
+
+	resp := mapPolicyToResponse(policy)
+	return &resp, nil
+}
@@ -0,0 +1,18 @@
+package passwordpolicydto
+
+import "github.com/google/uuid"
+
+type UpsertRequest struct {
+	TenantID         *uuid.UUID `json:"tenant_id,omitempty" validate:"omitempty"`
+	ProductID        *uuid.UUID `json:"product_id,omitempty" validate:"omitempty"`
+	MinLength        int        `json:"min_length" validate:"required,min=8,max=72"`
+	MaxLength        int        `json:"max_length" validate:"required,min=8,max=72"`
+	RequireUppercase bool       `json:"require_uppercase"`
+	RequireLowercase bool       `json:"require_lowercase"`
+	RequireNumber    bool       `json:"require_number"`
+	RequireSpecial   bool       `json:"require_special"`
+	MaxAgeDays       int        `json:"max_age_days" validate:"min=0,max=3650"`
+	HistoryDepth     int        `json:"history_depth" validate:"min=0,max=24"`
+	BannedWords      []string   `json:"banned_words,omitempty" validate:"omitempty,max=500,dive,required,max=100"`
+	BreachCheck      string     `json:"breach_check,omitempty" validate:"omitempty,oneof=OFF WARN BLOCK"`
+}
@@ -0,0 +1,41 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type PermissionRepository interface {
+	Create(ctx context.Context, permission *entity.Permission) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Permission, error)
+	GetByCode(ctx context.Context, productID uuid.UUID, code string) (*entity.Permission, error)
+	Update(ctx context.Context, permission *entity.Permission) error
+	List(ctx context.Context, filter *PermissionListFilter) ([]*entity.Permission, int64, error)
+	ListByProductID(ctx context.Context, productID uuid.UUID) ([]*entity.Permission, error)
+}
+
+type PermissionListFilter struct {
+	ProductID  *uuid.UUID
+	Module     string
+	Resource   string
+	ScopeLevel string
+	IsSystem   *bool
+	Search     string
+	Page       int
+	PerPage    int
+}
+
+type ProductRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Product, error)
+}
+
+type RolePermissionRepository interface {
+	CountByPermissionID(ctx context.Context, permissionID uuid.UUID) (int64, error)
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AdminAuditLog) error
+}
@@ -0,0 +1,17 @@
+package contract
+
+import (
+	"context"
+	"iam-service/iam/permission/permissiondto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, req *permissiondto.CreateRequest) (*permissiondto.PermissionResponse, error)
+	List(ctx context.Context, req *permissiondto.ListRequest) (*permissiondto.ListResponse, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*permissiondto.PermissionResponse, error)
+	Update(ctx context.Context, id uuid.UUID, req *permissiondto.UpdateRequest) (*permissiondto.PermissionResponse, error)
+	Delete(ctx context.Context, id uuid.UUID, req *permissiondto.DeleteRequest) error
+	ImportManifest(ctx context.Context, req *permissiondto.ManifestRequest) (*permissiondto.ManifestResponse, error)
+}
@@ -0,0 +1,27 @@
+package permission
+
+import (
+	"iam-service/config"
+	"iam-service/iam/permission/contract"
+	"iam-service/iam/permission/internal"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	permissionRepo contract.PermissionRepository,
+	productRepo contract.ProductRepository,
+	rolePermissionRepo contract.RolePermissionRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		permissionRepo,
+		productRepo,
+		rolePermissionRepo,
+		adminAuditLogRepo,
+	)
+}
@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/permission/contract"
+)
+
+type usecase struct {
+	TxManager          contract.TransactionManager
+	Config             *config.Config
+	PermissionRepo     contract.PermissionRepository
+	ProductRepo        contract.ProductRepository
+	RolePermissionRepo contract.RolePermissionRepository
+	AdminAuditLogRepo  contract.AdminAuditLogRepository
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	permissionRepo contract.PermissionRepository,
+	productRepo contract.ProductRepository,
+	rolePermissionRepo contract.RolePermissionRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+) *usecase {
+	return &usecase{
+		TxManager:          txManager,
+		Config:             cfg,
+		PermissionRepo:     permissionRepo,
+		ProductRepo:        productRepo,
+		RolePermissionRepo: rolePermissionRepo,
+		AdminAuditLogRepo:  adminAuditLogRepo,
+	}
+}
@@ -0,0 +1,6 @@
+package internal
+
+const (
+	ManifestSkipReasonSystem   = "system permissions are immutable"
+	ManifestSkipReasonAssigned = "permission is still granted to roles"
+)
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+)
+
+func (uc *usecase) Create(ctx context.Context, req *permissiondto.CreateRequest) (*permissiondto.PermissionResponse, error) {
+	code, err := buildCode(req.Resource, req.Action)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.getProduct(ctx, req.ProductID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	permission := &entity.Permission{
+		ProductID:   req.ProductID,
+		Code:        code,
+		Name:        req.Name,
+		Description: req.Description,
+		Module:      req.Module,
+		Resource:    req.Resource,
+		Action:      req.Action,
+		ScopeLevel:  entity.ScopeLevel(req.ScopeLevel),
+		IsSystem:    false,
+		Timestamps: entity.Timestamps{
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+	}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.insertPermission(txCtx, permission); err != nil {
+			return err
+		}
+		if err := uc.recordAudit(txCtx, &permission.ID, entity.AdminActionCreatePermission, req.ActorID, req.IPAddress, req.UserAgent, nil, mapPermissionToResponse(permission)); err != nil {
+			return errors.ErrInternal("failed to record audit log").WithError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPermissionToResponse(permission)
+	return &resp, nil
+}
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	productID := uuid.New()
+	deletedID := uuid.New()
+	createdAt := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		resource   string
+		existing   *entity.Permission
+		noProduct  bool
+		wantStatus int
+		wantID     uuid.UUID
+	}{
+		{
+			name:     "new code",
+			resource: "loan",
+		},
+		{
+			name:     "soft deleted code is restored in place",
+			resource: "loan",
+			existing: &entity.Permission{
+				ID:         deletedID,
+				ProductID:  productID,
+				Code:       "loan:approve",
+				Timestamps: entity.Timestamps{CreatedAt: createdAt, DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}},
+			},
+			wantID: deletedID,
+		},
+		{
+			name:       "code already registered",
+			resource:   "loan",
+			existing:   &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:approve"},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:     "deleted system code is not revived",
+			resource: "loan",
+			existing: &entity.Permission{
+				ID:         uuid.New(),
+				ProductID:  productID,
+				Code:       "loan:approve",
+				IsSystem:   true,
+				Timestamps: entity.Timestamps{DeletedAt: sql.NullTime{Time: time.Now(), Valid: true}},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "invalid resource",
+			resource:   "Loan",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown product",
+			resource:   "loan",
+			noProduct:  true,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			productRepo := new(MockProductRepository)
+			if tt.noProduct {
+				productRepo.On("GetByID", mock.Anything, productID).Return(nil, errors.ErrNotFound("product not found"))
+			} else {
+				productRepo.On("GetByID", mock.Anything, productID).Return(&entity.Product{ID: productID}, nil)
+			}
+			permissionRepo := new(MockPermissionRepository)
+			if tt.existing != nil {
+				permissionRepo.On("GetByCode", mock.Anything, productID, "loan:approve").Return(tt.existing, nil)
+			} else {
+				permissionRepo.On("GetByCode", mock.Anything, productID, "loan:approve").Return(nil, errors.ErrNotFound("permission not found"))
+			}
+			permissionRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+			permissionRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			uc := &usecase{
+				TxManager:         NewMockTransactionManager(),
+				PermissionRepo:    permissionRepo,
+				ProductRepo:       productRepo,
+				AdminAuditLogRepo: auditRepo,
+			}
+
+			resp, err := uc.Create(context.Background(), &permissiondto.CreateRequest{
+				ProductID:  productID,
+				Module:     "lending",
+				Resource:   tt.resource,
+				Action:     "approve",
+				Name:       "Approve loans",
+				ScopeLevel: string(entity.ScopeLevelTenant),
+			})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				auditRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "loan:approve", resp.Code)
+			assert.False(t, resp.IsSystem)
+			if tt.wantID != uuid.Nil {
+				assert.Equal(t, tt.wantID, resp.ID)
+				assert.Equal(t, createdAt, resp.CreatedAt)
+				permissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+			} else {
+				permissionRepo.AssertCalled(t, "Create", mock.Anything, mock.Anything)
+			}
+			auditRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+				return log.Action == entity.AdminActionCreatePermission && log.EntityID != nil && *log.EntityID == resp.ID
+			}))
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Delete(ctx context.Context, id uuid.UUID, req *permissiondto.DeleteRequest) error {
+	permission, err := uc.getMutablePermission(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	granted, err := uc.RolePermissionRepo.CountByPermissionID(ctx, permission.ID)
+	if err != nil {
+		return errors.ErrInternal("failed to check role permissions").WithError(err)
+	}
+	if granted > 0 {
+		return errors.ErrConflict(fmt.Sprintf("Permission is still granted to %d role(s)", granted))
+	}
+
+	before := mapPermissionToResponse(permission)
+	now := time.Now()
+	permission.UpdatedAt = now
+	permission.DeletedAt = sql.NullTime{Time: now, Valid: true}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.PermissionRepo.Update(txCtx, permission); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, &permission.ID, entity.AdminActionDeletePermission, req.ActorID, req.IPAddress, req.UserAgent, before, nil)
+	})
+	if err != nil {
+		return errors.ErrInternal("failed to delete permission").WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelete(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name       string
+		isSystem   bool
+		granted    int64
+		wantStatus int
+	}{
+		{name: "unused permission is soft deleted"},
+		{name: "system permission is immutable", isSystem: true, wantStatus: http.StatusForbidden},
+		{name: "permission still granted to roles", granted: 2, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permission := &entity.Permission{ID: id, Code: "loan:approve", IsSystem: tt.isSystem}
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("GetByID", mock.Anything, id).Return(permission, nil)
+			permissionRepo.On("Update", mock.Anything, permission).Return(nil)
+			rolePermissionRepo := new(MockRolePermissionRepository)
+			rolePermissionRepo.On("CountByPermissionID", mock.Anything, id).Return(tt.granted, nil)
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			uc := &usecase{
+				TxManager:          NewMockTransactionManager(),
+				PermissionRepo:     permissionRepo,
+				RolePermissionRepo: rolePermissionRepo,
+				AdminAuditLogRepo:  auditRepo,
+			}
+
+			err := uc.Delete(context.Background(), id, &permissiondto.DeleteRequest{ActorID: uuid.New()})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				assert.False(t, permission.DeletedAt.Valid)
+				permissionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, permission.DeletedAt.Valid)
+			permissionRepo.AssertCalled(t, "Update", mock.Anything, permission)
+			auditRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+				return log.Action == entity.AdminActionDeletePermission
+			}))
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/permission/permissiondto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetByID(ctx context.Context, id uuid.UUID) (*permissiondto.PermissionResponse, error) {
+	permission, err := uc.getPermission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPermissionToResponse(permission)
+	return &resp, nil
+}
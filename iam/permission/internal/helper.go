@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) getProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	product, err := uc.ProductRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Product not found")
+		}
+		return nil, errors.ErrInternal("failed to get product").WithError(err)
+	}
+	return product, nil
+}
+
+func (uc *usecase) getPermission(ctx context.Context, id uuid.UUID) (*entity.Permission, error) {
+	permission, err := uc.PermissionRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Permission not found")
+		}
+		return nil, errors.ErrInternal("failed to get permission").WithError(err)
+	}
+	return permission, nil
+}
+
+// getMutablePermission loads a permission that may be changed through the
+// API. Seeded system permissions are managed by migrations only.
+func (uc *usecase) getMutablePermission(ctx context.Context, id uuid.UUID) (*entity.Permission, error) {
+	permission, err := uc.getPermission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if permission.IsSystem {
+		return nil, errors.ErrForbidden("System permissions cannot be modified")
+	}
+	return permission, nil
+}
+
+// buildCode validates resource and action and joins them into the
+// resource:action code enforced by the permissions table.
+func buildCode(resource, action string) (string, error) {
+	if !isCodeSegment(resource) {
+		return "", errors.ErrBadRequest(fmt.Sprintf("Invalid permission resource %q: only lowercase letters and underscores are allowed", resource))
+	}
+	if !isCodeSegment(action) {
+		return "", errors.ErrBadRequest(fmt.Sprintf("Invalid permission action %q: only lowercase letters and underscores are allowed", action))
+	}
+	return entity.PermissionCode(resource, action), nil
+}
+
+func isCodeSegment(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// insertPermission registers a new permission code for a product. A code that
+// was soft deleted earlier is restored in place, since codes stay unique per
+// product even after deletion.
+func (uc *usecase) insertPermission(ctx context.Context, permission *entity.Permission) error {
+	existing, err := uc.PermissionRepo.GetByCode(ctx, permission.ProductID, permission.Code)
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.ErrInternal("failed to check permission code").WithError(err)
+	}
+	if existing == nil {
+		if err := uc.PermissionRepo.Create(ctx, permission); err != nil {
+			if errors.IsConflict(err) {
+				return errors.ErrConflict(fmt.Sprintf("Permission code %s already exists", permission.Code))
+			}
+			return errors.ErrInternal("failed to create permission").WithError(err)
+		}
+		return nil
+	}
+	if !existing.DeletedAt.Valid {
+		return errors.ErrConflict(fmt.Sprintf("Permission code %s already exists", permission.Code))
+	}
+	if existing.IsSystem {
+		return errors.ErrForbidden("System permissions cannot be modified")
+	}
+
+	permission.ID = existing.ID
+	permission.CreatedAt = existing.CreatedAt
+	if err := uc.PermissionRepo.Update(ctx, permission); err != nil {
+		return errors.ErrInternal("failed to restore permission").WithError(err)
+	}
+	return nil
+}
+
+func (uc *usecase) recordAudit(
+	ctx context.Context,
+	entityID *uuid.UUID,
+	action entity.AdminAction,
+	actorID uuid.UUID,
+	ipAddress, userAgent string,
+	before, after any,
+) error {
+	log := &entity.AdminAuditLog{
+		UserID:     actorID,
+		Action:     action,
+		EntityType: entity.EntityTypePermission,
+		EntityID:   entityID,
+		IPAddress:  optionalString(ipAddress),
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+	if before != nil {
+		log.BeforeState, _ = json.Marshal(before)
+	}
+	if after != nil {
+		log.AfterState, _ = json.Marshal(after)
+	}
+	return uc.AdminAuditLogRepo.Create(ctx, log)
+}
+
+func mapPermissionToResponse(permission *entity.Permission) permissiondto.PermissionResponse {
+	return permissiondto.PermissionResponse{
+		ID:          permission.ID,
+		ProductID:   permission.ProductID,
+		Code:        permission.Code,
+		Name:        permission.Name,
+		Description: permission.Description,
+		Module:      permission.Module,
+		Resource:    permission.Resource,
+		Action:      permission.Action,
+		ScopeLevel:  string(permission.ScopeLevel),
+		IsSystem:    permission.IsSystem,
+		CreatedAt:   permission.CreatedAt,
+		UpdatedAt:   permission.UpdatedAt,
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func sameDescription(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
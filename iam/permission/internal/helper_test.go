@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource string
+		action   string
+		expected string
+		wantErr  bool
+	}{
+		{name: "simple", resource: "loan", action: "approve", expected: "loan:approve"},
+		{name: "underscores", resource: "bank_account", action: "bulk_export", expected: "bank_account:bulk_export"},
+		{name: "uppercase resource", resource: "Loan", action: "approve", wantErr: true},
+		{name: "digit in action", resource: "loan", action: "approve2", wantErr: true},
+		{name: "colon in resource", resource: "loan:x", action: "read", wantErr: true},
+		{name: "empty action", resource: "loan", action: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := buildCode(tt.resource, tt.action)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, code)
+		})
+	}
+}
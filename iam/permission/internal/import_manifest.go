@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+)
+
+// ImportManifest reconciles the permissions of a product with a declarative
+// manifest in a single transaction. System permissions are never touched and
+// are reported as skipped when the manifest disagrees with them.
+func (uc *usecase) ImportManifest(ctx context.Context, req *permissiondto.ManifestRequest) (*permissiondto.ManifestResponse, error) {
+	if _, err := uc.getProduct(ctx, req.ProductID); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, len(req.Permissions))
+	declared := make(map[string]struct{}, len(req.Permissions))
+	for i, item := range req.Permissions {
+		code, err := buildCode(item.Resource, item.Action)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := declared[code]; ok {
+			return nil, errors.ErrBadRequest(fmt.Sprintf("Permission code %s is declared more than once", code))
+		}
+		declared[code] = struct{}{}
+		codes[i] = code
+	}
+
+	resp := &permissiondto.ManifestResponse{
+		ProductID: req.ProductID,
+		Created:   []string{},
+		Updated:   []string{},
+		Unchanged: []string{},
+		Deleted:   []string{},
+		Skipped:   []permissiondto.SkippedManifest{},
+	}
+
+	err := uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		current, err := uc.PermissionRepo.ListByProductID(txCtx, req.ProductID)
+		if err != nil {
+			return errors.ErrInternal("failed to list product permissions").WithError(err)
+		}
+		existing := make(map[string]*entity.Permission, len(current))
+		for _, permission := range current {
+			existing[permission.Code] = permission
+		}
+
+		now := time.Now()
+		for i, item := range req.Permissions {
+			code := codes[i]
+			permission, ok := existing[code]
+			if !ok {
+				permission = &entity.Permission{
+					ProductID:   req.ProductID,
+					Code:        code,
+					Name:        item.Name,
+					Description: item.Description,
+					Module:      item.Module,
+					Resource:    item.Resource,
+					Action:      item.Action,
+					ScopeLevel:  entity.ScopeLevel(item.ScopeLevel),
+					Timestamps: entity.Timestamps{
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+				}
+				if err := uc.insertPermission(txCtx, permission); err != nil {
+					return err
+				}
+				resp.Created = append(resp.Created, code)
+				continue
+			}
+
+			if permission.Name == item.Name &&
+				sameDescription(permission.Description, item.Description) &&
+				permission.Module == item.Module &&
+				permission.ScopeLevel == entity.ScopeLevel(item.ScopeLevel) {
+				resp.Unchanged = append(resp.Unchanged, code)
+				continue
+			}
+			if permission.IsSystem {
+				resp.Skipped = append(resp.Skipped, permissiondto.SkippedManifest{Code: code, Reason: ManifestSkipReasonSystem})
+				continue
+			}
+
+			permission.Name = item.Name
+			permission.Description = item.Description
+			permission.Module = item.Module
+			permission.ScopeLevel = entity.ScopeLevel(item.ScopeLevel)
+			permission.UpdatedAt = now
+			if err := uc.PermissionRepo.Update(txCtx, permission); err != nil {
+				return errors.ErrInternal("failed to update permission").WithError(err)
+			}
+			resp.Updated = append(resp.Updated, code)
+		}
+
+		if req.Prune {
+			for _, permission := range current {
+				if _, ok := declared[permission.Code]; ok {
+					continue
+				}
+				if permission.IsSystem {
+					resp.Skipped = append(resp.Skipped, permissiondto.SkippedManifest{Code: permission.Code, Reason: ManifestSkipReasonSystem})
+					continue
+				}
+				granted, err := uc.RolePermissionRepo.CountByPermissionID(txCtx, permission.ID)
+				if err != nil {
+					return errors.ErrInternal("failed to check role permissions").WithError(err)
+				}
+				if granted > 0 {
+					resp.Skipped = append(resp.Skipped, permissiondto.SkippedManifest{Code: permission.Code, Reason: ManifestSkipReasonAssigned})
+					continue
+				}
+
+				permission.UpdatedAt = now
+				permission.DeletedAt = sql.NullTime{Time: now, Valid: true}
+				if err := uc.PermissionRepo.Update(txCtx, permission); err != nil {
+					return errors.ErrInternal("failed to delete permission").WithError(err)
+				}
+				resp.Deleted = append(resp.Deleted, permission.Code)
+			}
+		}
+
+		if err := uc.recordAudit(txCtx, nil, entity.AdminActionImportPermission, req.ActorID, req.IPAddress, req.UserAgent, nil, resp); err != nil {
+			return errors.ErrInternal("failed to record audit log").WithError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
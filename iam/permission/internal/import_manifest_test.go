@@ -0,0 +1,110 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportManifest(t *testing.T) {
+	productID := uuid.New()
+
+	unchanged := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:read", Module: "lending", Resource: "loan", Action: "read", Name: "Read loans", ScopeLevel: entity.ScopeLevelTenant}
+	renamed := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:approve", Module: "lending", Resource: "loan", Action: "approve", Name: "Approve", ScopeLevel: entity.ScopeLevelTenant}
+	system := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:audit", Module: "lending", Resource: "loan", Action: "audit", Name: "Audit loans", ScopeLevel: entity.ScopeLevelSystem, IsSystem: true}
+	unused := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:export", Module: "lending", Resource: "loan", Action: "export", Name: "Export loans", ScopeLevel: entity.ScopeLevelTenant}
+	granted := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:delete", Module: "lending", Resource: "loan", Action: "delete", Name: "Delete loans", ScopeLevel: entity.ScopeLevelTenant}
+	systemUndeclared := &entity.Permission{ID: uuid.New(), ProductID: productID, Code: "loan:purge", Module: "lending", Resource: "loan", Action: "purge", Name: "Purge loans", ScopeLevel: entity.ScopeLevelSystem, IsSystem: true}
+
+	productRepo := new(MockProductRepository)
+	productRepo.On("GetByID", mock.Anything, productID).Return(&entity.Product{ID: productID}, nil)
+	permissionRepo := new(MockPermissionRepository)
+	permissionRepo.On("ListByProductID", mock.Anything, productID).Return([]*entity.Permission{unchanged, renamed, system, unused, granted, systemUndeclared}, nil)
+	permissionRepo.On("GetByCode", mock.Anything, productID, "loan:disburse").Return(nil, errors.ErrNotFound("permission not found"))
+	permissionRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	permissionRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+	rolePermissionRepo := new(MockRolePermissionRepository)
+	rolePermissionRepo.On("CountByPermissionID", mock.Anything, unused.ID).Return(int64(0), nil)
+	rolePermissionRepo.On("CountByPermissionID", mock.Anything, granted.ID).Return(int64(3), nil)
+	auditRepo := new(MockAdminAuditLogRepository)
+	auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+	uc := &usecase{
+		TxManager:          NewMockTransactionManager(),
+		PermissionRepo:     permissionRepo,
+		ProductRepo:        productRepo,
+		RolePermissionRepo: rolePermissionRepo,
+		AdminAuditLogRepo:  auditRepo,
+	}
+
+	resp, err := uc.ImportManifest(context.Background(), &permissiondto.ManifestRequest{
+		ProductID: productID,
+		Prune:     true,
+		Permissions: []permissiondto.ManifestPermission{
+			{Module: "lending", Resource: "loan", Action: "read", Name: "Read loans", ScopeLevel: "tenant"},
+			{Module: "lending", Resource: "loan", Action: "approve", Name: "Approve loans", ScopeLevel: "tenant"},
+			{Module: "lending", Resource: "loan", Action: "audit", Name: "Audit every loan", ScopeLevel: "tenant"},
+			{Module: "lending", Resource: "loan", Action: "disburse", Name: "Disburse loans", ScopeLevel: "branch"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"loan:disburse"}, resp.Created)
+	assert.Equal(t, []string{"loan:approve"}, resp.Updated)
+	assert.Equal(t, []string{"loan:read"}, resp.Unchanged)
+	assert.Equal(t, []string{"loan:export"}, resp.Deleted)
+	assert.Equal(t, []permissiondto.SkippedManifest{
+		{Code: "loan:audit", Reason: ManifestSkipReasonSystem},
+		{Code: "loan:delete", Reason: ManifestSkipReasonAssigned},
+		{Code: "loan:purge", Reason: ManifestSkipReasonSystem},
+	}, resp.Skipped)
+
+	assert.Equal(t, "Approve loans", renamed.Name)
+	assert.Equal(t, "Audit loans", system.Name)
+	assert.Equal(t, entity.ScopeLevelSystem, system.ScopeLevel)
+	assert.True(t, unused.DeletedAt.Valid)
+	assert.False(t, granted.DeletedAt.Valid)
+	assert.False(t, systemUndeclared.DeletedAt.Valid)
+	permissionRepo.AssertNotCalled(t, "Update", mock.Anything, system)
+	permissionRepo.AssertNotCalled(t, "Update", mock.Anything, systemUndeclared)
+	auditRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+		return log.Action == entity.AdminActionImportPermission
+	}))
+}
+
+func TestImportManifest_RejectsDuplicateCodes(t *testing.T) {
+	productID := uuid.New()
+
+	productRepo := new(MockProductRepository)
+	productRepo.On("GetByID", mock.Anything, productID).Return(&entity.Product{ID: productID}, nil)
+	permissionRepo := new(MockPermissionRepository)
+
+	uc := &usecase{
+		TxManager:      NewMockTransactionManager(),
+		PermissionRepo: permissionRepo,
+		ProductRepo:    productRepo,
+	}
+
+	_, err := uc.ImportManifest(context.Background(), &permissiondto.ManifestRequest{
+		ProductID: productID,
+		Permissions: []permissiondto.ManifestPermission{
+			{Module: "lending", Resource: "loan", Action: "read", Name: "Read loans", ScopeLevel: "tenant"},
+			{Module: "reporting", Resource: "loan", Action: "read", Name: "Read loan reports", ScopeLevel: "tenant"},
+		},
+	})
+
+	require.Error(t, err)
+	var appErr *errors.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, http.StatusBadRequest, appErr.HTTPStatus)
+	permissionRepo.AssertNotCalled(t, "ListByProductID", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/permission/contract"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+)
+
+func (uc *usecase) List(ctx context.Context, req *permissiondto.ListRequest) (*permissiondto.ListResponse, error) {
+	req.SetDefaults()
+
+	permissions, total, err := uc.PermissionRepo.List(ctx, &contract.PermissionListFilter{
+		ProductID:  req.ProductID,
+		Module:     req.Module,
+		Resource:   req.Resource,
+		ScopeLevel: req.ScopeLevel,
+		IsSystem:   req.IsSystem,
+		Search:     req.Search,
+		Page:       req.Page,
+		PerPage:    req.PerPage,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list permissions").WithError(err)
+	}
+
+	items := make([]permissiondto.PermissionResponse, len(permissions))
+	for i, permission := range permissions {
+		items[i] = mapPermissionToResponse(permission)
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &permissiondto.ListResponse{
+		Permissions: items,
+		Pagination: permissiondto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+	if args.Get(0) == nil {
+		return fn(ctx)
+	}
+	return args.Error(0)
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	m := &MockTransactionManager{}
+	m.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return m
+}
+
+type MockPermissionRepository struct {
+	mock.Mock
+}
+
+func (m *MockPermissionRepository) Create(ctx context.Context, permission *entity.Permission) error {
+	args := m.Called(ctx, permission)
+	if args.Error(0) == nil && permission.ID == uuid.Nil {
+		permission.ID = uuid.New()
+	}
+	return args.Error(0)
+}
+
+func (m *MockPermissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Permission, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Permission), args.Error(1)
+}
+
+func (m *MockPermissionRepository) GetByCode(ctx context.Context, productID uuid.UUID, code string) (*entity.Permission, error) {
+	args := m.Called(ctx, productID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Permission), args.Error(1)
+}
+
+func (m *MockPermissionRepository) Update(ctx context.Context, permission *entity.Permission) error {
+	args := m.Called(ctx, permission)
+	return args.Error(0)
+}
+
+func (m *MockPermissionRepository) List(ctx context.Context, filter *contract.PermissionListFilter) ([]*entity.Permission, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entity.Permission), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockPermissionRepository) ListByProductID(ctx context.Context, productID uuid.UUID) ([]*entity.Permission, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Permission), args.Error(1)
+}
+
+type MockProductRepository struct {
+	mock.Mock
+}
+
+func (m *MockProductRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Product), args.Error(1)
+}
+
+type MockRolePermissionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRolePermissionRepository) CountByPermissionID(ctx context.Context, permissionID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, permissionID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockAdminAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminAuditLogRepository) Create(ctx context.Context, log *entity.AdminAuditLog) error {
+	args := m.Called(ctx, log)
+	return args.Error(0)
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Update(ctx context.Context, id uuid.UUID, req *permissiondto.UpdateRequest) (*permissiondto.PermissionResponse, error) {
+	permission, err := uc.getMutablePermission(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := mapPermissionToResponse(permission)
+
+	if req.Name != nil {
+		permission.Name = *req.Name
+	}
+	if req.Description != nil {
+		permission.Description = req.Description
+	}
+	if req.Module != nil {
+		permission.Module = *req.Module
+	}
+	if req.ScopeLevel != nil {
+		permission.ScopeLevel = entity.ScopeLevel(*req.ScopeLevel)
+	}
+	permission.UpdatedAt = time.Now()
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.PermissionRepo.Update(txCtx, permission); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, &permission.ID, entity.AdminActionUpdatePermission, req.ActorID, req.IPAddress, req.UserAgent, before, mapPermissionToResponse(permission))
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to update permission").WithError(err)
+	}
+
+	resp := mapPermissionToResponse(permission)
+	return &resp, nil
+}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/permissiondto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	id := uuid.New()
+	name := "Approve any loan"
+	scope := string(entity.ScopeLevelBranch)
+
+	tests := []struct {
+		name       string
+		permission *entity.Permission
+		wantStatus int
+	}{
+		{
+			name:       "descriptive fields change, code stays",
+			permission: &entity.Permission{ID: id, Code: "loan:approve", Resource: "loan", Action: "approve", Name: "Approve loans", ScopeLevel: entity.ScopeLevelTenant},
+		},
+		{
+			name:       "system permission is immutable",
+			permission: &entity.Permission{ID: id, Code: "loan:approve", Resource: "loan", Action: "approve", Name: "Approve loans", IsSystem: true},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "unknown permission",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissionRepo := new(MockPermissionRepository)
+			if tt.permission != nil {
+				permissionRepo.On("GetByID", mock.Anything, id).Return(tt.permission, nil)
+			} else {
+				permissionRepo.On("GetByID", mock.Anything, id).Return(nil, errors.ErrNotFound("permission not found"))
+			}
+			permissionRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+
+			uc := &usecase{
+				TxManager:         NewMockTransactionManager(),
+				PermissionRepo:    permissionRepo,
+				AdminAuditLogRepo: auditRepo,
+			}
+
+			resp, err := uc.Update(context.Background(), id, &permissiondto.UpdateRequest{Name: &name, ScopeLevel: &scope})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				permissionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, name, resp.Name)
+			assert.Equal(t, scope, resp.ScopeLevel)
+			assert.Equal(t, "loan:approve", resp.Code)
+			auditRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+				return log.Action == entity.AdminActionUpdatePermission && len(log.BeforeState) > 0 && len(log.AfterState) > 0
+			}))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package permissiondto
+
+import "github.com/google/uuid"
+
+type CreateRequest struct {
+	ProductID   uuid.UUID `json:"product_id" validate:"required"`
+	Module      string    `json:"module" validate:"required,min=2,max=50"`
+	Resource    string    `json:"resource" validate:"required,min=2,max=50"`
+	Action      string    `json:"action" validate:"required,min=2,max=49"`
+	Name        string    `json:"name" validate:"required,min=2,max=255"`
+	Description *string   `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ScopeLevel  string    `json:"scope_level" validate:"required,oneof=system tenant branch self"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type ListRequest struct {
+	ProductID  *uuid.UUID `query:"product_id" validate:"omitempty"`
+	Module     string     `query:"module" validate:"omitempty,max=50"`
+	Resource   string     `query:"resource" validate:"omitempty,max=50"`
+	ScopeLevel string     `query:"scope_level" validate:"omitempty,oneof=system tenant branch self"`
+	IsSystem   *bool      `query:"is_system"`
+	Search     string     `query:"search" validate:"omitempty,max=100"`
+	Page       int        `query:"page" validate:"omitempty,min=1"`
+	PerPage    int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+// UpdateRequest only covers descriptive fields; the code of a permission is
+// immutable once registered.
+type UpdateRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Module      *string `json:"module,omitempty" validate:"omitempty,min=2,max=50"`
+	ScopeLevel  *string `json:"scope_level,omitempty" validate:"omitempty,oneof=system tenant branch self"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type DeleteRequest struct {
+	ActorID   uuid.UUID
+	IPAddress string
+	UserAgent string
+}
+
+// ManifestRequest declares the full permission catalog of a product. Entries
+// are matched by code; with Prune set, permissions missing from the manifest
+// are removed.
+type ManifestRequest struct {
+	ProductID   uuid.UUID            `json:"product_id" validate:"required"`
+	Permissions []ManifestPermission `json:"permissions" validate:"required,min=1,max=500,dive"`
+	Prune       bool                 `json:"prune"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type ManifestPermission struct {
+	Module      string  `json:"module" validate:"required,min=2,max=50"`
+	Resource    string  `json:"resource" validate:"required,min=2,max=50"`
+	Action      string  `json:"action" validate:"required,min=2,max=49"`
+	Name        string  `json:"name" validate:"required,min=2,max=255"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ScopeLevel  string  `json:"scope_level" validate:"required,oneof=system tenant branch self"`
+}
@@ -0,0 +1,48 @@
+package permissiondto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PermissionResponse struct {
+	ID          uuid.UUID `json:"id"`
+	ProductID   uuid.UUID `json:"product_id"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	Module      string    `json:"module"`
+	Resource    string    `json:"resource"`
+	Action      string    `json:"action"`
+	ScopeLevel  string    `json:"scope_level"`
+	IsSystem    bool      `json:"is_system"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListResponse struct {
+	Permissions []PermissionResponse `json:"permissions"`
+	Pagination  Pagination           `json:"pagination"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ManifestResponse struct {
+	ProductID uuid.UUID         `json:"product_id"`
+	Created   []string          `json:"created"`
+	Updated   []string          `json:"updated"`
+	Unchanged []string          `json:"unchanged"`
+	Deleted   []string          `json:"deleted"`
+	Skipped   []SkippedManifest `json:"skipped"`
+}
+
+type SkippedManifest struct {
+	Code   string `json:"code"`
+	Reason string `json:"reason"`
+}
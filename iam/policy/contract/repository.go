@@ -0,0 +1,38 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type PolicyRepository interface {
+	Create(ctx context.Context, policy *entity.AuthorizationPolicy) error
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entity.AuthorizationPolicy, error)
+	Update(ctx context.Context, policy *entity.AuthorizationPolicy) error
+	List(ctx context.Context, filter *PolicyListFilter) ([]*entity.AuthorizationPolicy, int64, error)
+	// ListApplicable returns the active policies guarding a permission. With
+	// an empty resourceType only policies not bound to a resource are returned,
+	// otherwise only policies bound to that resource type.
+	ListApplicable(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string) ([]*entity.AuthorizationPolicy, error)
+}
+
+type PolicyListFilter struct {
+	TenantID       uuid.UUID
+	PermissionCode string
+	ResourceType   string
+	Mode           string
+	IsActive       *bool
+	Page           int
+	PerPage        int
+}
+
+type TenantSettingsRepository interface {
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*entity.TenantSettings, error)
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AdminAuditLog) error
+}
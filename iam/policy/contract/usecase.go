@@ -0,0 +1,19 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/abac"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Create(ctx context.Context, tenantID uuid.UUID, req *policydto.CreateRequest) (*policydto.PolicyResponse, error)
+	List(ctx context.Context, tenantID uuid.UUID, req *policydto.ListRequest) (*policydto.ListResponse, error)
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*policydto.PolicyResponse, error)
+	Update(ctx context.Context, tenantID, id uuid.UUID, req *policydto.UpdateRequest) (*policydto.PolicyResponse, error)
+	Delete(ctx context.Context, tenantID, id uuid.UUID, req *policydto.DeleteRequest) error
+	Authorize(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string, input abac.Input) (*abac.Decision, error)
+}
@@ -0,0 +1,28 @@
+package policy
+
+import (
+	"iam-service/config"
+	"iam-service/iam/policy/contract"
+	"iam-service/iam/policy/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	policyRepo contract.PolicyRepository,
+	tenantSettingsRepo contract.TenantSettingsRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		policyRepo,
+		tenantSettingsRepo,
+		adminAuditLogRepo,
+		auditLogger,
+	)
+}
@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/abac"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Authorize evaluates the tenant policies guarding permissionCode. It is
+// meant to run after the permission itself has been checked: with no
+// applicable policy the request is allowed. Dry-run policies are evaluated
+// and logged but never deny.
+func (uc *usecase) Authorize(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string, input abac.Input) (*abac.Decision, error) {
+	policies, err := uc.PolicyRepo.ListApplicable(ctx, tenantID, permissionCode, resourceType)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to load authorization policies").WithError(err)
+	}
+
+	decision := &abac.Decision{Allowed: true}
+	if len(policies) == 0 {
+		return decision, nil
+	}
+
+	// Caller supplied keys never override the time computed here. The
+	// address is the request's own unless the input already carries one:
+	// API key callers of /authz/check are services asking on behalf of an
+	// end user, so their connection address says nothing about that user.
+	ipAddress, _ := input.Environment["ip_address"].(string)
+	if ipAddress == "" {
+		ipAddress, _ = ctx.Value(logger.CtxIPAddress).(string)
+	}
+	env := make(abac.Attributes, len(input.Environment))
+	for key, value := range input.Environment {
+		env[key] = value
+	}
+	for key, value := range abac.EnvironmentAt(time.Now(), uc.tenantLocation(ctx, tenantID), ipAddress) {
+		env[key] = value
+	}
+	input.Environment = env
+
+	for _, policy := range policies {
+		// A stored condition that no longer parses fails closed.
+		matched := false
+		if condition, err := parseCondition(policy.Condition, policy.ResourceType != nil); err == nil {
+			matched = condition.Evaluate(input)
+		}
+
+		if !matched {
+			decision.Violations = append(decision.Violations, abac.Violation{
+				PolicyID: policy.ID,
+				Name:     policy.Name,
+				DryRun:   policy.IsDryRun(),
+			})
+			if !policy.IsDryRun() {
+				decision.Allowed = false
+			}
+		}
+
+		if policy.IsDryRun() || !matched {
+			uc.logDecision(ctx, tenantID, policy, permissionCode, input, matched)
+		}
+	}
+
+	return decision, nil
+}
+
+func (uc *usecase) logDecision(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	policy *entity.AuthorizationPolicy,
+	permissionCode string,
+	input abac.Input,
+	matched bool,
+) {
+	action := "policy_denied"
+	if policy.IsDryRun() {
+		action = "policy_dry_run"
+	}
+
+	actorID := stringValue(input.Subject["id"])
+
+	metadata := map[string]any{
+		"policy_id":       policy.ID.String(),
+		"policy_name":     policy.Name,
+		"mode":            string(policy.Mode),
+		"permission_code": permissionCode,
+		"matched":         matched,
+	}
+	targetID, targetType := "", ""
+	if policy.ResourceType != nil {
+		targetType = *policy.ResourceType
+		metadata["resource_type"] = targetType
+		targetID = stringValue(input.Resource["id"])
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     AuditDomain,
+		Action:     action,
+		ActorID:    actorID,
+		ActorType:  "user",
+		TargetID:   targetID,
+		TargetType: targetType,
+		TenantID:   tenantID.String(),
+		Success:    matched,
+		Reason:     policy.Name,
+		Metadata:   metadata,
+	})
+}
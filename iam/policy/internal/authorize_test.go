@@ -0,0 +1,238 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/contract"
+	"iam-service/pkg/abac"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubPolicyRepository struct {
+	contract.PolicyRepository
+	policies []*entity.AuthorizationPolicy
+}
+
+func (r *stubPolicyRepository) ListApplicable(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string) ([]*entity.AuthorizationPolicy, error) {
+	return r.policies, nil
+}
+
+type stubTenantSettingsRepository struct{}
+
+func (stubTenantSettingsRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*entity.TenantSettings, error) {
+	return nil, errors.ErrNotFound("tenant settings not found")
+}
+
+type recordingAuditLogger struct {
+	events []logger.AuditEvent
+}
+
+func (l *recordingAuditLogger) Log(ctx context.Context, event logger.AuditEvent) {
+	l.events = append(l.events, event)
+}
+
+func (l *recordingAuditLogger) Sync() error { return nil }
+
+func TestAuthorize(t *testing.T) {
+	tenantID := uuid.New()
+	submitterID := uuid.New()
+	resourceType := "participant"
+	notSubmitter, _ := json.Marshal(abac.Condition{Attr: "resource.submitted_by", Op: abac.OpNeq, Ref: "subject.id"})
+
+	newPolicy := func(mode entity.PolicyMode) *entity.AuthorizationPolicy {
+		return &entity.AuthorizationPolicy{
+			ID:             uuid.New(),
+			TenantID:       tenantID,
+			Name:           "four eyes",
+			PermissionCode: "participant:approve",
+			ResourceType:   &resourceType,
+			Condition:      notSubmitter,
+			Mode:           mode,
+			IsActive:       true,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		policies       []*entity.AuthorizationPolicy
+		subjectID      uuid.UUID
+		wantAllowed    bool
+		wantViolations int
+		wantEvents     int
+	}{
+		{
+			name:        "no policies",
+			subjectID:   submitterID,
+			wantAllowed: true,
+		},
+		{
+			name:        "enforced policy holds",
+			policies:    []*entity.AuthorizationPolicy{newPolicy(entity.PolicyModeEnforce)},
+			subjectID:   uuid.New(),
+			wantAllowed: true,
+		},
+		{
+			name:           "enforced policy fails",
+			policies:       []*entity.AuthorizationPolicy{newPolicy(entity.PolicyModeEnforce)},
+			subjectID:      submitterID,
+			wantAllowed:    false,
+			wantViolations: 1,
+			wantEvents:     1,
+		},
+		{
+			name:           "dry run policy fails without denying",
+			policies:       []*entity.AuthorizationPolicy{newPolicy(entity.PolicyModeDryRun)},
+			subjectID:      submitterID,
+			wantAllowed:    true,
+			wantViolations: 1,
+			wantEvents:     1,
+		},
+		{
+			name:        "dry run policy holds and is still logged",
+			policies:    []*entity.AuthorizationPolicy{newPolicy(entity.PolicyModeDryRun)},
+			subjectID:   uuid.New(),
+			wantAllowed: true,
+			wantEvents:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditLogger := &recordingAuditLogger{}
+			uc := &usecase{
+				PolicyRepo:         &stubPolicyRepository{policies: tt.policies},
+				TenantSettingsRepo: stubTenantSettingsRepository{},
+				AuditLogger:        auditLogger,
+			}
+
+			decision, err := uc.Authorize(context.Background(), tenantID, "participant:approve", resourceType, abac.Input{
+				Subject:  abac.Attributes{"id": tt.subjectID},
+				Resource: abac.Attributes{"submitted_by": &submitterID},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, decision.Allowed)
+			assert.Len(t, decision.Violations, tt.wantViolations)
+			assert.Len(t, auditLogger.events, tt.wantEvents)
+		})
+	}
+}
+
+func TestAuthorize_ServerEnvironmentWins(t *testing.T) {
+	tenantID := uuid.New()
+	impossibleHour, _ := json.Marshal(abac.Condition{Attr: "env.hour", Op: abac.OpEq, Value: 99})
+	customKey, _ := json.Marshal(abac.Condition{Attr: "env.channel", Op: abac.OpEq, Value: "branch"})
+
+	newPolicy := func(condition json.RawMessage) *entity.AuthorizationPolicy {
+		return &entity.AuthorizationPolicy{
+			ID:             uuid.New(),
+			TenantID:       tenantID,
+			Name:           "environment",
+			PermissionCode: "participant:read",
+			Condition:      condition,
+			Mode:           entity.PolicyModeEnforce,
+			IsActive:       true,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		policy      *entity.AuthorizationPolicy
+		wantAllowed bool
+	}{
+		{
+			name:        "caller cannot override server computed keys",
+			policy:      newPolicy(impossibleHour),
+			wantAllowed: false,
+		},
+		{
+			name:        "caller keys the server does not compute are kept",
+			policy:      newPolicy(customKey),
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{
+				PolicyRepo:         &stubPolicyRepository{policies: []*entity.AuthorizationPolicy{tt.policy}},
+				TenantSettingsRepo: stubTenantSettingsRepository{},
+				AuditLogger:        &recordingAuditLogger{},
+			}
+
+			decision, err := uc.Authorize(context.Background(), tenantID, "participant:read", "", abac.Input{
+				Subject:     abac.Attributes{"id": uuid.New()},
+				Environment: abac.Attributes{"hour": 99, "channel": "branch"},
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, decision.Allowed)
+		})
+	}
+}
+
+func TestAuthorize_IPAddress(t *testing.T) {
+	tenantID := uuid.New()
+	officeOnly, _ := json.Marshal(abac.Condition{Attr: "env.ip_address", Op: abac.OpEq, Value: "203.0.113.7"})
+	policy := &entity.AuthorizationPolicy{
+		ID:             uuid.New(),
+		TenantID:       tenantID,
+		Name:           "office network",
+		PermissionCode: "participant:read",
+		Condition:      officeOnly,
+		Mode:           entity.PolicyModeEnforce,
+		IsActive:       true,
+	}
+
+	tests := []struct {
+		name        string
+		requestIP   string
+		environment abac.Attributes
+		wantAllowed bool
+	}{
+		{
+			name:        "end user address supplied by a calling service is used",
+			requestIP:   "10.0.0.5",
+			environment: abac.Attributes{"ip_address": "203.0.113.7"},
+			wantAllowed: true,
+		},
+		{
+			name:        "end user address outside the policy is denied",
+			requestIP:   "10.0.0.5",
+			environment: abac.Attributes{"ip_address": "198.51.100.9"},
+			wantAllowed: false,
+		},
+		{
+			name:        "request address is used when none is supplied",
+			requestIP:   "203.0.113.7",
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{
+				PolicyRepo:         &stubPolicyRepository{policies: []*entity.AuthorizationPolicy{policy}},
+				TenantSettingsRepo: stubTenantSettingsRepository{},
+				AuditLogger:        &recordingAuditLogger{},
+			}
+
+			ctx := context.WithValue(context.Background(), logger.CtxIPAddress, tt.requestIP)
+			decision, err := uc.Authorize(ctx, tenantID, "participant:read", "", abac.Input{
+				Subject:     abac.Attributes{"id": uuid.New()},
+				Environment: tt.environment,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, decision.Allowed)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/policy/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager          contract.TransactionManager
+	Config             *config.Config
+	PolicyRepo         contract.PolicyRepository
+	TenantSettingsRepo contract.TenantSettingsRepository
+	AdminAuditLogRepo  contract.AdminAuditLogRepository
+	AuditLogger        logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	policyRepo contract.PolicyRepository,
+	tenantSettingsRepo contract.TenantSettingsRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:          txManager,
+		Config:             cfg,
+		PolicyRepo:         policyRepo,
+		TenantSettingsRepo: tenantSettingsRepo,
+		AdminAuditLogRepo:  adminAuditLogRepo,
+		AuditLogger:        auditLogger,
+	}
+}
@@ -0,0 +1,6 @@
+package internal
+
+const (
+	DefaultTimezone = "Asia/Jakarta"
+	AuditDomain     = "authz"
+)
@@ -0,0 +1,67 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Create(ctx context.Context, tenantID uuid.UUID, req *policydto.CreateRequest) (*policydto.PolicyResponse, error) {
+	if err := validatePermissionCode(req.PermissionCode); err != nil {
+		return nil, err
+	}
+	condition, err := parseCondition(req.Condition, req.ResourceType != nil)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(condition)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to encode policy condition").WithError(err)
+	}
+
+	mode := entity.PolicyModeEnforce
+	if req.Mode != "" {
+		mode = entity.PolicyMode(req.Mode)
+	}
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	now := time.Now()
+	policy := &entity.AuthorizationPolicy{
+		TenantID:       tenantID,
+		Name:           req.Name,
+		Description:    req.Description,
+		PermissionCode: req.PermissionCode,
+		ResourceType:   req.ResourceType,
+		Condition:      encoded,
+		Mode:           mode,
+		IsActive:       isActive,
+		CreatedBy:      req.ActorID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.savePolicy(txCtx, policy, true); err != nil {
+			return err
+		}
+		if err := uc.recordAudit(txCtx, policy, entity.AdminActionCreatePolicy, req.ActorID, req.IPAddress, req.UserAgent, nil, mapPolicyToResponse(policy)); err != nil {
+			return errors.ErrInternal("failed to record audit log").WithError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPolicyToResponse(policy)
+	return &resp, nil
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Delete(ctx context.Context, tenantID, id uuid.UUID, req *policydto.DeleteRequest) error {
+	policy, err := uc.getPolicy(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	before := mapPolicyToResponse(policy)
+	now := time.Now()
+	policy.UpdatedBy = &req.ActorID
+	policy.UpdatedAt = now
+	policy.DeletedAt = &now
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.PolicyRepo.Update(txCtx, policy); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, policy, entity.AdminActionDeletePolicy, req.ActorID, req.IPAddress, req.UserAgent, before, nil)
+	})
+	if err != nil {
+		return errors.ErrInternal("failed to delete policy").WithError(err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/policy/policydto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*policydto.PolicyResponse, error) {
+	policy, err := uc.getPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPolicyToResponse(policy)
+	return &resp, nil
+}
@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/abac"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) getPolicy(ctx context.Context, tenantID, id uuid.UUID) (*entity.AuthorizationPolicy, error) {
+	policy, err := uc.PolicyRepo.GetByID(ctx, tenantID, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Policy not found")
+		}
+		return nil, errors.ErrInternal("failed to get policy").WithError(err)
+	}
+	return policy, nil
+}
+
+// parseCondition decodes and validates a condition tree. Resource attributes
+// can only be resolved when the policy is bound to a resource type.
+func parseCondition(raw json.RawMessage, resourceBound bool) (*abac.Condition, error) {
+	var condition abac.Condition
+	if err := json.Unmarshal(raw, &condition); err != nil {
+		return nil, errors.ErrBadRequest("Invalid policy condition: condition must be a JSON object")
+	}
+	if err := condition.Validate(resourceBound); err != nil {
+		return nil, errors.ErrBadRequest(fmt.Sprintf("Invalid policy condition: %s", err.Error()))
+	}
+	return &condition, nil
+}
+
+func validatePermissionCode(code string) error {
+	resource, action, ok := strings.Cut(code, ":")
+	if !ok || !isCodeSegment(resource) || !isCodeSegment(action) {
+		return errors.ErrBadRequest(fmt.Sprintf("Invalid permission code %q: expected resource:action", code))
+	}
+	return nil
+}
+
+func isCodeSegment(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if (r < 'a' || r > 'z') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+func (uc *usecase) savePolicy(ctx context.Context, policy *entity.AuthorizationPolicy, create bool) error {
+	var err error
+	if create {
+		err = uc.PolicyRepo.Create(ctx, policy)
+	} else {
+		err = uc.PolicyRepo.Update(ctx, policy)
+	}
+	if err != nil {
+		if errors.IsConflict(err) {
+			return errors.ErrConflict(fmt.Sprintf("Policy %s already exists", policy.Name))
+		}
+		return errors.ErrInternal("failed to save policy").WithError(err)
+	}
+	return nil
+}
+
+// tenantLocation resolves the timezone environment attributes are evaluated
+// in, falling back to the platform default when the tenant has none.
+func (uc *usecase) tenantLocation(ctx context.Context, tenantID uuid.UUID) *time.Location {
+	timezone := DefaultTimezone
+	if settings, err := uc.TenantSettingsRepo.GetByTenantID(ctx, tenantID); err == nil && settings.Timezone != "" {
+		timezone = settings.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (uc *usecase) recordAudit(
+	ctx context.Context,
+	policy *entity.AuthorizationPolicy,
+	action entity.AdminAction,
+	actorID uuid.UUID,
+	ipAddress, userAgent string,
+	before, after any,
+) error {
+	log := &entity.AdminAuditLog{
+		TenantID:   &policy.TenantID,
+		UserID:     actorID,
+		Action:     action,
+		EntityType: entity.EntityTypePolicy,
+		EntityID:   &policy.ID,
+		IPAddress:  optionalString(ipAddress),
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+	if before != nil {
+		log.BeforeState, _ = json.Marshal(before)
+	}
+	if after != nil {
+		log.AfterState, _ = json.Marshal(after)
+	}
+	return uc.AdminAuditLogRepo.Create(ctx, log)
+}
+
+func mapPolicyToResponse(policy *entity.AuthorizationPolicy) policydto.PolicyResponse {
+	return policydto.PolicyResponse{
+		ID:             policy.ID,
+		TenantID:       policy.TenantID,
+		Name:           policy.Name,
+		Description:    policy.Description,
+		PermissionCode: policy.PermissionCode,
+		ResourceType:   policy.ResourceType,
+		Condition:      policy.Condition,
+		Mode:           string(policy.Mode),
+		IsActive:       policy.IsActive,
+		CreatedBy:      policy.CreatedBy,
+		UpdatedBy:      policy.UpdatedBy,
+		CreatedAt:      policy.CreatedAt,
+		UpdatedAt:      policy.UpdatedAt,
+	}
+}
+
+func stringValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *uuid.UUID:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
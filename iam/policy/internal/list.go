@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/policy/contract"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID uuid.UUID, req *policydto.ListRequest) (*policydto.ListResponse, error) {
+	req.SetDefaults()
+
+	policies, total, err := uc.PolicyRepo.List(ctx, &contract.PolicyListFilter{
+		TenantID:       tenantID,
+		PermissionCode: req.PermissionCode,
+		ResourceType:   req.ResourceType,
+		Mode:           req.Mode,
+		IsActive:       req.IsActive,
+		Page:           req.Page,
+		PerPage:        req.PerPage,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list policies").WithError(err)
+	}
+
+	items := make([]policydto.PolicyResponse, len(policies))
+	for i, policy := range policies {
+		items[i] = mapPolicyToResponse(policy)
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &policydto.ListResponse{
+		Policies: items,
+		Pagination: policydto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/policydto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Update(ctx context.Context, tenantID, id uuid.UUID, req *policydto.UpdateRequest) (*policydto.PolicyResponse, error) {
+	policy, err := uc.getPolicy(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	before := mapPolicyToResponse(policy)
+
+	if len(req.Condition) > 0 {
+		condition, err := parseCondition(req.Condition, policy.ResourceType != nil)
+		if err != nil {
+			return nil, err
+		}
+		encoded, err := json.Marshal(condition)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to encode policy condition").WithError(err)
+		}
+		policy.Condition = encoded
+	}
+	if req.Name != nil {
+		policy.Name = *req.Name
+	}
+	if req.Description != nil {
+		policy.Description = req.Description
+	}
+	if req.Mode != nil {
+		policy.Mode = entity.PolicyMode(*req.Mode)
+	}
+	if req.IsActive != nil {
+		policy.IsActive = *req.IsActive
+	}
+	policy.UpdatedBy = &req.ActorID
+	policy.UpdatedAt = time.Now()
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.savePolicy(txCtx, policy, false); err != nil {
+			return err
+		}
+		if err := uc.recordAudit(txCtx, policy, entity.AdminActionUpdatePolicy, req.ActorID, req.IPAddress, req.UserAgent, before, mapPolicyToResponse(policy)); err != nil {
+			return errors.ErrInternal("failed to record audit log").WithError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := mapPolicyToResponse(policy)
+	return &resp, nil
+}
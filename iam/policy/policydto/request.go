@@ -0,0 +1,65 @@
+package policydto
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// CreateRequest defines a policy guarding PermissionCode. Condition is a JSON
+// condition tree; it may reference resource attributes only when the policy
+// is bound to a ResourceType.
+type CreateRequest struct {
+	Name           string          `json:"name" validate:"required,min=2,max=255"`
+	Description    *string         `json:"description,omitempty" validate:"omitempty,max=1000"`
+	PermissionCode string          `json:"permission_code" validate:"required,min=3,max=100"`
+	ResourceType   *string         `json:"resource_type,omitempty" validate:"omitempty,min=2,max=50"`
+	Condition      json.RawMessage `json:"condition" validate:"required"`
+	Mode           string          `json:"mode" validate:"omitempty,oneof=ENFORCE DRY_RUN"`
+	IsActive       *bool           `json:"is_active,omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type ListRequest struct {
+	PermissionCode string `query:"permission_code" validate:"omitempty,max=100"`
+	ResourceType   string `query:"resource_type" validate:"omitempty,max=50"`
+	Mode           string `query:"mode" validate:"omitempty,oneof=ENFORCE DRY_RUN"`
+	IsActive       *bool  `query:"is_active"`
+	Page           int    `query:"page" validate:"omitempty,min=1"`
+	PerPage        int    `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+// UpdateRequest leaves the guarded permission and resource type untouched;
+// create a new policy to guard something else.
+type UpdateRequest struct {
+	Name        *string         `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	Description *string         `json:"description,omitempty" validate:"omitempty,max=1000"`
+	Condition   json.RawMessage `json:"condition,omitempty"`
+	Mode        *string         `json:"mode,omitempty" validate:"omitempty,oneof=ENFORCE DRY_RUN"`
+	IsActive    *bool           `json:"is_active,omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type DeleteRequest struct {
+	ActorID   uuid.UUID
+	IPAddress string
+	UserAgent string
+}
@@ -0,0 +1,36 @@
+package policydto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PolicyResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	TenantID       uuid.UUID       `json:"tenant_id"`
+	Name           string          `json:"name"`
+	Description    *string         `json:"description,omitempty"`
+	PermissionCode string          `json:"permission_code"`
+	ResourceType   *string         `json:"resource_type,omitempty"`
+	Condition      json.RawMessage `json:"condition"`
+	Mode           string          `json:"mode"`
+	IsActive       bool            `json:"is_active"`
+	CreatedBy      uuid.UUID       `json:"created_by"`
+	UpdatedBy      *uuid.UUID      `json:"updated_by,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type ListResponse struct {
+	Policies   []PolicyResponse `json:"policies"`
+	Pagination Pagination       `json:"pagination"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
@@ -1,15 +1,16 @@
 package publickey
 
 import (
-	"encoding/base64"
 	"iam-service/config"
 	"iam-service/pkg/jwt"
-	"math/big"
-	"os"
 
 	"github.com/gofiber/fiber/v2"
 )
 
+// Verifiers refresh on an unknown kid, so a short cache is enough to keep
+// load down while letting rotations propagate quickly.
+const publicKeyCacheControl = "public, max-age=300"
+
 type Handler struct {
 	Config *config.Config
 }
@@ -21,66 +22,57 @@ func NewHandler(cfg *config.Config) *Handler {
 }
 
 func (h *Handler) GetPublicKeyPEM(c *fiber.Ctx) error {
-	keyData, err := os.ReadFile(h.Config.JWT.PublicKeyPath)
+	if h.Config.JWT.KeyRing == nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Public key is not available for this signing method",
+		})
+	}
+
+	active, err := h.Config.JWT.KeyRing.Active()
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to read public key",
+			"error": "Failed to load public key",
+		})
+	}
+
+	keyData, err := jwt.EncodePublicKeyPEM(active.PublicKey)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to encode public key",
 		})
 	}
 
 	c.Set("Content-Type", "application/x-pem-file")
-	c.Set("Cache-Control", "public, max-age=3600")
+	c.Set("Cache-Control", publicKeyCacheControl)
 
 	return c.Send(keyData)
 }
 
 type JWKSResponse struct {
-	Keys []JWK `json:"keys"`
-}
-
-type JWK struct {
-	Kty string `json:"kty"`
-	Use string `json:"use"`
-	Kid string `json:"kid"`
-	N   string `json:"n"`
-	E   string `json:"e"`
+	Keys []jwt.JWK `json:"keys"`
 }
 
 func (h *Handler) GetJWKS(c *fiber.Ctx) error {
-
-	publicKey, err := jwt.LoadPublicKeyFromFile(h.Config.JWT.PublicKeyPath)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Failed to load public key",
-		})
-	}
-
-	jwk := JWK{
-		Kty: "RSA",
-		Use: "sig",
-		Kid: "2024-01-key",
-		N:   encodeBase64URL(publicKey.N.Bytes()),
-		E:   encodeBase64URL(bigIntToBytes(publicKey.E)),
+	response := JWKSResponse{
+		Keys: []jwt.JWK{},
 	}
 
-	response := JWKSResponse{
-		Keys: []JWK{jwk},
+	if h.Config.JWT.KeyRing != nil {
+		for _, key := range h.Config.JWT.KeyRing.PublishedKeys() {
+			jwk, err := jwt.NewJWK(key.PublicKey, key.Kid)
+			if err != nil {
+				continue
+			}
+			response.Keys = append(response.Keys, jwk)
+		}
 	}
 
 	c.Set("Content-Type", "application/json")
-	c.Set("Cache-Control", "public, max-age=3600")
+	c.Set("Cache-Control", publicKeyCacheControl)
 
 	return c.JSON(response)
 }
 
-func encodeBase64URL(data []byte) string {
-	return base64.RawURLEncoding.EncodeToString(data)
-}
-
-func bigIntToBytes(n int) []byte {
-	return big.NewInt(int64(n)).Bytes()
-}
-
 func (h *Handler) RegisterRoutes(router fiber.Router) {
 	wellKnown := router.Group("/.well-known")
 	wellKnown.Get("/public-key.pem", h.GetPublicKeyPEM)
@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"iam-service/entity"
 
@@ -48,8 +49,26 @@ type TenantRepository interface {
 type RoleRepository interface {
 	Create(ctx context.Context, role *entity.Role) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
 	GetByName(ctx context.Context, tenantID uuid.UUID, name string) (*entity.Role, error)
 	GetByCode(ctx context.Context, tenantID uuid.UUID, code string) (*entity.Role, error)
+	Update(ctx context.Context, role *entity.Role) error
+	List(ctx context.Context, filter *RoleListFilter) ([]*entity.Role, int64, error)
+	ListAncestors(ctx context.Context, id uuid.UUID) ([]*entity.Role, error)
+	CountChildren(ctx context.Context, id uuid.UUID) (int64, error)
+	SubtreeHeight(ctx context.Context, id uuid.UUID) (int, error)
+	LockHierarchy(ctx context.Context, tenantID uuid.UUID) error
+}
+
+type RoleListFilter struct {
+	TenantID      *uuid.UUID
+	IncludeSystem bool
+	ProductID     *uuid.UUID
+	ScopeLevel    string
+	IsActive      *bool
+	Search        string
+	Page          int
+	PerPage       int
 }
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, token *entity.RefreshToken) error
@@ -65,4 +84,30 @@ type PINVerificationLogRepository interface {
 
 type RolePermissionRepository interface {
 	Create(ctx context.Context, rolePermission *entity.RolePermission) error
+	Delete(ctx context.Context, roleID, permissionID uuid.UUID) error
+	ListPermissionIDsByRoleID(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type PermissionRepository interface {
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Permission, error)
+	ListByRoleID(ctx context.Context, roleID uuid.UUID) ([]entity.Permission, error)
+	GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error)
+}
+
+type UserRoleRepository interface {
+	CountActiveByRoleID(ctx context.Context, roleID uuid.UUID) (int64, error)
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+	ListUserIDsInheritingRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error)
+}
+
+type ProductRepository interface {
+	GetByIDAndTenant(ctx context.Context, productID, tenantID uuid.UUID) (*entity.Product, error)
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AdminAuditLog) error
+}
+
+type TokenBlacklistStore interface {
+	BlacklistUser(ctx context.Context, userID uuid.UUID, timestamp time.Time, ttl time.Duration) error
 }
@@ -3,8 +3,17 @@ package contract
 import (
 	"context"
 	"iam-service/iam/role/roledto"
+
+	"github.com/google/uuid"
 )
 
 type Usecase interface {
-	Create(ctx context.Context, req *roledto.CreateRequest) (*roledto.CreateResponse, error)
+	Create(ctx context.Context, tenantID uuid.UUID, req *roledto.CreateRequest) (*roledto.CreateResponse, error)
+	List(ctx context.Context, tenantID uuid.UUID, req *roledto.ListRequest) (*roledto.ListResponse, error)
+	GetByID(ctx context.Context, tenantID, id uuid.UUID) (*roledto.RoleDetailResponse, error)
+	GetEffectivePermissions(ctx context.Context, tenantID, id uuid.UUID) (*roledto.EffectivePermissionsResponse, error)
+	Update(ctx context.Context, tenantID, id uuid.UUID, req *roledto.UpdateRequest) (*roledto.RoleDetailResponse, error)
+	Delete(ctx context.Context, tenantID, id uuid.UUID, req *roledto.DeleteRequest) error
+	AddPermissions(ctx context.Context, tenantID, id uuid.UUID, req *roledto.PermissionsRequest) (*roledto.RoleDetailResponse, error)
+	RemovePermissions(ctx context.Context, tenantID, id uuid.UUID, req *roledto.PermissionsRequest) (*roledto.RoleDetailResponse, error)
 }
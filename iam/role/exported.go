@@ -14,6 +14,11 @@ func NewUsecase(
 	tenantRepo contract.TenantRepository,
 	roleRepo contract.RoleRepository,
 	rolePermissionRepo contract.RolePermissionRepository,
+	permissionRepo contract.PermissionRepository,
+	userRoleRepo contract.UserRoleRepository,
+	productRepo contract.ProductRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
 ) Usecase {
 	return internal.NewUsecase(
 		txManager,
@@ -21,5 +26,10 @@ func NewUsecase(
 		tenantRepo,
 		roleRepo,
 		rolePermissionRepo,
+		permissionRepo,
+		userRoleRepo,
+		productRepo,
+		adminAuditLogRepo,
+		tokenBlacklist,
 	)
 }
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) AddPermissions(ctx context.Context, tenantID, id uuid.UUID, req *roledto.PermissionsRequest) (*roledto.RoleDetailResponse, error) {
+	role, err := uc.getMutableRole(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	permissionIDs, err := uc.resolvePermissions(ctx, tenantID, req.ActorID, role.Code, req.PermissionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.RolePermissionRepo.ListPermissionIDsByRoleID(ctx, role.ID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+	}
+	attached := make(map[uuid.UUID]struct{}, len(current))
+	for _, permissionID := range current {
+		attached[permissionID] = struct{}{}
+	}
+
+	added := make([]uuid.UUID, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		if _, ok := attached[permissionID]; !ok {
+			added = append(added, permissionID)
+		}
+	}
+	if len(added) == 0 {
+		return uc.buildRoleDetail(ctx, role)
+	}
+
+	now := time.Now()
+	var holders []uuid.UUID
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		for _, permissionID := range added {
+			if err := uc.RolePermissionRepo.Create(txCtx, &entity.RolePermission{
+				RoleID:       role.ID,
+				PermissionID: permissionID,
+				CreatedAt:    now,
+			}); err != nil {
+				return err
+			}
+		}
+		userIDs, err := uc.UserRoleRepo.ListUserIDsInheritingRole(txCtx, role.ID)
+		if err != nil {
+			return err
+		}
+		holders = userIDs
+		return uc.recordAudit(txCtx, role, entity.AdminActionAddRolePermissions, req.ActorID, req.IPAddress, req.UserAgent,
+			map[string]any{"permission_ids": current},
+			map[string]any{"added_permission_ids": added},
+		)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to add role permissions").WithError(err)
+	}
+	uc.invalidateTokens(ctx, holders)
+
+	return uc.buildRoleDetail(ctx, role)
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddPermissions(t *testing.T) {
+	tenantID := uuid.New()
+	roleID := uuid.New()
+	actorID := uuid.New()
+	actorRoleID := uuid.New()
+	productID := uuid.New()
+	holderID := uuid.New()
+
+	tests := []struct {
+		name       string
+		permission entity.Permission
+		actorHolds []string
+		ownProduct bool
+		wantStatus int
+	}{
+		{
+			name:       "permission held by the actor",
+			permission: entity.Permission{Code: "participant:read", ProductID: productID},
+			actorHolds: []string{"participant:read", "role:update"},
+			ownProduct: true,
+		},
+		{
+			name:       "permission the actor does not hold",
+			permission: entity.Permission{Code: "role:delete", ProductID: productID},
+			actorHolds: []string{"role:update"},
+			ownProduct: true,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "system permission",
+			permission: entity.Permission{Code: "tenant:manage", ProductID: productID, IsSystem: true},
+			actorHolds: []string{"tenant:manage", "role:update"},
+			ownProduct: true,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "permission of another tenant's product",
+			permission: entity.Permission{Code: "participant:read", ProductID: productID},
+			actorHolds: []string{"participant:read", "role:update"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permission := tt.permission
+			permission.ID = uuid.New()
+			role := &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER", IsActive: true}
+
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, roleID).Return(role, nil)
+			roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{actorRoleID}).Return([]*entity.Role{{ID: actorRoleID, TenantID: &tenantID, IsActive: true}}, nil).Maybe()
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("GetByIDs", mock.Anything, []uuid.UUID{permission.ID}).Return([]entity.Permission{permission}, nil)
+			permissionRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{actorRoleID}).Return(tt.actorHolds, nil).Maybe()
+			permissionRepo.On("ListByRoleID", mock.Anything, roleID).Return([]entity.Permission{permission}, nil).Maybe()
+			productRepo := new(MockProductRepository)
+			if tt.ownProduct {
+				productRepo.On("GetByIDAndTenant", mock.Anything, productID, tenantID).Return(&entity.Product{ID: productID}, nil).Maybe()
+			} else {
+				productRepo.On("GetByIDAndTenant", mock.Anything, productID, tenantID).Return(nil, errors.ErrNotFound("product not found"))
+			}
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("ListActiveByUserID", mock.Anything, actorID, (*uuid.UUID)(nil)).Return([]entity.UserRole{{UserID: actorID, RoleID: actorRoleID}}, nil).Maybe()
+			userRoleRepo.On("ListUserIDsInheritingRole", mock.Anything, roleID).Return([]uuid.UUID{holderID}, nil).Maybe()
+			rolePermissionRepo := new(MockRolePermissionRepository)
+			rolePermissionRepo.On("ListPermissionIDsByRoleID", mock.Anything, roleID).Return([]uuid.UUID{}, nil).Maybe()
+			rolePermissionRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			blacklist := new(MockTokenBlacklistStore)
+			blacklist.On("BlacklistUser", mock.Anything, holderID, mock.Anything, TokenInvalidationTTL).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:          NewMockTransactionManager(),
+				Config:             &config.Config{},
+				RoleRepo:           roleRepo,
+				RolePermissionRepo: rolePermissionRepo,
+				PermissionRepo:     permissionRepo,
+				UserRoleRepo:       userRoleRepo,
+				ProductRepo:        productRepo,
+				AdminAuditLogRepo:  auditRepo,
+				TokenBlacklist:     blacklist,
+			}
+
+			resp, err := uc.AddPermissions(context.Background(), tenantID, roleID, &roledto.PermissionsRequest{
+				PermissionIDs: []uuid.UUID{permission.ID},
+				ActorID:       actorID,
+			})
+
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.wantStatus, appErr.HTTPStatus)
+				rolePermissionRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				blacklist.AssertNotCalled(t, "BlacklistUser", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, resp.Permissions, 1)
+			rolePermissionRepo.AssertCalled(t, "Create", mock.Anything, mock.MatchedBy(func(rp *entity.RolePermission) bool {
+				return rp.RoleID == roleID && rp.PermissionID == permission.ID
+			}))
+			blacklist.AssertCalled(t, "BlacklistUser", mock.Anything, holderID, mock.Anything, TokenInvalidationTTL)
+		})
+	}
+}
@@ -11,6 +11,11 @@ type usecase struct {
 	TenantRepo         contract.TenantRepository
 	RoleRepo           contract.RoleRepository
 	RolePermissionRepo contract.RolePermissionRepository
+	PermissionRepo     contract.PermissionRepository
+	UserRoleRepo       contract.UserRoleRepository
+	ProductRepo        contract.ProductRepository
+	AdminAuditLogRepo  contract.AdminAuditLogRepository
+	TokenBlacklist     contract.TokenBlacklistStore
 }
 
 func NewUsecase(
@@ -19,6 +24,11 @@ func NewUsecase(
 	tenantRepo contract.TenantRepository,
 	roleRepo contract.RoleRepository,
 	rolePermissionRepo contract.RolePermissionRepository,
+	permissionRepo contract.PermissionRepository,
+	userRoleRepo contract.UserRoleRepository,
+	productRepo contract.ProductRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
 ) *usecase {
 	return &usecase{
 		TxManager:          txManager,
@@ -26,5 +36,10 @@ func NewUsecase(
 		TenantRepo:         tenantRepo,
 		RoleRepo:           roleRepo,
 		RolePermissionRepo: rolePermissionRepo,
+		PermissionRepo:     permissionRepo,
+		UserRoleRepo:       userRoleRepo,
+		ProductRepo:        productRepo,
+		AdminAuditLogRepo:  adminAuditLogRepo,
+		TokenBlacklist:     tokenBlacklist,
 	}
 }
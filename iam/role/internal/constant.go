@@ -0,0 +1,7 @@
+package internal
+
+import "time"
+
+// TokenInvalidationTTL covers the longest-lived access token when the access
+// expiry is not configured.
+const TokenInvalidationTTL = 15 * time.Minute
@@ -7,10 +7,12 @@ import (
 	"iam-service/entity"
 	"iam-service/iam/role/roledto"
 	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
 )
 
-func (uc *usecase) Create(ctx context.Context, req *roledto.CreateRequest) (*roledto.CreateResponse, error) {
-	tenant, err := uc.TenantRepo.GetByID(ctx, req.TenantID)
+func (uc *usecase) Create(ctx context.Context, tenantID uuid.UUID, req *roledto.CreateRequest) (*roledto.CreateResponse, error) {
+	tenant, err := uc.TenantRepo.GetByID(ctx, tenantID)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, errors.ErrTenantNotFound()
@@ -21,7 +23,7 @@ func (uc *usecase) Create(ctx context.Context, req *roledto.CreateRequest) (*rol
 		return nil, errors.ErrTenantInactive()
 	}
 
-	existingRole, err := uc.RoleRepo.GetByCode(ctx, req.TenantID, req.Code)
+	existingRole, err := uc.RoleRepo.GetByCode(ctx, tenantID, req.Code)
 	if err != nil && !errors.IsNotFound(err) {
 		return nil, errors.ErrInternal("failed to check role existence").WithError(err)
 	}
@@ -35,24 +37,42 @@ func (uc *usecase) Create(ctx context.Context, req *roledto.CreateRequest) (*rol
 		return nil, errors.ErrValidation("invalid scope level")
 	}
 
+	permissionIDs := req.Permissions
+	if len(permissionIDs) > 0 {
+		permissionIDs, err = uc.resolvePermissions(ctx, tenantID, req.ActorID, req.Code, permissionIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now()
 	role := &entity.Role{
-		TenantID:    &req.TenantID,
-		Code:        req.Code,
-		Name:        req.Name,
-		Description: req.Description,
-		ScopeLevel:  scopeLevel,
-		IsSystem:    false,
-		IsActive:    true,
+		TenantID:     &tenantID,
+		Code:         req.Code,
+		Name:         req.Name,
+		Description:  req.Description,
+		ParentRoleID: req.ParentRoleID,
+		ScopeLevel:   scopeLevel,
+		IsSystem:     false,
+		IsActive:     true,
 	}
 
+	var parentErr error
 	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if req.ParentRoleID != nil {
+			if err := uc.RoleRepo.LockHierarchy(txCtx, tenantID); err != nil {
+				return err
+			}
+			if parentErr = uc.validateParent(txCtx, &tenantID, uuid.Nil, *req.ParentRoleID); parentErr != nil {
+				return parentErr
+			}
+		}
 		if err := uc.RoleRepo.Create(txCtx, role); err != nil {
 			return err
 		}
 
-		if len(req.Permissions) > 0 {
-			for _, permissionID := range req.Permissions {
+		if len(permissionIDs) > 0 {
+			for _, permissionID := range permissionIDs {
 				rolePermission := &entity.RolePermission{
 					RoleID:       role.ID,
 					PermissionID: permissionID,
@@ -65,23 +85,30 @@ func (uc *usecase) Create(ctx context.Context, req *roledto.CreateRequest) (*rol
 			}
 		}
 
-		return nil
+		return uc.recordAudit(txCtx, role, entity.AdminActionCreateRole, req.ActorID, req.IPAddress, req.UserAgent, nil, map[string]any{
+			"role":           mapRoleToResponse(role),
+			"permission_ids": permissionIDs,
+		})
 	})
 
+	if parentErr != nil {
+		return nil, parentErr
+	}
 	if err != nil {
 		return nil, errors.ErrInternal("failed to create role").WithError(err)
 	}
 
 	response := &roledto.CreateResponse{
-		RoleID:      role.ID,
-		TenantID:    req.TenantID,
-		Code:        role.Code,
-		Name:        role.Name,
-		Description: role.Description,
-		ScopeLevel:  string(role.ScopeLevel),
-		IsSystem:    role.IsSystem,
-		IsActive:    role.IsActive,
-		CreatedAt:   role.CreatedAt,
+		RoleID:       role.ID,
+		TenantID:     tenantID,
+		Code:         role.Code,
+		Name:         role.Name,
+		Description:  role.Description,
+		ParentRoleID: role.ParentRoleID,
+		ScopeLevel:   string(role.ScopeLevel),
+		IsSystem:     role.IsSystem,
+		IsActive:     role.IsActive,
+		CreatedAt:    role.CreatedAt,
 	}
 
 	return response, nil
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate_UsesCallerTenant(t *testing.T) {
+	tenantID := uuid.New()
+
+	tenantRepo := new(MockTenantRepository)
+	tenantRepo.On("GetByID", mock.Anything, tenantID).Return(&entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive}, nil)
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByCode", mock.Anything, tenantID, "TELLER").Return(nil, errors.ErrNotFound("role not found"))
+	roleRepo.On("Create", mock.Anything, mock.MatchedBy(func(role *entity.Role) bool {
+		return role.TenantID != nil && *role.TenantID == tenantID
+	})).Return(nil)
+	auditRepo := new(MockAdminAuditLogRepository)
+	auditRepo.On("Create", mock.Anything, mock.MatchedBy(func(log *entity.AdminAuditLog) bool {
+		return log.TenantID != nil && *log.TenantID == tenantID
+	})).Return(nil)
+
+	uc := &usecase{
+		TxManager:         NewMockTransactionManager(),
+		TenantRepo:        tenantRepo,
+		RoleRepo:          roleRepo,
+		AdminAuditLogRepo: auditRepo,
+	}
+
+	resp, err := uc.Create(context.Background(), tenantID, &roledto.CreateRequest{
+		Code:       "TELLER",
+		Name:       "Teller",
+		ScopeLevel: string(entity.ScopeLevelBranch),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, tenantID, resp.TenantID)
+	roleRepo.AssertExpectations(t)
+	auditRepo.AssertExpectations(t)
+}
+
+func TestCreate_RejectsPlatformParent(t *testing.T) {
+	tenantID := uuid.New()
+	parentID := uuid.New()
+
+	tenantRepo := new(MockTenantRepository)
+	tenantRepo.On("GetByID", mock.Anything, tenantID).Return(&entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive}, nil)
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByCode", mock.Anything, tenantID, "TELLER").Return(nil, errors.ErrNotFound("role not found"))
+	roleRepo.On("LockHierarchy", mock.Anything, tenantID).Return(nil)
+	roleRepo.On("GetByID", mock.Anything, parentID).Return(&entity.Role{ID: parentID, Code: "SUPER_ADMIN", IsSystem: true, IsActive: true}, nil)
+
+	uc := &usecase{
+		TxManager:  NewMockTransactionManager(),
+		TenantRepo: tenantRepo,
+		RoleRepo:   roleRepo,
+	}
+
+	_, err := uc.Create(context.Background(), tenantID, &roledto.CreateRequest{
+		Code:         "TELLER",
+		Name:         "Teller",
+		ScopeLevel:   string(entity.ScopeLevelBranch),
+		ParentRoleID: &parentID,
+	})
+
+	require.Error(t, err)
+	var appErr *errors.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, errors.CodeBadRequest, appErr.Code)
+	roleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
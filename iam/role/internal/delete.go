@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Delete(ctx context.Context, tenantID, id uuid.UUID, req *roledto.DeleteRequest) error {
+	role, err := uc.getMutableRole(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	assigned, err := uc.UserRoleRepo.CountActiveByRoleID(ctx, role.ID)
+	if err != nil {
+		return errors.ErrInternal("failed to check role assignments").WithError(err)
+	}
+	if assigned > 0 {
+		return errors.ErrConflict(fmt.Sprintf("Role is still assigned to %d user(s)", assigned))
+	}
+
+	children, err := uc.RoleRepo.CountChildren(ctx, role.ID)
+	if err != nil {
+		return errors.ErrInternal("failed to check child roles").WithError(err)
+	}
+	if children > 0 {
+		return errors.ErrConflict(fmt.Sprintf("Role is the parent of %d role(s)", children))
+	}
+
+	before := mapRoleToResponse(role)
+	now := time.Now()
+	role.IsActive = false
+	role.UpdatedAt = now
+	role.DeletedAt = sql.NullTime{Time: now, Valid: true}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.RoleRepo.Update(txCtx, role); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, role, entity.AdminActionDeleteRole, req.ActorID, req.IPAddress, req.UserAgent, before, nil)
+	})
+	if err != nil {
+		return errors.ErrInternal("failed to delete role").WithError(err)
+	}
+	return nil
+}
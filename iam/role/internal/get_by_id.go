@@ -0,0 +1,17 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/role/roledto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*roledto.RoleDetailResponse, error) {
+	role, err := uc.getRole(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	return uc.buildRoleDetail(ctx, role)
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByID(t *testing.T) {
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	roleID := uuid.New()
+
+	tests := []struct {
+		name         string
+		role         *entity.Role
+		expectedCode string
+	}{
+		{
+			name: "success - role of the caller tenant",
+			role: &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER"},
+		},
+		{
+			name: "success - system role is visible to every tenant",
+			role: &entity.Role{ID: roleID, Code: "PLATFORM_ADMIN", IsSystem: true},
+		},
+		{
+			name:         "error - role of another tenant",
+			role:         &entity.Role{ID: roleID, TenantID: &otherTenantID, Code: "TELLER"},
+			expectedCode: errors.CodeRoleNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, roleID).Return(tt.role, nil)
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("ListByRoleID", mock.Anything, roleID).Return([]entity.Permission{}, nil).Maybe()
+
+			uc := &usecase{
+				RoleRepo:       roleRepo,
+				PermissionRepo: permissionRepo,
+			}
+
+			resp, err := uc.GetByID(context.Background(), tenantID, roleID)
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, roleID, resp.ID)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetEffectivePermissions(ctx context.Context, tenantID, id uuid.UUID) (*roledto.EffectivePermissionsResponse, error) {
+	role, err := uc.getRole(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors, err := uc.RoleRepo.ListAncestors(ctx, role.ID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get role ancestors").WithError(err)
+	}
+
+	resp := &roledto.EffectivePermissionsResponse{
+		Role:        mapRoleToSummary(role),
+		Ancestors:   make([]roledto.RoleSummary, len(ancestors)),
+		Permissions: []roledto.EffectivePermissionResponse{},
+	}
+	for i, ancestor := range ancestors {
+		resp.Ancestors[i] = mapRoleToSummary(ancestor)
+	}
+
+	// Inheritance stops at the first inactive ancestor, matching how claims
+	// are resolved at login.
+	chain := []*entity.Role{role}
+	for _, ancestor := range ancestors {
+		if !ancestor.IsActive {
+			break
+		}
+		chain = append(chain, ancestor)
+	}
+
+	seen := make(map[uuid.UUID]struct{})
+	for _, source := range chain {
+		permissions, err := uc.PermissionRepo.ListByRoleID(ctx, source.ID)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+		}
+		for i := range permissions {
+			if _, ok := seen[permissions[i].ID]; ok {
+				continue
+			}
+			seen[permissions[i].ID] = struct{}{}
+			resp.Permissions = append(resp.Permissions, roledto.EffectivePermissionResponse{
+				PermissionResponse: mapPermissionToResponse(&permissions[i]),
+				SourceRoleID:       source.ID,
+				SourceRoleCode:     source.Code,
+				Inherited:          source.ID != role.ID,
+			})
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,249 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/rbac"
+
+	"github.com/google/uuid"
+)
+
+// getRole loads a role visible to tenantID: one of the tenant's own roles or a
+// platform-wide system role. Other tenants' roles are reported as not found.
+func (uc *usecase) getRole(ctx context.Context, tenantID, id uuid.UUID) (*entity.Role, error) {
+	role, err := uc.RoleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrRoleNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get role").WithError(err)
+	}
+	if role.TenantID != nil && *role.TenantID != tenantID {
+		return nil, errors.ErrRoleNotFound()
+	}
+	return role, nil
+}
+
+// getMutableRole loads a role that may be changed through the API. Seeded
+// system roles are managed by migrations only.
+func (uc *usecase) getMutableRole(ctx context.Context, tenantID, id uuid.UUID) (*entity.Role, error) {
+	role, err := uc.getRole(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsSystem || role.TenantID == nil {
+		return nil, errors.ErrForbidden("System roles cannot be modified")
+	}
+	return role, nil
+}
+
+// validateParent checks that parentID can become the parent of roleID: it must
+// be an active role of the same tenant, must not have roleID among its ancestors,
+// and the deepest role below roleID must stay within MaxRoleHierarchyDepth.
+// roleID is uuid.Nil for a role that is still being created. Callers run it in
+// the transaction that saves the role, after LockHierarchy.
+func (uc *usecase) validateParent(ctx context.Context, tenantID *uuid.UUID, roleID, parentID uuid.UUID) error {
+	if parentID == roleID {
+		return errors.ErrBadRequest("Role cannot be its own parent")
+	}
+
+	parent, err := uc.RoleRepo.GetByID(ctx, parentID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("Parent role not found")
+		}
+		return errors.ErrInternal("failed to get parent role").WithError(err)
+	}
+	if !parent.IsActive {
+		return errors.ErrBadRequest("Parent role is inactive")
+	}
+	// Platform role permissions are not the tenant's to hand out; inheriting
+	// them would grant them to every holder of the child role.
+	if parent.TenantID == nil {
+		return errors.ErrBadRequest("Platform roles cannot be the parent of a tenant role")
+	}
+	if tenantID == nil || *parent.TenantID != *tenantID {
+		return errors.ErrBadRequest("Parent role does not belong to this tenant")
+	}
+
+	ancestors, err := uc.RoleRepo.ListAncestors(ctx, parent.ID)
+	if err != nil {
+		return errors.ErrInternal("failed to get role ancestors").WithError(err)
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == roleID {
+			return errors.ErrBadRequest("Parent role would create a cycle in the role hierarchy")
+		}
+	}
+
+	height := 0
+	if roleID != uuid.Nil {
+		height, err = uc.RoleRepo.SubtreeHeight(ctx, roleID)
+		if err != nil {
+			return errors.ErrInternal("failed to get role descendants").WithError(err)
+		}
+	}
+	if len(ancestors)+1+height >= entity.MaxRoleHierarchyDepth {
+		return errors.ErrBadRequest(fmt.Sprintf("Role hierarchy cannot be deeper than %d levels", entity.MaxRoleHierarchyDepth))
+	}
+	return nil
+}
+
+// resolvePermissions de-duplicates permissionIDs and checks that the actor may
+// put them on the tenant role roleCode: every permission must exist, belong to
+// one of the tenant's products, not be a system permission, and already be held
+// by the actor, so role:update cannot be used to escalate privileges.
+func (uc *usecase) resolvePermissions(ctx context.Context, tenantID, actorID uuid.UUID, roleCode string, permissionIDs []uuid.UUID) ([]uuid.UUID, error) {
+	unique := make([]uuid.UUID, 0, len(permissionIDs))
+	seen := make(map[uuid.UUID]struct{}, len(permissionIDs))
+	for _, id := range permissionIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	permissions, err := uc.PermissionRepo.GetByIDs(ctx, unique)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get permissions").WithError(err)
+	}
+	if len(permissions) != len(unique) {
+		return nil, errors.ErrBadRequest("One or more permissions do not exist")
+	}
+
+	tenantProducts := make(map[uuid.UUID]struct{})
+	codes := make([]string, 0, len(permissions))
+	for _, permission := range permissions {
+		if permission.IsSystem {
+			return nil, errors.ErrForbidden("System permission " + permission.Code + " cannot be granted through tenant roles")
+		}
+		if _, ok := tenantProducts[permission.ProductID]; !ok {
+			if _, err := uc.ProductRepo.GetByIDAndTenant(ctx, permission.ProductID, tenantID); err != nil {
+				if errors.IsNotFound(err) {
+					return nil, errors.ErrBadRequest("Permission " + permission.Code + " does not belong to a product of this tenant")
+				}
+				return nil, errors.ErrInternal("failed to get product").WithError(err)
+			}
+			tenantProducts[permission.ProductID] = struct{}{}
+		}
+		codes = append(codes, permission.Code)
+	}
+
+	if err := rbac.VerifyDelegable(ctx, uc.UserRoleRepo, uc.RoleRepo, uc.PermissionRepo, tenantID, actorID, "Role "+roleCode, codes); err != nil {
+		return nil, err
+	}
+	return unique, nil
+}
+
+// invalidateTokens rejects access tokens issued before now so the users' next
+// refresh picks up the role's changed permissions. userIDs come from
+// ListUserIDsInheritingRole, read in the transaction that changed the role.
+func (uc *usecase) invalidateTokens(ctx context.Context, userIDs []uuid.UUID) {
+	if len(userIDs) == 0 {
+		return
+	}
+	ttl := uc.Config.JWT.AccessExpiry
+	if ttl <= 0 {
+		ttl = TokenInvalidationTTL
+	}
+	now := time.Now()
+	for _, userID := range userIDs {
+		_ = uc.TokenBlacklist.BlacklistUser(context.WithoutCancel(ctx), userID, now, ttl)
+	}
+}
+
+func (uc *usecase) buildRoleDetail(ctx context.Context, role *entity.Role) (*roledto.RoleDetailResponse, error) {
+	permissions, err := uc.PermissionRepo.ListByRoleID(ctx, role.ID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+	}
+
+	detail := &roledto.RoleDetailResponse{
+		RoleResponse: mapRoleToResponse(role),
+		Permissions:  make([]roledto.PermissionResponse, len(permissions)),
+	}
+	for i := range permissions {
+		detail.Permissions[i] = mapPermissionToResponse(&permissions[i])
+	}
+	return detail, nil
+}
+
+func mapPermissionToResponse(permission *entity.Permission) roledto.PermissionResponse {
+	return roledto.PermissionResponse{
+		ID:          permission.ID,
+		Code:        permission.Code,
+		Name:        permission.Name,
+		Description: permission.Description,
+		Module:      permission.Module,
+		Resource:    permission.Resource,
+		Action:      permission.Action,
+		ScopeLevel:  string(permission.ScopeLevel),
+	}
+}
+
+func (uc *usecase) recordAudit(
+	ctx context.Context,
+	role *entity.Role,
+	action entity.AdminAction,
+	actorID uuid.UUID,
+	ipAddress, userAgent string,
+	before, after any,
+) error {
+	log := &entity.AdminAuditLog{
+		TenantID:   role.TenantID,
+		UserID:     actorID,
+		Action:     action,
+		EntityType: entity.EntityTypeRole,
+		EntityID:   &role.ID,
+		IPAddress:  optionalString(ipAddress),
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+	if before != nil {
+		log.BeforeState, _ = json.Marshal(before)
+	}
+	if after != nil {
+		log.AfterState, _ = json.Marshal(after)
+	}
+	return uc.AdminAuditLogRepo.Create(ctx, log)
+}
+
+func mapRoleToResponse(role *entity.Role) roledto.RoleResponse {
+	return roledto.RoleResponse{
+		ID:           role.ID,
+		TenantID:     role.TenantID,
+		ProductID:    role.ProductID,
+		Code:         role.Code,
+		Name:         role.Name,
+		Description:  role.Description,
+		ParentRoleID: role.ParentRoleID,
+		ScopeLevel:   string(role.ScopeLevel),
+		IsSystem:     role.IsSystem,
+		IsActive:     role.IsActive,
+		CreatedAt:    role.CreatedAt,
+		UpdatedAt:    role.UpdatedAt,
+	}
+}
+
+func mapRoleToSummary(role *entity.Role) roledto.RoleSummary {
+	return roledto.RoleSummary{
+		ID:       role.ID,
+		Code:     role.Code,
+		Name:     role.Name,
+		IsActive: role.IsActive,
+	}
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/role/contract"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID uuid.UUID, req *roledto.ListRequest) (*roledto.ListResponse, error) {
+	req.SetDefaults()
+
+	roles, total, err := uc.RoleRepo.List(ctx, &contract.RoleListFilter{
+		TenantID:      &tenantID,
+		IncludeSystem: req.IncludeSystem,
+		ProductID:     req.ProductID,
+		ScopeLevel:    req.ScopeLevel,
+		IsActive:      req.IsActive,
+		Search:        req.Search,
+		Page:          req.Page,
+		PerPage:       req.PerPage,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list roles").WithError(err)
+	}
+
+	items := make([]roledto.RoleResponse, len(roles))
+	for i, role := range roles {
+		items[i] = mapRoleToResponse(role)
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &roledto.ListResponse{
+		Roles: items,
+		Pagination: roledto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
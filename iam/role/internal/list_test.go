@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/iam/role/contract"
+	"iam-service/iam/role/roledto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestList_ScopedToCallerTenant(t *testing.T) {
+	tenantID := uuid.New()
+
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("List", mock.Anything, mock.MatchedBy(func(filter *contract.RoleListFilter) bool {
+		return filter.TenantID != nil && *filter.TenantID == tenantID && filter.IncludeSystem
+	})).Return([]*entity.Role{{ID: uuid.New(), TenantID: &tenantID, Code: "TELLER"}}, int64(1), nil)
+
+	uc := &usecase{RoleRepo: roleRepo}
+
+	resp, err := uc.List(context.Background(), tenantID, &roledto.ListRequest{IncludeSystem: true})
+
+	require.NoError(t, err)
+	assert.Len(t, resp.Roles, 1)
+	assert.Equal(t, int64(1), resp.Pagination.Total)
+	roleRepo.AssertExpectations(t)
+}
@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) RemovePermissions(ctx context.Context, tenantID, id uuid.UUID, req *roledto.PermissionsRequest) (*roledto.RoleDetailResponse, error) {
+	role, err := uc.getMutableRole(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := uc.RolePermissionRepo.ListPermissionIDsByRoleID(ctx, role.ID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get role permissions").WithError(err)
+	}
+	attached := make(map[uuid.UUID]struct{}, len(current))
+	for _, permissionID := range current {
+		attached[permissionID] = struct{}{}
+	}
+
+	removed := make([]uuid.UUID, 0, len(req.PermissionIDs))
+	for _, permissionID := range req.PermissionIDs {
+		if _, ok := attached[permissionID]; ok {
+			removed = append(removed, permissionID)
+			delete(attached, permissionID)
+		}
+	}
+	if len(removed) == 0 {
+		return uc.buildRoleDetail(ctx, role)
+	}
+
+	var holders []uuid.UUID
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		for _, permissionID := range removed {
+			if err := uc.RolePermissionRepo.Delete(txCtx, role.ID, permissionID); err != nil {
+				return err
+			}
+		}
+		userIDs, err := uc.UserRoleRepo.ListUserIDsInheritingRole(txCtx, role.ID)
+		if err != nil {
+			return err
+		}
+		holders = userIDs
+		return uc.recordAudit(txCtx, role, entity.AdminActionRemoveRolePermissions, req.ActorID, req.IPAddress, req.UserAgent,
+			map[string]any{"permission_ids": current},
+			map[string]any{"removed_permission_ids": removed},
+		)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to remove role permissions").WithError(err)
+	}
+	uc.invalidateTokens(ctx, holders)
+
+	return uc.buildRoleDetail(ctx, role)
+}
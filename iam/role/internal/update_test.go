@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/role/roledto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	roleID := uuid.New()
+	name := "Senior Teller"
+
+	tests := []struct {
+		name         string
+		role         *entity.Role
+		expectUpdate bool
+		expectedCode string
+	}{
+		{
+			name:         "success - role of the caller tenant",
+			role:         &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER", Name: "Teller", IsActive: true},
+			expectUpdate: true,
+		},
+		{
+			name:         "error - role of another tenant",
+			role:         &entity.Role{ID: roleID, TenantID: &otherTenantID, Code: "TELLER", IsActive: true},
+			expectedCode: errors.CodeRoleNotFound,
+		},
+		{
+			name:         "error - system role",
+			role:         &entity.Role{ID: roleID, Code: "PLATFORM_ADMIN", IsSystem: true, IsActive: true},
+			expectedCode: errors.CodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, roleID).Return(tt.role, nil)
+			roleRepo.On("Update", mock.Anything, tt.role).Return(nil).Maybe()
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("ListByRoleID", mock.Anything, roleID).Return([]entity.Permission{}, nil).Maybe()
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:         NewMockTransactionManager(),
+				RoleRepo:          roleRepo,
+				PermissionRepo:    permissionRepo,
+				AdminAuditLogRepo: auditRepo,
+			}
+
+			resp, err := uc.Update(context.Background(), tenantID, roleID, &roledto.UpdateRequest{Name: &name})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				roleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, name, resp.Name)
+			roleRepo.AssertCalled(t, "Update", mock.Anything, tt.role)
+		})
+	}
+}
+
+func TestUpdate_Reparent(t *testing.T) {
+	tenantID := uuid.New()
+	roleID := uuid.New()
+	parentID := uuid.New()
+
+	ancestorChain := func(n int) []*entity.Role {
+		ancestors := make([]*entity.Role, n)
+		for i := range ancestors {
+			ancestors[i] = &entity.Role{ID: uuid.New(), TenantID: &tenantID, IsActive: true}
+		}
+		return ancestors
+	}
+
+	tests := []struct {
+		name          string
+		ancestors     []*entity.Role
+		subtreeHeight int
+		expectUpdate  bool
+		expectedCode  string
+	}{
+		{
+			name:          "success - hierarchy stays within the depth limit",
+			ancestors:     ancestorChain(2),
+			subtreeHeight: 3,
+			expectUpdate:  true,
+		},
+		{
+			name:         "error - new parent descends from the role",
+			ancestors:    []*entity.Role{{ID: uuid.New(), TenantID: &tenantID}, {ID: roleID, TenantID: &tenantID}},
+			expectedCode: errors.CodeBadRequest,
+		},
+		{
+			name:          "error - moved subtree would exceed the depth limit",
+			ancestors:     ancestorChain(entity.MaxRoleHierarchyDepth - 4),
+			subtreeHeight: 3,
+			expectedCode:  errors.CodeBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role := &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER", IsActive: true}
+
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, roleID).Return(role, nil)
+			roleRepo.On("GetByID", mock.Anything, parentID).Return(&entity.Role{ID: parentID, TenantID: &tenantID, IsActive: true}, nil)
+			roleRepo.On("LockHierarchy", mock.Anything, tenantID).Return(nil)
+			roleRepo.On("ListAncestors", mock.Anything, parentID).Return(tt.ancestors, nil)
+			roleRepo.On("SubtreeHeight", mock.Anything, roleID).Return(tt.subtreeHeight, nil).Maybe()
+			roleRepo.On("Update", mock.Anything, role).Return(nil).Maybe()
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("ListByRoleID", mock.Anything, roleID).Return([]entity.Permission{}, nil).Maybe()
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			holderID := uuid.New()
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("ListUserIDsInheritingRole", mock.Anything, roleID).Return([]uuid.UUID{holderID}, nil).Maybe()
+			blacklist := new(MockTokenBlacklistStore)
+			blacklist.On("BlacklistUser", mock.Anything, holderID, mock.Anything, TokenInvalidationTTL).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:         NewMockTransactionManager(),
+				Config:            &config.Config{},
+				RoleRepo:          roleRepo,
+				PermissionRepo:    permissionRepo,
+				UserRoleRepo:      userRoleRepo,
+				AdminAuditLogRepo: auditRepo,
+				TokenBlacklist:    blacklist,
+			}
+
+			_, err := uc.Update(context.Background(), tenantID, roleID, &roledto.UpdateRequest{ParentRoleID: &parentID})
+
+			roleRepo.AssertCalled(t, "LockHierarchy", mock.Anything, tenantID)
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				roleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			roleRepo.AssertCalled(t, "Update", mock.Anything, role)
+			blacklist.AssertCalled(t, "BlacklistUser", mock.Anything, holderID, mock.Anything, TokenInvalidationTTL)
+		})
+	}
+}
+
+func TestUpdate_DeactivationInvalidatesHolderTokens(t *testing.T) {
+	tenantID := uuid.New()
+	roleID := uuid.New()
+	holderID := uuid.New()
+	inactive := false
+
+	role := &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER", IsActive: true}
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByID", mock.Anything, roleID).Return(role, nil)
+	roleRepo.On("Update", mock.Anything, role).Return(nil)
+	permissionRepo := new(MockPermissionRepository)
+	permissionRepo.On("ListByRoleID", mock.Anything, roleID).Return([]entity.Permission{}, nil)
+	userRoleRepo := new(MockUserRoleRepository)
+	userRoleRepo.On("ListUserIDsInheritingRole", mock.Anything, roleID).Return([]uuid.UUID{holderID}, nil)
+	auditRepo := new(MockAdminAuditLogRepository)
+	auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	blacklist := new(MockTokenBlacklistStore)
+	blacklist.On("BlacklistUser", mock.Anything, holderID, mock.Anything, TokenInvalidationTTL).Return(nil)
+
+	uc := &usecase{
+		TxManager:         NewMockTransactionManager(),
+		Config:            &config.Config{},
+		RoleRepo:          roleRepo,
+		PermissionRepo:    permissionRepo,
+		UserRoleRepo:      userRoleRepo,
+		AdminAuditLogRepo: auditRepo,
+		TokenBlacklist:    blacklist,
+	}
+
+	resp, err := uc.Update(context.Background(), tenantID, roleID, &roledto.UpdateRequest{IsActive: &inactive})
+
+	require.NoError(t, err)
+	assert.False(t, resp.IsActive)
+	blacklist.AssertExpectations(t)
+}
+
+func TestUpdate_RejectsPlatformParent(t *testing.T) {
+	tenantID := uuid.New()
+	roleID := uuid.New()
+	parentID := uuid.New()
+
+	role := &entity.Role{ID: roleID, TenantID: &tenantID, Code: "TELLER", IsActive: true}
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByID", mock.Anything, roleID).Return(role, nil)
+	roleRepo.On("GetByID", mock.Anything, parentID).Return(&entity.Role{ID: parentID, Code: "PLATFORM_AUDITOR", IsActive: true}, nil)
+	roleRepo.On("LockHierarchy", mock.Anything, tenantID).Return(nil)
+
+	uc := &usecase{
+		TxManager: NewMockTransactionManager(),
+		RoleRepo:  roleRepo,
+	}
+
+	_, err := uc.Update(context.Background(), tenantID, roleID, &roledto.UpdateRequest{ParentRoleID: &parentID})
+
+	require.Error(t, err)
+	var appErr *errors.AppError
+	require.True(t, errors.As(err, &appErr))
+	assert.Equal(t, errors.CodeBadRequest, appErr.Code)
+	roleRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
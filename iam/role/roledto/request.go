@@ -3,10 +3,64 @@ package roledto
 import "github.com/google/uuid"
 
 type CreateRequest struct {
-	TenantID    uuid.UUID   `json:"tenant_id" validate:"required"`
-	Code        string      `json:"code" validate:"required,min=2,max=100,uppercase"`
-	Name        string      `json:"name" validate:"required,min=2,max=255"`
-	Description *string     `json:"description,omitempty" validate:"omitempty,max=1000"`
-	ScopeLevel  string      `json:"scope_level" validate:"required,oneof=tenant branch self"`
-	Permissions []uuid.UUID `json:"permissions,omitempty" validate:"omitempty,dive,uuid"`
+	Code         string      `json:"code" validate:"required,min=2,max=100,uppercase"`
+	Name         string      `json:"name" validate:"required,min=2,max=255"`
+	Description  *string     `json:"description,omitempty" validate:"omitempty,max=1000"`
+	ScopeLevel   string      `json:"scope_level" validate:"required,oneof=tenant branch self"`
+	Permissions  []uuid.UUID `json:"permissions,omitempty" validate:"omitempty,dive,uuid"`
+	ParentRoleID *uuid.UUID  `json:"parent_role_id,omitempty" validate:"omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type ListRequest struct {
+	IncludeSystem bool       `query:"include_system"`
+	ProductID     *uuid.UUID `query:"product_id" validate:"omitempty"`
+	ScopeLevel    string     `query:"scope_level" validate:"omitempty,oneof=system tenant branch self"`
+	IsActive      *bool      `query:"is_active"`
+	Search        string     `query:"search" validate:"omitempty,max=100"`
+	Page          int        `query:"page" validate:"omitempty,min=1"`
+	PerPage       int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type UpdateRequest struct {
+	Name        *string `json:"name,omitempty" validate:"omitempty,min=2,max=255"`
+	Description *string `json:"description,omitempty" validate:"omitempty,max=1000"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+
+	// ParentRoleID re-parents the role; RemoveParent detaches it instead.
+	ParentRoleID *uuid.UUID `json:"parent_role_id,omitempty" validate:"omitempty,excluded_with=RemoveParent"`
+	RemoveParent bool       `json:"remove_parent,omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type DeleteRequest struct {
+	ActorID   uuid.UUID
+	IPAddress string
+	UserAgent string
+}
+
+type PermissionsRequest struct {
+	PermissionIDs []uuid.UUID `json:"permission_ids" validate:"required,min=1,max=100,dive,required"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
 }
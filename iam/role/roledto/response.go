@@ -7,13 +7,79 @@ import (
 )
 
 type CreateResponse struct {
-	RoleID      uuid.UUID `json:"role_id"`
-	TenantID    uuid.UUID `json:"tenant_id"`
+	RoleID       uuid.UUID  `json:"role_id"`
+	TenantID     uuid.UUID  `json:"tenant_id"`
+	Code         string     `json:"code"`
+	Name         string     `json:"name"`
+	Description  *string    `json:"description,omitempty"`
+	ParentRoleID *uuid.UUID `json:"parent_role_id,omitempty"`
+	ScopeLevel   string     `json:"scope_level"`
+	IsSystem     bool       `json:"is_system"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+type RoleResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	TenantID     *uuid.UUID `json:"tenant_id,omitempty"`
+	ProductID    *uuid.UUID `json:"product_id,omitempty"`
+	Code         string     `json:"code"`
+	Name         string     `json:"name"`
+	Description  *string    `json:"description,omitempty"`
+	ParentRoleID *uuid.UUID `json:"parent_role_id,omitempty"`
+	ScopeLevel   string     `json:"scope_level"`
+	IsSystem     bool       `json:"is_system"`
+	IsActive     bool       `json:"is_active"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type PermissionResponse struct {
+	ID          uuid.UUID `json:"id"`
 	Code        string    `json:"code"`
 	Name        string    `json:"name"`
 	Description *string   `json:"description,omitempty"`
+	Module      string    `json:"module"`
+	Resource    string    `json:"resource"`
+	Action      string    `json:"action"`
 	ScopeLevel  string    `json:"scope_level"`
-	IsSystem    bool      `json:"is_system"`
-	IsActive    bool      `json:"is_active"`
-	CreatedAt   time.Time `json:"created_at"`
+}
+
+type RoleDetailResponse struct {
+	RoleResponse
+	Permissions []PermissionResponse `json:"permissions"`
+}
+
+type RoleSummary struct {
+	ID       uuid.UUID `json:"id"`
+	Code     string    `json:"code"`
+	Name     string    `json:"name"`
+	IsActive bool      `json:"is_active"`
+}
+
+type EffectivePermissionResponse struct {
+	PermissionResponse
+	SourceRoleID   uuid.UUID `json:"source_role_id"`
+	SourceRoleCode string    `json:"source_role_code"`
+	Inherited      bool      `json:"inherited"`
+}
+
+// EffectivePermissionsResponse lists the permissions a role grants once its
+// ancestors are included. Ancestors are ordered from the direct parent up.
+type EffectivePermissionsResponse struct {
+	Role        RoleSummary                   `json:"role"`
+	Ancestors   []RoleSummary                 `json:"ancestors"`
+	Permissions []EffectivePermissionResponse `json:"permissions"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ListResponse struct {
+	Roles      []RoleResponse `json:"roles"`
+	Pagination Pagination     `json:"pagination"`
 }
@@ -0,0 +1,81 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type UserRoleListFilter struct {
+	TenantID       uuid.UUID
+	UserID         *uuid.UUID
+	RoleID         *uuid.UUID
+	ProductID      *uuid.UUID
+	BranchID       *uuid.UUID
+	IncludeExpired bool
+	Page           int
+	PerPage        int
+}
+
+type UserRoleRepository interface {
+	Create(ctx context.Context, userRole *entity.UserRole) error
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.UserRole, error)
+	Update(ctx context.Context, userRole *entity.UserRole) error
+	List(ctx context.Context, filter *UserRoleListFilter) ([]entity.UserRole, int64, error)
+	HasOverlapping(ctx context.Context, userRole *entity.UserRole) (bool, error)
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+}
+
+// RoleAssignmentBatchSummary describes a bulk assignment batch and how many of
+// its items are in each status.
+type RoleAssignmentBatchSummary struct {
+	BatchID    uuid.UUID
+	RoleID     uuid.UUID
+	AssignedBy uuid.UUID
+	AssignedAt time.Time
+	Counts     map[entity.RoleAssignmentQueueStatus]int64
+}
+
+type RoleAssignmentQueueRepository interface {
+	CreateBatch(ctx context.Context, items []*entity.RoleAssignmentQueue) error
+	ClaimPending(ctx context.Context, limit int) ([]*entity.RoleAssignmentQueue, error)
+	Update(ctx context.Context, item *entity.RoleAssignmentQueue) error
+	GetBatchSummary(ctx context.Context, tenantID, batchID uuid.UUID) (*RoleAssignmentBatchSummary, error)
+	ListFailedByBatchID(ctx context.Context, batchID uuid.UUID, limit int) ([]*entity.RoleAssignmentQueue, error)
+}
+
+type RoleRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
+}
+
+type PermissionRepository interface {
+	GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error)
+}
+
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+}
+
+type UserTenantRegistrationRepository interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error)
+}
+
+type ProductRepository interface {
+	GetByIDAndTenant(ctx context.Context, productID, tenantID uuid.UUID) (*entity.Product, error)
+}
+
+type BranchRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error)
+}
+
+type AdminAuditLogRepository interface {
+	Create(ctx context.Context, log *entity.AdminAuditLog) error
+}
+
+type TokenBlacklistStore interface {
+	BlacklistUser(ctx context.Context, userID uuid.UUID, timestamp time.Time, ttl time.Duration) error
+}
@@ -0,0 +1,18 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/roleassignment/roleassignmentdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	Assign(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.AssignRequest) (*roleassignmentdto.AssignmentResponse, error)
+	List(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.ListRequest) (*roleassignmentdto.ListResponse, error)
+	Revoke(ctx context.Context, tenantID, id uuid.UUID, req *roleassignmentdto.RevokeRequest) (*roleassignmentdto.AssignmentResponse, error)
+	BulkAssign(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.BulkAssignRequest) (*roleassignmentdto.BatchResponse, error)
+	GetBatch(ctx context.Context, tenantID, batchID uuid.UUID) (*roleassignmentdto.BatchResponse, error)
+	ProcessPendingAssignments(ctx context.Context) (int, error)
+}
@@ -0,0 +1,42 @@
+package roleassignment
+
+import (
+	"iam-service/config"
+	"iam-service/iam/roleassignment/contract"
+	"iam-service/iam/roleassignment/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	userRoleRepo contract.UserRoleRepository,
+	roleAssignmentQueueRepo contract.RoleAssignmentQueueRepository,
+	roleRepo contract.RoleRepository,
+	permissionRepo contract.PermissionRepository,
+	userRepo contract.UserRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	productRepo contract.ProductRepository,
+	branchRepo contract.BranchRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		userRoleRepo,
+		roleAssignmentQueueRepo,
+		roleRepo,
+		permissionRepo,
+		userRepo,
+		userTenantRegRepo,
+		productRepo,
+		branchRepo,
+		adminAuditLogRepo,
+		tokenBlacklist,
+		auditLogger,
+	)
+}
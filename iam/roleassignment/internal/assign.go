@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Assign(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.AssignRequest) (*roleassignmentdto.AssignmentResponse, error) {
+	g, err := uc.resolveGrant(ctx, tenantID, req.RoleID, req.ProductID, req.BranchID, req.EffectiveFrom, req.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyDelegable(ctx, tenantID, req.ActorID, g.role, req.UserID); err != nil {
+		return nil, err
+	}
+
+	userRole := g.userRole(req.UserID, time.Now())
+	if err := uc.checkAssignable(ctx, tenantID, userRole); err != nil {
+		return nil, err
+	}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.UserRoleRepo.Create(txCtx, userRole); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, tenantID, userRole, entity.AdminActionAssignRole, req.ActorID, req.IPAddress, req.UserAgent, nil, userRole)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to assign role").WithError(err)
+	}
+
+	uc.invalidateTokens(ctx, userRole.UserID)
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "role_assignment",
+		Action:     "role_assigned",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "user",
+		TargetID:   userRole.UserID.String(),
+		TargetType: "user",
+		TenantID:   tenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"user_role_id": userRole.ID.String(), "role_code": g.role.Code},
+	})
+
+	resp := mapAssignmentToResponse(userRole, g.role)
+	return &resp, nil
+}
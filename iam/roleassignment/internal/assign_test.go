@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssign_Delegation(t *testing.T) {
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	roleID := uuid.New()
+	actorRoleID := uuid.New()
+	actorID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		targetUserID uuid.UUID
+		actorRole    *entity.Role
+		actorCodes   []string
+		expectedCode string
+	}{
+		{
+			name:         "success - actor holds every permission of the role",
+			targetUserID: userID,
+			actorRole:    &entity.Role{ID: actorRoleID, TenantID: &tenantID, IsActive: true},
+			actorCodes:   []string{"participant:read", "participant:update", "role:assign"},
+		},
+		{
+			name:         "error - actor assigns a role to themselves",
+			targetUserID: actorID,
+			actorRole:    &entity.Role{ID: actorRoleID, TenantID: &tenantID, IsActive: true},
+			actorCodes:   []string{"participant:read", "participant:update", "role:assign"},
+			expectedCode: errors.CodeForbidden,
+		},
+		{
+			name:         "error - role carries a permission the actor lacks",
+			targetUserID: userID,
+			actorRole:    &entity.Role{ID: actorRoleID, TenantID: &tenantID, IsActive: true},
+			actorCodes:   []string{"participant:read", "role:assign"},
+			expectedCode: errors.CodeForbidden,
+		},
+		{
+			name:         "error - permissions held in another tenant do not count",
+			targetUserID: userID,
+			actorRole:    &entity.Role{ID: actorRoleID, TenantID: &otherTenantID, IsActive: true},
+			actorCodes:   []string{"participant:read", "participant:update", "role:assign"},
+			expectedCode: errors.CodeForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, roleID).Return(&entity.Role{
+				ID: roleID, TenantID: &tenantID, Code: "TELLER", ScopeLevel: entity.ScopeLevelTenant, IsActive: true,
+			}, nil)
+			roleRepo.On("GetByIDs", mock.Anything, []uuid.UUID{actorRoleID}).Return([]*entity.Role{tt.actorRole}, nil).Maybe()
+
+			permissionRepo := new(MockPermissionRepository)
+			permissionRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{roleID}).Return([]string{"participant:read", "participant:update"}, nil).Maybe()
+			permissionRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{actorRoleID}).Return(tt.actorCodes, nil).Maybe()
+			permissionRepo.On("GetCodesByRoleIDs", mock.Anything, []uuid.UUID{}).Return([]string{}, nil).Maybe()
+
+			userRoleRepo := new(MockUserRoleRepository)
+			userRoleRepo.On("ListActiveByUserID", mock.Anything, actorID, (*uuid.UUID)(nil)).Return([]entity.UserRole{{UserID: actorID, RoleID: actorRoleID}}, nil).Maybe()
+			userRoleRepo.On("HasOverlapping", mock.Anything, mock.Anything).Return(false, nil).Maybe()
+			userRoleRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			userRepo := new(MockUserRepository)
+			userRepo.On("GetByID", mock.Anything, tt.targetUserID).Return(&entity.User{ID: tt.targetUserID}, nil).Maybe()
+			regRepo := new(MockUserTenantRegistrationRepository)
+			regRepo.On("ListActiveByUserID", mock.Anything, tt.targetUserID).Return([]entity.UserTenantRegistration{{UserID: tt.targetUserID, TenantID: tenantID}}, nil).Maybe()
+			auditRepo := new(MockAdminAuditLogRepository)
+			auditRepo.On("Create", mock.Anything, mock.Anything).Return(nil).Maybe()
+			blacklist := new(MockTokenBlacklistStore)
+			blacklist.On("BlacklistUser", mock.Anything, tt.targetUserID, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			uc := &usecase{
+				TxManager:         NewMockTransactionManager(),
+				Config:            &config.Config{},
+				UserRoleRepo:      userRoleRepo,
+				RoleRepo:          roleRepo,
+				PermissionRepo:    permissionRepo,
+				UserRepo:          userRepo,
+				UserTenantRegRepo: regRepo,
+				AdminAuditLogRepo: auditRepo,
+				TokenBlacklist:    blacklist,
+				AuditLogger:       logger.NewNoopAuditLogger(),
+			}
+
+			resp, err := uc.Assign(context.Background(), tenantID, &roleassignmentdto.AssignRequest{
+				UserID:  tt.targetUserID,
+				RoleID:  roleID,
+				ActorID: actorID,
+			})
+
+			if tt.expectedCode != "" {
+				require.Error(t, err)
+				appErr, ok := err.(*errors.AppError)
+				require.True(t, ok, "Error should be AppError")
+				assert.Equal(t, tt.expectedCode, appErr.Code)
+				userRoleRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.targetUserID, resp.UserID)
+			userRoleRepo.AssertCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestBulkAssign_RejectsSelfAssignment(t *testing.T) {
+	tenantID := uuid.New()
+	roleID := uuid.New()
+	actorID := uuid.New()
+
+	roleRepo := new(MockRoleRepository)
+	roleRepo.On("GetByID", mock.Anything, roleID).Return(&entity.Role{
+		ID: roleID, TenantID: &tenantID, Code: "TELLER", ScopeLevel: entity.ScopeLevelTenant, IsActive: true,
+	}, nil)
+	queueRepo := new(MockRoleAssignmentQueueRepository)
+
+	uc := &usecase{
+		TxManager:               NewMockTransactionManager(),
+		Config:                  &config.Config{},
+		RoleRepo:                roleRepo,
+		PermissionRepo:          new(MockPermissionRepository),
+		RoleAssignmentQueueRepo: queueRepo,
+		AuditLogger:             logger.NewNoopAuditLogger(),
+	}
+
+	_, err := uc.BulkAssign(context.Background(), tenantID, &roleassignmentdto.BulkAssignRequest{
+		UserIDs: []uuid.UUID{uuid.New(), actorID},
+		RoleID:  roleID,
+		ActorID: actorID,
+	})
+
+	require.Error(t, err)
+	appErr, ok := err.(*errors.AppError)
+	require.True(t, ok, "Error should be AppError")
+	assert.Equal(t, errors.CodeForbidden, appErr.Code)
+	queueRepo.AssertNotCalled(t, "CreateBatch", mock.Anything, mock.Anything)
+}
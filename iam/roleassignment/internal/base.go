@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/roleassignment/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager               contract.TransactionManager
+	Config                  *config.Config
+	UserRoleRepo            contract.UserRoleRepository
+	RoleAssignmentQueueRepo contract.RoleAssignmentQueueRepository
+	RoleRepo                contract.RoleRepository
+	PermissionRepo          contract.PermissionRepository
+	UserRepo                contract.UserRepository
+	UserTenantRegRepo       contract.UserTenantRegistrationRepository
+	ProductRepo             contract.ProductRepository
+	BranchRepo              contract.BranchRepository
+	AdminAuditLogRepo       contract.AdminAuditLogRepository
+	TokenBlacklist          contract.TokenBlacklistStore
+	AuditLogger             logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	userRoleRepo contract.UserRoleRepository,
+	roleAssignmentQueueRepo contract.RoleAssignmentQueueRepository,
+	roleRepo contract.RoleRepository,
+	permissionRepo contract.PermissionRepository,
+	userRepo contract.UserRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	productRepo contract.ProductRepository,
+	branchRepo contract.BranchRepository,
+	adminAuditLogRepo contract.AdminAuditLogRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:               txManager,
+		Config:                  cfg,
+		UserRoleRepo:            userRoleRepo,
+		RoleAssignmentQueueRepo: roleAssignmentQueueRepo,
+		RoleRepo:                roleRepo,
+		PermissionRepo:          permissionRepo,
+		UserRepo:                userRepo,
+		UserTenantRegRepo:       userTenantRegRepo,
+		ProductRepo:             productRepo,
+		BranchRepo:              branchRepo,
+		AdminAuditLogRepo:       adminAuditLogRepo,
+		TokenBlacklist:          tokenBlacklist,
+		AuditLogger:             auditLogger,
+	}
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// BulkAssign validates the grant once and queues one assignment per user. The
+// worker checks each user and applies the assignments in the background.
+func (uc *usecase) BulkAssign(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.BulkAssignRequest) (*roleassignmentdto.BatchResponse, error) {
+	userIDs := make([]uuid.UUID, 0, len(req.UserIDs))
+	seen := make(map[uuid.UUID]struct{}, len(req.UserIDs))
+	for _, id := range req.UserIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		userIDs = append(userIDs, id)
+	}
+	if len(userIDs) > BulkAssignMaxUsers {
+		return nil, errors.ErrBadRequest(fmt.Sprintf("A batch can assign at most %d users", BulkAssignMaxUsers))
+	}
+
+	g, err := uc.resolveGrant(ctx, tenantID, req.RoleID, req.ProductID, req.BranchID, req.EffectiveFrom, req.EffectiveTo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.verifyDelegable(ctx, tenantID, req.ActorID, g.role, userIDs...); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	batchID := uuid.New()
+	total := len(userIDs)
+	items := make([]*entity.RoleAssignmentQueue, total)
+	for i, userID := range userIDs {
+		sequence := i + 1
+		items[i] = &entity.RoleAssignmentQueue{
+			UserID:        userID,
+			TenantID:      tenantID,
+			RoleID:        g.role.ID,
+			ProductID:     g.productID,
+			BranchID:      g.branchID,
+			EffectiveFrom: g.effectiveFrom,
+			EffectiveTo:   g.effectiveTo,
+			Status:        entity.RoleAssignmentQueueStatusPending,
+			AssignedBy:    req.ActorID,
+			AssignedAt:    now,
+			BatchID:       &batchID,
+			BatchTotal:    &total,
+			BatchSequence: &sequence,
+		}
+	}
+
+	afterState, _ := json.Marshal(map[string]any{
+		"batch_id":   batchID,
+		"total":      total,
+		"product_id": g.productID,
+		"branch_id":  g.branchID,
+	})
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.RoleAssignmentQueueRepo.CreateBatch(txCtx, items); err != nil {
+			return err
+		}
+		return uc.AdminAuditLogRepo.Create(txCtx, &entity.AdminAuditLog{
+			TenantID:   &tenantID,
+			UserID:     req.ActorID,
+			Action:     entity.AdminActionBulkAssignRole,
+			EntityType: entity.EntityTypeRole,
+			EntityID:   &g.role.ID,
+			AfterState: afterState,
+			IPAddress:  optionalString(req.IPAddress),
+			UserAgent:  req.UserAgent,
+			CreatedAt:  now,
+		})
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to queue role assignments").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "role_assignment",
+		Action:     "bulk_assignment_queued",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "user",
+		TargetID:   g.role.ID.String(),
+		TargetType: "role",
+		TenantID:   tenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"batch_id": batchID.String(), "total": total},
+	})
+
+	return &roleassignmentdto.BatchResponse{
+		BatchID:    batchID,
+		RoleID:     g.role.ID,
+		AssignedBy: req.ActorID,
+		AssignedAt: now,
+		Total:      int64(total),
+		Pending:    int64(total),
+		Failures:   []roleassignmentdto.BatchFailure{},
+	}, nil
+}
@@ -0,0 +1,20 @@
+package internal
+
+import "time"
+
+const (
+	AssignmentStatusActive    = "ACTIVE"
+	AssignmentStatusScheduled = "SCHEDULED"
+	AssignmentStatusExpired   = "EXPIRED"
+	AssignmentStatusRevoked   = "REVOKED"
+)
+
+const (
+	BulkAssignMaxUsers    = 5000
+	QueueWorkerBatchSize  = 200
+	QueueMaxRetries       = 3
+	QueueRetryBaseDelay   = 30 * time.Second
+	QueueRetryMaxDelay    = 10 * time.Minute
+	BatchFailureListLimit = 100
+	TokenInvalidationTTL  = 15 * time.Minute
+)
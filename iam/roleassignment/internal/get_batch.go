@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) GetBatch(ctx context.Context, tenantID, batchID uuid.UUID) (*roleassignmentdto.BatchResponse, error) {
+	summary, err := uc.RoleAssignmentQueueRepo.GetBatchSummary(ctx, tenantID, batchID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Batch not found")
+		}
+		return nil, errors.ErrInternal("failed to get batch").WithError(err)
+	}
+
+	resp := &roleassignmentdto.BatchResponse{
+		BatchID:    summary.BatchID,
+		RoleID:     summary.RoleID,
+		AssignedBy: summary.AssignedBy,
+		AssignedAt: summary.AssignedAt,
+		Pending:    summary.Counts[entity.RoleAssignmentQueueStatusPending],
+		Processing: summary.Counts[entity.RoleAssignmentQueueStatusProcessing],
+		Completed:  summary.Counts[entity.RoleAssignmentQueueStatusCompleted],
+		Failed:     summary.Counts[entity.RoleAssignmentQueueStatusFailed],
+		Cancelled:  summary.Counts[entity.RoleAssignmentQueueStatusCancelled],
+		Failures:   []roleassignmentdto.BatchFailure{},
+	}
+	for _, count := range summary.Counts {
+		resp.Total += count
+	}
+
+	done := resp.Completed + resp.Failed + resp.Cancelled
+	if resp.Total > 0 {
+		resp.Progress = float64(done) / float64(resp.Total) * 100
+	}
+	resp.Finished = done == resp.Total
+
+	if resp.Failed > 0 {
+		failed, err := uc.RoleAssignmentQueueRepo.ListFailedByBatchID(ctx, batchID, BatchFailureListLimit)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to get batch failures").WithError(err)
+		}
+		for _, item := range failed {
+			failure := roleassignmentdto.BatchFailure{
+				QueueID:    item.QueueID,
+				UserID:     item.UserID,
+				RetryCount: item.RetryCount,
+			}
+			if item.FailureReason != nil {
+				failure.FailureReason = *item.FailureReason
+			}
+			resp.Failures = append(resp.Failures, failure)
+		}
+	}
+
+	return resp, nil
+}
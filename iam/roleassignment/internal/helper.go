@@ -0,0 +1,278 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/rbac"
+
+	"github.com/google/uuid"
+)
+
+// getAssignableRole loads a role that tenant administrators may grant. System
+// roles are platform-wide and cannot be handed out per tenant.
+func (uc *usecase) getAssignableRole(ctx context.Context, tenantID, roleID uuid.UUID) (*entity.Role, error) {
+	role, err := uc.RoleRepo.GetByID(ctx, roleID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrRoleNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get role").WithError(err)
+	}
+	if role.TenantID == nil {
+		return nil, errors.ErrForbidden("System roles cannot be assigned per tenant")
+	}
+	if *role.TenantID != tenantID {
+		return nil, errors.ErrRoleNotFound()
+	}
+	if !role.IsActive {
+		return nil, errors.ErrBadRequest("Role " + role.Code + " is inactive")
+	}
+	return role, nil
+}
+
+func (uc *usecase) verifyMembership(ctx context.Context, tenantID, userID uuid.UUID) error {
+	if _, err := uc.UserRepo.GetByID(ctx, userID); err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrUserNotFound()
+		}
+		return errors.ErrInternal("failed to get user").WithError(err)
+	}
+
+	registrations, err := uc.UserTenantRegRepo.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return errors.ErrInternal("failed to get tenant registrations").WithError(err)
+	}
+	for _, registration := range registrations {
+		if registration.TenantID == tenantID {
+			return nil
+		}
+	}
+	return errors.ErrBadRequest("User is not a member of this tenant")
+}
+
+// resolveProduct returns the product the assignment applies to. Roles bound to
+// a product can only be granted for that product.
+func (uc *usecase) resolveProduct(ctx context.Context, tenantID uuid.UUID, role *entity.Role, productID *uuid.UUID) (*uuid.UUID, error) {
+	if productID == nil {
+		return role.ProductID, nil
+	}
+	if role.ProductID != nil && *role.ProductID != *productID {
+		return nil, errors.ErrBadRequest("Role " + role.Code + " is bound to a different product")
+	}
+	if _, err := uc.ProductRepo.GetByIDAndTenant(ctx, *productID, tenantID); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Product not found")
+		}
+		return nil, errors.ErrInternal("failed to get product").WithError(err)
+	}
+	return productID, nil
+}
+
+// verifyDelegable stops actors from escalating privileges through role grants:
+// they cannot assign roles to themselves, and the role, including what it
+// inherits, may only carry permissions the actor already holds in the tenant.
+func (uc *usecase) verifyDelegable(ctx context.Context, tenantID, actorID uuid.UUID, role *entity.Role, userIDs ...uuid.UUID) error {
+	for _, userID := range userIDs {
+		if userID == actorID {
+			return errors.ErrForbidden("You cannot assign roles to yourself")
+		}
+	}
+
+	required, err := uc.PermissionRepo.GetCodesByRoleIDs(ctx, []uuid.UUID{role.ID})
+	if err != nil {
+		return errors.ErrInternal("failed to get role permissions").WithError(err)
+	}
+	return rbac.VerifyDelegable(ctx, uc.UserRoleRepo, uc.RoleRepo, uc.PermissionRepo, tenantID, actorID, "Role "+role.Code, required)
+}
+
+// verifyBranch enforces that branch-scoped roles are granted for exactly one
+// branch of the tenant and other roles are not tied to a branch.
+func (uc *usecase) verifyBranch(ctx context.Context, tenantID uuid.UUID, role *entity.Role, branchID *uuid.UUID) error {
+	if role.ScopeLevel != entity.ScopeLevelBranch {
+		if branchID != nil {
+			return errors.ErrBadRequest("Branch can only be set for branch-scoped roles")
+		}
+		return nil
+	}
+	if branchID == nil {
+		return errors.ErrBadRequest("Branch is required for branch-scoped roles")
+	}
+
+	branch, err := uc.BranchRepo.GetByID(ctx, *branchID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.ErrNotFound("Branch not found")
+		}
+		return errors.ErrInternal("failed to get branch").WithError(err)
+	}
+	if branch.TenantID != tenantID {
+		return errors.ErrBadRequest("Branch does not belong to this tenant")
+	}
+	if !branch.IsActive {
+		return errors.ErrBadRequest("Branch is inactive")
+	}
+	return nil
+}
+
+// grant is a validated role grant that can be applied to individual users.
+type grant struct {
+	role          *entity.Role
+	productID     *uuid.UUID
+	branchID      *uuid.UUID
+	effectiveFrom time.Time
+	effectiveTo   *time.Time
+}
+
+func (g *grant) userRole(userID uuid.UUID, now time.Time) *entity.UserRole {
+	return &entity.UserRole{
+		UserID:        userID,
+		RoleID:        g.role.ID,
+		ProductID:     g.productID,
+		BranchID:      g.branchID,
+		EffectiveFrom: g.effectiveFrom,
+		EffectiveTo:   g.effectiveTo,
+		CreatedAt:     now,
+	}
+}
+
+// resolveGrant validates everything about a grant that does not depend on
+// the user receiving it.
+func (uc *usecase) resolveGrant(
+	ctx context.Context,
+	tenantID, roleID uuid.UUID,
+	productID, branchID *uuid.UUID,
+	effectiveFrom, effectiveTo *time.Time,
+) (*grant, error) {
+	role, err := uc.getAssignableRole(ctx, tenantID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	resolvedProductID, err := uc.resolveProduct(ctx, tenantID, role, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.verifyBranch(ctx, tenantID, role, branchID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := now
+	if effectiveFrom != nil {
+		from = *effectiveFrom
+	}
+	if effectiveTo != nil {
+		if !effectiveTo.After(from) {
+			return nil, errors.ErrBadRequest("effective_to must be after effective_from")
+		}
+		if !effectiveTo.After(now) {
+			return nil, errors.ErrBadRequest("effective_to must be in the future")
+		}
+	}
+
+	return &grant{
+		role:          role,
+		productID:     resolvedProductID,
+		branchID:      branchID,
+		effectiveFrom: from,
+		effectiveTo:   effectiveTo,
+	}, nil
+}
+
+// checkAssignable verifies that the user can receive the assignment: they
+// must belong to the tenant and not already hold the role for the period.
+func (uc *usecase) checkAssignable(ctx context.Context, tenantID uuid.UUID, userRole *entity.UserRole) error {
+	if err := uc.verifyMembership(ctx, tenantID, userRole.UserID); err != nil {
+		return err
+	}
+	overlapping, err := uc.UserRoleRepo.HasOverlapping(ctx, userRole)
+	if err != nil {
+		return errors.ErrInternal("failed to check existing assignments").WithError(err)
+	}
+	if overlapping {
+		return errors.ErrConflict("User already holds this role for an overlapping period")
+	}
+	return nil
+}
+
+// invalidateTokens rejects access tokens issued before now so the user's next
+// refresh picks up the changed roles.
+func (uc *usecase) invalidateTokens(ctx context.Context, userID uuid.UUID) {
+	ttl := uc.Config.JWT.AccessExpiry
+	if ttl <= 0 {
+		ttl = TokenInvalidationTTL
+	}
+	_ = uc.TokenBlacklist.BlacklistUser(context.WithoutCancel(ctx), userID, time.Now(), ttl)
+}
+
+func (uc *usecase) recordAudit(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	userRole *entity.UserRole,
+	action entity.AdminAction,
+	actorID uuid.UUID,
+	ipAddress, userAgent string,
+	before, after any,
+) error {
+	log := &entity.AdminAuditLog{
+		TenantID:   &tenantID,
+		UserID:     actorID,
+		Action:     action,
+		EntityType: entity.EntityTypeUserRole,
+		EntityID:   &userRole.ID,
+		IPAddress:  optionalString(ipAddress),
+		UserAgent:  userAgent,
+		CreatedAt:  time.Now(),
+	}
+	if before != nil {
+		log.BeforeState, _ = json.Marshal(before)
+	}
+	if after != nil {
+		log.AfterState, _ = json.Marshal(after)
+	}
+	return uc.AdminAuditLogRepo.Create(ctx, log)
+}
+
+func assignmentStatus(userRole *entity.UserRole, now time.Time) string {
+	switch {
+	case userRole.DeletedAt != nil:
+		return AssignmentStatusRevoked
+	case now.Before(userRole.EffectiveFrom):
+		return AssignmentStatusScheduled
+	case userRole.EffectiveTo != nil && !now.Before(*userRole.EffectiveTo):
+		return AssignmentStatusExpired
+	default:
+		return AssignmentStatusActive
+	}
+}
+
+func mapAssignmentToResponse(userRole *entity.UserRole, role *entity.Role) roleassignmentdto.AssignmentResponse {
+	resp := roleassignmentdto.AssignmentResponse{
+		ID:            userRole.ID,
+		UserID:        userRole.UserID,
+		RoleID:        userRole.RoleID,
+		ProductID:     userRole.ProductID,
+		BranchID:      userRole.BranchID,
+		EffectiveFrom: userRole.EffectiveFrom,
+		EffectiveTo:   userRole.EffectiveTo,
+		Status:        assignmentStatus(userRole, time.Now()),
+		CreatedAt:     userRole.CreatedAt,
+		RevokedAt:     userRole.DeletedAt,
+	}
+	if role != nil {
+		resp.RoleCode = role.Code
+		resp.RoleName = role.Name
+	}
+	return resp
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
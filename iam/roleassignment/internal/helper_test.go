@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignmentStatus(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		userRole entity.UserRole
+		expected string
+	}{
+		{
+			name:     "open ended and started",
+			userRole: entity.UserRole{EffectiveFrom: past},
+			expected: AssignmentStatusActive,
+		},
+		{
+			name:     "within window",
+			userRole: entity.UserRole{EffectiveFrom: past, EffectiveTo: &future},
+			expected: AssignmentStatusActive,
+		},
+		{
+			name:     "not yet started",
+			userRole: entity.UserRole{EffectiveFrom: future},
+			expected: AssignmentStatusScheduled,
+		},
+		{
+			name:     "ended",
+			userRole: entity.UserRole{EffectiveFrom: past.Add(-time.Hour), EffectiveTo: &past},
+			expected: AssignmentStatusExpired,
+		},
+		{
+			name:     "ends exactly now",
+			userRole: entity.UserRole{EffectiveFrom: past, EffectiveTo: &now},
+			expected: AssignmentStatusExpired,
+		},
+		{
+			name:     "revoked takes precedence",
+			userRole: entity.UserRole{EffectiveFrom: past, DeletedAt: &now},
+			expected: AssignmentStatusRevoked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, assignmentStatus(&tt.userRole, now))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/contract"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID uuid.UUID, req *roleassignmentdto.ListRequest) (*roleassignmentdto.ListResponse, error) {
+	req.SetDefaults()
+
+	userRoles, total, err := uc.UserRoleRepo.List(ctx, &contract.UserRoleListFilter{
+		TenantID:       tenantID,
+		UserID:         req.UserID,
+		RoleID:         req.RoleID,
+		ProductID:      req.ProductID,
+		BranchID:       req.BranchID,
+		IncludeExpired: req.IncludeExpired,
+		Page:           req.Page,
+		PerPage:        req.PerPage,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list role assignments").WithError(err)
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(userRoles))
+	for _, userRole := range userRoles {
+		roleIDs = append(roleIDs, userRole.RoleID)
+	}
+	rolesByID := make(map[uuid.UUID]*entity.Role, len(roleIDs))
+	if len(roleIDs) > 0 {
+		roles, err := uc.RoleRepo.GetByIDs(ctx, roleIDs)
+		if err != nil {
+			return nil, errors.ErrInternal("failed to get roles").WithError(err)
+		}
+		for _, role := range roles {
+			rolesByID[role.ID] = role
+		}
+	}
+
+	items := make([]roleassignmentdto.AssignmentResponse, 0, len(userRoles))
+	for i := range userRoles {
+		items = append(items, mapAssignmentToResponse(&userRoles[i], rolesByID[userRoles[i].RoleID]))
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &roleassignmentdto.ListResponse{
+		Assignments: items,
+		Pagination: roleassignmentdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,185 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTransactionManager struct {
+	mock.Mock
+}
+
+func (m *MockTransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	args := m.Called(ctx, fn)
+	if args.Get(0) == nil {
+		return fn(ctx)
+	}
+	return args.Error(0)
+}
+
+func NewMockTransactionManager() *MockTransactionManager {
+	m := &MockTransactionManager{}
+	m.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return m
+}
+
+type MockUserRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRoleRepository) Create(ctx context.Context, userRole *entity.UserRole) error {
+	args := m.Called(ctx, userRole)
+	return args.Error(0)
+}
+
+func (m *MockUserRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.UserRole, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserRole), args.Error(1)
+}
+
+func (m *MockUserRoleRepository) Update(ctx context.Context, userRole *entity.UserRole) error {
+	args := m.Called(ctx, userRole)
+	return args.Error(0)
+}
+
+func (m *MockUserRoleRepository) List(ctx context.Context, filter *contract.UserRoleListFilter) ([]entity.UserRole, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]entity.UserRole), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *MockUserRoleRepository) HasOverlapping(ctx context.Context, userRole *entity.UserRole) (bool, error) {
+	args := m.Called(ctx, userRole)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRoleRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error) {
+	args := m.Called(ctx, userID, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserRole), args.Error(1)
+}
+
+type MockRoleAssignmentQueueRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoleAssignmentQueueRepository) CreateBatch(ctx context.Context, items []*entity.RoleAssignmentQueue) error {
+	args := m.Called(ctx, items)
+	return args.Error(0)
+}
+
+func (m *MockRoleAssignmentQueueRepository) ClaimPending(ctx context.Context, limit int) ([]*entity.RoleAssignmentQueue, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.RoleAssignmentQueue), args.Error(1)
+}
+
+func (m *MockRoleAssignmentQueueRepository) Update(ctx context.Context, item *entity.RoleAssignmentQueue) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockRoleAssignmentQueueRepository) GetBatchSummary(ctx context.Context, tenantID, batchID uuid.UUID) (*contract.RoleAssignmentBatchSummary, error) {
+	args := m.Called(ctx, tenantID, batchID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*contract.RoleAssignmentBatchSummary), args.Error(1)
+}
+
+func (m *MockRoleAssignmentQueueRepository) ListFailedByBatchID(ctx context.Context, batchID uuid.UUID, limit int) ([]*entity.RoleAssignmentQueue, error) {
+	args := m.Called(ctx, batchID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.RoleAssignmentQueue), args.Error(1)
+}
+
+type MockRoleRepository struct {
+	mock.Mock
+}
+
+func (m *MockRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*entity.Role), args.Error(1)
+}
+
+type MockPermissionRepository struct {
+	mock.Mock
+}
+
+func (m *MockPermissionRepository) GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error) {
+	args := m.Called(ctx, roleIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+type MockUserTenantRegistrationRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserTenantRegistrationRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserTenantRegistration), args.Error(1)
+}
+
+type MockAdminAuditLogRepository struct {
+	mock.Mock
+}
+
+func (m *MockAdminAuditLogRepository) Create(ctx context.Context, log *entity.AdminAuditLog) error {
+	args := m.Called(ctx, log)
+	return args.Error(0)
+}
+
+type MockTokenBlacklistStore struct {
+	mock.Mock
+}
+
+func (m *MockTokenBlacklistStore) BlacklistUser(ctx context.Context, userID uuid.UUID, timestamp time.Time, ttl time.Duration) error {
+	args := m.Called(ctx, userID, timestamp, ttl)
+	return args.Error(0)
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+)
+
+// ProcessPendingAssignments applies queued role assignments. Assignments that
+// can never succeed, such as a user outside the tenant, fail straight away;
+// other errors are retried up to QueueMaxRetries times with exponential
+// backoff. It returns the number of assignments completed.
+func (uc *usecase) ProcessPendingAssignments(ctx context.Context) (int, error) {
+	items, err := uc.RoleAssignmentQueueRepo.ClaimPending(ctx, QueueWorkerBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	completed := 0
+	for _, item := range items {
+		if err := uc.applyQueuedAssignment(ctx, item); err != nil {
+			uc.markAssignmentFailed(ctx, item, err)
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+func (uc *usecase) applyQueuedAssignment(ctx context.Context, item *entity.RoleAssignmentQueue) error {
+	g, err := uc.resolveGrant(ctx, item.TenantID, item.RoleID, item.ProductID, item.BranchID, &item.EffectiveFrom, item.EffectiveTo)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	userRole := g.userRole(item.UserID, now)
+	if err := uc.checkAssignable(ctx, item.TenantID, userRole); err != nil {
+		return err
+	}
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.UserRoleRepo.Create(txCtx, userRole); err != nil {
+			return err
+		}
+		item.Status = entity.RoleAssignmentQueueStatusCompleted
+		item.ProcessedAt = &now
+		item.FailureReason = nil
+		item.NextAttemptAt = nil
+		item.UserRoleID = &userRole.ID
+		if err := uc.RoleAssignmentQueueRepo.Update(txCtx, item); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, item.TenantID, userRole, entity.AdminActionAssignRole, item.AssignedBy, "", "", nil, userRole)
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.invalidateTokens(ctx, userRole.UserID)
+	return nil
+}
+
+func (uc *usecase) markAssignmentFailed(ctx context.Context, item *entity.RoleAssignmentQueue, cause error) {
+	reason := cause.Error()
+	if appErr := errors.GetAppError(cause); appErr != nil {
+		reason = appErr.Message
+	}
+	permanent := errors.GetHTTPStatus(cause) < http.StatusInternalServerError
+
+	now := time.Now()
+	item.FailureReason = &reason
+	item.UserRoleID = nil
+	if permanent || item.RetryCount >= QueueMaxRetries {
+		item.Status = entity.RoleAssignmentQueueStatusFailed
+		item.ProcessedAt = &now
+		item.NextAttemptAt = nil
+	} else {
+		item.Status = entity.RoleAssignmentQueueStatusPending
+		item.RetryCount++
+		nextAttempt := now.Add(retryBackoff(item.RetryCount))
+		item.NextAttemptAt = &nextAttempt
+	}
+	_ = uc.RoleAssignmentQueueRepo.Update(ctx, item)
+
+	if item.Status != entity.RoleAssignmentQueueStatusFailed {
+		return
+	}
+	metadata := map[string]any{
+		"queue_id":    item.QueueID.String(),
+		"role_id":     item.RoleID.String(),
+		"retry_count": item.RetryCount,
+	}
+	if item.BatchID != nil {
+		metadata["batch_id"] = item.BatchID.String()
+	}
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "role_assignment",
+		Action:     "queued_assignment_failed",
+		ActorID:    item.AssignedBy.String(),
+		ActorType:  "system",
+		TargetID:   item.UserID.String(),
+		TargetType: "user",
+		TenantID:   item.TenantID.String(),
+		Success:    false,
+		Reason:     reason,
+		Metadata:   metadata,
+	})
+}
+
+// retryBackoff returns how long the worker waits before the given retry,
+// doubling from QueueRetryBaseDelay up to QueueRetryMaxDelay.
+func retryBackoff(retry int) time.Duration {
+	delay := QueueRetryBaseDelay
+	for i := 1; i < retry && delay < QueueRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, QueueRetryMaxDelay)
+}
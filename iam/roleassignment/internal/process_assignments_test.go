@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessPendingAssignments_Failures(t *testing.T) {
+	tests := []struct {
+		name           string
+		retryCount     int
+		roleErr        error
+		expectedStatus entity.RoleAssignmentQueueStatus
+		expectedRetry  int
+		expectedDelay  time.Duration
+	}{
+		{
+			name:           "transient error is retried after the base delay",
+			retryCount:     0,
+			roleErr:        stderrors.New("connection refused"),
+			expectedStatus: entity.RoleAssignmentQueueStatusPending,
+			expectedRetry:  1,
+			expectedDelay:  QueueRetryBaseDelay,
+		},
+		{
+			name:           "later retries back off exponentially",
+			retryCount:     2,
+			roleErr:        stderrors.New("connection refused"),
+			expectedStatus: entity.RoleAssignmentQueueStatusPending,
+			expectedRetry:  3,
+			expectedDelay:  4 * QueueRetryBaseDelay,
+		},
+		{
+			name:           "transient error after the last retry is dead-lettered",
+			retryCount:     QueueMaxRetries,
+			roleErr:        stderrors.New("connection refused"),
+			expectedStatus: entity.RoleAssignmentQueueStatusFailed,
+			expectedRetry:  QueueMaxRetries,
+		},
+		{
+			name:           "permanent error is dead-lettered straight away",
+			retryCount:     0,
+			roleErr:        errors.ErrNotFound("role not found"),
+			expectedStatus: entity.RoleAssignmentQueueStatusFailed,
+			expectedRetry:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := &entity.RoleAssignmentQueue{
+				QueueID:       uuid.New(),
+				UserID:        uuid.New(),
+				TenantID:      uuid.New(),
+				RoleID:        uuid.New(),
+				AssignedBy:    uuid.New(),
+				EffectiveFrom: time.Now(),
+				Status:        entity.RoleAssignmentQueueStatusProcessing,
+				RetryCount:    tt.retryCount,
+			}
+
+			queueRepo := new(MockRoleAssignmentQueueRepository)
+			queueRepo.On("ClaimPending", mock.Anything, QueueWorkerBatchSize).Return([]*entity.RoleAssignmentQueue{item}, nil)
+			queueRepo.On("Update", mock.Anything, item).Return(nil)
+
+			roleRepo := new(MockRoleRepository)
+			roleRepo.On("GetByID", mock.Anything, item.RoleID).Return(nil, tt.roleErr)
+
+			uc := &usecase{
+				TxManager:               NewMockTransactionManager(),
+				RoleRepo:                roleRepo,
+				RoleAssignmentQueueRepo: queueRepo,
+				AuditLogger:             logger.NewNoopAuditLogger(),
+			}
+
+			before := time.Now()
+			completed, err := uc.ProcessPendingAssignments(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, 0, completed)
+			assert.Equal(t, tt.expectedStatus, item.Status)
+			assert.Equal(t, tt.expectedRetry, item.RetryCount)
+			require.NotNil(t, item.FailureReason)
+
+			if tt.expectedStatus == entity.RoleAssignmentQueueStatusFailed {
+				assert.NotNil(t, item.ProcessedAt)
+				assert.Nil(t, item.NextAttemptAt)
+			} else {
+				assert.Nil(t, item.ProcessedAt)
+				require.NotNil(t, item.NextAttemptAt)
+				assert.WithinDuration(t, before.Add(tt.expectedDelay), *item.NextAttemptAt, time.Second)
+			}
+			queueRepo.AssertCalled(t, "Update", mock.Anything, item)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		retry    int
+		expected time.Duration
+	}{
+		{retry: 1, expected: QueueRetryBaseDelay},
+		{retry: 2, expected: 2 * QueueRetryBaseDelay},
+		{retry: 3, expected: 4 * QueueRetryBaseDelay},
+		{retry: 20, expected: QueueRetryMaxDelay},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, retryBackoff(tt.retry), "retry %d", tt.retry)
+	}
+}
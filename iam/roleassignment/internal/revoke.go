@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/roleassignmentdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Revoke(ctx context.Context, tenantID, id uuid.UUID, req *roleassignmentdto.RevokeRequest) (*roleassignmentdto.AssignmentResponse, error) {
+	userRole, err := uc.UserRoleRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Role assignment not found")
+		}
+		return nil, errors.ErrInternal("failed to get role assignment").WithError(err)
+	}
+
+	role, err := uc.RoleRepo.GetByID(ctx, userRole.RoleID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, errors.ErrInternal("failed to get role").WithError(err)
+	}
+	if role == nil || role.TenantID == nil || *role.TenantID != tenantID {
+		return nil, errors.ErrNotFound("Role assignment not found")
+	}
+
+	before := *userRole
+	now := time.Now()
+	userRole.DeletedAt = &now
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := uc.UserRoleRepo.Update(txCtx, userRole); err != nil {
+			return err
+		}
+		return uc.recordAudit(txCtx, tenantID, userRole, entity.AdminActionRevokeRole, req.ActorID, req.IPAddress, req.UserAgent, before, userRole)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to revoke role").WithError(err)
+	}
+
+	uc.invalidateTokens(ctx, userRole.UserID)
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "role_assignment",
+		Action:     "role_revoked",
+		ActorID:    req.ActorID.String(),
+		ActorType:  "user",
+		TargetID:   userRole.UserID.String(),
+		TargetType: "user",
+		TenantID:   tenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"user_role_id": userRole.ID.String(), "role_code": role.Code},
+	})
+
+	resp := mapAssignmentToResponse(userRole, role)
+	return &resp, nil
+}
@@ -0,0 +1,61 @@
+package roleassignmentdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssignRequest struct {
+	UserID        uuid.UUID  `json:"user_id" validate:"required"`
+	RoleID        uuid.UUID  `json:"role_id" validate:"required"`
+	ProductID     *uuid.UUID `json:"product_id,omitempty" validate:"omitempty"`
+	BranchID      *uuid.UUID `json:"branch_id,omitempty" validate:"omitempty"`
+	EffectiveFrom *time.Time `json:"effective_from,omitempty" validate:"omitempty"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty" validate:"omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type BulkAssignRequest struct {
+	UserIDs       []uuid.UUID `json:"user_ids" validate:"required,min=1,max=5000,dive,required"`
+	RoleID        uuid.UUID   `json:"role_id" validate:"required"`
+	ProductID     *uuid.UUID  `json:"product_id,omitempty" validate:"omitempty"`
+	BranchID      *uuid.UUID  `json:"branch_id,omitempty" validate:"omitempty"`
+	EffectiveFrom *time.Time  `json:"effective_from,omitempty" validate:"omitempty"`
+	EffectiveTo   *time.Time  `json:"effective_to,omitempty" validate:"omitempty"`
+
+	ActorID   uuid.UUID `json:"-"`
+	IPAddress string    `json:"-"`
+	UserAgent string    `json:"-"`
+}
+
+type ListRequest struct {
+	UserID         *uuid.UUID `query:"user_id" validate:"omitempty"`
+	RoleID         *uuid.UUID `query:"role_id" validate:"omitempty"`
+	ProductID      *uuid.UUID `query:"product_id" validate:"omitempty"`
+	BranchID       *uuid.UUID `query:"branch_id" validate:"omitempty"`
+	IncludeExpired bool       `query:"include_expired"`
+	Page           int        `query:"page" validate:"omitempty,min=1"`
+	PerPage        int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+func (r *ListRequest) SetDefaults() {
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type RevokeRequest struct {
+	ActorID   uuid.UUID
+	IPAddress string
+	UserAgent string
+}
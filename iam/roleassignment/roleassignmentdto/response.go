@@ -0,0 +1,57 @@
+package roleassignmentdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AssignmentResponse struct {
+	ID            uuid.UUID  `json:"id"`
+	UserID        uuid.UUID  `json:"user_id"`
+	RoleID        uuid.UUID  `json:"role_id"`
+	RoleCode      string     `json:"role_code,omitempty"`
+	RoleName      string     `json:"role_name,omitempty"`
+	ProductID     *uuid.UUID `json:"product_id,omitempty"`
+	BranchID      *uuid.UUID `json:"branch_id,omitempty"`
+	EffectiveFrom time.Time  `json:"effective_from"`
+	EffectiveTo   *time.Time `json:"effective_to,omitempty"`
+	Status        string     `json:"status"`
+	CreatedAt     time.Time  `json:"created_at"`
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`
+}
+
+type BatchFailure struct {
+	QueueID       uuid.UUID `json:"queue_id"`
+	UserID        uuid.UUID `json:"user_id"`
+	FailureReason string    `json:"failure_reason"`
+	RetryCount    int       `json:"retry_count"`
+}
+
+type BatchResponse struct {
+	BatchID    uuid.UUID      `json:"batch_id"`
+	RoleID     uuid.UUID      `json:"role_id"`
+	AssignedBy uuid.UUID      `json:"assigned_by"`
+	AssignedAt time.Time      `json:"assigned_at"`
+	Total      int64          `json:"total"`
+	Pending    int64          `json:"pending"`
+	Processing int64          `json:"processing"`
+	Completed  int64          `json:"completed"`
+	Failed     int64          `json:"failed"`
+	Cancelled  int64          `json:"cancelled"`
+	Progress   float64        `json:"progress"`
+	Finished   bool           `json:"finished"`
+	Failures   []BatchFailure `json:"failures"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ListResponse struct {
+	Assignments []AssignmentResponse `json:"assignments"`
+	Pagination  Pagination           `json:"pagination"`
+}
@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/signingkey/signingkeydto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	List(ctx context.Context) ([]signingkeydto.SigningKeyResponse, error)
+	Rotate(ctx context.Context, rotatedBy uuid.UUID) (*signingkeydto.RotateResponse, error)
+	RotateIfDue(ctx context.Context) (bool, error)
+}
@@ -0,0 +1,20 @@
+package signingkey
+
+import (
+	"iam-service/config"
+	"iam-service/iam/signingkey/contract"
+	"iam-service/iam/signingkey/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	cfg *config.Config,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		cfg,
+		auditLogger,
+	)
+}
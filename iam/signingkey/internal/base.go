@@ -0,0 +1,21 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	Config      *config.Config
+	AuditLogger logger.AuditLogger
+}
+
+func NewUsecase(
+	cfg *config.Config,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		Config:      cfg,
+		AuditLogger: auditLogger,
+	}
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"iam-service/iam/signingkey/signingkeydto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+)
+
+func (uc *usecase) keyRing() (*jwtpkg.KeyRing, error) {
+	if uc.Config.JWT.KeyRing == nil {
+		return nil, errors.ErrBadRequest("signing keys are only managed for asymmetric signing methods")
+	}
+	return uc.Config.JWT.KeyRing, nil
+}
+
+func mapSigningKeysToResponse(keys []*jwtpkg.SigningKey) []signingkeydto.SigningKeyResponse {
+	result := make([]signingkeydto.SigningKeyResponse, len(keys))
+	for i, key := range keys {
+		result[i] = signingkeydto.SigningKeyResponse{
+			Kid:         key.Kid,
+			Algorithm:   key.Algorithm,
+			Status:      string(key.Status),
+			Published:   key.IsPublished(),
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+		}
+	}
+	return result
+}
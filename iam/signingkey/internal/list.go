@@ -0,0 +1,16 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/signingkey/signingkeydto"
+)
+
+func (uc *usecase) List(ctx context.Context) ([]signingkeydto.SigningKeyResponse, error) {
+	keyRing, err := uc.keyRing()
+	if err != nil {
+		return nil, err
+	}
+
+	return mapSigningKeysToResponse(keyRing.Keys()), nil
+}
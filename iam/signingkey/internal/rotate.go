@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/signingkey/signingkeydto"
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Rotate(ctx context.Context, rotatedBy uuid.UUID) (*signingkeydto.RotateResponse, error) {
+	keyRing, err := uc.keyRing()
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := uc.rotate(ctx, rotatedBy.String(), "user", func() (*jwtpkg.SigningKey, bool, error) {
+		active, err := keyRing.Rotate()
+		return active, err == nil, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &signingkeydto.RotateResponse{
+		ActiveKid: active.Kid,
+		Keys:      mapSigningKeysToResponse(keyRing.Keys()),
+	}, nil
+}
+
+func (uc *usecase) RotateIfDue(ctx context.Context) (bool, error) {
+	keyRing := uc.Config.JWT.KeyRing
+	interval := uc.Config.JWT.KeyRotationInterval
+	if keyRing == nil || !keyRing.NeedsRotation(interval) {
+		return false, nil
+	}
+
+	// NeedsRotation only looks at the cached keys; the ring checks again
+	// against the store so another instance's rotation is not repeated.
+	active, err := uc.rotate(ctx, "", "system", func() (*jwtpkg.SigningKey, bool, error) {
+		return keyRing.RotateIfDue(interval)
+	})
+	if err != nil {
+		return false, err
+	}
+	return active != nil, nil
+}
+
+// rotate runs rotateFn and audits the outcome. It returns nil without an
+// audit entry when rotateFn decided no rotation was due.
+func (uc *usecase) rotate(ctx context.Context, actorID, actorType string, rotateFn func() (*jwtpkg.SigningKey, bool, error)) (*jwtpkg.SigningKey, error) {
+	active, rotated, err := rotateFn()
+	if err != nil {
+		uc.AuditLogger.Log(ctx, logger.AuditEvent{
+			Domain:    "signing_key",
+			Action:    "rotate",
+			ActorID:   actorID,
+			ActorType: actorType,
+			Success:   false,
+			Reason:    err.Error(),
+		})
+		if errors.Is(err, jwtpkg.ErrKeyRingReadOnly) {
+			return nil, errors.ErrBadRequest("signing key rotation requires JWT_KEYS_DIR to be configured")
+		}
+		return nil, errors.ErrInternal("failed to rotate signing keys").WithError(err)
+	}
+	if !rotated {
+		return nil, nil
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "signing_key",
+		Action:     "rotate",
+		ActorID:    actorID,
+		ActorType:  actorType,
+		TargetID:   active.Kid,
+		TargetType: "signing_key",
+		Success:    true,
+	})
+
+	return active, nil
+}
@@ -0,0 +1,18 @@
+package signingkeydto
+
+import "time"
+
+type SigningKeyResponse struct {
+	Kid         string     `json:"kid"`
+	Algorithm   string     `json:"algorithm"`
+	Status      string     `json:"status"`
+	Published   bool       `json:"published"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+}
+
+type RotateResponse struct {
+	ActiveKid string               `json:"active_kid"`
+	Keys      []SigningKeyResponse `json:"keys"`
+}
@@ -0,0 +1,40 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+)
+
+type UserTenantRegistrationListFilter struct {
+	TenantID         uuid.UUID
+	Status           *entity.UserTenantRegistrationStatus
+	ProductID        *uuid.UUID
+	RegistrationType string
+	Page             int
+	PerPage          int
+}
+
+type UserTenantRegistrationRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.UserTenantRegistration, error)
+	List(ctx context.Context, filter *UserTenantRegistrationListFilter) ([]entity.UserTenantRegistration, int64, error)
+	Update(ctx context.Context, registration *entity.UserTenantRegistration) error
+}
+
+type ProductRegistrationConfigRepository interface {
+	GetByProductAndType(ctx context.Context, productID uuid.UUID, registrationType string) (*entity.ProductRegistrationConfig, error)
+}
+
+type UserRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+}
+
+type UserRoleRepository interface {
+	Create(ctx context.Context, userRole *entity.UserRole) error
+}
+
+type ParticipantRepository interface {
+	LinkUser(ctx context.Context, participantID, userID uuid.UUID) error
+}
@@ -0,0 +1,15 @@
+package contract
+
+import (
+	"context"
+
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+
+	"github.com/google/uuid"
+)
+
+type Usecase interface {
+	List(ctx context.Context, tenantID uuid.UUID, req *tenantregistrationdto.ListRequest) (*tenantregistrationdto.ListResponse, error)
+	Approve(ctx context.Context, tenantID, id, approvedBy uuid.UUID, req *tenantregistrationdto.ApproveRequest) (*tenantregistrationdto.RegistrationResponse, error)
+	Reject(ctx context.Context, tenantID, id, rejectedBy uuid.UUID, req *tenantregistrationdto.RejectRequest) (*tenantregistrationdto.RegistrationResponse, error)
+}
@@ -0,0 +1,32 @@
+package tenantregistration
+
+import (
+	"iam-service/config"
+	"iam-service/iam/tenantregistration/contract"
+	"iam-service/iam/tenantregistration/internal"
+	"iam-service/pkg/logger"
+)
+
+type Usecase = contract.Usecase
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	productRegConfigRepo contract.ProductRegistrationConfigRepository,
+	userRepo contract.UserRepository,
+	userRoleRepo contract.UserRoleRepository,
+	participantRepo contract.ParticipantRepository,
+	auditLogger logger.AuditLogger,
+) Usecase {
+	return internal.NewUsecase(
+		txManager,
+		cfg,
+		userTenantRegRepo,
+		productRegConfigRepo,
+		userRepo,
+		userRoleRepo,
+		participantRepo,
+		auditLogger,
+	)
+}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// Approve activates a pending registration and grants the role configured for
+// the product the user registered for. The participant matched at
+// registration is only linked when the approver confirms it.
+func (uc *usecase) Approve(ctx context.Context, tenantID, id, approvedBy uuid.UUID, req *tenantregistrationdto.ApproveRequest) (*tenantregistrationdto.RegistrationResponse, error) {
+	registration, err := uc.getPendingRegistration(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	productID := registration.GetProductID()
+	var grantRoleID *uuid.UUID
+	if productID != nil {
+		regConfig, err := uc.ProductRegConfigRepo.GetByProductAndType(ctx, *productID, registration.RegistrationType)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, errors.ErrInternal("failed to get registration config").WithError(err)
+		}
+		if regConfig != nil {
+			grantRoleID = regConfig.AutoGrantRoleID
+		}
+	}
+
+	now := time.Now()
+	registration.Status = entity.UTRStatusActive
+	registration.ApprovedBy = &approvedBy
+	registration.ApprovedAt = &now
+	registration.UpdatedAt = now
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if req != nil && req.LinkParticipant {
+			if err := uc.linkMatchedParticipant(txCtx, registration); err != nil {
+				return err
+			}
+		}
+		if err := uc.UserTenantRegRepo.Update(txCtx, registration); err != nil {
+			return err
+		}
+		if grantRoleID == nil {
+			return nil
+		}
+		return uc.UserRoleRepo.Create(txCtx, &entity.UserRole{
+			UserID:        registration.UserID,
+			RoleID:        *grantRoleID,
+			ProductID:     productID,
+			EffectiveFrom: now,
+			CreatedAt:     now,
+		})
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to approve registration").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "tenant_registration",
+		Action:     "registration_approved",
+		ActorID:    approvedBy.String(),
+		ActorType:  "user",
+		TargetID:   registration.ID.String(),
+		TargetType: "user_tenant_registration",
+		TenantID:   tenantID.String(),
+		Success:    true,
+		Metadata:   map[string]any{"user_id": registration.UserID.String(), "granted_role_id": grantRoleID},
+	})
+
+	response := uc.mapRegistrationToResponse(ctx, registration)
+	response.GrantedRoleID = grantRoleID
+	return &response, nil
+}
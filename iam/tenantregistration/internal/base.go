@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"iam-service/config"
+	"iam-service/iam/tenantregistration/contract"
+	"iam-service/pkg/logger"
+)
+
+type usecase struct {
+	TxManager            contract.TransactionManager
+	Config               *config.Config
+	UserTenantRegRepo    contract.UserTenantRegistrationRepository
+	ProductRegConfigRepo contract.ProductRegistrationConfigRepository
+	UserRepo             contract.UserRepository
+	UserRoleRepo         contract.UserRoleRepository
+	ParticipantRepo      contract.ParticipantRepository
+	AuditLogger          logger.AuditLogger
+}
+
+func NewUsecase(
+	txManager contract.TransactionManager,
+	cfg *config.Config,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	productRegConfigRepo contract.ProductRegistrationConfigRepository,
+	userRepo contract.UserRepository,
+	userRoleRepo contract.UserRoleRepository,
+	participantRepo contract.ParticipantRepository,
+	auditLogger logger.AuditLogger,
+) *usecase {
+	return &usecase{
+		TxManager:            txManager,
+		Config:               cfg,
+		UserTenantRegRepo:    userTenantRegRepo,
+		ProductRegConfigRepo: productRegConfigRepo,
+		UserRepo:             userRepo,
+		UserRoleRepo:         userRoleRepo,
+		ParticipantRepo:      participantRepo,
+		AuditLogger:          auditLogger,
+	}
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// getPendingRegistration loads a registration awaiting review. Registrations
+// of other tenants are reported as not found.
+func (uc *usecase) getPendingRegistration(ctx context.Context, tenantID, id uuid.UUID) (*entity.UserTenantRegistration, error) {
+	registration, err := uc.UserTenantRegRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("Registration not found")
+		}
+		return nil, errors.ErrInternal("failed to get registration").WithError(err)
+	}
+	if registration.TenantID != tenantID {
+		return nil, errors.ErrNotFound("Registration not found")
+	}
+	if !registration.IsPendingApproval() {
+		return nil, errors.ErrBadRequest("Registration is not pending approval")
+	}
+	return registration, nil
+}
+
+func (uc *usecase) mapRegistrationToResponse(ctx context.Context, registration *entity.UserTenantRegistration) tenantregistrationdto.RegistrationResponse {
+	response := tenantregistrationdto.RegistrationResponse{
+		ID:                   registration.ID,
+		UserID:               registration.UserID,
+		TenantID:             registration.TenantID,
+		ProductID:            registration.GetProductID(),
+		RegistrationType:     registration.RegistrationType,
+		IdentificationNumber: registration.IdentificationNumber,
+		Status:               string(registration.Status),
+		ApprovedBy:           registration.ApprovedBy,
+		ApprovedAt:           registration.ApprovedAt,
+		CreatedAt:            registration.CreatedAt,
+	}
+	response.ParticipantID, response.ParticipantLink = registration.GetParticipantLink()
+	if user, err := uc.UserRepo.GetByID(ctx, registration.UserID); err == nil {
+		response.Email = user.Email
+	}
+	return response
+}
+
+// linkMatchedParticipant links the participant matched at registration once an
+// approver has confirmed the match. Losing the participant to another user in
+// the meantime is recorded as a conflict rather than failing the approval.
+func (uc *usecase) linkMatchedParticipant(ctx context.Context, registration *entity.UserTenantRegistration) error {
+	participantID, status := registration.GetParticipantLink()
+	if participantID == nil || status != entity.ParticipantLinkMatched {
+		return nil
+	}
+
+	status = entity.ParticipantLinkLinked
+	if err := uc.ParticipantRepo.LinkUser(ctx, *participantID, registration.UserID); err != nil {
+		if !errors.IsConflict(err) {
+			return err
+		}
+		status = entity.ParticipantLinkConflict
+	}
+	return registration.SetMetadata(map[string]any{"participant_link_status": status})
+}
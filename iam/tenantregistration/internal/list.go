@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/tenantregistration/contract"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) List(ctx context.Context, tenantID uuid.UUID, req *tenantregistrationdto.ListRequest) (*tenantregistrationdto.ListResponse, error) {
+	req.SetDefaults()
+
+	status := entity.UserTenantRegistrationStatus(req.Status)
+	filter := &contract.UserTenantRegistrationListFilter{
+		TenantID:         tenantID,
+		Status:           &status,
+		ProductID:        req.ProductID,
+		RegistrationType: req.RegistrationType,
+		Page:             req.Page,
+		PerPage:          req.PerPage,
+	}
+
+	registrations, total, err := uc.UserTenantRegRepo.List(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list registrations").WithError(err)
+	}
+
+	items := make([]tenantregistrationdto.RegistrationResponse, 0, len(registrations))
+	for i := range registrations {
+		items = append(items, uc.mapRegistrationToResponse(ctx, &registrations[i]))
+	}
+
+	totalPages := int(total) / req.PerPage
+	if int(total)%req.PerPage > 0 {
+		totalPages++
+	}
+
+	return &tenantregistrationdto.ListResponse{
+		Registrations: items,
+		Pagination: tenantregistrationdto.Pagination{
+			Total:      total,
+			Page:       req.Page,
+			PerPage:    req.PerPage,
+			TotalPages: totalPages,
+		},
+	}, nil
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/tenantregistration/tenantregistrationdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) Reject(ctx context.Context, tenantID, id, rejectedBy uuid.UUID, req *tenantregistrationdto.RejectRequest) (*tenantregistrationdto.RegistrationResponse, error) {
+	registration, err := uc.getPendingRegistration(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = registration.SetMetadata(map[string]any{
+		"rejection_reason": req.Reason,
+		"rejected_by":      rejectedBy.String(),
+		"rejected_at":      now,
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to encode registration metadata").WithError(err)
+	}
+
+	registration.Status = entity.UTRStatusRejected
+	registration.UpdatedAt = now
+
+	if err := uc.UserTenantRegRepo.Update(ctx, registration); err != nil {
+		return nil, errors.ErrInternal("failed to reject registration").WithError(err)
+	}
+
+	uc.AuditLogger.Log(ctx, logger.AuditEvent{
+		Domain:     "tenant_registration",
+		Action:     "registration_rejected",
+		ActorID:    rejectedBy.String(),
+		ActorType:  "user",
+		TargetID:   registration.ID.String(),
+		TargetType: "user_tenant_registration",
+		TenantID:   tenantID.String(),
+		Success:    true,
+		Reason:     req.Reason,
+		Metadata:   map[string]any{"user_id": registration.UserID.String()},
+	})
+
+	response := uc.mapRegistrationToResponse(ctx, registration)
+	return &response, nil
+}
@@ -0,0 +1,37 @@
+package tenantregistrationdto
+
+import "github.com/google/uuid"
+
+type ListRequest struct {
+	Status           string     `query:"status" validate:"omitempty,oneof=PENDING_APPROVAL ACTIVE REJECTED INACTIVE"`
+	ProductID        *uuid.UUID `query:"product_id" validate:"omitempty"`
+	RegistrationType string     `query:"registration_type" validate:"omitempty,oneof=PARTICIPANT MEMBER"`
+	Page             int        `query:"page" validate:"omitempty,min=1"`
+	PerPage          int        `query:"per_page" validate:"omitempty,min=1,max=100"`
+}
+
+// SetDefaults lists the pending-approval queue unless another status is asked for.
+func (r *ListRequest) SetDefaults() {
+	if r.Status == "" {
+		r.Status = "PENDING_APPROVAL"
+	}
+	if r.Page <= 0 {
+		r.Page = 1
+	}
+	if r.PerPage <= 0 {
+		r.PerPage = 20
+	}
+	if r.PerPage > 100 {
+		r.PerPage = 100
+	}
+}
+
+type RejectRequest struct {
+	Reason string `json:"reason" validate:"required,min=1,max=500"`
+}
+
+// ApproveRequest is optional. LinkParticipant confirms that the participant
+// matched at registration belongs to the registrant and should be linked.
+type ApproveRequest struct {
+	LinkParticipant bool `json:"link_participant"`
+}
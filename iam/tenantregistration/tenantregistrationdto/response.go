@@ -0,0 +1,36 @@
+package tenantregistrationdto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RegistrationResponse struct {
+	ID                   uuid.UUID  `json:"id"`
+	UserID               uuid.UUID  `json:"user_id"`
+	Email                string     `json:"email"`
+	TenantID             uuid.UUID  `json:"tenant_id"`
+	ProductID            *uuid.UUID `json:"product_id,omitempty"`
+	RegistrationType     string     `json:"registration_type"`
+	IdentificationNumber *string    `json:"identification_number,omitempty"`
+	Status               string     `json:"status"`
+	ApprovedBy           *uuid.UUID `json:"approved_by,omitempty"`
+	ApprovedAt           *time.Time `json:"approved_at,omitempty"`
+	GrantedRoleID        *uuid.UUID `json:"granted_role_id,omitempty"`
+	ParticipantID        *uuid.UUID `json:"participant_id,omitempty"`
+	ParticipantLink      string     `json:"participant_link,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+}
+
+type Pagination struct {
+	Total      int64 `json:"total"`
+	Page       int   `json:"page"`
+	PerPage    int   `json:"per_page"`
+	TotalPages int   `json:"total_pages"`
+}
+
+type ListResponse struct {
+	Registrations []RegistrationResponse `json:"registrations"`
+	Pagination    Pagination             `json:"pagination"`
+}
@@ -2,6 +2,8 @@ package contract
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"iam-service/entity"
 
@@ -41,6 +43,7 @@ type UserSecurityStateRepository interface {
 	Create(ctx context.Context, securityState *entity.UserSecurityState) error
 	GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserSecurityState, error)
 	Update(ctx context.Context, securityState *entity.UserSecurityState) error
+	ForcePasswordChangeByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error)
 }
 type EmailVerificationRepository interface {
 	Create(ctx context.Context, verification *entity.EmailVerification) error
@@ -70,6 +73,15 @@ type RefreshTokenRepository interface {
 	RevokeAllByUserID(ctx context.Context, userID uuid.UUID, reason string) error
 	RevokeByFamily(ctx context.Context, tokenFamily uuid.UUID, reason string) error
 }
+type UserSessionRepository interface {
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID) error
+}
+type PersonalAccessTokenRepository interface {
+	RevokeAllByUserID(ctx context.Context, userID uuid.UUID, reason string) error
+}
+type TokenBlacklistStore interface {
+	BlacklistUser(ctx context.Context, userID uuid.UUID, timestamp time.Time, ttl time.Duration) error
+}
 type PINVerificationLogRepository interface {
 	Create(ctx context.Context, log *entity.PINVerificationLog) error
 	CountRecentFailures(ctx context.Context, userID uuid.UUID, since int) (int, error)
@@ -77,4 +89,28 @@ type PINVerificationLogRepository interface {
 
 type UserRoleRepository interface {
 	Create(ctx context.Context, userRole *entity.UserRole) error
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+}
+
+type UserTenantRegistrationRepository interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error)
+}
+
+type PasswordPolicyRepository interface {
+	Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+}
+
+type PasswordHistoryRepository interface {
+	Create(ctx context.Context, history *entity.PasswordHistory) error
+	ListRecentHashes(ctx context.Context, userID uuid.UUID, limit int) ([]string, error)
+}
+
+type BreachedPasswordChecker interface {
+	IsBreached(password string) bool
+}
+
+type FileStorage interface {
+	UploadFile(ctx context.Context, bucket, objectKey string, data io.Reader, size int64, contentType string) (string, error)
+	DeleteFile(ctx context.Context, bucket, objectKey string) error
+	GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error)
 }
@@ -15,6 +15,7 @@ type Usecase interface {
 	GetByID(ctx context.Context, callerTenantID *uuid.UUID, id uuid.UUID) (*userdto.UserDetailResponse, error)
 	GetMe(ctx context.Context, userID uuid.UUID) (*userdto.UserDetailResponse, error)
 	UpdateMe(ctx context.Context, userID uuid.UUID, req *userdto.UpdateMeRequest) (*userdto.UserDetailResponse, error)
+	UploadAvatar(ctx context.Context, userID uuid.UUID, req *userdto.UploadAvatarRequest) (*userdto.UserDetailResponse, error)
 	List(ctx context.Context, tenantID *uuid.UUID, req *userdto.ListRequest) (*userdto.ListResponse, error)
 	Update(ctx context.Context, callerTenantID *uuid.UUID, id uuid.UUID, req *userdto.UpdateRequest) (*userdto.UserDetailResponse, error)
 	Delete(ctx context.Context, callerTenantID *uuid.UUID, id uuid.UUID) error
@@ -22,6 +23,10 @@ type Usecase interface {
 	Reject(ctx context.Context, id uuid.UUID, approverID uuid.UUID, req *userdto.RejectRequest) (*userdto.RejectResponse, error)
 	Unlock(ctx context.Context, id uuid.UUID) (*userdto.UnlockResponse, error)
 	ResetPIN(ctx context.Context, id uuid.UUID) (*userdto.ResetPINResponse, error)
+	ChangePassword(ctx context.Context, userID uuid.UUID, req *userdto.ChangePasswordRequest) (*userdto.ChangePasswordResponse, error)
+	ResetPassword(ctx context.Context, id uuid.UUID, req *userdto.ResetPasswordRequest) (*userdto.ResetPasswordResponse, error)
+	ForcePasswordChange(ctx context.Context, id uuid.UUID) (*userdto.ForcePasswordChangeResponse, error)
+	ForceTenantPasswordChange(ctx context.Context, req *userdto.ForceTenantPasswordChangeRequest) (*userdto.ForceTenantPasswordChangeResponse, error)
 }
 
 func NewUsecase(
@@ -34,6 +39,15 @@ func NewUsecase(
 	tenantRepo contract.TenantRepository,
 	roleRepo contract.RoleRepository,
 	userRoleRepo contract.UserRoleRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	fileStorage contract.FileStorage,
+	refreshTokenRepo contract.RefreshTokenRepository,
+	userSessionRepo contract.UserSessionRepository,
+	personalAccessTokenRepo contract.PersonalAccessTokenRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
 ) Usecase {
 	return internal.NewUsecase(
 		txManager,
@@ -45,5 +59,14 @@ func NewUsecase(
 		tenantRepo,
 		roleRepo,
 		userRoleRepo,
+		userTenantRegRepo,
+		passwordPolicyRepo,
+		passwordHistoryRepo,
+		breachChecker,
+		fileStorage,
+		refreshTokenRepo,
+		userSessionRepo,
+		personalAccessTokenRepo,
+		tokenBlacklist,
 	)
 }
@@ -8,15 +8,24 @@ import (
 )
 
 type usecase struct {
-	TxManager             contract.TransactionManager
-	Config                *config.Config
-	UserRepo              contract.UserRepository
-	UserProfileRepo       contract.UserProfileRepository
-	UserAuthMethodRepo    contract.UserAuthMethodRepository
-	UserSecurityStateRepo contract.UserSecurityStateRepository
-	TenantRepo            contract.TenantRepository
-	RoleRepo              contract.RoleRepository
-	UserRoleRepo          contract.UserRoleRepository
+	TxManager               contract.TransactionManager
+	Config                  *config.Config
+	UserRepo                contract.UserRepository
+	UserProfileRepo         contract.UserProfileRepository
+	UserAuthMethodRepo      contract.UserAuthMethodRepository
+	UserSecurityStateRepo   contract.UserSecurityStateRepository
+	TenantRepo              contract.TenantRepository
+	RoleRepo                contract.RoleRepository
+	UserRoleRepo            contract.UserRoleRepository
+	UserTenantRegRepo       contract.UserTenantRegistrationRepository
+	PasswordPolicyRepo      contract.PasswordPolicyRepository
+	PasswordHistoryRepo     contract.PasswordHistoryRepository
+	BreachChecker           contract.BreachedPasswordChecker
+	FileStorage             contract.FileStorage
+	RefreshTokenRepo        contract.RefreshTokenRepository
+	UserSessionRepo         contract.UserSessionRepository
+	PersonalAccessTokenRepo contract.PersonalAccessTokenRepository
+	TokenBlacklist          contract.TokenBlacklistStore
 }
 
 func NewUsecase(
@@ -29,17 +38,35 @@ func NewUsecase(
 	tenantRepo contract.TenantRepository,
 	roleRepo contract.RoleRepository,
 	userRoleRepo contract.UserRoleRepository,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	passwordPolicyRepo contract.PasswordPolicyRepository,
+	passwordHistoryRepo contract.PasswordHistoryRepository,
+	breachChecker contract.BreachedPasswordChecker,
+	fileStorage contract.FileStorage,
+	refreshTokenRepo contract.RefreshTokenRepository,
+	userSessionRepo contract.UserSessionRepository,
+	personalAccessTokenRepo contract.PersonalAccessTokenRepository,
+	tokenBlacklist contract.TokenBlacklistStore,
 ) *usecase {
 	return &usecase{
-		TxManager:             txManager,
-		Config:                cfg,
-		UserRepo:              userRepo,
-		UserProfileRepo:       userProfileRepo,
-		UserAuthMethodRepo:    userAuthMethodRepo,
-		UserSecurityStateRepo: userSecurityStateRepo,
-		TenantRepo:            tenantRepo,
-		RoleRepo:              roleRepo,
-		UserRoleRepo:          userRoleRepo,
+		TxManager:               txManager,
+		Config:                  cfg,
+		UserRepo:                userRepo,
+		UserProfileRepo:         userProfileRepo,
+		UserAuthMethodRepo:      userAuthMethodRepo,
+		UserSecurityStateRepo:   userSecurityStateRepo,
+		TenantRepo:              tenantRepo,
+		RoleRepo:                roleRepo,
+		UserRoleRepo:            userRoleRepo,
+		UserTenantRegRepo:       userTenantRegRepo,
+		PasswordPolicyRepo:      passwordPolicyRepo,
+		PasswordHistoryRepo:     passwordHistoryRepo,
+		BreachChecker:           breachChecker,
+		FileStorage:             fileStorage,
+		RefreshTokenRepo:        refreshTokenRepo,
+		UserSessionRepo:         userSessionRepo,
+		PersonalAccessTokenRepo: personalAccessTokenRepo,
+		TokenBlacklist:          tokenBlacklist,
 	}
 }
 
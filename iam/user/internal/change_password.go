@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (uc *usecase) ChangePassword(ctx context.Context, userID uuid.UUID, req *userdto.ChangePasswordRequest) (*userdto.ChangePasswordResponse, error) {
+	user, err := uc.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, errors.ErrInternal("failed to get user").WithError(err)
+	}
+
+	authMethod, err := uc.getPasswordAuthMethod(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(authMethod.GetPasswordHash()), []byte(req.CurrentPassword)) != nil {
+		return nil, errors.ErrInvalidCredentials()
+	}
+
+	policy, err := uc.userPasswordPolicy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	warning, err := uc.validateNewPassword(ctx, policy, user, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.updatePassword(ctx, authMethod, req.NewPassword, false); err != nil {
+		return nil, err
+	}
+
+	return &userdto.ChangePasswordResponse{
+		UserID:   userID,
+		Warnings: passwordWarnings(warning),
+	}, nil
+}
+
+func (uc *usecase) getPasswordAuthMethod(ctx context.Context, userID uuid.UUID) (*entity.UserAuthMethod, error) {
+	authMethod, err := uc.UserAuthMethodRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrBadRequest("user does not have a password set")
+		}
+		return nil, errors.ErrInternal("failed to get auth method").WithError(err)
+	}
+	if authMethod.MethodType != string(entity.AuthMethodPassword) {
+		return nil, errors.ErrBadRequest("user does not have a password set")
+	}
+	return authMethod, nil
+}
+
+// updatePassword stores the new hash, records it in the password history and
+// sets or clears the forced-change flag in one transaction. Setting the flag
+// also revokes the user's personal access tokens, which would otherwise keep
+// working with credentials the user no longer controls alone.
+func (uc *usecase) updatePassword(ctx context.Context, authMethod *entity.UserAuthMethod, newPassword string, forceChange bool) error {
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return errors.ErrInternal("failed to hash password").WithError(err)
+	}
+	passwordHashStr := string(passwordHash)
+
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		authMethod.SetPasswordHash(passwordHashStr)
+		if err := uc.UserAuthMethodRepo.Update(txCtx, authMethod); err != nil {
+			return err
+		}
+
+		if err := uc.recordPasswordHistory(txCtx, authMethod.UserID, passwordHashStr); err != nil {
+			return err
+		}
+
+		securityState, err := uc.UserSecurityStateRepo.GetByUserID(txCtx, authMethod.UserID)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		securityState.ForcePasswordChange = forceChange
+		securityState.PasswordChangedAt = &now
+		if err := uc.UserSecurityStateRepo.Update(txCtx, securityState); err != nil {
+			return err
+		}
+
+		if forceChange {
+			return uc.PersonalAccessTokenRepo.RevokeAllByUserID(txCtx, authMethod.UserID, "Password reset")
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.ErrInternal("failed to update password").WithError(err)
+	}
+
+	return nil
+}
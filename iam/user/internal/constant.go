@@ -0,0 +1,28 @@
+package internal
+
+import "time"
+
+const (
+	AvatarJPEGQuality = 85
+	MaxAvatarBytes    = 5 * 1024 * 1024
+	AvatarURLExpiry   = time.Hour
+
+	// TokenInvalidationTTL covers the longest-lived access token when the
+	// access expiry is not configured.
+	TokenInvalidationTTL = 15 * time.Minute
+)
+
+type avatarSize struct {
+	Name   string
+	Pixels int
+}
+
+// AvatarSizes are the square renditions stored for every uploaded avatar.
+// AvatarDefaultSize is the one exposed as profile_picture_url.
+var AvatarSizes = []avatarSize{
+	{Name: "small", Pixels: 64},
+	{Name: "medium", Pixels: 256},
+	{Name: "large", Pixels: 512},
+}
+
+const AvatarDefaultSize = "medium"
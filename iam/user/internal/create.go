@@ -7,6 +7,7 @@ import (
 	"iam-service/entity"
 	"iam-service/iam/user/userdto"
 	"iam-service/pkg/errors"
+	"iam-service/pkg/password"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -43,7 +44,12 @@ func (uc *usecase) Create(ctx context.Context, req *userdto.CreateRequest) (*use
 		return nil, errors.ErrUserAlreadyExists()
 	}
 
-	if err := validatePassword(req.Password); err != nil {
+	policy, err := password.ResolvePolicy(ctx, uc.PasswordPolicyRepo, &req.TenantID, role.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	warning, err := uc.checkPassword(policy, req.Password, password.ContextWords(req.Email, req.FirstName, req.LastName)...)
+	if err != nil {
 		return nil, err
 	}
 
@@ -72,6 +78,10 @@ func (uc *usecase) Create(ctx context.Context, req *userdto.CreateRequest) (*use
 			return err
 		}
 
+		if err := uc.recordPasswordHistory(txCtx, user.ID, passwordHashStr); err != nil {
+			return err
+		}
+
 		profile := &entity.UserProfile{
 			UserID:    user.ID,
 			FirstName: req.FirstName,
@@ -96,6 +106,7 @@ func (uc *usecase) Create(ctx context.Context, req *userdto.CreateRequest) (*use
 			UserID:        user.ID,
 			EmailVerified: true,
 			EmailVerifiedAt: &now,
+			PasswordChangedAt: &now,
 			UpdatedAt:     now,
 		}
 		if err := uc.UserSecurityStateRepo.Create(txCtx, securityState); err != nil {
@@ -108,6 +119,7 @@ func (uc *usecase) Create(ctx context.Context, req *userdto.CreateRequest) (*use
 			FullName: req.FirstName + " " + req.LastName,
 			RoleCode: req.RoleCode,
 			TenantID: req.TenantID,
+			Warnings: passwordWarnings(warning),
 		}
 
 		return nil
@@ -119,43 +131,3 @@ func (uc *usecase) Create(ctx context.Context, req *userdto.CreateRequest) (*use
 
 	return response, nil
 }
-func validatePassword(password string) error {
-	if len(password) < 8 {
-		return errors.ErrValidation("password must be at least 8 characters")
-	}
-
-	var (
-		hasUpper   bool
-		hasLower   bool
-		hasNumber  bool
-		hasSpecial bool
-	)
-
-	for _, char := range password {
-		switch {
-		case char >= 'A' && char <= 'Z':
-			hasUpper = true
-		case char >= 'a' && char <= 'z':
-			hasLower = true
-		case char >= '0' && char <= '9':
-			hasNumber = true
-		case char == '!' || char == '@' || char == '#' || char == '$' || char == '%' || char == '^' || char == '&' || char == '*':
-			hasSpecial = true
-		}
-	}
-
-	if !hasUpper {
-		return errors.ErrValidation("password must contain at least one uppercase letter")
-	}
-	if !hasLower {
-		return errors.ErrValidation("password must contain at least one lowercase letter")
-	}
-	if !hasNumber {
-		return errors.ErrValidation("password must contain at least one number")
-	}
-	if !hasSpecial {
-		return errors.ErrValidation("password must contain at least one special character")
-	}
-
-	return nil
-}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) ForcePasswordChange(ctx context.Context, id uuid.UUID) (*userdto.ForcePasswordChangeResponse, error) {
+	_, err := uc.UserRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, err
+	}
+
+	if _, err := uc.getPasswordAuthMethod(ctx, id); err != nil {
+		return nil, err
+	}
+
+	security, err := uc.UserSecurityStateRepo.GetByUserID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrInternal("user security state not found")
+		}
+		return nil, err
+	}
+
+	// Existing sessions and personal access tokens are ended so the user
+	// cannot keep working with the old password; the next login gets the
+	// password-change token.
+	err = uc.TxManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		if !security.ForcePasswordChange {
+			security.ForcePasswordChange = true
+			if err := uc.UserSecurityStateRepo.Update(txCtx, security); err != nil {
+				return err
+			}
+		}
+		if err := uc.RefreshTokenRepo.RevokeAllByUserID(txCtx, id, "Password change forced"); err != nil {
+			return err
+		}
+		if err := uc.PersonalAccessTokenRepo.RevokeAllByUserID(txCtx, id, "Password change forced"); err != nil {
+			return err
+		}
+		return uc.UserSessionRepo.RevokeAllByUserID(txCtx, id)
+	})
+	if err != nil {
+		return nil, errors.ErrInternal("failed to force password change").WithError(err)
+	}
+	uc.invalidateTokens(ctx, id)
+
+	return &userdto.ForcePasswordChangeResponse{
+		UserID:  id,
+		Message: "User must change password on next login",
+	}, nil
+}
+
+func (uc *usecase) ForceTenantPasswordChange(ctx context.Context, req *userdto.ForceTenantPasswordChangeRequest) (*userdto.ForceTenantPasswordChangeResponse, error) {
+	exists, err := uc.TenantRepo.Exists(ctx, req.TenantID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to check tenant").WithError(err)
+	}
+	if !exists {
+		return nil, errors.ErrTenantNotFound()
+	}
+
+	affected, err := uc.UserSecurityStateRepo.ForcePasswordChangeByTenant(ctx, req.TenantID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to force password change").WithError(err)
+	}
+
+	return &userdto.ForceTenantPasswordChangeResponse{
+		TenantID:      req.TenantID,
+		AffectedUsers: affected,
+		Message:       "Users must change password on next login",
+	}, nil
+}
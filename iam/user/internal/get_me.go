@@ -33,5 +33,7 @@ func (uc *usecase) GetMe(ctx context.Context, userID uuid.UUID) (*userdto.UserDe
 		return nil, err
 	}
 
-	return mapUserToDetailResponse(user, profile, authMethod, securityState), nil
+	resp := mapUserToDetailResponse(user, profile, authMethod, securityState)
+	uc.attachAvatarURLs(ctx, resp, profile)
+	return resp, nil
 }
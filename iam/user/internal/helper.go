@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/password"
+
+	"github.com/google/uuid"
+)
+
+// userPasswordPolicy returns the strictest policy across the platform and
+// every tenant and application the user belongs to, so no single tenant can
+// weaken the rules of another.
+func (uc *usecase) userPasswordPolicy(ctx context.Context, userID uuid.UUID) (*password.Policy, error) {
+	registrations, err := uc.UserTenantRegRepo.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list tenant registrations").WithError(err)
+	}
+	userRoles, err := uc.UserRoleRepo.ListActiveByUserID(ctx, userID, nil)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to list user roles").WithError(err)
+	}
+	tenantIDs, productIDs := password.UserScopes(registrations, userRoles)
+	return password.ResolveUserPolicy(ctx, uc.PasswordPolicyRepo, tenantIDs, productIDs)
+}
+
+// passwordContextWords returns the user's email local part and names, which a
+// new password must not contain.
+func (uc *usecase) passwordContextWords(ctx context.Context, user *entity.User) ([]string, error) {
+	profile, err := uc.UserProfileRepo.GetByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return password.ContextWords(user.Email), nil
+		}
+		return nil, errors.ErrInternal("failed to get user profile").WithError(err)
+	}
+	return password.ContextWords(user.Email, profile.FirstName, profile.LastName), nil
+}
+
+// checkPassword applies the policy rules and breach screening. A non-empty
+// warning means the password was accepted but found in the breach dataset.
+func (uc *usecase) checkPassword(policy *password.Policy, plain string, contextWords ...string) (string, error) {
+	if err := policy.Validate(plain, contextWords...); err != nil {
+		return "", err
+	}
+	return policy.CheckBreached(uc.BreachChecker, plain)
+}
+
+// validateNewPassword runs checkPassword and rejects reuse of the user's last
+// HistoryDepth passwords.
+func (uc *usecase) validateNewPassword(ctx context.Context, policy *password.Policy, user *entity.User, plain string) (string, error) {
+	contextWords, err := uc.passwordContextWords(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	warning, err := uc.checkPassword(policy, plain, contextWords...)
+	if err != nil {
+		return "", err
+	}
+	if policy.HistoryDepth <= 0 {
+		return warning, nil
+	}
+
+	hashes, err := uc.PasswordHistoryRepo.ListRecentHashes(ctx, user.ID, policy.HistoryDepth)
+	if err != nil {
+		return "", errors.ErrInternal("failed to get password history").WithError(err)
+	}
+	if password.MatchesAny(plain, hashes) {
+		return "", errors.ErrValidation(fmt.Sprintf("Password must not match any of the last %d passwords", policy.HistoryDepth))
+	}
+	return warning, nil
+}
+
+func passwordWarnings(warning string) []string {
+	if warning == "" {
+		return nil
+	}
+	return []string{warning}
+}
+
+func (uc *usecase) recordPasswordHistory(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	return uc.PasswordHistoryRepo.Create(ctx, &entity.PasswordHistory{
+		UserID:       userID,
+		PasswordHash: passwordHash,
+		CreatedAt:    time.Now(),
+	})
+}
+
+// invalidateTokens rejects access tokens issued before now.
+func (uc *usecase) invalidateTokens(ctx context.Context, userID uuid.UUID) {
+	ttl := uc.Config.JWT.AccessExpiry
+	if ttl <= 0 {
+		ttl = TokenInvalidationTTL
+	}
+	_ = uc.TokenBlacklist.BlacklistUser(context.WithoutCancel(ctx), userID, time.Now(), ttl)
+}
+
+func avatarPrefix(userID, version uuid.UUID) string {
+	return fmt.Sprintf("users/%s/avatar/%s", userID, version)
+}
+
+func avatarObjectKey(prefix, size string) string {
+	return prefix + "/" + size + ".jpg"
+}
+
+// attachAvatarURLs replaces the profile picture with presigned URLs when the
+// user has uploaded an avatar. Storage errors leave the response without one
+// rather than failing the whole profile read.
+func (uc *usecase) attachAvatarURLs(ctx context.Context, resp *userdto.UserDetailResponse, profile *entity.UserProfile) {
+	if profile == nil || profile.ProfilePictureKey == nil {
+		return
+	}
+
+	urls := make(map[string]string, len(AvatarSizes))
+	for _, size := range AvatarSizes {
+		url, err := uc.FileStorage.GetPresignedURL(ctx, uc.Config.Infra.Minio.Bucket, avatarObjectKey(*profile.ProfilePictureKey, size.Name), AvatarURLExpiry)
+		if err != nil {
+			return
+		}
+		urls[size.Name] = url
+	}
+
+	defaultURL := urls[AvatarDefaultSize]
+	resp.ProfilePictureURL = &defaultURL
+	resp.ProfilePictures = urls
+}
+
+func (uc *usecase) deleteAvatarObjects(ctx context.Context, objectKeys []string) {
+	for _, objectKey := range objectKeys {
+		_ = uc.FileStorage.DeleteFile(ctx, uc.Config.Infra.Minio.Bucket, objectKey)
+	}
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/user/contract"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockUserRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.User), args.Error(1)
+}
+
+func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserRepository) List(ctx context.Context, filter *contract.UserListFilter) ([]*entity.User, int64, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, 0, args.Error(2)
+	}
+	return args.Get(0).([]*entity.User), args.Get(1).(int64), args.Error(2)
+}
+
+type MockUserProfileRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserProfileRepository) Create(ctx context.Context, profile *entity.UserProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockUserProfileRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserProfile, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserProfile), args.Error(1)
+}
+
+func (m *MockUserProfileRepository) Update(ctx context.Context, profile *entity.UserProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+type MockUserAuthMethodRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserAuthMethodRepository) Create(ctx context.Context, authMethod *entity.UserAuthMethod) error {
+	args := m.Called(ctx, authMethod)
+	return args.Error(0)
+}
+
+func (m *MockUserAuthMethodRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserAuthMethod, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserAuthMethod), args.Error(1)
+}
+
+func (m *MockUserAuthMethodRepository) Update(ctx context.Context, authMethod *entity.UserAuthMethod) error {
+	args := m.Called(ctx, authMethod)
+	return args.Error(0)
+}
+
+type MockUserSecurityStateRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserSecurityStateRepository) Create(ctx context.Context, securityState *entity.UserSecurityState) error {
+	args := m.Called(ctx, securityState)
+	return args.Error(0)
+}
+
+func (m *MockUserSecurityStateRepository) GetByUserID(ctx context.Context, userID uuid.UUID) (*entity.UserSecurityState, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.UserSecurityState), args.Error(1)
+}
+
+func (m *MockUserSecurityStateRepository) Update(ctx context.Context, securityState *entity.UserSecurityState) error {
+	args := m.Called(ctx, securityState)
+	return args.Error(0)
+}
+
+func (m *MockUserSecurityStateRepository) ForcePasswordChangeByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, tenantID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockFileStorage struct {
+	mock.Mock
+}
+
+func (m *MockFileStorage) UploadFile(ctx context.Context, bucket, objectKey string, data io.Reader, size int64, contentType string) (string, error) {
+	args := m.Called(ctx, bucket, objectKey, data, size, contentType)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockFileStorage) DeleteFile(ctx context.Context, bucket, objectKey string) error {
+	args := m.Called(ctx, bucket, objectKey)
+	return args.Error(0)
+}
+
+func (m *MockFileStorage) GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error) {
+	args := m.Called(ctx, bucket, objectKey, expiry)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) ResetPassword(ctx context.Context, id uuid.UUID, req *userdto.ResetPasswordRequest) (*userdto.ResetPasswordResponse, error) {
+	user, err := uc.UserRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, err
+	}
+
+	authMethod, err := uc.getPasswordAuthMethod(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := uc.userPasswordPolicy(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	warning, err := uc.validateNewPassword(ctx, policy, user, req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	// The admin knows the new password, so the user must pick their own.
+	if err := uc.updatePassword(ctx, authMethod, req.NewPassword, true); err != nil {
+		return nil, err
+	}
+
+	return &userdto.ResetPasswordResponse{
+		UserID:   id,
+		Message:  "Password reset successfully. User will need to change it on next login.",
+		Warnings: passwordWarnings(warning),
+	}, nil
+}
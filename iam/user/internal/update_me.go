@@ -53,5 +53,7 @@ func (uc *usecase) UpdateMe(ctx context.Context, userID uuid.UUID, req *userdto.
 		return nil, err
 	}
 
-	return mapUserToDetailResponse(user, profile, authMethod, securityState), nil
+	resp := mapUserToDetailResponse(user, profile, authMethod, securityState)
+	uc.attachAvatarURLs(ctx, resp, profile)
+	return resp, nil
 }
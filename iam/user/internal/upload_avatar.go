@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/imaging"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) UploadAvatar(ctx context.Context, userID uuid.UUID, req *userdto.UploadAvatarRequest) (*userdto.UserDetailResponse, error) {
+	if len(req.Content) > MaxAvatarBytes {
+		return nil, errors.ErrBadRequest("File size exceeds 5MB limit")
+	}
+
+	user, err := uc.UserRepo.GetByID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUserNotFound()
+		}
+		return nil, err
+	}
+
+	profile, err := uc.UserProfileRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrInternal("user profile not found")
+		}
+		return nil, err
+	}
+
+	img, _, err := imaging.Decode(req.Content)
+	if err != nil {
+		switch {
+		case errors.Is(err, imaging.ErrUnsupportedFormat):
+			return nil, errors.ErrBadRequest("Unsupported image format; allowed: jpeg, png, gif")
+		case errors.Is(err, imaging.ErrTooLarge):
+			return nil, errors.ErrBadRequest(fmt.Sprintf("Image dimensions must not exceed %dx%d pixels", imaging.MaxDimension, imaging.MaxDimension))
+		default:
+			return nil, errors.ErrBadRequest("Image could not be decoded")
+		}
+	}
+
+	// Every upload gets a fresh prefix so cached URLs of the previous avatar
+	// never serve the new image.
+	prefix := avatarPrefix(userID, uuid.New())
+	bucket := uc.Config.Infra.Minio.Bucket
+
+	square := imaging.CropSquare(img)
+	var uploaded []string
+	for _, size := range AvatarSizes {
+		content, err := imaging.EncodeJPEG(imaging.Scale(square, size.Pixels), AvatarJPEGQuality)
+		if err != nil {
+			uc.deleteAvatarObjects(ctx, uploaded)
+			return nil, errors.ErrInternal("failed to encode avatar").WithError(err)
+		}
+
+		objectKey := avatarObjectKey(prefix, size.Name)
+		if _, err := uc.FileStorage.UploadFile(ctx, bucket, objectKey, bytes.NewReader(content), int64(len(content)), "image/jpeg"); err != nil {
+			uc.deleteAvatarObjects(ctx, uploaded)
+			return nil, errors.ErrInternal("failed to store avatar").WithError(err)
+		}
+		uploaded = append(uploaded, objectKey)
+	}
+
+	previous := profile.ProfilePictureKey
+	profile.ProfilePictureKey = &prefix
+	if err := uc.UserProfileRepo.Update(ctx, profile); err != nil {
+		uc.deleteAvatarObjects(ctx, uploaded)
+		return nil, errors.ErrInternal("failed to update user profile").WithError(err)
+	}
+
+	if previous != nil {
+		var stale []string
+		for _, size := range AvatarSizes {
+			stale = append(stale, avatarObjectKey(*previous, size.Name))
+		}
+		uc.deleteAvatarObjects(ctx, stale)
+	}
+
+	authMethod, err := uc.UserAuthMethodRepo.GetByUserID(ctx, userID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	securityState, err := uc.UserSecurityStateRepo.GetByUserID(ctx, userID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	resp := mapUserToDetailResponse(user, profile, authMethod, securityState)
+	uc.attachAvatarURLs(ctx, resp, profile)
+	return resp, nil
+}
@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"net/http"
+	"strings"
+	"testing"
+
+	"iam-service/config"
+	"iam-service/entity"
+	"iam-service/iam/user/userdto"
+	"iam-service/pkg/errors"
+	"iam-service/pkg/imaging"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeTestPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func TestUploadAvatar(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     func(t *testing.T) []byte
+		contentType string
+		wantErr     string
+	}{
+		{
+			name:        "stores every rendition",
+			content:     func(t *testing.T) []byte { return encodeTestPNG(t, 40, 30) },
+			contentType: "image/png",
+		},
+		{
+			name: "file larger than the byte limit",
+			content: func(t *testing.T) []byte {
+				return bytes.Repeat([]byte{0}, MaxAvatarBytes+1)
+			},
+			contentType: "image/png",
+			wantErr:     "5MB",
+		},
+		{
+			name:        "image dimensions over the limit",
+			content:     func(t *testing.T) []byte { return encodeTestPNG(t, imaging.MaxDimension+1, 1) },
+			contentType: "image/png",
+			wantErr:     "dimensions",
+		},
+		{
+			name:        "text declared as an image",
+			content:     func(t *testing.T) []byte { return []byte("not an image at all") },
+			contentType: "image/png",
+			wantErr:     "Unsupported image format",
+		},
+		{
+			name:        "pdf upload",
+			content:     func(t *testing.T) []byte { return []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n1 0 obj\n") },
+			contentType: "application/pdf",
+			wantErr:     "Unsupported image format",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := uuid.New()
+			userRepo := new(MockUserRepository)
+			profileRepo := new(MockUserProfileRepository)
+			authMethodRepo := new(MockUserAuthMethodRepository)
+			securityStateRepo := new(MockUserSecurityStateRepository)
+			fileStorage := new(MockFileStorage)
+
+			content := tt.content(t)
+			oversized := len(content) > MaxAvatarBytes
+			if !oversized {
+				userRepo.On("GetByID", mock.Anything, userID).Return(&entity.User{ID: userID, Status: entity.UserStatusActive}, nil)
+				profileRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserProfile{UserID: userID, FirstName: "Jane", LastName: "Doe"}, nil)
+			}
+			if tt.wantErr == "" {
+				fileStorage.On("UploadFile", mock.Anything, "iam-test", mock.Anything, mock.Anything, mock.Anything, "image/jpeg").Return("", nil).Times(len(AvatarSizes))
+				profileRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+				authMethodRepo.On("GetByUserID", mock.Anything, userID).Return(nil, errors.ErrNotFound("auth method not found"))
+				securityStateRepo.On("GetByUserID", mock.Anything, userID).Return(&entity.UserSecurityState{UserID: userID}, nil)
+				fileStorage.On("GetPresignedURL", mock.Anything, "iam-test", mock.Anything, AvatarURLExpiry).Return("https://files.example.com/avatar", nil)
+			}
+
+			uc := &usecase{
+				Config:                &config.Config{Infra: config.InfraConfig{Minio: config.MinioConfig{Bucket: "iam-test"}}},
+				UserRepo:              userRepo,
+				UserProfileRepo:       profileRepo,
+				UserAuthMethodRepo:    authMethodRepo,
+				UserSecurityStateRepo: securityStateRepo,
+				FileStorage:           fileStorage,
+			}
+
+			resp, err := uc.UploadAvatar(context.Background(), userID, &userdto.UploadAvatarRequest{
+				Content:     content,
+				ContentType: tt.contentType,
+			})
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, http.StatusBadRequest, appErr.HTTPStatus)
+				assert.True(t, strings.Contains(appErr.Message, tt.wantErr), appErr.Message)
+				fileStorage.AssertNotCalled(t, "UploadFile", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				profileRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				if oversized {
+					userRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.ProfilePictureURL)
+			assert.Len(t, resp.ProfilePictures, len(AvatarSizes))
+			fileStorage.AssertExpectations(t)
+			profileRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -46,6 +46,13 @@ type UpdateMeRequest struct {
 	Address     *string `json:"address,omitempty" validate:"omitempty,max=500"`
 }
 
+// UploadAvatarRequest carries the uploaded bytes and the content type sniffed
+// from them. The usecase enforces the size limit and decodes the image itself.
+type UploadAvatarRequest struct {
+	Content     []byte
+	ContentType string
+}
+
 type UpdateRequest struct {
 	FirstName *string `json:"first_name,omitempty" validate:"omitempty,min=2,max=100"`
 	LastName  *string `json:"last_name,omitempty" validate:"omitempty,min=2,max=100"`
@@ -57,3 +64,16 @@ type UpdateRequest struct {
 type RejectRequest struct {
 	Reason string `json:"reason" validate:"required,max=500"`
 }
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required"`
+	NewPassword     string `json:"new_password" validate:"required,min=8,max=256"`
+}
+
+type ResetPasswordRequest struct {
+	NewPassword string `json:"new_password" validate:"required,min=8,max=256"`
+}
+
+type ForceTenantPasswordChangeRequest struct {
+	TenantID uuid.UUID `json:"tenant_id" validate:"required"`
+}
@@ -12,6 +12,7 @@ type CreateResponse struct {
 	FullName string    `json:"full_name"`
 	RoleCode string    `json:"role_code"`
 	TenantID uuid.UUID `json:"tenant_id"`
+	Warnings []string  `json:"warnings,omitempty"`
 }
 
 type BranchInfo struct {
@@ -27,24 +28,25 @@ type RoleInfo struct {
 }
 
 type UserDetailResponse struct {
-	ID                uuid.UUID    `json:"id"`
-	Email             string       `json:"email"`
-	FirstName         string       `json:"first_name"`
-	LastName          string       `json:"last_name"`
-	FullName          string       `json:"full_name"`
-	PhoneNumber       *string      `json:"phone_number,omitempty"`
-	DateOfBirth       *string      `json:"date_of_birth,omitempty"`
-	Address           *string      `json:"address,omitempty"`
-	ProfilePictureURL *string      `json:"profile_picture_url,omitempty"`
-	EmailVerified     bool         `json:"email_verified"`
-	PINSet            bool         `json:"pin_set"`
-	Status            string       `json:"status"`
-	IsActive          bool         `json:"is_active"`
-	LastLoginAt       *time.Time   `json:"last_login_at,omitempty"`
-	CreatedAt         time.Time    `json:"created_at"`
-	UpdatedAt         time.Time    `json:"updated_at"`
-	Branches          []BranchInfo `json:"branches,omitempty"`
-	Roles             []RoleInfo   `json:"roles,omitempty"`
+	ID                uuid.UUID         `json:"id"`
+	Email             string            `json:"email"`
+	FirstName         string            `json:"first_name"`
+	LastName          string            `json:"last_name"`
+	FullName          string            `json:"full_name"`
+	PhoneNumber       *string           `json:"phone_number,omitempty"`
+	DateOfBirth       *string           `json:"date_of_birth,omitempty"`
+	Address           *string           `json:"address,omitempty"`
+	ProfilePictureURL *string           `json:"profile_picture_url,omitempty"`
+	ProfilePictures   map[string]string `json:"profile_pictures,omitempty"`
+	EmailVerified     bool              `json:"email_verified"`
+	PINSet            bool              `json:"pin_set"`
+	Status            string            `json:"status"`
+	IsActive          bool              `json:"is_active"`
+	LastLoginAt       *time.Time        `json:"last_login_at,omitempty"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+	Branches          []BranchInfo      `json:"branches,omitempty"`
+	Roles             []RoleInfo        `json:"roles,omitempty"`
 }
 
 type UserListItem struct {
@@ -98,6 +100,28 @@ type ResetPINResponse struct {
 	Message string    `json:"message"`
 }
 
+type ResetPasswordResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Message  string    `json:"message"`
+	Warnings []string  `json:"warnings,omitempty"`
+}
+
+type ChangePasswordResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Warnings []string  `json:"warnings,omitempty"`
+}
+
+type ForcePasswordChangeResponse struct {
+	UserID  uuid.UUID `json:"user_id"`
+	Message string    `json:"message"`
+}
+
+type ForceTenantPasswordChangeResponse struct {
+	TenantID      uuid.UUID `json:"tenant_id"`
+	AffectedUsers int64     `json:"affected_users"`
+	Message       string    `json:"message"`
+}
+
 type DeleteResponse struct {
 	UserID  uuid.UUID `json:"user_id"`
 	Message string    `json:"message"`
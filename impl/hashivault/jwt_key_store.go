@@ -0,0 +1,188 @@
+package hashivault
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"iam-service/pkg/errors"
+	jwtpkg "iam-service/pkg/jwt"
+)
+
+const transitRequestTimeout = 5 * time.Second
+
+var transitJWTKeyTypes = map[string]string{
+	jwtpkg.SigningMethodRS256: "rsa-2048",
+	jwtpkg.SigningMethodES256: "ecdsa-p256",
+	jwtpkg.SigningMethodEdDSA: "ed25519",
+}
+
+// TransitKeyStore exposes a Vault Transit signing key as a JWT key ring. Every key
+// version still allowed for verification is published; the latest version
+// signs through Vault so the private key never leaves it.
+type TransitKeyStore struct {
+	vault     *SecureVault
+	keyName   string
+	algorithm string
+}
+
+func NewTransitKeyStore(vault *SecureVault, keyName string, algorithm string) (*TransitKeyStore, error) {
+	if _, ok := transitJWTKeyTypes[algorithm]; !ok {
+		return nil, fmt.Errorf("signing method %s is not supported by vault transit", algorithm)
+	}
+	return &TransitKeyStore{
+		vault:     vault,
+		keyName:   keyName,
+		algorithm: algorithm,
+	}, nil
+}
+
+func (s *TransitKeyStore) LoadKeys() ([]*jwtpkg.SigningKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), transitRequestTimeout)
+	defer cancel()
+
+	transitKey, err := s.vault.ReadTransitKey(ctx, s.keyName)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		if err := s.vault.CreateTransitKey(ctx, s.keyName, transitJWTKeyTypes[s.algorithm], false); err != nil {
+			return nil, err
+		}
+		transitKey, err = s.vault.ReadTransitKey(ctx, s.keyName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !transitKey.SupportsSigning {
+		return nil, fmt.Errorf("transit key %s does not support signing", s.keyName)
+	}
+
+	minVersion := transitKey.MinDecryptionVersion
+	if minVersion < 1 {
+		minVersion = 1
+	}
+
+	keys := make([]*jwtpkg.SigningKey, 0, len(transitKey.Versions))
+	for version := transitKey.LatestVersion; version >= minVersion; version-- {
+		keyVersion, ok := transitKey.Versions[version]
+		if !ok || keyVersion.PublicKey == "" {
+			continue
+		}
+
+		publicKey, err := parseTransitPublicKey(keyVersion.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse transit public key v%d: %w", version, err)
+		}
+		algorithm, err := jwtpkg.AlgorithmForKey(publicKey)
+		if err != nil {
+			return nil, fmt.Errorf("transit key %s v%d: %w", s.keyName, version, err)
+		}
+		if version == transitKey.LatestVersion && algorithm != s.algorithm {
+			return nil, fmt.Errorf("transit key %s is a %s key, expected %s", s.keyName, algorithm, s.algorithm)
+		}
+
+		createdAt := keyVersion.CreationTime
+		signingKey := &jwtpkg.SigningKey{
+			Kid:       jwtpkg.KeyThumbprint(publicKey),
+			Algorithm: algorithm,
+			Status:    jwtpkg.KeyStatusPrevious,
+			PublicKey: publicKey,
+			CreatedAt: createdAt,
+		}
+		if version == transitKey.LatestVersion {
+			signingKey.Status = jwtpkg.KeyStatusActive
+			signingKey.ActivatedAt = &createdAt
+			signingKey.Signer = &transitSigner{
+				vault:     s.vault,
+				keyName:   s.keyName,
+				version:   version,
+				publicKey: publicKey,
+			}
+		}
+		keys = append(keys, signingKey)
+	}
+
+	return keys, nil
+}
+
+func (s *TransitKeyStore) SaveKeys(keys []*jwtpkg.SigningKey) error {
+	return jwtpkg.ErrKeyRingReadOnly
+}
+
+func (s *TransitKeyStore) RotateKeys() error {
+	ctx, cancel := context.WithTimeout(context.Background(), transitRequestTimeout)
+	defer cancel()
+
+	return s.vault.RotateTransitKey(ctx, s.keyName)
+}
+
+// parseTransitPublicKey handles both PEM public keys (RSA, ECDSA) and the bare
+// base64 form Vault returns for Ed25519 keys.
+func parseTransitPublicKey(value string) (crypto.PublicKey, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return jwtpkg.ParsePublicKeyPEM([]byte(value))
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("unexpected public key length %d", len(raw))
+	}
+	return ed25519.PublicKey(raw), nil
+}
+
+type transitSigner struct {
+	vault     *SecureVault
+	keyName   string
+	version   int
+	publicKey crypto.PublicKey
+}
+
+func (s *transitSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *transitSigner) Sign(_ io.Reader, message []byte, opts crypto.SignerOpts) ([]byte, error) {
+	if _, isPSS := opts.(*rsa.PSSOptions); isPSS {
+		return nil, fmt.Errorf("transit signer does not support RSA-PSS")
+	}
+
+	signOpts := TransitSignOptions{KeyVersion: s.version}
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		signOpts.HashAlgorithm = "sha2-256"
+		signOpts.Prehashed = true
+		if _, isRSA := s.publicKey.(*rsa.PublicKey); isRSA {
+			signOpts.SignatureAlgorithm = "pkcs1v15"
+		}
+	case crypto.Hash(0):
+		// Ed25519 signs the message itself.
+	default:
+		return nil, fmt.Errorf("transit signer does not support hash %v", opts.HashFunc())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), transitRequestTimeout)
+	defer cancel()
+
+	signature, err := s.vault.SignDataWithOptions(ctx, s.keyName, message, signOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Transit signatures look like vault:v<version>:<base64>.
+	parts := strings.SplitN(signature, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected transit signature format")
+	}
+	return base64.StdEncoding.DecodeString(parts[2])
+}
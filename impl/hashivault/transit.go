@@ -3,8 +3,11 @@ package hashivault
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"iam-service/pkg/errors"
+	"strconv"
+	"time"
 )
 
 type TransitKey struct {
@@ -21,6 +24,24 @@ type TransitKey struct {
 	SupportsDecryption   bool             `json:"supports_decryption"`
 	SupportsSigning      bool             `json:"supports_signing"`
 	SupportsDerivation   bool             `json:"supports_derivation"`
+
+	LatestVersion int                       `json:"latest_version"`
+	Versions      map[int]TransitKeyVersion `json:"versions"`
+}
+
+// TransitKeyVersion is only returned for asymmetric keys, which expose the
+// public half of every version.
+type TransitKeyVersion struct {
+	Version      int       `json:"version"`
+	CreationTime time.Time `json:"creation_time"`
+	PublicKey    string    `json:"public_key"`
+}
+
+type TransitSignOptions struct {
+	KeyVersion         int
+	HashAlgorithm      string
+	SignatureAlgorithm string
+	Prehashed          bool
 }
 
 func (v *SecureVault) EncryptData(ctx context.Context, keyName string, plaintext []byte) (string, error) {
@@ -258,6 +279,45 @@ func (v *SecureVault) ReadTransitKey(ctx context.Context, keyName string) (*Tran
 	if v, ok := secret.Data["supports_derivation"].(bool); ok {
 		transitKey.SupportsDerivation = v
 	}
+	if v, ok := toInt64(secret.Data["latest_version"]); ok {
+		transitKey.LatestVersion = int(v)
+	}
+	if v, ok := toInt64(secret.Data["min_decryption_version"]); ok {
+		transitKey.MinDecryptionVersion = int(v)
+	}
+	if v, ok := toInt64(secret.Data["min_encryption_version"]); ok {
+		transitKey.MinEncryptionVersion = int(v)
+	}
+	if keys, ok := secret.Data["keys"].(map[string]interface{}); ok {
+		transitKey.Keys = make(map[string]int64, len(keys))
+		transitKey.Versions = make(map[int]TransitKeyVersion, len(keys))
+		for name, raw := range keys {
+			if v, ok := toInt64(raw); ok {
+				transitKey.Keys[name] = v
+				continue
+			}
+
+			details, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			version, err := strconv.Atoi(name)
+			if err != nil {
+				continue
+			}
+			keyVersion := TransitKeyVersion{Version: version}
+			if v, ok := details["public_key"].(string); ok {
+				keyVersion.PublicKey = v
+			}
+			if v, ok := details["creation_time"].(string); ok {
+				if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+					keyVersion.CreationTime = t
+					transitKey.Keys[name] = t.Unix()
+				}
+			}
+			transitKey.Versions[version] = keyVersion
+		}
+	}
 
 	return transitKey, nil
 }
@@ -279,11 +339,27 @@ func (v *SecureVault) RotateTransitKey(ctx context.Context, keyName string) erro
 }
 
 func (v *SecureVault) SignData(ctx context.Context, keyName string, input []byte) (string, error) {
+	return v.SignDataWithOptions(ctx, keyName, input, TransitSignOptions{})
+}
+
+func (v *SecureVault) SignDataWithOptions(ctx context.Context, keyName string, input []byte, opts TransitSignOptions) (string, error) {
 	encodedInput := base64.StdEncoding.EncodeToString(input)
 
 	data := map[string]interface{}{
 		"input": encodedInput,
 	}
+	if opts.KeyVersion > 0 {
+		data["key_version"] = opts.KeyVersion
+	}
+	if opts.HashAlgorithm != "" {
+		data["hash_algorithm"] = opts.HashAlgorithm
+	}
+	if opts.SignatureAlgorithm != "" {
+		data["signature_algorithm"] = opts.SignatureAlgorithm
+	}
+	if opts.Prehashed {
+		data["prehashed"] = true
+	}
 
 	secret, err := v.client.Logical().WriteWithContext(ctx, fmt.Sprintf("transit/sign/%s", keyName), data)
 	if err != nil {
@@ -404,3 +480,18 @@ func (v *SecureVault) GenerateHMAC(ctx context.Context, keyName string, input []
 
 	return hmac, nil
 }
+
+func toInt64(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		n, err := v.Int64()
+		return n, err == nil
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	}
+	return 0, false
+}
@@ -44,6 +44,34 @@ func (fs *fileStorage) DeleteFile(ctx context.Context, bucket, objectKey string)
 	return nil
 }
 
+// DeletePrefix removes every object whose key starts with prefix.
+func (fs *fileStorage) DeletePrefix(ctx context.Context, bucket, prefix string) error {
+	var objects []minio.ObjectInfo
+	for object := range fs.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return fmt.Errorf("list objects in MinIO: %w", object.Err)
+		}
+		objects = append(objects, object)
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+
+	keys := make(chan minio.ObjectInfo, len(objects))
+	for _, object := range objects {
+		keys <- object
+	}
+	close(keys)
+
+	var firstErr error
+	for result := range fs.client.RemoveObjects(ctx, bucket, keys, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("delete objects from MinIO: %w", result.Err)
+		}
+	}
+	return firstErr
+}
+
 func (fs *fileStorage) GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error) {
 	url, err := fs.client.PresignedGetObject(ctx, bucket, objectKey, expiry, nil)
 	if err != nil {
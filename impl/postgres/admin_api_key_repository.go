@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/apikey/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type adminAPIKeyRepository struct {
+	baseRepository
+}
+
+func NewAdminAPIKeyRepository(db *gorm.DB) contract.AdminAPIKeyRepository {
+	return &adminAPIKeyRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *adminAPIKeyRepository) Create(ctx context.Context, key *entity.AdminAPIKey) error {
+	if err := r.getDB(ctx).Create(key).Error; err != nil {
+		return translateError(err, "api key")
+	}
+	return nil
+}
+
+func (r *adminAPIKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.AdminAPIKey, error) {
+	var key entity.AdminAPIKey
+	err := r.getDB(ctx).Where("id = ?", id).First(&key).Error
+	if err != nil {
+		return nil, translateError(err, "api key")
+	}
+	return &key, nil
+}
+
+func (r *adminAPIKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*entity.AdminAPIKey, error) {
+	var key entity.AdminAPIKey
+	err := r.getDB(ctx).Where("key_hash = ?", keyHash).First(&key).Error
+	if err != nil {
+		return nil, translateError(err, "api key")
+	}
+	return &key, nil
+}
+
+func (r *adminAPIKeyRepository) ListByTenantID(ctx context.Context, tenantID uuid.UUID) ([]*entity.AdminAPIKey, error) {
+	var keys []*entity.AdminAPIKey
+	err := r.getDB(ctx).
+		Where("tenant_id = ?", tenantID).
+		Order("created_at DESC").
+		Find(&keys).Error
+	if err != nil {
+		return nil, translateError(err, "api keys")
+	}
+	return keys, nil
+}
+
+func (r *adminAPIKeyRepository) Revoke(ctx context.Context, id uuid.UUID, revokedBy uuid.UUID, reason string) error {
+	if err := r.getDB(ctx).
+		Model(&entity.AdminAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_by":     revokedBy,
+			"revoked_reason": reason,
+			"is_active":      false,
+		}).Error; err != nil {
+		return translateError(err, "api key")
+	}
+	return nil
+}
+
+func (r *adminAPIKeyRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error {
+	updates := map[string]interface{}{
+		"last_used_at": time.Now(),
+	}
+	if ip != "" {
+		updates["last_used_ip"] = ip
+	}
+	if err := r.getDB(ctx).
+		Model(&entity.AdminAPIKey{}).
+		Where("id = ?", id).
+		Updates(updates).Error; err != nil {
+		return translateError(err, "api key")
+	}
+	return nil
+}
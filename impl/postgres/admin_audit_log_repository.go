@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/contract"
+
+	"gorm.io/gorm"
+)
+
+type adminAuditLogRepository struct {
+	baseRepository
+}
+
+func NewAdminAuditLogRepository(db *gorm.DB) contract.AdminAuditLogRepository {
+	return &adminAuditLogRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *adminAuditLogRepository) Create(ctx context.Context, log *entity.AdminAuditLog) error {
+	if err := r.getDB(ctx).Create(log).Error; err != nil {
+		return translateError(err, "admin audit log")
+	}
+	return nil
+}
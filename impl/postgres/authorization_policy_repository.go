@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/policy/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type authorizationPolicyRepository struct {
+	baseRepository
+}
+
+func NewAuthorizationPolicyRepository(db *gorm.DB) *authorizationPolicyRepository {
+	return &authorizationPolicyRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *authorizationPolicyRepository) Create(ctx context.Context, policy *entity.AuthorizationPolicy) error {
+	if err := r.getDB(ctx).Create(policy).Error; err != nil {
+		return translateError(err, "authorization policy")
+	}
+	return nil
+}
+
+func (r *authorizationPolicyRepository) GetByID(ctx context.Context, tenantID, id uuid.UUID) (*entity.AuthorizationPolicy, error) {
+	var policy entity.AuthorizationPolicy
+	err := r.getDB(ctx).
+		Where("id = ? AND tenant_id = ? AND deleted_at IS NULL", id, tenantID).
+		First(&policy).Error
+	if err != nil {
+		return nil, translateError(err, "authorization policy")
+	}
+	return &policy, nil
+}
+
+func (r *authorizationPolicyRepository) Update(ctx context.Context, policy *entity.AuthorizationPolicy) error {
+	if err := r.getDB(ctx).Save(policy).Error; err != nil {
+		return translateError(err, "authorization policy")
+	}
+	return nil
+}
+
+func (r *authorizationPolicyRepository) List(ctx context.Context, filter *contract.PolicyListFilter) ([]*entity.AuthorizationPolicy, int64, error) {
+	query := r.getDB(ctx).Model(&entity.AuthorizationPolicy{}).
+		Where("tenant_id = ? AND deleted_at IS NULL", filter.TenantID)
+
+	if filter.PermissionCode != "" {
+		query = query.Where("permission_code = ?", filter.PermissionCode)
+	}
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+	if filter.Mode != "" {
+		query = query.Where("mode = ?", filter.Mode)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "authorization policies")
+	}
+
+	var policies []*entity.AuthorizationPolicy
+	offset := (filter.Page - 1) * filter.PerPage
+	if err := query.Order("name ASC").Offset(offset).Limit(filter.PerPage).Find(&policies).Error; err != nil {
+		return nil, 0, translateError(err, "authorization policies")
+	}
+
+	return policies, total, nil
+}
+
+func (r *authorizationPolicyRepository) ListApplicable(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string) ([]*entity.AuthorizationPolicy, error) {
+	query := r.getDB(ctx).
+		Where("tenant_id = ? AND permission_code = ? AND is_active = true AND deleted_at IS NULL", tenantID, permissionCode)
+	if resourceType == "" {
+		query = query.Where("resource_type IS NULL")
+	} else {
+		query = query.Where("resource_type = ?", resourceType)
+	}
+
+	var policies []*entity.AuthorizationPolicy
+	if err := query.Order("created_at ASC").Find(&policies).Error; err != nil {
+		return nil, translateError(err, "authorization policies")
+	}
+	return policies, nil
+}
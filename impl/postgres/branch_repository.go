@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type branchRepository struct {
+	baseRepository
+}
+
+func NewBranchRepository(db *gorm.DB) contract.BranchRepository {
+	return &branchRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *branchRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error) {
+	var branch entity.Branch
+	err := r.getDB(ctx).Table("branches").Where("id = ? AND deleted_at IS NULL", id).First(&branch).Error
+	if err != nil {
+		return nil, translateError(err, "branch")
+	}
+	return &branch, nil
+}
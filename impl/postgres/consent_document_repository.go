@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type consentDocumentRepository struct {
+	baseRepository
+}
+
+func NewConsentDocumentRepository(db *gorm.DB) *consentDocumentRepository {
+	return &consentDocumentRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *consentDocumentRepository) Create(ctx context.Context, document *entity.ConsentDocument) error {
+	if err := r.getDB(ctx).Create(document).Error; err != nil {
+		return translateError(err, "consent document")
+	}
+	return nil
+}
+
+func (r *consentDocumentRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.ConsentDocument, error) {
+	var document entity.ConsentDocument
+	if err := r.getDB(ctx).Where("id = ?", id).First(&document).Error; err != nil {
+		return nil, translateError(err, "consent document")
+	}
+	return &document, nil
+}
+
+func (r *consentDocumentRepository) List(ctx context.Context, filter *contract.ConsentDocumentListFilter) ([]*entity.ConsentDocument, int64, error) {
+	var documents []*entity.ConsentDocument
+	var total int64
+
+	query := r.getDB(ctx).Model(&entity.ConsentDocument{})
+
+	if filter.PlatformOnly {
+		query = query.Where("tenant_id IS NULL")
+	} else if filter.TenantID != nil {
+		query = query.Where("tenant_id = ?", *filter.TenantID)
+	}
+
+	if filter.ProductID != nil {
+		query = query.Where("application_id = ?", *filter.ProductID)
+	}
+
+	if filter.DocumentType != nil {
+		query = query.Where("document_type = ?", string(*filter.DocumentType))
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "consent document")
+	}
+
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Find(&documents).Error
+	if err != nil {
+		return nil, 0, translateError(err, "consent document")
+	}
+
+	return documents, total, nil
+}
+
+func (r *consentDocumentRepository) Update(ctx context.Context, document *entity.ConsentDocument) error {
+	if err := r.getDB(ctx).Save(document).Error; err != nil {
+		return translateError(err, "consent document")
+	}
+	return nil
+}
+
+func (r *consentDocumentRepository) SupersedePublished(ctx context.Context, tenantID, productID *uuid.UUID, documentType entity.ConsentDocumentType) error {
+	err := r.getDB(ctx).Model(&entity.ConsentDocument{}).
+		Where("status = ? AND document_type = ?", entity.ConsentDocumentStatusPublished, documentType).
+		Where("tenant_id IS NOT DISTINCT FROM ? AND application_id IS NOT DISTINCT FROM ?", tenantID, productID).
+		Update("status", entity.ConsentDocumentStatusSuperseded).Error
+	if err != nil {
+		return translateError(err, "consent document")
+	}
+	return nil
+}
+
+// ListCurrentForRegistration returns the published platform documents plus
+// those of the tenant and product being registered for.
+func (r *consentDocumentRepository) ListCurrentForRegistration(ctx context.Context, tenantID, productID *uuid.UUID) ([]entity.ConsentDocument, error) {
+	query := r.getDB(ctx).Where("status = ?", entity.ConsentDocumentStatusPublished)
+
+	scope := r.getDB(ctx).Where("tenant_id IS NULL")
+	if tenantID != nil {
+		scope = scope.Or("tenant_id = ? AND application_id IS NULL", *tenantID)
+		if productID != nil {
+			scope = scope.Or("tenant_id = ? AND application_id = ?", *tenantID, *productID)
+		}
+	}
+
+	var documents []entity.ConsentDocument
+	err := query.Where(scope).
+		Order("tenant_id NULLS FIRST, application_id NULLS FIRST, document_type").
+		Find(&documents).Error
+	if err != nil {
+		return nil, translateError(err, "consent document")
+	}
+	return documents, nil
+}
+
+// ListCurrentForUser returns the published documents that apply to the user:
+// platform documents, documents of tenants they are an active member of, and
+// documents of the products they registered for.
+func (r *consentDocumentRepository) ListCurrentForUser(ctx context.Context, userID uuid.UUID) ([]entity.ConsentDocument, error) {
+	var documents []entity.ConsentDocument
+	err := r.getDB(ctx).
+		Where("status = ?", entity.ConsentDocumentStatusPublished).
+		Where(`tenant_id IS NULL
+			OR EXISTS (
+				SELECT 1 FROM user_tenant_registrations utr
+				WHERE utr.user_id = ? AND utr.tenant_id = consent_documents.tenant_id
+					AND utr.status = ? AND utr.deleted_at IS NULL
+					AND (consent_documents.application_id IS NULL
+						OR utr.metadata->>'product_id' = consent_documents.application_id::text)
+			)`, userID, entity.UTRStatusActive).
+		Order("tenant_id NULLS FIRST, application_id NULLS FIRST, document_type").
+		Find(&documents).Error
+	if err != nil {
+		return nil, translateError(err, "consent document")
+	}
+	return documents, nil
+}
+
+// ListCurrentForTenant returns every published document, or only the platform
+// and tenant documents when a tenant is given.
+func (r *consentDocumentRepository) ListCurrentForTenant(ctx context.Context, tenantID *uuid.UUID) ([]entity.ConsentDocument, error) {
+	query := r.getDB(ctx).Where("status = ?", entity.ConsentDocumentStatusPublished)
+	if tenantID != nil {
+		query = query.Where("tenant_id IS NULL OR tenant_id = ?", *tenantID)
+	}
+
+	var documents []entity.ConsentDocument
+	err := query.
+		Order("tenant_id NULLS FIRST, application_id NULLS FIRST, document_type").
+		Find(&documents).Error
+	if err != nil {
+		return nil, translateError(err, "consent document")
+	}
+	return documents, nil
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// exportStaleAfter is how long an export may stay PROCESSING before another
+// worker assumes the one that claimed it died and picks it up again.
+const exportStaleAfter = 30 * time.Minute
+
+type dataSubjectRequestRepository struct {
+	baseRepository
+}
+
+func NewDataSubjectRequestRepository(db *gorm.DB) contract.DataSubjectRequestRepository {
+	return &dataSubjectRequestRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *dataSubjectRequestRepository) Create(ctx context.Context, request *entity.DataSubjectRequest) error {
+	if err := r.getDB(ctx).Create(request).Error; err != nil {
+		return translateError(err, "data subject request")
+	}
+	return nil
+}
+
+func (r *dataSubjectRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.DataSubjectRequest, error) {
+	var request entity.DataSubjectRequest
+	if err := r.getDB(ctx).Where("id = ?", id).First(&request).Error; err != nil {
+		return nil, translateError(err, "data subject request")
+	}
+	return &request, nil
+}
+
+func (r *dataSubjectRequestRepository) GetOpenByUserAndType(ctx context.Context, userID uuid.UUID, requestType entity.DataSubjectRequestType) (*entity.DataSubjectRequest, error) {
+	var request entity.DataSubjectRequest
+	err := r.getDB(ctx).
+		Where("user_id = ? AND type = ? AND status IN ?", userID, requestType, []entity.DataSubjectRequestStatus{
+			entity.DSRStatusPending, entity.DSRStatusProcessing, entity.DSRStatusApproved,
+		}).
+		First(&request).Error
+	if err != nil {
+		return nil, translateError(err, "data subject request")
+	}
+	return &request, nil
+}
+
+func (r *dataSubjectRequestRepository) List(ctx context.Context, filter *contract.DataSubjectRequestListFilter) ([]*entity.DataSubjectRequest, int64, error) {
+	var requests []*entity.DataSubjectRequest
+	var total int64
+
+	query := r.getDB(ctx).Model(&entity.DataSubjectRequest{})
+
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+
+	if filter.Type != nil {
+		query = query.Where("type = ?", string(*filter.Type))
+	}
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "data subject request")
+	}
+
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Find(&requests).Error
+	if err != nil {
+		return nil, 0, translateError(err, "data subject request")
+	}
+
+	return requests, total, nil
+}
+
+func (r *dataSubjectRequestRepository) Update(ctx context.Context, request *entity.DataSubjectRequest) error {
+	if err := r.getDB(ctx).Save(request).Error; err != nil {
+		return translateError(err, "data subject request")
+	}
+	return nil
+}
+
+// ClaimPendingExports marks up to limit queued exports as PROCESSING and
+// returns them. Rows locked by another worker are skipped.
+func (r *dataSubjectRequestRepository) ClaimPendingExports(ctx context.Context, limit int) ([]*entity.DataSubjectRequest, error) {
+	var requests []*entity.DataSubjectRequest
+
+	err := r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("type = ?", entity.DataSubjectRequestExport).
+			Where("status = ? OR (status = ? AND updated_at < ?)",
+				entity.DSRStatusPending, entity.DSRStatusProcessing, time.Now().Add(-exportStaleAfter)).
+			Order("created_at ASC").
+			Limit(limit).
+			Find(&requests).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, request := range requests {
+			request.Status = entity.DSRStatusProcessing
+			request.Attempts++
+			request.UpdatedAt = now
+			if err := tx.Save(request).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, "data subject request")
+	}
+	return requests, nil
+}
+
+func (r *dataSubjectRequestRepository) ListDueErasures(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error) {
+	var requests []*entity.DataSubjectRequest
+	err := r.getDB(ctx).
+		Where("type = ? AND status = ? AND scheduled_for <= ?", entity.DataSubjectRequestErasure, entity.DSRStatusApproved, now).
+		Order("scheduled_for ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, translateError(err, "data subject request")
+	}
+	return requests, nil
+}
+
+func (r *dataSubjectRequestRepository) ListExpiredExports(ctx context.Context, now time.Time, limit int) ([]*entity.DataSubjectRequest, error) {
+	var requests []*entity.DataSubjectRequest
+	err := r.getDB(ctx).
+		Where("type = ? AND status = ? AND object_key IS NOT NULL AND expires_at <= ?", entity.DataSubjectRequestExport, entity.DSRStatusCompleted, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&requests).Error
+	if err != nil {
+		return nil, translateError(err, "data subject request")
+	}
+	return requests, nil
+}
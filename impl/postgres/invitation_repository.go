@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/invitation/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type invitationRepository struct {
+	baseRepository
+}
+
+func NewInvitationRepository(db *gorm.DB) contract.InvitationRepository {
+	return &invitationRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *invitationRepository) Create(ctx context.Context, invitation *entity.Invitation) error {
+	if err := r.getDB(ctx).Create(invitation).Error; err != nil {
+		return translateError(err, "invitation")
+	}
+	return nil
+}
+
+func (r *invitationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Invitation, error) {
+	var invitation entity.Invitation
+	err := r.getDB(ctx).Where("id = ?", id).First(&invitation).Error
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return &invitation, nil
+}
+
+func (r *invitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.Invitation, error) {
+	var invitation entity.Invitation
+	err := r.getDB(ctx).Where("token_hash = ?", tokenHash).First(&invitation).Error
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return &invitation, nil
+}
+
+func (r *invitationRepository) GetPendingByEmail(ctx context.Context, tenantID uuid.UUID, email string) (*entity.Invitation, error) {
+	var invitation entity.Invitation
+	err := r.getDB(ctx).
+		Where("tenant_id = ? AND LOWER(email) = LOWER(?) AND status = ?", tenantID, email, entity.InvitationStatusPending).
+		First(&invitation).Error
+	if err != nil {
+		return nil, translateError(err, "invitation")
+	}
+	return &invitation, nil
+}
+
+func (r *invitationRepository) List(ctx context.Context, filter *contract.InvitationListFilter) ([]*entity.Invitation, int64, error) {
+	var invitations []*entity.Invitation
+	var total int64
+
+	query := r.getDB(ctx).Model(&entity.Invitation{})
+
+	if filter.TenantID != nil {
+		query = query.Where("tenant_id = ?", *filter.TenantID)
+	}
+
+	// Pending invitations past their expiry are reported as expired even if
+	// the stored status has not caught up yet.
+	if filter.Status != nil {
+		switch *filter.Status {
+		case entity.InvitationStatusPending:
+			query = query.Where("status = ? AND expires_at > NOW()", entity.InvitationStatusPending)
+		case entity.InvitationStatusExpired:
+			query = query.Where("status = ? OR (status = ? AND expires_at <= NOW())",
+				entity.InvitationStatusExpired, entity.InvitationStatusPending)
+		default:
+			query = query.Where("status = ?", string(*filter.Status))
+		}
+	}
+
+	if filter.Email != "" {
+		query = query.Where("email ILIKE ?", "%"+filter.Email+"%")
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "invitation")
+	}
+
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Find(&invitations).Error
+	if err != nil {
+		return nil, 0, translateError(err, "invitation")
+	}
+
+	return invitations, total, nil
+}
+
+func (r *invitationRepository) Update(ctx context.Context, invitation *entity.Invitation) error {
+	if err := r.getDB(ctx).Save(invitation).Error; err != nil {
+		return translateError(err, "invitation")
+	}
+	return nil
+}
@@ -94,6 +94,14 @@ func (r *participantRepository) List(ctx context.Context, filter *contract.Parti
 		query = query.Where("status = ?", *filter.Status)
 	}
 
+	if len(filter.BranchIDs) > 0 {
+		query = query.Where("branch_id IN ?", filter.BranchIDs)
+	}
+
+	if filter.OwnerID != nil {
+		query = query.Where("created_by = ? OR user_id = ?", *filter.OwnerID, *filter.OwnerID)
+	}
+
 	if filter.Search != "" {
 		search := "%" + escapeILIKE(filter.Search) + "%"
 		query = query.Where(
@@ -127,3 +135,52 @@ func (r *participantRepository) List(ctx context.Context, filter *contract.Parti
 
 	return participants, total, nil
 }
+
+func (r *participantRepository) GetByUserID(ctx context.Context, tenantID, applicationID, userID uuid.UUID) (*entity.Participant, error) {
+	var participant entity.Participant
+	err := r.getDB(ctx).
+		Where("tenant_id = ? AND application_id = ? AND user_id = ? AND deleted_at IS NULL", tenantID, applicationID, userID).
+		First(&participant).Error
+	if err != nil {
+		return nil, translateError(err, "participant")
+	}
+	return &participant, nil
+}
+
+// ListApprovedByIdentificationNumber returns approved participants whose KTP
+// or employee number matches, linked or not, so callers can detect ambiguity.
+func (r *participantRepository) ListApprovedByIdentificationNumber(ctx context.Context, tenantID uuid.UUID, applicationID *uuid.UUID, identificationNumber string) ([]entity.Participant, error) {
+	var participants []entity.Participant
+
+	query := r.getDB(ctx).
+		Where("tenant_id = ? AND status = ? AND deleted_at IS NULL", tenantID, entity.ParticipantStatusApproved).
+		Where("ktp_number = ? OR employee_number = ?", identificationNumber, identificationNumber)
+
+	if applicationID != nil {
+		query = query.Where("application_id = ?", *applicationID)
+	}
+
+	if err := query.Find(&participants).Error; err != nil {
+		return nil, translateError(err, "participant")
+	}
+	return participants, nil
+}
+
+// LinkUser sets the participant's user only while it is still unlinked, so two
+// registrations racing for the same record cannot both claim it.
+func (r *participantRepository) LinkUser(ctx context.Context, participantID, userID uuid.UUID) error {
+	result := r.getDB(ctx).Model(&entity.Participant{}).
+		Where("id = ? AND user_id IS NULL AND deleted_at IS NULL", participantID).
+		Updates(map[string]any{
+			"user_id":    userID,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": gorm.Expr("NOW()"),
+		})
+	if result.Error != nil {
+		return translateError(result.Error, "participant")
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrConflict("participant is already linked to a user")
+	}
+	return nil
+}
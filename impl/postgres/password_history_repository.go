@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type passwordHistoryRepository struct {
+	baseRepository
+}
+
+func NewPasswordHistoryRepository(db *gorm.DB) contract.PasswordHistoryRepository {
+	return &passwordHistoryRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *passwordHistoryRepository) Create(ctx context.Context, history *entity.PasswordHistory) error {
+	if err := r.getDB(ctx).Create(history).Error; err != nil {
+		return translateError(err, "password history")
+	}
+	return nil
+}
+
+func (r *passwordHistoryRepository) ListRecentHashes(ctx context.Context, userID uuid.UUID, limit int) ([]string, error) {
+	var hashes []string
+	err := r.getDB(ctx).
+		Model(&entity.PasswordHistory{}).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(limit).
+		Pluck("password_hash", &hashes).Error
+	if err != nil {
+		return nil, translateError(err, "password history")
+	}
+	return hashes, nil
+}
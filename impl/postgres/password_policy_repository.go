@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/passwordpolicy/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type passwordPolicyRepository struct {
+	baseRepository
+}
+
+func NewPasswordPolicyRepository(db *gorm.DB) contract.PasswordPolicyRepository {
+	return &passwordPolicyRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *passwordPolicyRepository) Create(ctx context.Context, policy *entity.PasswordPolicy) error {
+	if err := r.getDB(ctx).Create(policy).Error; err != nil {
+		return translateError(err, "password policy")
+	}
+	return nil
+}
+
+func (r *passwordPolicyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.PasswordPolicy, error) {
+	var policy entity.PasswordPolicy
+	err := r.getDB(ctx).Where("id = ?", id).First(&policy).Error
+	if err != nil {
+		return nil, translateError(err, "password policy")
+	}
+	return &policy, nil
+}
+
+func (r *passwordPolicyRepository) GetByScope(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error) {
+	var policy entity.PasswordPolicy
+	err := r.getDB(ctx).
+		Where("tenant_id IS NOT DISTINCT FROM ? AND application_id IS NOT DISTINCT FROM ?", tenantID, productID).
+		First(&policy).Error
+	if err != nil {
+		return nil, translateError(err, "password policy")
+	}
+	return &policy, nil
+}
+
+// Resolve returns the most specific policy for the scope:
+// tenant + product, then tenant, then the platform-wide default.
+func (r *passwordPolicyRepository) Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error) {
+	var policy entity.PasswordPolicy
+	err := r.getDB(ctx).
+		Where(`(tenant_id IS NULL AND application_id IS NULL)
+			OR (tenant_id = ? AND application_id IS NULL)
+			OR (tenant_id = ? AND application_id = ?)`, tenantID, tenantID, productID).
+		Order("application_id IS NULL, tenant_id IS NULL").
+		First(&policy).Error
+	if err != nil {
+		return nil, translateError(err, "password policy")
+	}
+	return &policy, nil
+}
+
+func (r *passwordPolicyRepository) List(ctx context.Context, tenantID *uuid.UUID) ([]*entity.PasswordPolicy, error) {
+	var policies []*entity.PasswordPolicy
+	query := r.getDB(ctx)
+	if tenantID != nil {
+		query = query.Where("tenant_id = ?", *tenantID)
+	}
+	err := query.
+		Order("tenant_id NULLS FIRST, application_id NULLS FIRST").
+		Find(&policies).Error
+	if err != nil {
+		return nil, translateError(err, "password policies")
+	}
+	return policies, nil
+}
+
+func (r *passwordPolicyRepository) Update(ctx context.Context, policy *entity.PasswordPolicy) error {
+	if err := r.getDB(ctx).Save(policy).Error; err != nil {
+		return translateError(err, "password policy")
+	}
+	return nil
+}
+
+func (r *passwordPolicyRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.getDB(ctx).Where("id = ?", id).Delete(&entity.PasswordPolicy{}).Error; err != nil {
+		return translateError(err, "password policy")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"gorm.io/gorm"
+)
+
+type permissionCheckRepository struct {
+	baseRepository
+}
+
+func NewPermissionCheckRepository(db *gorm.DB) *permissionCheckRepository {
+	return &permissionCheckRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *permissionCheckRepository) CreateBatch(ctx context.Context, checks []*entity.PermissionCheck) error {
+	if len(checks) == 0 {
+		return nil
+	}
+	if err := r.getDB(ctx).Create(&checks).Error; err != nil {
+		return translateError(err, "permission checks")
+	}
+	return nil
+}
@@ -2,6 +2,10 @@ package postgres
 
 import (
 	"context"
+	"strings"
+
+	"iam-service/entity"
+	"iam-service/iam/permission/contract"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -17,6 +21,89 @@ func NewPermissionRepository(db *gorm.DB) *permissionRepository {
 	}
 }
 
+func (r *permissionRepository) Create(ctx context.Context, permission *entity.Permission) error {
+	if err := r.getDB(ctx).Create(permission).Error; err != nil {
+		return translateError(err, "permission")
+	}
+	return nil
+}
+
+func (r *permissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Permission, error) {
+	var permission entity.Permission
+	err := r.getDB(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&permission).Error
+	if err != nil {
+		return nil, translateError(err, "permission")
+	}
+	return &permission, nil
+}
+
+// GetByCode also returns soft deleted permissions, because codes remain
+// unique per product after deletion.
+func (r *permissionRepository) GetByCode(ctx context.Context, productID uuid.UUID, code string) (*entity.Permission, error) {
+	var permission entity.Permission
+	err := r.getDB(ctx).Where("application_id = ? AND code = ?", productID, code).First(&permission).Error
+	if err != nil {
+		return nil, translateError(err, "permission")
+	}
+	return &permission, nil
+}
+
+func (r *permissionRepository) Update(ctx context.Context, permission *entity.Permission) error {
+	if err := r.getDB(ctx).Save(permission).Error; err != nil {
+		return translateError(err, "permission")
+	}
+	return nil
+}
+
+func (r *permissionRepository) List(ctx context.Context, filter *contract.PermissionListFilter) ([]*entity.Permission, int64, error) {
+	query := r.getDB(ctx).Model(&entity.Permission{}).Where("deleted_at IS NULL")
+
+	if filter.ProductID != nil {
+		query = query.Where("application_id = ?", *filter.ProductID)
+	}
+	if filter.Module != "" {
+		query = query.Where("module = ?", filter.Module)
+	}
+	if filter.Resource != "" {
+		query = query.Where("resource_type = ?", filter.Resource)
+	}
+	if filter.ScopeLevel != "" {
+		query = query.Where("scope_level = ?", filter.ScopeLevel)
+	}
+	if filter.IsSystem != nil {
+		query = query.Where("is_system = ?", *filter.IsSystem)
+	}
+	if filter.Search != "" {
+		search := "%" + strings.ToLower(filter.Search) + "%"
+		query = query.Where("LOWER(code) LIKE ? OR LOWER(name) LIKE ?", search, search)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "permissions")
+	}
+
+	var permissions []*entity.Permission
+	offset := (filter.Page - 1) * filter.PerPage
+	if err := query.Order("code ASC").Offset(offset).Limit(filter.PerPage).Find(&permissions).Error; err != nil {
+		return nil, 0, translateError(err, "permissions")
+	}
+
+	return permissions, total, nil
+}
+
+func (r *permissionRepository) ListByProductID(ctx context.Context, productID uuid.UUID) ([]*entity.Permission, error) {
+	var permissions []*entity.Permission
+	err := r.getDB(ctx).
+		Where("application_id = ? AND deleted_at IS NULL", productID).
+		Order("code ASC").
+		Find(&permissions).Error
+	if err != nil {
+		return nil, translateError(err, "permissions")
+	}
+	return permissions, nil
+}
+
 func (r *permissionRepository) GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error) {
 	if len(roleIDs) == 0 {
 		return []string{}, nil
@@ -26,14 +113,27 @@ func (r *permissionRepository) GetCodesByRoleIDs(ctx context.Context, roleIDs []
 		Code string
 	}
 
+	// Permissions come from the given roles that are active and are inherited
+	// from every active ancestor of those roles.
 	var results []permissionResult
 	err := r.getDB(ctx).Raw(`
+		WITH RECURSIVE role_tree AS (
+			SELECT r.id, 0 AS depth
+			FROM roles r
+			WHERE r.id IN ? AND r.deleted_at IS NULL AND r.is_active
+			UNION
+			SELECT parent.id, rt.depth + 1
+			FROM role_tree rt
+			INNER JOIN roles child ON child.id = rt.id
+			INNER JOIN roles parent ON parent.id = child.parent_role_id
+			WHERE parent.deleted_at IS NULL AND parent.is_active AND rt.depth < ?
+		)
 		SELECT DISTINCT p.code
 		FROM role_permissions rp
 		INNER JOIN permissions p ON p.id = rp.permission_id
-		WHERE rp.role_id IN ? AND p.deleted_at IS NULL
+		WHERE rp.role_id IN (SELECT id FROM role_tree) AND p.deleted_at IS NULL
 		ORDER BY p.code
-	`, roleIDs).Scan(&results).Error
+	`, roleIDs, entity.MaxRoleHierarchyDepth).Scan(&results).Error
 
 	if err != nil {
 		return nil, translateError(err, "permissions")
@@ -46,3 +146,29 @@ func (r *permissionRepository) GetCodesByRoleIDs(ctx context.Context, roleIDs []
 
 	return permissions, nil
 }
+
+func (r *permissionRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]entity.Permission, error) {
+	if len(ids) == 0 {
+		return []entity.Permission{}, nil
+	}
+
+	var permissions []entity.Permission
+	err := r.getDB(ctx).Where("id IN ? AND deleted_at IS NULL", ids).Find(&permissions).Error
+	if err != nil {
+		return nil, translateError(err, "permissions")
+	}
+	return permissions, nil
+}
+
+func (r *permissionRepository) ListByRoleID(ctx context.Context, roleID uuid.UUID) ([]entity.Permission, error) {
+	var permissions []entity.Permission
+	err := r.getDB(ctx).
+		Joins("INNER JOIN role_permissions rp ON rp.permission_id = permissions.id").
+		Where("rp.role_id = ? AND permissions.deleted_at IS NULL", roleID).
+		Order("permissions.code ASC").
+		Find(&permissions).Error
+	if err != nil {
+		return nil, translateError(err, "permissions")
+	}
+	return permissions, nil
+}
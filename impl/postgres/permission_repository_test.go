@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermissionRepository_GetCodesByRoleIDs(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := NewPermissionRepository(gormDB)
+
+	tenantRoleID := uuid.New()
+	deactivatedBranchRoleID := uuid.New()
+
+	// A deactivated role held directly must be dropped in the base case, not
+	// only when it is reached as an ancestor.
+	mock.ExpectQuery(`SELECT r\.id, 0 AS depth\s+FROM roles r\s+WHERE r\.id IN \(\$1,\$2\) AND r\.deleted_at IS NULL AND r\.is_active\s+UNION`).
+		WithArgs(tenantRoleID, deactivatedBranchRoleID, entity.MaxRoleHierarchyDepth).
+		WillReturnRows(sqlmock.NewRows([]string{"code"}).AddRow("participant:read"))
+
+	codes, err := repo.GetCodesByRoleIDs(context.Background(), []uuid.UUID{tenantRoleID, deactivatedBranchRoleID})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"participant:read"}, codes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/auth/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type personalAccessTokenRepository struct {
+	baseRepository
+}
+
+func NewPersonalAccessTokenRepository(db *gorm.DB) contract.PersonalAccessTokenRepository {
+	return &personalAccessTokenRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *personalAccessTokenRepository) Create(ctx context.Context, token *entity.PersonalAccessToken) error {
+	if err := r.getDB(ctx).Create(token).Error; err != nil {
+		return translateError(err, "personal access token")
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.PersonalAccessToken, error) {
+	var token entity.PersonalAccessToken
+	err := r.getDB(ctx).Where("id = ?", id).First(&token).Error
+	if err != nil {
+		return nil, translateError(err, "personal access token")
+	}
+	return &token, nil
+}
+
+func (r *personalAccessTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*entity.PersonalAccessToken, error) {
+	var token entity.PersonalAccessToken
+	err := r.getDB(ctx).Where("token_hash = ?", tokenHash).First(&token).Error
+	if err != nil {
+		return nil, translateError(err, "personal access token")
+	}
+	return &token, nil
+}
+
+func (r *personalAccessTokenRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.PersonalAccessToken, error) {
+	var tokens []*entity.PersonalAccessToken
+	err := r.getDB(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&tokens).Error
+	if err != nil {
+		return nil, translateError(err, "personal access tokens")
+	}
+	return tokens, nil
+}
+
+func (r *personalAccessTokenRepository) Revoke(ctx context.Context, id uuid.UUID, reason string) error {
+	if err := r.getDB(ctx).
+		Model(&entity.PersonalAccessToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error; err != nil {
+		return translateError(err, "personal access token")
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepository) RevokeAllByUserID(ctx context.Context, userID uuid.UUID, reason string) error {
+	if err := r.getDB(ctx).
+		Model(&entity.PersonalAccessToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error; err != nil {
+		return translateError(err, "personal access tokens")
+	}
+	return nil
+}
+
+func (r *personalAccessTokenRepository) UpdateLastUsed(ctx context.Context, id uuid.UUID, ip string) error {
+	updates := map[string]interface{}{
+		"last_used_at": time.Now(),
+	}
+	if ip != "" {
+		updates["last_used_ip"] = ip
+	}
+	if err := r.getDB(ctx).
+		Model(&entity.PersonalAccessToken{}).
+		Where("id = ?", id).
+		Updates(updates).Error; err != nil {
+		return translateError(err, "personal access token")
+	}
+	return nil
+}
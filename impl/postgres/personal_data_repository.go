@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"iam-service/entity"
+	"iam-service/iam/datasubject/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// personalDataRepository reads and scrubs everything stored about a user
+// across the identity and participant tables, for data-subject requests.
+type personalDataRepository struct {
+	baseRepository
+}
+
+func NewPersonalDataRepository(db *gorm.DB) contract.PersonalDataRepository {
+	return &personalDataRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *personalDataRepository) Collect(ctx context.Context, userID uuid.UUID) (*entity.PersonalDataSnapshot, error) {
+	db := r.getDB(ctx)
+	snapshot := &entity.PersonalDataSnapshot{}
+
+	var user entity.User
+	if err := db.Where("id = ?", userID).First(&user).Error; err != nil {
+		return nil, translateError(err, "user")
+	}
+	snapshot.User = &user
+
+	var profile entity.UserProfile
+	if err := db.Where("user_id = ?", userID).First(&profile).Error; err == nil {
+		snapshot.Profile = &profile
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, translateError(err, "user profile")
+	}
+
+	var securityState entity.UserSecurityState
+	if err := db.Where("user_id = ?", userID).First(&securityState).Error; err == nil {
+		snapshot.SecurityState = &securityState
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, translateError(err, "user security state")
+	}
+
+	byUser := []struct {
+		dest  any
+		query string
+		order string
+		name  string
+	}{
+		{&snapshot.AuthMethods, "user_id = ?", "created_at ASC", "user auth method"},
+		{&snapshot.Sessions, "user_id = ?", "created_at DESC", "user session"},
+		{&snapshot.TenantRegistrations, "user_id = ? AND deleted_at IS NULL", "created_at ASC", "user tenant registration"},
+		{&snapshot.RoleAssignments, "user_id = ? AND deleted_at IS NULL", "created_at ASC", "user role"},
+		{&snapshot.Consents, "user_id = ?", "accepted_at ASC", "user consent"},
+		{&snapshot.AuditLogs, "user_id = ?", "created_at DESC", "admin audit log"},
+		{&snapshot.Participants, "user_id = ? AND deleted_at IS NULL", "created_at ASC", "participant"},
+	}
+	for _, q := range byUser {
+		if err := db.Where(q.query, userID).Order(q.order).Find(q.dest).Error; err != nil {
+			return nil, translateError(err, q.name)
+		}
+	}
+
+	if len(snapshot.Participants) == 0 {
+		return snapshot, nil
+	}
+
+	participantIDs := make([]uuid.UUID, 0, len(snapshot.Participants))
+	for _, participant := range snapshot.Participants {
+		participantIDs = append(participantIDs, participant.ID)
+	}
+
+	byParticipant := []struct {
+		dest any
+		name string
+	}{
+		{&snapshot.ParticipantIdentities, "participant identity"},
+		{&snapshot.ParticipantAddresses, "participant address"},
+		{&snapshot.ParticipantBankAccounts, "participant bank account"},
+		{&snapshot.ParticipantFamilyMembers, "participant family member"},
+		{&snapshot.ParticipantEmployments, "participant employment"},
+		{&snapshot.ParticipantBeneficiaries, "participant beneficiary"},
+	}
+	for _, q := range byParticipant {
+		err := db.Where("participant_id IN ? AND deleted_at IS NULL", participantIDs).
+			Order("created_at ASC").
+			Find(q.dest).Error
+		if err != nil {
+			return nil, translateError(err, q.name)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Anonymize replaces the user's personal data with placeholders and revokes
+// every credential and session. Rows are kept wherever other records point at
+// them, so audit logs and tenant data stay consistent. Consent records are
+// kept as evidence of what was accepted, without the client details.
+// Participant records belong to the tenant and are only unlinked from the
+// account.
+func (r *personalDataRepository) Anonymize(ctx context.Context, userID uuid.UUID, placeholderEmail string) error {
+	db := r.getDB(ctx)
+
+	statements := []struct {
+		sql  string
+		args []any
+	}{
+		{`UPDATE users SET email = ?, status = ?, status_changed_at = NOW(), deleted_at = COALESCE(deleted_at, NOW()),
+			version = version + 1, updated_at = NOW() WHERE id = ?`,
+			[]any{placeholderEmail, entity.UserStatusInactive, userID}},
+		{`UPDATE user_profiles SET first_name = 'Erased', last_name = 'User', phone_number = NULL, date_of_birth = NULL,
+			gender = NULL, marital_status = NULL, address = NULL, id_number = NULL, profile_picture_url = NULL, profile_picture_key = NULL,
+			metadata = '{}', updated_at = NOW() WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE user_auth_methods SET credential_data = '{}', is_active = FALSE, updated_at = NOW() WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE user_security_states SET last_login_ip = NULL, updated_at = NOW() WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE user_consents SET ip_address = NULL, user_agent = NULL WHERE user_id = ?`, []any{userID}},
+		{`DELETE FROM password_history WHERE user_id = ?`, []any{userID}},
+		{`DELETE FROM mfa_enrollments WHERE user_id = ?`, []any{userID}},
+		{`DELETE FROM recovery_codes WHERE user_id = ?`, []any{userID}},
+		{`DELETE FROM verification_challenges WHERE user_id = ?`, []any{userID}},
+		{`UPDATE refresh_tokens SET revoked_at = COALESCE(revoked_at, NOW()), revoked_reason = COALESCE(revoked_reason, 'account_erased'),
+			ip_address = NULL, user_agent = NULL WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE personal_access_tokens SET revoked_at = COALESCE(revoked_at, NOW()), revoked_reason = COALESCE(revoked_reason, 'account_erased'),
+			last_used_ip = NULL WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE user_sessions SET status = ?, revoked_at = COALESCE(revoked_at, NOW()), ip_address = '0.0.0.0',
+			user_agent = NULL, device_fingerprint = NULL, updated_at = NOW() WHERE user_id = ?`,
+			[]any{entity.UserSessionStatusRevoked, userID}},
+		{`UPDATE user_tenant_registrations SET identification_number = NULL, deleted_at = COALESCE(deleted_at, NOW()),
+			updated_at = NOW() WHERE user_id = ?`,
+			[]any{userID}},
+		{`UPDATE participants SET user_id = NULL, updated_at = NOW() WHERE user_id = ?`, []any{userID}},
+		{`UPDATE invitations SET email = ?, updated_at = NOW() WHERE accepted_user_id = ?`,
+			[]any{placeholderEmail, userID}},
+	}
+
+	for _, stmt := range statements {
+		if err := db.Exec(stmt.sql, stmt.args...).Error; err != nil {
+			return translateError(err, "personal data")
+		}
+	}
+	return nil
+}
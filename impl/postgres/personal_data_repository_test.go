@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"iam-service/entity"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPersonalDataRepository_Collect(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := NewPersonalDataRepository(gormDB)
+
+	userID := uuid.New()
+	documentID := uuid.New()
+	acceptedAt := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectQuery(`SELECT \* FROM "users" WHERE id = \$1`).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email"}).AddRow(userID, "subject@example.com"))
+	mock.ExpectQuery(`SELECT \* FROM "user_profiles" WHERE user_id = \$1`).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	mock.ExpectQuery(`SELECT \* FROM "user_security_states" WHERE user_id = \$1`).
+		WithArgs(userID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	for _, table := range []string{"user_auth_methods", "user_sessions", "user_tenant_registrations", "user_roles"} {
+		mock.ExpectQuery(`SELECT \* FROM "` + table + `" WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "user_consents" WHERE user_id = $1 ORDER BY accepted_at ASC`)).
+		WithArgs(userID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "document_id", "document_type", "version", "accepted_at", "ip_address", "user_agent"}).
+			AddRow(uuid.New(), userID, documentID, entity.ConsentDocumentTerms, "2.0", acceptedAt, "203.0.113.10", "Mozilla/5.0"))
+	for _, table := range []string{"admin_audit_logs", "participants"} {
+		mock.ExpectQuery(`SELECT \* FROM "` + table + `" WHERE user_id = \$1`).
+			WithArgs(userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	snapshot, err := repo.Collect(context.Background(), userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "subject@example.com", snapshot.User.Email)
+	assert.Nil(t, snapshot.Profile)
+	require.Len(t, snapshot.Consents, 1)
+	assert.Equal(t, documentID, snapshot.Consents[0].DocumentID)
+	assert.Equal(t, "2.0", snapshot.Consents[0].Version)
+	require.NotNil(t, snapshot.Consents[0].IPAddress)
+	assert.Equal(t, "203.0.113.10", *snapshot.Consents[0].IPAddress)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPersonalDataRepository_Anonymize(t *testing.T) {
+	gormDB, mock := setupMockDB(t)
+	repo := NewPersonalDataRepository(gormDB)
+
+	userID := uuid.New()
+	placeholder := "erased-" + userID.String() + "@erased.invalid"
+
+	mock.MatchExpectationsInOrder(false)
+	mock.ExpectExec(`UPDATE users SET email = \$1`).
+		WithArgs(placeholder, entity.UserStatusInactive, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE user_consents SET ip_address = NULL, user_agent = NULL WHERE user_id = $1`)).
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	for _, stmt := range []string{
+		`UPDATE user_profiles SET`,
+		`UPDATE user_auth_methods SET`,
+		`UPDATE user_security_states SET`,
+		`DELETE FROM password_history`,
+		`DELETE FROM mfa_enrollments`,
+		`DELETE FROM recovery_codes`,
+		`DELETE FROM verification_challenges`,
+		`UPDATE refresh_tokens SET`,
+		`UPDATE personal_access_tokens SET`,
+		`UPDATE user_tenant_registrations SET`,
+		`UPDATE participants SET`,
+	} {
+		mock.ExpectExec(regexp.QuoteMeta(stmt)).
+			WithArgs(userID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE user_sessions SET`)).
+		WithArgs(entity.UserSessionStatusRevoked, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE invitations SET`)).
+		WithArgs(placeholder, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.Anonymize(context.Background(), userID, placeholder)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type productRegistrationConfigRepository struct {
+	baseRepository
+}
+
+func NewProductRegistrationConfigRepository(db *gorm.DB) *productRegistrationConfigRepository {
+	return &productRegistrationConfigRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *productRegistrationConfigRepository) GetByProductAndType(ctx context.Context, productID uuid.UUID, registrationType string) (*entity.ProductRegistrationConfig, error) {
+	var config entity.ProductRegistrationConfig
+	err := r.getDB(ctx).
+		Where("application_id = ? AND registration_type = ?", productID, registrationType).
+		First(&config).Error
+	if err != nil {
+		return nil, translateError(err, "product registration config")
+	}
+	return &config, nil
+}
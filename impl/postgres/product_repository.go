@@ -25,6 +25,15 @@ func NewProductsByTenantRepository(db *gorm.DB) *productRepository {
 	}
 }
 
+func (r *productRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	var product entity.Product
+	err := r.getDB(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&product).Error
+	if err != nil {
+		return nil, translateError(err, "product")
+	}
+	return &product, nil
+}
+
 func (r *productRepository) GetByCodeAndTenant(ctx context.Context, tenantID uuid.UUID, code string) (*entity.Product, error) {
 	var product entity.Product
 	err := r.getDB(ctx).Where("tenant_id = ? AND code = ? AND is_active = ? AND deleted_at IS NULL",
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"iam-service/entity"
+	"iam-service/iam/roleassignment/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// roleAssignmentStaleAfter is how long an item may stay processing before
+// another worker assumes the one that claimed it died and picks it up again.
+const roleAssignmentStaleAfter = 10 * time.Minute
+
+const roleAssignmentInsertBatchSize = 500
+
+type roleAssignmentQueueRepository struct {
+	baseRepository
+}
+
+func NewRoleAssignmentQueueRepository(db *gorm.DB) contract.RoleAssignmentQueueRepository {
+	return &roleAssignmentQueueRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *roleAssignmentQueueRepository) CreateBatch(ctx context.Context, items []*entity.RoleAssignmentQueue) error {
+	if err := r.getDB(ctx).CreateInBatches(items, roleAssignmentInsertBatchSize).Error; err != nil {
+		return translateError(err, "role assignment queue")
+	}
+	return nil
+}
+
+// ClaimPending marks up to limit queued items as processing and returns them.
+// Rows locked by another worker or still backing off are skipped.
+func (r *roleAssignmentQueueRepository) ClaimPending(ctx context.Context, limit int) ([]*entity.RoleAssignmentQueue, error) {
+	var items []*entity.RoleAssignmentQueue
+
+	err := r.getDB(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND (next_attempt_at IS NULL OR next_attempt_at <= ?)) OR (status = ? AND processing_started_at < ?)",
+				entity.RoleAssignmentQueueStatusPending, time.Now(),
+				entity.RoleAssignmentQueueStatusProcessing, time.Now().Add(-roleAssignmentStaleAfter)).
+			Order("assigned_at ASC, batch_sequence ASC").
+			Limit(limit).
+			Find(&items).Error
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, item := range items {
+			item.Status = entity.RoleAssignmentQueueStatusProcessing
+			item.ProcessingStartedAt = &now
+			if err := tx.Save(item).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err, "role assignment queue")
+	}
+	return items, nil
+}
+
+func (r *roleAssignmentQueueRepository) Update(ctx context.Context, item *entity.RoleAssignmentQueue) error {
+	if err := r.getDB(ctx).Save(item).Error; err != nil {
+		return translateError(err, "role assignment queue")
+	}
+	return nil
+}
+
+func (r *roleAssignmentQueueRepository) GetBatchSummary(ctx context.Context, tenantID, batchID uuid.UUID) (*contract.RoleAssignmentBatchSummary, error) {
+	var first entity.RoleAssignmentQueue
+	err := r.getDB(ctx).
+		Where("batch_id = ? AND tenant_id = ?", batchID, tenantID).
+		Order("batch_sequence ASC").
+		First(&first).Error
+	if err != nil {
+		return nil, translateError(err, "role assignment batch")
+	}
+
+	var rows []struct {
+		Status entity.RoleAssignmentQueueStatus
+		Count  int64
+	}
+	err = r.getDB(ctx).Model(&entity.RoleAssignmentQueue{}).
+		Select("status, COUNT(*) AS count").
+		Where("batch_id = ?", batchID).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "role assignment batch")
+	}
+
+	summary := &contract.RoleAssignmentBatchSummary{
+		BatchID:    batchID,
+		RoleID:     first.RoleID,
+		AssignedBy: first.AssignedBy,
+		AssignedAt: first.AssignedAt,
+		Counts:     make(map[entity.RoleAssignmentQueueStatus]int64, len(rows)),
+	}
+	for _, row := range rows {
+		summary.Counts[row.Status] = row.Count
+	}
+	return summary, nil
+}
+
+func (r *roleAssignmentQueueRepository) ListFailedByBatchID(ctx context.Context, batchID uuid.UUID, limit int) ([]*entity.RoleAssignmentQueue, error) {
+	var items []*entity.RoleAssignmentQueue
+	err := r.getDB(ctx).
+		Where("batch_id = ? AND status = ?", batchID, entity.RoleAssignmentQueueStatusFailed).
+		Order("batch_sequence ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, translateError(err, "role assignment queue")
+	}
+	return items, nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"iam-service/entity"
+	"iam-service/pkg/errors"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
@@ -24,3 +26,36 @@ func (r *rolePermissionRepository) Create(ctx context.Context, rolePermission *e
 	}
 	return nil
 }
+
+func (r *rolePermissionRepository) Delete(ctx context.Context, roleID, permissionID uuid.UUID) error {
+	result := r.getDB(ctx).Where("role_id = ? AND permission_id = ?", roleID, permissionID).Delete(&entity.RolePermission{})
+	if result.Error != nil {
+		return translateError(result.Error, "role permission")
+	}
+	if result.RowsAffected == 0 {
+		return errors.ErrNotFound("role permission not found")
+	}
+	return nil
+}
+
+func (r *rolePermissionRepository) ListPermissionIDsByRoleID(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	var permissionIDs []uuid.UUID
+	err := r.getDB(ctx).Model(&entity.RolePermission{}).
+		Where("role_id = ?", roleID).
+		Pluck("permission_id", &permissionIDs).Error
+	if err != nil {
+		return nil, translateError(err, "role permissions")
+	}
+	return permissionIDs, nil
+}
+
+func (r *rolePermissionRepository) CountByPermissionID(ctx context.Context, permissionID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entity.RolePermission{}).
+		Where("permission_id = ?", permissionID).
+		Count(&count).Error
+	if err != nil {
+		return 0, translateError(err, "role permissions")
+	}
+	return count, nil
+}
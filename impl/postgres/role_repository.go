@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"strings"
 
 	"iam-service/entity"
+	"iam-service/iam/role/contract"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -28,7 +30,7 @@ func (r *roleRepository) Create(ctx context.Context, role *entity.Role) error {
 
 func (r *roleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Role, error) {
 	var role entity.Role
-	err := r.getDB(ctx).Where("id = ?", id).First(&role).Error
+	err := r.getDB(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&role).Error
 	if err != nil {
 		return nil, translateError(err, "role")
 	}
@@ -37,7 +39,7 @@ func (r *roleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Rol
 
 func (r *roleRepository) GetByName(ctx context.Context, tenantID uuid.UUID, name string) (*entity.Role, error) {
 	var role entity.Role
-	err := r.getDB(ctx).Where("tenant_id = ? AND name = ?", tenantID, name).First(&role).Error
+	err := r.getDB(ctx).Where("tenant_id = ? AND name = ? AND deleted_at IS NULL", tenantID, name).First(&role).Error
 	if err != nil {
 		return nil, translateError(err, "role")
 	}
@@ -55,7 +57,7 @@ func (r *roleRepository) GetByEmail(ctx context.Context, tenantID uuid.UUID, ema
 
 func (r *roleRepository) GetByCode(ctx context.Context, tenantID uuid.UUID, code string) (*entity.Role, error) {
 	var role entity.Role
-	err := r.getDB(ctx).Where("tenant_id = ? AND code = ?", tenantID, code).First(&role).Error
+	err := r.getDB(ctx).Where("tenant_id = ? AND code = ? AND deleted_at IS NULL", tenantID, code).First(&role).Error
 	if err != nil {
 		return nil, translateError(err, "role")
 	}
@@ -71,9 +73,131 @@ func (r *roleRepository) Update(ctx context.Context, role *entity.Role) error {
 
 func (r *roleRepository) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
 	var roles []*entity.Role
-	err := r.getDB(ctx).Where("id IN ? AND is_active = ?", ids, true).Find(&roles).Error
+	err := r.getDB(ctx).Where("id IN ? AND is_active = ? AND deleted_at IS NULL", ids, true).Find(&roles).Error
 	if err != nil {
 		return nil, translateError(err, "roles")
 	}
 	return roles, nil
 }
+
+// GetByIDsIncludingInactive is GetByIDs without the is_active filter, for
+// callers that need to report on deactivated roles.
+func (r *roleRepository) GetByIDsIncludingInactive(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	err := r.getDB(ctx).Where("id IN ? AND deleted_at IS NULL", ids).Find(&roles).Error
+	if err != nil {
+		return nil, translateError(err, "roles")
+	}
+	return roles, nil
+}
+
+func (r *roleRepository) List(ctx context.Context, filter *contract.RoleListFilter) ([]*entity.Role, int64, error) {
+	query := r.getDB(ctx).Model(&entity.Role{}).Where("deleted_at IS NULL")
+
+	if filter.TenantID != nil {
+		if filter.IncludeSystem {
+			query = query.Where("tenant_id = ? OR tenant_id IS NULL", *filter.TenantID)
+		} else {
+			query = query.Where("tenant_id = ?", *filter.TenantID)
+		}
+	}
+	if filter.ProductID != nil {
+		query = query.Where("product_id = ?", *filter.ProductID)
+	}
+	if filter.ScopeLevel != "" {
+		query = query.Where("scope_level = ?", filter.ScopeLevel)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+	if filter.Search != "" {
+		search := "%" + strings.ToLower(filter.Search) + "%"
+		query = query.Where("LOWER(code) LIKE ? OR LOWER(name) LIKE ?", search, search)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "roles")
+	}
+
+	var roles []*entity.Role
+	offset := (filter.Page - 1) * filter.PerPage
+	if err := query.Order("code ASC").Offset(offset).Limit(filter.PerPage).Find(&roles).Error; err != nil {
+		return nil, 0, translateError(err, "roles")
+	}
+
+	return roles, total, nil
+}
+
+// ListAncestors returns the role's ancestors ordered from the direct parent
+// upwards. Deleted ancestors end the chain.
+func (r *roleRepository) ListAncestors(ctx context.Context, id uuid.UUID) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	err := r.getDB(ctx).Raw(`
+		WITH RECURSIVE ancestors AS (
+			SELECT parent.id, 1 AS depth
+			FROM roles child
+			INNER JOIN roles parent ON parent.id = child.parent_role_id
+			WHERE child.id = ? AND parent.deleted_at IS NULL
+			UNION
+			SELECT parent.id, a.depth + 1
+			FROM ancestors a
+			INNER JOIN roles child ON child.id = a.id
+			INNER JOIN roles parent ON parent.id = child.parent_role_id
+			WHERE parent.deleted_at IS NULL AND a.depth <= ?
+		)
+		SELECT r.*
+		FROM ancestors a
+		INNER JOIN roles r ON r.id = a.id
+		ORDER BY a.depth
+	`, id, entity.MaxRoleHierarchyDepth).Scan(&roles).Error
+	if err != nil {
+		return nil, translateError(err, "roles")
+	}
+	return roles, nil
+}
+
+// SubtreeHeight returns how many levels of descendants hang below the role; 0
+// for a role without children.
+func (r *roleRepository) SubtreeHeight(ctx context.Context, id uuid.UUID) (int, error) {
+	var height int
+	err := r.getDB(ctx).Raw(`
+		WITH RECURSIVE descendants AS (
+			SELECT id, 1 AS depth
+			FROM roles
+			WHERE parent_role_id = ? AND deleted_at IS NULL
+			UNION
+			SELECT child.id, d.depth + 1
+			FROM descendants d
+			INNER JOIN roles child ON child.parent_role_id = d.id
+			WHERE child.deleted_at IS NULL AND d.depth <= ?
+		)
+		SELECT COALESCE(MAX(depth), 0) FROM descendants
+	`, id, entity.MaxRoleHierarchyDepth).Scan(&height).Error
+	if err != nil {
+		return 0, translateError(err, "roles")
+	}
+	return height, nil
+}
+
+// LockHierarchy serializes changes to the tenant's role hierarchy until the
+// surrounding transaction ends, so concurrent re-parenting cannot combine
+// into a cycle that neither change would create on its own.
+func (r *roleRepository) LockHierarchy(ctx context.Context, tenantID uuid.UUID) error {
+	err := r.getDB(ctx).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "role_hierarchy:"+tenantID.String()).Error
+	if err != nil {
+		return translateError(err, "roles")
+	}
+	return nil
+}
+
+func (r *roleRepository) CountChildren(ctx context.Context, id uuid.UUID) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entity.Role{}).
+		Where("parent_role_id = ? AND deleted_at IS NULL", id).
+		Count(&count).Error
+	if err != nil {
+		return 0, translateError(err, "roles")
+	}
+	return count, nil
+}
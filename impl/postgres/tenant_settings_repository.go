@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type tenantSettingsRepository struct {
+	baseRepository
+}
+
+func NewTenantSettingsRepository(db *gorm.DB) *tenantSettingsRepository {
+	return &tenantSettingsRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+func (r *tenantSettingsRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*entity.TenantSettings, error) {
+	var settings entity.TenantSettings
+	err := r.getDB(ctx).Where("tenant_id = ?", tenantID).First(&settings).Error
+	if err != nil {
+		return nil, translateError(err, "tenant settings")
+	}
+	return &settings, nil
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/iam/consent/contract"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type userConsentRepository struct {
+	baseRepository
+}
+
+func NewUserConsentRepository(db *gorm.DB) *userConsentRepository {
+	return &userConsentRepository{
+		baseRepository: baseRepository{db: db},
+	}
+}
+
+// Create records the acceptance. Accepting the same document again keeps the
+// original record.
+func (r *userConsentRepository) Create(ctx context.Context, consent *entity.UserConsent) error {
+	err := r.getDB(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "document_id"}},
+			DoNothing: true,
+		}).
+		Create(consent).Error
+	if err != nil {
+		return translateError(err, "user consent")
+	}
+	return nil
+}
+
+func (r *userConsentRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserConsent, error) {
+	var consents []entity.UserConsent
+	err := r.getDB(ctx).
+		Where("user_id = ?", userID).
+		Order("accepted_at DESC").
+		Find(&consents).Error
+	if err != nil {
+		return nil, translateError(err, "user consent")
+	}
+	return consents, nil
+}
+
+func (r *userConsentRepository) CountAudience(ctx context.Context, filter *contract.ConsentAudienceFilter) (int64, int64, error) {
+	var total, accepted int64
+	if err := r.audienceQuery(ctx, filter).Count(&total).Error; err != nil {
+		return 0, 0, translateError(err, "user consent")
+	}
+	if err := r.audienceQuery(ctx, filter).Where("uc.id IS NOT NULL").Count(&accepted).Error; err != nil {
+		return 0, 0, translateError(err, "user consent")
+	}
+	return total, accepted, nil
+}
+
+func (r *userConsentRepository) ListAudience(ctx context.Context, filter *contract.ConsentAudienceFilter) ([]contract.ConsentAudienceMember, int64, error) {
+	var members []contract.ConsentAudienceMember
+	var total int64
+
+	query := r.audienceQuery(ctx, filter)
+	if filter.Accepted != nil {
+		if *filter.Accepted {
+			query = query.Where("uc.id IS NOT NULL")
+		} else {
+			query = query.Where("uc.id IS NULL")
+		}
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "user consent")
+	}
+
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.
+		Select("u.id AS user_id, u.email AS email, uc.accepted_at AS accepted_at").
+		Order("u.email ASC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Scan(&members).Error
+	if err != nil {
+		return nil, 0, translateError(err, "user consent")
+	}
+
+	return members, total, nil
+}
+
+// audienceQuery selects the active users a document applies to, joined with
+// their acceptance of it. Tenant documents apply to the tenant's active
+// members, product documents to members registered for the product.
+func (r *userConsentRepository) audienceQuery(ctx context.Context, filter *contract.ConsentAudienceFilter) *gorm.DB {
+	document := filter.Document
+
+	query := r.getDB(ctx).Table("users u").
+		Joins("LEFT JOIN user_consents uc ON uc.user_id = u.id AND uc.document_id = ?", document.ID).
+		Where("u.deleted_at IS NULL AND u.status = ?", entity.UserStatusActive)
+
+	if document.TenantID != nil {
+		membership := `EXISTS (
+			SELECT 1 FROM user_tenant_registrations utr
+			WHERE utr.user_id = u.id AND utr.tenant_id = ? AND utr.status = ? AND utr.deleted_at IS NULL`
+		args := []any{*document.TenantID, entity.UTRStatusActive}
+		if document.ProductID != nil {
+			membership += ` AND utr.metadata->>'product_id' = ?`
+			args = append(args, document.ProductID.String())
+		}
+		query = query.Where(membership+")", args...)
+	}
+
+	if filter.TenantID != nil {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM user_tenant_registrations utr
+			WHERE utr.user_id = u.id AND utr.tenant_id = ? AND utr.status = ? AND utr.deleted_at IS NULL
+		)`, *filter.TenantID, entity.UTRStatusActive)
+	}
+
+	return query
+}
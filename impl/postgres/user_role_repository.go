@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"iam-service/entity"
+	"iam-service/iam/roleassignment/contract"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -44,3 +45,133 @@ func (r *userRoleRepository) ListActiveByUserID(ctx context.Context, userID uuid
 	}
 	return userRoles, nil
 }
+
+// ListByUserID returns every assignment of the user that has not been
+// revoked, including scheduled and expired ones.
+func (r *userRoleRepository) ListByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error) {
+	var userRoles []entity.UserRole
+
+	query := r.getDB(ctx).Where("user_id = ? AND deleted_at IS NULL", userID)
+	if productID != nil {
+		query = query.Where("product_id = ? OR product_id IS NULL", *productID)
+	}
+
+	if err := query.Order("effective_from ASC").Find(&userRoles).Error; err != nil {
+		return nil, translateError(err, "user roles")
+	}
+	return userRoles, nil
+}
+
+func (r *userRoleRepository) CountActiveByRoleID(ctx context.Context, roleID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.getDB(ctx).Model(&entity.UserRole{}).
+		Where("role_id = ? AND deleted_at IS NULL", roleID).
+		Where("effective_to IS NULL OR effective_to > ?", time.Now()).
+		Count(&count).Error
+	if err != nil {
+		return 0, translateError(err, "user roles")
+	}
+	return count, nil
+}
+
+// ListUserIDsInheritingRole returns the users with an unexpired assignment of
+// the role or of any role below it, all of which inherit its permissions.
+func (r *userRoleRepository) ListUserIDsInheritingRole(ctx context.Context, roleID uuid.UUID) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.getDB(ctx).Raw(`
+		WITH RECURSIVE role_tree AS (
+			SELECT id, 0 AS depth
+			FROM roles
+			WHERE id = ?
+			UNION
+			SELECT child.id, rt.depth + 1
+			FROM role_tree rt
+			INNER JOIN roles child ON child.parent_role_id = rt.id
+			WHERE child.deleted_at IS NULL AND rt.depth < ?
+		)
+		SELECT DISTINCT ur.user_id
+		FROM user_roles ur
+		WHERE ur.role_id IN (SELECT id FROM role_tree)
+			AND ur.deleted_at IS NULL
+			AND (ur.effective_to IS NULL OR ur.effective_to > ?)
+	`, roleID, entity.MaxRoleHierarchyDepth, time.Now()).Scan(&userIDs).Error
+	if err != nil {
+		return nil, translateError(err, "user roles")
+	}
+	return userIDs, nil
+}
+
+func (r *userRoleRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.UserRole, error) {
+	var userRole entity.UserRole
+	err := r.getDB(ctx).Where("id = ? AND deleted_at IS NULL", id).First(&userRole).Error
+	if err != nil {
+		return nil, translateError(err, "user role")
+	}
+	return &userRole, nil
+}
+
+func (r *userRoleRepository) Update(ctx context.Context, userRole *entity.UserRole) error {
+	if err := r.getDB(ctx).Save(userRole).Error; err != nil {
+		return translateError(err, "user role")
+	}
+	return nil
+}
+
+func (r *userRoleRepository) List(ctx context.Context, filter *contract.UserRoleListFilter) ([]entity.UserRole, int64, error) {
+	query := r.getDB(ctx).Model(&entity.UserRole{}).
+		Joins("INNER JOIN roles ON roles.id = user_roles.role_id").
+		Where("roles.tenant_id = ? AND user_roles.deleted_at IS NULL", filter.TenantID)
+
+	if filter.UserID != nil {
+		query = query.Where("user_roles.user_id = ?", *filter.UserID)
+	}
+	if filter.RoleID != nil {
+		query = query.Where("user_roles.role_id = ?", *filter.RoleID)
+	}
+	if filter.ProductID != nil {
+		query = query.Where("user_roles.product_id = ?", *filter.ProductID)
+	}
+	if filter.BranchID != nil {
+		query = query.Where("user_roles.branch_id = ?", *filter.BranchID)
+	}
+	if !filter.IncludeExpired {
+		query = query.Where("user_roles.effective_to IS NULL OR user_roles.effective_to > ?", time.Now())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "user roles")
+	}
+
+	var userRoles []entity.UserRole
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.Select("user_roles.*").
+		Order("user_roles.created_at DESC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Find(&userRoles).Error
+	if err != nil {
+		return nil, 0, translateError(err, "user roles")
+	}
+
+	return userRoles, total, nil
+}
+
+// HasOverlapping reports whether the user already holds the same role for the
+// same product and branch during any part of the given period.
+func (r *userRoleRepository) HasOverlapping(ctx context.Context, userRole *entity.UserRole) (bool, error) {
+	query := r.getDB(ctx).Model(&entity.UserRole{}).
+		Where("user_id = ? AND role_id = ? AND deleted_at IS NULL", userRole.UserID, userRole.RoleID).
+		Where("product_id IS NOT DISTINCT FROM ?", userRole.ProductID).
+		Where("branch_id IS NOT DISTINCT FROM ?", userRole.BranchID).
+		Where("effective_to IS NULL OR effective_to > ?", userRole.EffectiveFrom)
+	if userRole.EffectiveTo != nil {
+		query = query.Where("effective_from < ?", *userRole.EffectiveTo)
+	}
+
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, translateError(err, "user roles")
+	}
+	return count > 0, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"iam-service/entity"
-	"iam-service/iam/auth/contract"
+	"iam-service/iam/user/contract"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -42,3 +42,21 @@ func (r *userSecurityStateRepository) Update(ctx context.Context, securityState
 	}
 	return nil
 }
+
+// ForcePasswordChangeByTenant flags every user registered with the tenant and
+// returns how many users were newly flagged.
+func (r *userSecurityStateRepository) ForcePasswordChangeByTenant(ctx context.Context, tenantID uuid.UUID) (int64, error) {
+	registeredUsers := r.getDB(ctx).
+		Model(&entity.UserTenantRegistration{}).
+		Select("user_id").
+		Where("tenant_id = ? AND deleted_at IS NULL", tenantID)
+
+	result := r.getDB(ctx).
+		Model(&entity.UserSecurityState{}).
+		Where("user_id IN (?) AND force_password_change = ?", registeredUsers, false).
+		Update("force_password_change", true)
+	if result.Error != nil {
+		return 0, translateError(result.Error, "user security state")
+	}
+	return result.RowsAffected, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"iam-service/entity"
-	"iam-service/iam/auth/contract"
+	"iam-service/iam/tenantregistration/contract"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -14,7 +14,7 @@ type userTenantRegistrationRepository struct {
 	baseRepository
 }
 
-func NewUserTenantRegistrationRepository(db *gorm.DB) contract.UserTenantRegistrationRepository {
+func NewUserTenantRegistrationRepository(db *gorm.DB) *userTenantRegistrationRepository {
 	return &userTenantRegistrationRepository{
 		baseRepository: baseRepository{db: db},
 	}
@@ -30,3 +30,65 @@ func (r *userTenantRegistrationRepository) ListActiveByUserID(ctx context.Contex
 	}
 	return registrations, nil
 }
+
+func (r *userTenantRegistrationRepository) Create(ctx context.Context, registration *entity.UserTenantRegistration) error {
+	if err := r.getDB(ctx).Create(registration).Error; err != nil {
+		return translateError(err, "user tenant registration")
+	}
+	return nil
+}
+
+func (r *userTenantRegistrationRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.UserTenantRegistration, error) {
+	var registration entity.UserTenantRegistration
+	err := r.getDB(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&registration).Error
+	if err != nil {
+		return nil, translateError(err, "user tenant registration")
+	}
+	return &registration, nil
+}
+
+func (r *userTenantRegistrationRepository) List(ctx context.Context, filter *contract.UserTenantRegistrationListFilter) ([]entity.UserTenantRegistration, int64, error) {
+	var registrations []entity.UserTenantRegistration
+	var total int64
+
+	query := r.getDB(ctx).Model(&entity.UserTenantRegistration{}).
+		Where("tenant_id = ? AND deleted_at IS NULL", filter.TenantID)
+
+	if filter.Status != nil {
+		query = query.Where("status = ?", string(*filter.Status))
+	}
+
+	if filter.ProductID != nil {
+		query = query.Where("metadata->>'product_id' = ?", filter.ProductID.String())
+	}
+
+	if filter.RegistrationType != "" {
+		query = query.Where("registration_type = ?", filter.RegistrationType)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, translateError(err, "user tenant registration")
+	}
+
+	// Oldest first so the approval queue is worked in arrival order.
+	offset := (filter.Page - 1) * filter.PerPage
+	err := query.
+		Order("created_at ASC").
+		Offset(offset).
+		Limit(filter.PerPage).
+		Find(&registrations).Error
+	if err != nil {
+		return nil, 0, translateError(err, "user tenant registration")
+	}
+
+	return registrations, total, nil
+}
+
+func (r *userTenantRegistrationRepository) Update(ctx context.Context, registration *entity.UserTenantRegistration) error {
+	if err := r.getDB(ctx).Save(registration).Error; err != nil {
+		return translateError(err, "user tenant registration")
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"iam-service/config"
+	jwtpkg "iam-service/pkg/jwt"
+)
+
+// NewJWTKeyRing returns nil for HS256. With keys_dir set the ring is rotatable
+// and, on first start, seeded with the configured private key so tokens issued
+// before the ring existed stay valid; otherwise it wraps that single key.
+func NewJWTKeyRing(cfg config.JWTConfig) (*jwtpkg.KeyRing, error) {
+	if !jwtpkg.IsAsymmetric(cfg.SigningMethod) {
+		return nil, nil
+	}
+
+	opts := jwtKeyRingOptions(cfg)
+
+	if cfg.KeysDir == "" {
+		privateKey, err := jwtpkg.LoadPrivateKeyFromFile(cfg.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT private key: %w", err)
+		}
+		if algorithm, _ := jwtpkg.AlgorithmForKey(privateKey.Public()); algorithm != cfg.SigningMethod {
+			return nil, fmt.Errorf("JWT private key is not a %s key", cfg.SigningMethod)
+		}
+		store, err := jwtpkg.NewStaticKeyStore(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWT private key: %w", err)
+		}
+		return jwtpkg.NewKeyRing(store, opts)
+	}
+
+	if cfg.PrivateKeyPath != "" {
+		if privateKey, err := jwtpkg.LoadPrivateKeyFromFile(cfg.PrivateKeyPath); err == nil {
+			opts.Seed = privateKey
+		}
+	}
+
+	keyRing, err := jwtpkg.NewKeyRing(jwtpkg.NewFileKeyStore(cfg.KeysDir), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT key ring: %w", err)
+	}
+	return keyRing, nil
+}
+
+// NewJWTKeyRingWithStore builds a key ring over an externally managed store
+// such as Vault Transit.
+func NewJWTKeyRingWithStore(cfg config.JWTConfig, store jwtpkg.KeyStore) (*jwtpkg.KeyRing, error) {
+	keyRing, err := jwtpkg.NewKeyRing(store, jwtKeyRingOptions(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT key ring: %w", err)
+	}
+	return keyRing, nil
+}
+
+func jwtKeyRingOptions(cfg config.JWTConfig) jwtpkg.KeyRingOptions {
+	return jwtpkg.KeyRingOptions{
+		Algorithm:       cfg.SigningMethod,
+		RefreshInterval: cfg.KeyRefreshInterval,
+		Retention:       cfg.RefreshExpiry,
+	}
+}
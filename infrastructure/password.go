@@ -0,0 +1,15 @@
+package infrastructure
+
+import (
+	"iam-service/config"
+	"iam-service/pkg/password"
+)
+
+// NewBreachChecker loads the offline breached-password dataset. Without a
+// configured dataset screening is disabled.
+func NewBreachChecker(cfg config.PasswordConfig) (password.BreachChecker, error) {
+	if cfg.BreachDatasetPath == "" {
+		return password.NoopBreachChecker{}, nil
+	}
+	return password.LoadSHA1PrefixFile(cfg.BreachDatasetPath)
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_admin_api_keys_tenant_id;
+DROP INDEX IF EXISTS uq_admin_api_keys_key_hash;
+DROP TABLE IF EXISTS admin_api_keys;
@@ -0,0 +1,54 @@
+-- Append-only table like refresh_tokens: keys are created, used, and revoked once.
+-- NO updated_at, deleted_at, or version columns. Revocation sets revoked_at.
+
+CREATE TABLE IF NOT EXISTS admin_api_keys (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Owner
+    tenant_id            UUID NOT NULL,
+
+    -- Key Data
+    key_name             VARCHAR(100) NOT NULL,
+    key_hash             VARCHAR(64) NOT NULL,
+    key_prefix           VARCHAR(20) NOT NULL,
+    ip_whitelist         JSONB NOT NULL DEFAULT '[]',
+    is_active            BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Lifecycle
+    expires_at           TIMESTAMPTZ,
+    revoked_at           TIMESTAMPTZ,
+    revoked_by           UUID,
+    revoked_reason       VARCHAR(255),
+
+    -- Usage
+    last_used_at         TIMESTAMPTZ,
+    last_used_ip         INET,
+
+    -- Audit
+    created_by           UUID,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign Keys
+    CONSTRAINT fk_admin_api_keys_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT fk_admin_api_keys_created_by FOREIGN KEY (created_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_admin_api_keys_revoked_by FOREIGN KEY (revoked_by)
+        REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- Key lookup by hash (X-API-Key authentication, CRITICAL path)
+CREATE UNIQUE INDEX IF NOT EXISTS uq_admin_api_keys_key_hash
+    ON admin_api_keys(key_hash);
+
+-- FK index: list keys for a tenant (admin view)
+CREATE INDEX IF NOT EXISTS idx_admin_api_keys_tenant_id
+    ON admin_api_keys(tenant_id);
+
+COMMENT ON TABLE admin_api_keys IS 'Tenant-scoped API keys for machine-to-machine admin access. Secret is shown once on creation.';
+COMMENT ON COLUMN admin_api_keys.key_hash IS 'SHA-256 hex digest of the raw API key. Never stored in plaintext.';
+COMMENT ON COLUMN admin_api_keys.key_prefix IS 'Leading characters of the raw key, kept for identification in listings.';
+COMMENT ON COLUMN admin_api_keys.ip_whitelist IS 'JSON array of allowed IPs or CIDR ranges. Empty array = any IP.';
+COMMENT ON COLUMN admin_api_keys.revoked_at IS 'Set once when key is revoked. NULL = not revoked.';
+COMMENT ON COLUMN admin_api_keys.last_used_ip IS 'Client IP of the most recent authenticated request.';
@@ -0,0 +1,50 @@
+-- Append-only table like refresh_tokens: tokens are created, used, and revoked once.
+-- Scopes are a subset of the owner's tenant permissions at creation time and are
+-- intersected with the owner's current permissions on every request.
+
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Owner
+    user_id              UUID NOT NULL,
+    tenant_id            UUID NOT NULL,
+
+    -- Token Data
+    name                 VARCHAR(100) NOT NULL,
+    token_hash           VARCHAR(64) NOT NULL,
+    token_prefix         VARCHAR(20) NOT NULL,
+    scopes               JSONB NOT NULL DEFAULT '[]',
+
+    -- Lifecycle
+    expires_at           TIMESTAMPTZ NOT NULL,
+    revoked_at           TIMESTAMPTZ,
+    revoked_reason       VARCHAR(100),
+
+    -- Usage
+    last_used_at         TIMESTAMPTZ,
+    last_used_ip         INET,
+
+    -- Audit
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign Keys
+    CONSTRAINT fk_personal_access_tokens_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_personal_access_tokens_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE
+);
+
+-- Token lookup by hash (Bearer authentication, CRITICAL path)
+CREATE UNIQUE INDEX IF NOT EXISTS uq_personal_access_tokens_token_hash
+    ON personal_access_tokens(token_hash);
+
+-- FK index: list tokens owned by a user
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id
+    ON personal_access_tokens(user_id);
+
+COMMENT ON TABLE personal_access_tokens IS 'User-owned long-lived tokens for automation, restricted to an explicit permission subset in one tenant.';
+COMMENT ON COLUMN personal_access_tokens.token_hash IS 'SHA-256 hex digest of the raw token. Never stored in plaintext.';
+COMMENT ON COLUMN personal_access_tokens.token_prefix IS 'Leading characters of the raw token, kept for identification in listings.';
+COMMENT ON COLUMN personal_access_tokens.scopes IS 'JSON array of permission codes granted to the token.';
+COMMENT ON COLUMN personal_access_tokens.revoked_at IS 'Set once when token is revoked. NULL = not revoked.';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_admin_audit_logs_tenant_id;
+DROP INDEX IF EXISTS idx_admin_audit_logs_entity;
+DROP INDEX IF EXISTS idx_admin_audit_logs_user_id;
+DROP TABLE IF EXISTS admin_audit_logs;
@@ -0,0 +1,50 @@
+-- Append-only record of privileged actions taken by platform and tenant admins.
+-- Rows are never updated or deleted by the application.
+
+CREATE TABLE IF NOT EXISTS admin_audit_logs (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Scope
+    tenant_id            UUID,
+
+    -- Actor
+    user_id              UUID NOT NULL,
+
+    -- Action
+    action               VARCHAR(50) NOT NULL,
+    entity_type          VARCHAR(50) NOT NULL,
+    entity_id            UUID,
+    before_state         JSONB,
+    after_state          JSONB,
+
+    -- Request
+    ip_address           INET,
+    user_agent           TEXT,
+
+    -- Audit
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign Keys
+    CONSTRAINT fk_admin_audit_logs_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE SET NULL,
+    CONSTRAINT fk_admin_audit_logs_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE RESTRICT
+);
+
+-- FK index: actions taken by an admin, newest first
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_user_id
+    ON admin_audit_logs(user_id, created_at DESC);
+
+-- Actions targeting an entity (e.g. every impersonation of a user)
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_entity
+    ON admin_audit_logs(entity_type, entity_id, created_at DESC);
+
+-- FK index: tenant admin view
+CREATE INDEX IF NOT EXISTS idx_admin_audit_logs_tenant_id
+    ON admin_audit_logs(tenant_id)
+    WHERE tenant_id IS NOT NULL;
+
+COMMENT ON TABLE admin_audit_logs IS 'Append-only audit trail of privileged admin actions, including impersonation sessions.';
+COMMENT ON COLUMN admin_audit_logs.user_id IS 'The admin who performed the action (the real actor, never the impersonated user).';
+COMMENT ON COLUMN admin_audit_logs.after_state IS 'Action details, e.g. impersonation session ID, token expiry and reason.';
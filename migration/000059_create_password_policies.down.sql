@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_password_policies_updated_at ON password_policies;
+DROP TABLE IF EXISTS password_policies;
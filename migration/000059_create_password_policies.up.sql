@@ -0,0 +1,55 @@
+-- Password rules per scope. A row with no tenant is the platform-wide default,
+-- a tenant row overrides it and a tenant + application row overrides both.
+
+CREATE TABLE IF NOT EXISTS password_policies (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Scope
+    tenant_id            UUID,
+    application_id       UUID,
+
+    -- Composition Rules
+    min_length           INTEGER NOT NULL DEFAULT 8,
+    max_length           INTEGER NOT NULL DEFAULT 128,
+    require_uppercase    BOOLEAN NOT NULL DEFAULT TRUE,
+    require_lowercase    BOOLEAN NOT NULL DEFAULT TRUE,
+    require_number       BOOLEAN NOT NULL DEFAULT TRUE,
+    require_special      BOOLEAN NOT NULL DEFAULT TRUE,
+    banned_words         JSONB NOT NULL DEFAULT '[]',
+
+    -- Lifecycle Rules
+    max_age_days         INTEGER NOT NULL DEFAULT 0,
+    history_depth        INTEGER NOT NULL DEFAULT 5,
+
+    -- Audit
+    updated_by           UUID,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT fk_password_policies_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT fk_password_policies_application FOREIGN KEY (application_id)
+        REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT fk_password_policies_updated_by FOREIGN KEY (updated_by)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT uq_password_policies_scope UNIQUE NULLS NOT DISTINCT (tenant_id, application_id),
+    CONSTRAINT chk_password_policies_application_tenant CHECK (application_id IS NULL OR tenant_id IS NOT NULL),
+    CONSTRAINT chk_password_policies_length CHECK (min_length >= 8 AND max_length >= min_length AND max_length <= 256),
+    CONSTRAINT chk_password_policies_max_age CHECK (max_age_days >= 0),
+    CONSTRAINT chk_password_policies_history CHECK (history_depth BETWEEN 0 AND 24)
+);
+
+CREATE TRIGGER trg_password_policies_updated_at
+    BEFORE UPDATE ON password_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Comments
+COMMENT ON TABLE password_policies IS 'Configurable password policy per platform, tenant or tenant application';
+COMMENT ON COLUMN password_policies.tenant_id IS 'NULL for the platform-wide default policy';
+COMMENT ON COLUMN password_policies.application_id IS 'Reference to the product (applications table); NULL applies to the whole tenant';
+COMMENT ON COLUMN password_policies.banned_words IS 'JSON array of case-insensitive words a password must not contain';
+COMMENT ON COLUMN password_policies.max_age_days IS 'Days before a password must be changed - 0 disables expiry';
+COMMENT ON COLUMN password_policies.history_depth IS 'Number of previous passwords that cannot be reused - 0 disables the check';
@@ -0,0 +1,2 @@
+ALTER TABLE password_policies DROP CONSTRAINT IF EXISTS chk_password_policies_breach_check;
+ALTER TABLE password_policies DROP COLUMN IF EXISTS breach_check;
@@ -0,0 +1,10 @@
+-- Breached-password screening mode per policy. The dataset itself is a local
+-- file loaded at startup (PASSWORD_BREACH_DATASET_PATH); no external calls are made.
+
+ALTER TABLE password_policies
+    ADD COLUMN IF NOT EXISTS breach_check VARCHAR(10) NOT NULL DEFAULT 'BLOCK';
+
+ALTER TABLE password_policies
+    ADD CONSTRAINT chk_password_policies_breach_check CHECK (breach_check IN ('OFF', 'WARN', 'BLOCK'));
+
+COMMENT ON COLUMN password_policies.breach_check IS 'Breached password handling: OFF, WARN (accept and warn) or BLOCK (reject)';
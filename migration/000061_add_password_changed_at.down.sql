@@ -0,0 +1 @@
+ALTER TABLE user_security_states DROP COLUMN IF EXISTS password_changed_at;
@@ -0,0 +1,15 @@
+-- Password age tracking for max-age enforcement at login.
+
+ALTER TABLE user_security_states
+    ADD COLUMN IF NOT EXISTS password_changed_at TIMESTAMPTZ;
+
+-- Backfill from the current password credential so existing users are not
+-- all treated as expired (or as never expiring) after the upgrade.
+UPDATE user_security_states uss
+SET password_changed_at = uam.updated_at
+FROM user_auth_methods uam
+WHERE uam.user_id = uss.user_id
+  AND uam.method_type = 'PASSWORD'
+  AND uss.password_changed_at IS NULL;
+
+COMMENT ON COLUMN user_security_states.password_changed_at IS 'When the current password was set. Compared against password_policies.max_age_days at login (NULL = unknown, never expires).';
@@ -0,0 +1,12 @@
+UPDATE users SET registration_source = 'ADMIN' WHERE registration_source = 'INVITATION';
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_registration_source;
+ALTER TABLE users ADD CONSTRAINT chk_users_registration_source CHECK (registration_source IN (
+    'SELF',
+    'ADMIN',
+    'IMPORT',
+    'GOOGLE'
+));
+
+DROP INDEX IF EXISTS idx_invitations_tenant_created;
+DROP INDEX IF EXISTS idx_invitations_pending_email;
+DROP TABLE IF EXISTS invitations;
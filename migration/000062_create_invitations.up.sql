@@ -0,0 +1,76 @@
+-- Invitation-based onboarding. An admin invites an email address into a tenant
+-- with pre-assigned roles (and optionally a branch); the invitee accepts with
+-- the emailed token and sets their own password.
+
+CREATE TABLE IF NOT EXISTS invitations (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Scope
+    tenant_id            UUID NOT NULL,
+    branch_id            UUID,
+
+    -- Invitee
+    email                VARCHAR(255) NOT NULL,
+    role_ids             JSONB NOT NULL DEFAULT '[]',
+
+    -- Token (SHA-256 of the signed token; replaced on resend)
+    token_hash           VARCHAR(64) NOT NULL,
+
+    -- Lifecycle
+    status               VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    expires_at           TIMESTAMPTZ NOT NULL,
+    resend_count         INTEGER NOT NULL DEFAULT 0,
+    last_sent_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    accepted_at          TIMESTAMPTZ,
+    accepted_user_id     UUID,
+    revoked_at           TIMESTAMPTZ,
+    revoked_by           UUID,
+
+    -- Audit Fields
+    invited_by           UUID NOT NULL,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT fk_invitations_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitations_branch FOREIGN KEY (branch_id)
+        REFERENCES branches(id) ON DELETE SET NULL,
+    CONSTRAINT fk_invitations_accepted_user FOREIGN KEY (accepted_user_id)
+        REFERENCES users(id) ON DELETE SET NULL,
+    CONSTRAINT fk_invitations_invited_by FOREIGN KEY (invited_by)
+        REFERENCES users(id) ON DELETE RESTRICT,
+    CONSTRAINT uq_invitations_token_hash UNIQUE (token_hash),
+    CONSTRAINT chk_invitations_status CHECK (status IN ('PENDING', 'ACCEPTED', 'REVOKED', 'EXPIRED')),
+    CONSTRAINT chk_invitations_resend_count CHECK (resend_count >= 0)
+);
+
+CREATE TRIGGER trg_invitations_updated_at
+    BEFORE UPDATE ON invitations
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- At most one open invitation per email and tenant
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invitations_pending_email
+    ON invitations(tenant_id, LOWER(email))
+    WHERE status = 'PENDING';
+
+-- Admin listing per tenant, newest first
+CREATE INDEX IF NOT EXISTS idx_invitations_tenant_created
+    ON invitations(tenant_id, created_at DESC);
+
+-- Users created by accepting an invitation
+ALTER TABLE users DROP CONSTRAINT IF EXISTS chk_users_registration_source;
+ALTER TABLE users ADD CONSTRAINT chk_users_registration_source CHECK (registration_source IN (
+    'SELF',
+    'ADMIN',
+    'IMPORT',
+    'GOOGLE',
+    'INVITATION'
+));
+
+COMMENT ON TABLE invitations IS 'Pending and historical tenant invitations. Accepting one creates the user with the pre-assigned roles.';
+COMMENT ON COLUMN invitations.role_ids IS 'JSON array of role IDs granted on acceptance. Roles must be system roles or belong to tenant_id.';
+COMMENT ON COLUMN invitations.token_hash IS 'SHA-256 of the signed invitation token. Resending issues a new token and invalidates the previous one.';
+COMMENT ON COLUMN invitations.status IS 'PENDING until accepted or revoked. Pending invitations past expires_at are marked EXPIRED when next touched.';
@@ -0,0 +1,74 @@
+DO $$
+DECLARE
+    v_platform_tenant_id UUID;
+    v_iam_app_id UUID;
+    v_admin_role_id UUID;
+BEGIN
+
+    SELECT id INTO v_platform_tenant_id FROM tenants WHERE code = 'platform';
+
+    IF v_platform_tenant_id IS NULL THEN
+        RAISE NOTICE 'Platform tenant not found, skipping registration approval seed';
+        RETURN;
+    END IF;
+
+
+    SELECT id INTO v_iam_app_id
+    FROM applications
+    WHERE tenant_id = v_platform_tenant_id AND code = 'iam-admin';
+
+    IF v_iam_app_id IS NULL THEN
+        RAISE NOTICE 'IAM admin application not found, skipping registration approval seed';
+        RETURN;
+    END IF;
+
+
+    INSERT INTO permissions (application_id, code, name, resource_type, action, status) VALUES
+        (v_iam_app_id, 'registration:read',    'View Tenant Registrations',           'registration', 'read',    'ACTIVE'),
+        (v_iam_app_id, 'registration:approve', 'Approve or Reject Tenant Registrations', 'registration', 'approve', 'ACTIVE')
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE 'Ensured 2 registration permissions';
+
+
+    INSERT INTO roles (application_id, code, name, description, is_system, status)
+    VALUES (
+        v_iam_app_id,
+        'TENANT_PRODUCT_ADMIN',
+        'Tenant Product Admin',
+        'Can review and approve self-registrations into the tenant''s products',
+        TRUE,
+        'ACTIVE'
+    )
+    ON CONFLICT (application_id, code) DO NOTHING;
+
+    SELECT id INTO v_admin_role_id
+    FROM roles
+    WHERE application_id = v_iam_app_id AND code = 'TENANT_PRODUCT_ADMIN';
+
+    RAISE NOTICE 'Ensured TENANT_PRODUCT_ADMIN role with ID: %', v_admin_role_id;
+
+
+    IF v_admin_role_id IS NOT NULL THEN
+        INSERT INTO role_permissions (role_id, permission_id)
+        SELECT v_admin_role_id, p.id
+        FROM permissions p
+        WHERE p.application_id = v_iam_app_id
+          AND p.code IN ('registration:read', 'registration:approve')
+        ON CONFLICT DO NOTHING;
+
+        RAISE NOTICE 'Assigned 2 permissions to TENANT_PRODUCT_ADMIN role';
+    END IF;
+
+
+    INSERT INTO role_permissions (role_id, permission_id)
+    SELECT r.id, p.id
+    FROM roles r, permissions p
+    WHERE r.application_id = v_iam_app_id
+      AND r.code = 'PLATFORM_ADMIN'
+      AND p.application_id = v_iam_app_id
+      AND p.code LIKE 'registration:%'
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE '=== Registration approval role and permissions seeded successfully ===';
+END $$;
@@ -0,0 +1,42 @@
+DO $$
+DECLARE
+    v_platform_tenant_id UUID;
+    v_iam_app_id UUID;
+BEGIN
+
+    SELECT id INTO v_platform_tenant_id FROM tenants WHERE code = 'platform';
+
+    IF v_platform_tenant_id IS NULL THEN
+        RAISE NOTICE 'Platform tenant not found, skipping participant link seed';
+        RETURN;
+    END IF;
+
+
+    SELECT id INTO v_iam_app_id
+    FROM applications
+    WHERE tenant_id = v_platform_tenant_id AND code = 'iam-admin';
+
+    IF v_iam_app_id IS NULL THEN
+        RAISE NOTICE 'IAM admin application not found, skipping participant link seed';
+        RETURN;
+    END IF;
+
+
+    -- Manual linking resolves registrations whose identification number
+    -- matched ambiguously or matched an already linked participant.
+    INSERT INTO permissions (application_id, code, name, resource_type, action, status) VALUES
+        (v_iam_app_id, 'participant:link', 'Link Participant to User', 'participant', 'link', 'ACTIVE')
+    ON CONFLICT DO NOTHING;
+
+
+    INSERT INTO role_permissions (role_id, permission_id)
+    SELECT r.id, p.id
+    FROM roles r, permissions p
+    WHERE r.application_id = v_iam_app_id
+      AND r.code IN ('PLATFORM_ADMIN', 'PARTICIPANT_APPROVER')
+      AND p.application_id = v_iam_app_id
+      AND p.code = 'participant:link'
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE '=== Participant link permission seeded successfully ===';
+END $$;
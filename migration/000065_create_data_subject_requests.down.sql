@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_dsr_user_created;
+DROP INDEX IF EXISTS idx_dsr_work_queue;
+DROP INDEX IF EXISTS idx_dsr_open_per_user;
+DROP TABLE IF EXISTS data_subject_requests;
@@ -0,0 +1,60 @@
+-- Data-subject requests under the PDP law. Exports are generated
+-- asynchronously into object storage; erasures are reviewed by an admin and
+-- carried out once the grace period has passed.
+
+CREATE TABLE IF NOT EXISTS data_subject_requests (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Subject
+    user_id              UUID NOT NULL,
+    type                 VARCHAR(20) NOT NULL,
+    reason               TEXT,
+
+    -- Lifecycle
+    status               VARCHAR(20) NOT NULL,
+    object_key           VARCHAR(500),
+    expires_at           TIMESTAMPTZ,
+    scheduled_for        TIMESTAMPTZ,
+    reviewed_by          UUID,
+    reviewed_at          TIMESTAMPTZ,
+    review_note          TEXT,
+    attempts             INTEGER NOT NULL DEFAULT 0,
+    failure_reason       TEXT,
+    completed_at         TIMESTAMPTZ,
+
+    -- Audit Fields
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    -- No FK to users: the request outlives the erased account as its record.
+    CONSTRAINT chk_dsr_type CHECK (type IN ('EXPORT', 'ERASURE')),
+    CONSTRAINT chk_dsr_status CHECK (status IN (
+        'PENDING', 'PROCESSING', 'APPROVED', 'REJECTED', 'CANCELLED', 'COMPLETED', 'FAILED'
+    )),
+    CONSTRAINT chk_dsr_attempts CHECK (attempts >= 0)
+);
+
+CREATE TRIGGER trg_data_subject_requests_updated_at
+    BEFORE UPDATE ON data_subject_requests
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- At most one open request of each type per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dsr_open_per_user
+    ON data_subject_requests(user_id, type)
+    WHERE status IN ('PENDING', 'PROCESSING', 'APPROVED');
+
+-- Worker pickup of queued exports and due erasures
+CREATE INDEX IF NOT EXISTS idx_dsr_work_queue
+    ON data_subject_requests(type, status, scheduled_for);
+
+-- User and admin listings, newest first
+CREATE INDEX IF NOT EXISTS idx_dsr_user_created
+    ON data_subject_requests(user_id, created_at DESC);
+
+COMMENT ON TABLE data_subject_requests IS 'PDP access (export) and erasure requests and their review trail.';
+COMMENT ON COLUMN data_subject_requests.object_key IS 'Object storage key of the generated export archive.';
+COMMENT ON COLUMN data_subject_requests.expires_at IS 'Until when the export archive can be downloaded.';
+COMMENT ON COLUMN data_subject_requests.scheduled_for IS 'When an approved erasure is carried out. The user can cancel until then.';
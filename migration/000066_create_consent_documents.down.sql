@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_user_consents_document;
+DROP TABLE IF EXISTS user_consents;
+
+DROP INDEX IF EXISTS idx_consent_documents_published;
+DROP INDEX IF EXISTS idx_consent_documents_version;
+DROP TABLE IF EXISTS consent_documents;
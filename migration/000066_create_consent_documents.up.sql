@@ -0,0 +1,98 @@
+-- Versioned terms and privacy documents, and the record of which version each
+-- user accepted. Documents are scoped platform-wide (no tenant), to a tenant,
+-- or to a tenant's product.
+
+CREATE TABLE IF NOT EXISTS consent_documents (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Scope
+    tenant_id            UUID,
+    application_id       UUID,
+
+    -- Document
+    document_type        VARCHAR(30) NOT NULL,
+    version              VARCHAR(50) NOT NULL,
+    title                VARCHAR(255) NOT NULL,
+    content_url          VARCHAR(1000) NOT NULL,
+    summary              TEXT,
+    is_required          BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Lifecycle
+    status               VARCHAR(20) NOT NULL DEFAULT 'DRAFT',
+    published_at         TIMESTAMPTZ,
+    published_by         UUID,
+
+    -- Audit Fields
+    created_by           UUID NOT NULL,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT fk_consent_documents_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT fk_consent_documents_application FOREIGN KEY (application_id)
+        REFERENCES applications(id) ON DELETE CASCADE,
+    CONSTRAINT chk_consent_documents_type CHECK (document_type IN ('TERMS_OF_SERVICE', 'PRIVACY_POLICY')),
+    CONSTRAINT chk_consent_documents_status CHECK (status IN ('DRAFT', 'PUBLISHED', 'SUPERSEDED')),
+    CONSTRAINT chk_consent_documents_scope CHECK (application_id IS NULL OR tenant_id IS NOT NULL)
+);
+
+CREATE TRIGGER trg_consent_documents_updated_at
+    BEFORE UPDATE ON consent_documents
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Version labels are unique within a scope and type
+CREATE UNIQUE INDEX IF NOT EXISTS idx_consent_documents_version
+    ON consent_documents(
+        COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'),
+        COALESCE(application_id, '00000000-0000-0000-0000-000000000000'),
+        document_type,
+        version
+    );
+
+-- Only one published version per scope and type
+CREATE UNIQUE INDEX IF NOT EXISTS idx_consent_documents_published
+    ON consent_documents(
+        COALESCE(tenant_id, '00000000-0000-0000-0000-000000000000'),
+        COALESCE(application_id, '00000000-0000-0000-0000-000000000000'),
+        document_type
+    )
+    WHERE status = 'PUBLISHED';
+
+CREATE TABLE IF NOT EXISTS user_consents (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    user_id              UUID NOT NULL,
+    document_id          UUID NOT NULL,
+
+    -- Copied from the document at acceptance
+    tenant_id            UUID,
+    application_id       UUID,
+    document_type        VARCHAR(30) NOT NULL,
+    version              VARCHAR(50) NOT NULL,
+
+    -- Evidence
+    accepted_at          TIMESTAMPTZ NOT NULL,
+    ip_address           INET,
+    user_agent           TEXT,
+
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Constraints
+    CONSTRAINT fk_user_consents_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_user_consents_document FOREIGN KEY (document_id)
+        REFERENCES consent_documents(id) ON DELETE RESTRICT,
+    CONSTRAINT uq_user_consents_user_document UNIQUE (user_id, document_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_consents_document
+    ON user_consents(document_id, accepted_at DESC);
+
+COMMENT ON TABLE consent_documents IS 'Versioned terms of service and privacy policy documents. Publishing a version supersedes the previous one in the same scope.';
+COMMENT ON COLUMN consent_documents.tenant_id IS 'NULL for platform-wide documents that every user must accept.';
+COMMENT ON COLUMN consent_documents.application_id IS 'Product the document applies to. Only users registered for the product must accept it.';
+COMMENT ON TABLE user_consents IS 'Which document version each user accepted, when and from where. Rows are never updated.';
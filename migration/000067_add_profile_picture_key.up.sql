@@ -0,0 +1,7 @@
+-- Uploaded avatars are stored in object storage. The profile keeps the object
+-- prefix; readers are handed short-lived presigned URLs per size.
+
+ALTER TABLE user_profiles
+    ADD COLUMN IF NOT EXISTS profile_picture_key VARCHAR(255);
+
+COMMENT ON COLUMN user_profiles.profile_picture_key IS 'Object storage prefix of the uploaded avatar (users/{user_id}/avatar/{version}); each size is stored as {prefix}/{size}.jpg. Takes precedence over profile_picture_url.';
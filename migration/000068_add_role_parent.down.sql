@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_roles_parent;
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS chk_roles_no_self_parent;
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS fk_roles_parent;
+ALTER TABLE roles DROP COLUMN IF EXISTS parent_role_id;
@@ -0,0 +1,19 @@
+-- Roles inherit the permissions of their ancestors through parent_role_id.
+-- Cycles are rejected by the application; the check below only guards the
+-- trivial self-reference.
+
+ALTER TABLE roles
+    ADD COLUMN IF NOT EXISTS parent_role_id UUID;
+
+ALTER TABLE roles
+    ADD CONSTRAINT fk_roles_parent FOREIGN KEY (parent_role_id)
+        REFERENCES roles(id) ON DELETE SET NULL;
+
+ALTER TABLE roles
+    ADD CONSTRAINT chk_roles_no_self_parent CHECK (id != parent_role_id);
+
+CREATE INDEX IF NOT EXISTS idx_roles_parent
+    ON roles(parent_role_id)
+    WHERE deleted_at IS NULL AND parent_role_id IS NOT NULL;
+
+COMMENT ON COLUMN roles.parent_role_id IS 'Parent role whose permissions are inherited. NULL = root role.';
@@ -0,0 +1,55 @@
+-- Queued role assignments. Bulk grants are enqueued as one batch and applied
+-- by a background worker, which retries transient failures and records the
+-- reason for assignments it gives up on.
+
+CREATE TABLE IF NOT EXISTS role_assignments_queue (
+    -- Primary Key
+    queue_id              UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Assignment
+    user_id               UUID NOT NULL,
+    tenant_id             UUID NOT NULL,
+    role_id               UUID NOT NULL,
+    product_id            UUID,
+    branch_id             UUID,
+    effective_from        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    effective_to          TIMESTAMPTZ,
+
+    -- Lifecycle
+    status                VARCHAR(20) NOT NULL DEFAULT 'pending',
+    assigned_by           UUID NOT NULL,
+    assigned_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processing_started_at TIMESTAMPTZ,
+    processed_at          TIMESTAMPTZ,
+    failure_reason        TEXT,
+    retry_count           INTEGER NOT NULL DEFAULT 0,
+    user_role_id          UUID,
+
+    -- Batch Tracking
+    batch_id              UUID,
+    batch_total           INTEGER,
+    batch_sequence        INTEGER,
+
+    metadata              JSONB NOT NULL DEFAULT '{}',
+
+    -- Constraints
+    CONSTRAINT fk_raq_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT chk_raq_status CHECK (status IN ('pending', 'processing', 'completed', 'failed', 'cancelled')),
+    CONSTRAINT chk_raq_retry_count CHECK (retry_count >= 0),
+    CONSTRAINT chk_raq_effective_period CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+-- Worker pickup, oldest first
+CREATE INDEX IF NOT EXISTS idx_raq_work_queue
+    ON role_assignments_queue(status, assigned_at)
+    WHERE status IN ('pending', 'processing');
+
+-- Batch status lookups
+CREATE INDEX IF NOT EXISTS idx_raq_batch
+    ON role_assignments_queue(batch_id, status)
+    WHERE batch_id IS NOT NULL;
+
+COMMENT ON TABLE role_assignments_queue IS 'Role assignments waiting to be applied by the background worker, grouped into batches for bulk grants.';
+COMMENT ON COLUMN role_assignments_queue.retry_count IS 'Transient failures so far. The item is failed for good once the worker limit is reached.';
+COMMENT ON COLUMN role_assignments_queue.user_role_id IS 'The user_roles row created when the assignment completed.';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_participants_branch;
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS fk_participants_branch;
+ALTER TABLE participants DROP COLUMN IF EXISTS branch_id;
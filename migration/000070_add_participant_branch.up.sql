@@ -0,0 +1,15 @@
+-- Participants can belong to a branch so that branch-scoped roles only see
+-- the participants of their own branches. NULL = not tied to a branch.
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS branch_id UUID;
+
+ALTER TABLE participants
+    ADD CONSTRAINT fk_participants_branch FOREIGN KEY (branch_id)
+        REFERENCES branches(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_participants_branch
+    ON participants(tenant_id, branch_id)
+    WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN participants.branch_id IS 'Branch the participant belongs to, used for branch-scoped access. NULL = tenant level.';
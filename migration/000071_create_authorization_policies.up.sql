@@ -0,0 +1,54 @@
+-- Tenant defined attribute based policies. A policy applies to one permission
+-- code and, optionally, one resource type; its condition must hold for the
+-- request to be allowed. DRY_RUN policies are evaluated and logged only.
+
+CREATE TABLE IF NOT EXISTS authorization_policies (
+    -- Primary Key
+    id                  UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Foreign Keys
+    tenant_id           UUID NOT NULL,
+
+    -- Business Identifiers
+    name                VARCHAR(255) NOT NULL,
+    description         TEXT,
+
+    -- Target
+    permission_code     VARCHAR(100) NOT NULL,
+    resource_type       VARCHAR(50),
+
+    -- Rule
+    condition           JSONB NOT NULL,
+    mode                VARCHAR(20) NOT NULL DEFAULT 'ENFORCE',
+    is_active           BOOLEAN NOT NULL DEFAULT TRUE,
+
+    -- Audit Fields
+    created_by          UUID NOT NULL,
+    updated_by          UUID,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at          TIMESTAMPTZ,
+
+    -- Constraints
+    CONSTRAINT fk_authorization_policies_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE CASCADE,
+    CONSTRAINT chk_authorization_policies_mode CHECK (mode IN ('ENFORCE', 'DRY_RUN'))
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_authorization_policies_tenant_name
+    ON authorization_policies(tenant_id, name)
+    WHERE deleted_at IS NULL;
+
+-- Evaluation lookups
+CREATE INDEX IF NOT EXISTS idx_authorization_policies_lookup
+    ON authorization_policies(tenant_id, permission_code)
+    WHERE deleted_at IS NULL AND is_active;
+
+CREATE TRIGGER trg_authorization_policies_updated_at
+    BEFORE UPDATE ON authorization_policies
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+COMMENT ON TABLE authorization_policies IS 'Attribute based conditions evaluated after the permission check.';
+COMMENT ON COLUMN authorization_policies.resource_type IS 'Resource the condition inspects. NULL = subject and environment attributes only.';
+COMMENT ON COLUMN authorization_policies.mode IS 'ENFORCE denies failing requests; DRY_RUN only logs the decision.';
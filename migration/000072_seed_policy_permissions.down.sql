@@ -0,0 +1,29 @@
+DO $$
+DECLARE
+    v_platform_tenant_id UUID;
+    v_iam_app_id UUID;
+BEGIN
+    SELECT id INTO v_platform_tenant_id FROM tenants WHERE code = 'platform';
+
+    IF v_platform_tenant_id IS NULL THEN
+        RAISE NOTICE 'Platform tenant not found, nothing to delete';
+        RETURN;
+    END IF;
+
+    SELECT id INTO v_iam_app_id
+    FROM applications
+    WHERE tenant_id = v_platform_tenant_id AND code = 'iam-admin';
+
+    IF v_iam_app_id IS NOT NULL THEN
+        DELETE FROM role_permissions
+        WHERE permission_id IN (
+            SELECT id FROM permissions
+            WHERE application_id = v_iam_app_id AND code LIKE 'policy:%'
+        );
+
+        DELETE FROM permissions
+        WHERE application_id = v_iam_app_id AND code LIKE 'policy:%';
+
+        RAISE NOTICE 'Removed policy permissions';
+    END IF;
+END $$;
@@ -0,0 +1,42 @@
+DO $$
+DECLARE
+    v_platform_tenant_id UUID;
+    v_iam_app_id UUID;
+BEGIN
+
+    SELECT id INTO v_platform_tenant_id FROM tenants WHERE code = 'platform';
+
+    IF v_platform_tenant_id IS NULL THEN
+        RAISE NOTICE 'Platform tenant not found, skipping policy permission seed';
+        RETURN;
+    END IF;
+
+    SELECT id INTO v_iam_app_id
+    FROM applications
+    WHERE tenant_id = v_platform_tenant_id AND code = 'iam-admin';
+
+    IF v_iam_app_id IS NULL THEN
+        RAISE NOTICE 'IAM admin application not found, skipping policy permission seed';
+        RETURN;
+    END IF;
+
+
+    INSERT INTO permissions (application_id, code, name, resource_type, action, status) VALUES
+        (v_iam_app_id, 'policy:read',   'View Authorization Policy',   'policy', 'read',   'ACTIVE'),
+        (v_iam_app_id, 'policy:manage', 'Manage Authorization Policy', 'policy', 'manage', 'ACTIVE')
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE 'Ensured 2 policy permissions';
+
+
+    INSERT INTO role_permissions (role_id, permission_id)
+    SELECT r.id, p.id
+    FROM roles r, permissions p
+    WHERE r.application_id = v_iam_app_id
+      AND r.code = 'PLATFORM_ADMIN'
+      AND p.application_id = v_iam_app_id
+      AND p.code LIKE 'policy:%'
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE 'Assigned policy permissions to PLATFORM_ADMIN role';
+END $$;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_permission_checks_tenant_id;
+DROP INDEX IF EXISTS idx_permission_checks_resource;
+DROP INDEX IF EXISTS idx_permission_checks_user_id;
+DROP TABLE IF EXISTS permission_checks;
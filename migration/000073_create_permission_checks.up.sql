@@ -0,0 +1,49 @@
+-- Append-only log of decisions made by the authorization decision API.
+-- user_id has no foreign key: callers may ask about users that do not exist,
+-- and those denials are logged too.
+
+CREATE TABLE IF NOT EXISTS permission_checks (
+    -- Primary Key
+    id                   UUID PRIMARY KEY DEFAULT uuidv7(),
+
+    -- Scope
+    tenant_id            UUID,
+
+    -- Subject
+    user_id              UUID,
+
+    -- Question
+    permission_code      VARCHAR(100) NOT NULL,
+    resource_id          UUID,
+    resource_type        VARCHAR(50),
+    branch_id            UUID,
+
+    -- Answer
+    result               BOOLEAN NOT NULL,
+    metadata             JSONB,
+
+    -- Audit
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign Keys
+    CONSTRAINT fk_permission_checks_tenant FOREIGN KEY (tenant_id)
+        REFERENCES tenants(id) ON DELETE SET NULL
+);
+
+-- Decisions about one user, newest first
+CREATE INDEX IF NOT EXISTS idx_permission_checks_user_id
+    ON permission_checks(user_id, created_at DESC)
+    WHERE user_id IS NOT NULL;
+
+-- Decisions touching one resource
+CREATE INDEX IF NOT EXISTS idx_permission_checks_resource
+    ON permission_checks(resource_type, resource_id, created_at DESC)
+    WHERE resource_id IS NOT NULL;
+
+-- FK index: tenant view
+CREATE INDEX IF NOT EXISTS idx_permission_checks_tenant_id
+    ON permission_checks(tenant_id, created_at DESC)
+    WHERE tenant_id IS NOT NULL;
+
+COMMENT ON TABLE permission_checks IS 'Append-only log of authorization decisions answered for other services.';
+COMMENT ON COLUMN permission_checks.metadata IS 'Decision details, e.g. reason, resolved scope, roles and the calling API key.';
@@ -0,0 +1,42 @@
+DO $$
+DECLARE
+    v_platform_tenant_id UUID;
+    v_iam_app_id UUID;
+BEGIN
+
+    SELECT id INTO v_platform_tenant_id FROM tenants WHERE code = 'platform';
+
+    IF v_platform_tenant_id IS NULL THEN
+        RAISE NOTICE 'Platform tenant not found, skipping API key permission seed';
+        RETURN;
+    END IF;
+
+    SELECT id INTO v_iam_app_id
+    FROM applications
+    WHERE tenant_id = v_platform_tenant_id AND code = 'iam-admin';
+
+    IF v_iam_app_id IS NULL THEN
+        RAISE NOTICE 'IAM admin application not found, skipping API key permission seed';
+        RETURN;
+    END IF;
+
+
+    INSERT INTO permissions (application_id, code, name, resource_type, action, status) VALUES
+        (v_iam_app_id, 'api_key:read',   'View API Key',   'api_key', 'read',   'ACTIVE'),
+        (v_iam_app_id, 'api_key:manage', 'Manage API Key', 'api_key', 'manage', 'ACTIVE')
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE 'Ensured 2 API key permissions';
+
+
+    INSERT INTO role_permissions (role_id, permission_id)
+    SELECT r.id, p.id
+    FROM roles r, permissions p
+    WHERE r.application_id = v_iam_app_id
+      AND r.code = 'PLATFORM_ADMIN'
+      AND p.application_id = v_iam_app_id
+      AND p.code IN ('api_key:read', 'api_key:manage')
+    ON CONFLICT DO NOTHING;
+
+    RAISE NOTICE 'Assigned API key permissions to PLATFORM_ADMIN role';
+END $$;
@@ -0,0 +1,5 @@
+ALTER TABLE password_policies DROP CONSTRAINT IF EXISTS chk_password_policies_length;
+ALTER TABLE password_policies ADD CONSTRAINT chk_password_policies_length
+    CHECK (min_length >= 8 AND max_length >= min_length AND max_length <= 256);
+
+ALTER TABLE password_policies ALTER COLUMN max_length SET DEFAULT 128;
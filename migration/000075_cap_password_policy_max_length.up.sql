@@ -0,0 +1,13 @@
+-- bcrypt only hashes the first 72 bytes of a password, so longer limits let
+-- users set passwords whose tail is silently ignored.
+
+UPDATE password_policies
+SET min_length = LEAST(min_length, 72),
+    max_length = LEAST(max_length, 72)
+WHERE max_length > 72;
+
+ALTER TABLE password_policies ALTER COLUMN max_length SET DEFAULT 72;
+
+ALTER TABLE password_policies DROP CONSTRAINT IF EXISTS chk_password_policies_length;
+ALTER TABLE password_policies ADD CONSTRAINT chk_password_policies_length
+    CHECK (min_length >= 8 AND max_length >= min_length AND max_length <= 72);
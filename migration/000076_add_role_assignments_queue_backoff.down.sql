@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_raq_work_queue;
+CREATE INDEX IF NOT EXISTS idx_raq_work_queue
+    ON role_assignments_queue(status, assigned_at)
+    WHERE status IN ('pending', 'processing');
+
+ALTER TABLE role_assignments_queue DROP COLUMN IF EXISTS next_attempt_at;
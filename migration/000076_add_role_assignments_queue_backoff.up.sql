@@ -0,0 +1,11 @@
+-- Failed assignments wait before the worker picks them up again, so a
+-- dependency that is down is not hammered on every poll.
+
+ALTER TABLE role_assignments_queue ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ;
+
+DROP INDEX IF EXISTS idx_raq_work_queue;
+CREATE INDEX IF NOT EXISTS idx_raq_work_queue
+    ON role_assignments_queue(status, next_attempt_at, assigned_at)
+    WHERE status IN ('pending', 'processing');
+
+COMMENT ON COLUMN role_assignments_queue.next_attempt_at IS 'Earliest time the worker retries a pending item after a transient failure. NULL means immediately.';
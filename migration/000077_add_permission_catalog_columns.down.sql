@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_permissions_module;
+ALTER TABLE permissions DROP CONSTRAINT IF EXISTS chk_permissions_scope_level;
+ALTER TABLE permissions DROP COLUMN IF EXISTS is_system;
+ALTER TABLE permissions DROP COLUMN IF EXISTS scope_level;
+ALTER TABLE permissions DROP COLUMN IF EXISTS module;
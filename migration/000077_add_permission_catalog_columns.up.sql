@@ -0,0 +1,25 @@
+-- Catalog metadata for permissions managed through the permission API. The
+-- resource is kept in the existing resource_type column. Permissions that
+-- already exist were seeded by the platform and are marked as system.
+
+ALTER TABLE permissions ADD COLUMN IF NOT EXISTS module VARCHAR(50);
+ALTER TABLE permissions ADD COLUMN IF NOT EXISTS scope_level VARCHAR(20) NOT NULL DEFAULT 'tenant';
+ALTER TABLE permissions ADD COLUMN IF NOT EXISTS is_system BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE permissions
+SET resource_type = COALESCE(resource_type, split_part(code, ':', 1)),
+    action = COALESCE(action, split_part(code, ':', 2)),
+    module = COALESCE(module, resource_type, split_part(code, ':', 1)),
+    is_system = TRUE;
+
+ALTER TABLE permissions ALTER COLUMN module SET NOT NULL;
+ALTER TABLE permissions ADD CONSTRAINT chk_permissions_scope_level
+    CHECK (scope_level IN ('system', 'tenant', 'branch', 'self'));
+
+CREATE INDEX IF NOT EXISTS idx_permissions_module
+    ON permissions(application_id, module)
+    WHERE deleted_at IS NULL;
+
+COMMENT ON COLUMN permissions.module IS 'Functional module the permission is grouped under in the catalog.';
+COMMENT ON COLUMN permissions.scope_level IS 'Narrowest scope the permission is meant to be granted at: system, tenant, branch or self.';
+COMMENT ON COLUMN permissions.is_system IS 'Platform permission referenced by service code. Cannot be changed or deleted through the catalog API.';
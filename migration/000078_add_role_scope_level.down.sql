@@ -0,0 +1,2 @@
+ALTER TABLE roles DROP CONSTRAINT IF EXISTS chk_roles_scope_level;
+ALTER TABLE roles DROP COLUMN IF EXISTS scope_level;
@@ -0,0 +1,10 @@
+-- Branch and self scoped roles only grant their permissions within the
+-- assignment's branch or on resources the user owns. Existing roles keep
+-- their tenant wide reach.
+
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS scope_level VARCHAR(20) NOT NULL DEFAULT 'tenant';
+
+ALTER TABLE roles ADD CONSTRAINT chk_roles_scope_level
+    CHECK (scope_level IN ('system', 'tenant', 'branch', 'self'));
+
+COMMENT ON COLUMN roles.scope_level IS 'Reach of the role''s permissions: system, tenant, branch (assignment branch only) or self (own resources only).';
@@ -0,0 +1,413 @@
+package abac
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type Operator string
+
+const (
+	OpEq       Operator = "eq"
+	OpNeq      Operator = "neq"
+	OpGt       Operator = "gt"
+	OpGte      Operator = "gte"
+	OpLt       Operator = "lt"
+	OpLte      Operator = "lte"
+	OpIn       Operator = "in"
+	OpNotIn    Operator = "not_in"
+	OpContains Operator = "contains"
+	OpExists   Operator = "exists"
+)
+
+const (
+	MaxConditionDepth = 5
+	MaxConditionNodes = 50
+)
+
+// Condition is a boolean expression over request attributes. A node is either
+// a group (All, Any or Not) or a comparison of Attr against a literal Value or
+// against another attribute named by Ref.
+type Condition struct {
+	All []Condition `json:"all,omitempty"`
+	Any []Condition `json:"any,omitempty"`
+	Not *Condition  `json:"not,omitempty"`
+
+	Attr  string   `json:"attr,omitempty"`
+	Op    Operator `json:"op,omitempty"`
+	Value any      `json:"value,omitempty"`
+	Ref   string   `json:"ref,omitempty"`
+}
+
+// Validate checks the shape of the condition. Resource attributes are only
+// allowed when the policy is bound to a resource type.
+func (c *Condition) Validate(allowResource bool) error {
+	nodes := 0
+	return c.validate(allowResource, 1, &nodes)
+}
+
+// References reports whether the condition reads any attribute in scope.
+func (c *Condition) References(scope string) bool {
+	if attributeScope(c.Attr) == scope || attributeScope(c.Ref) == scope {
+		return true
+	}
+	for i := range c.All {
+		if c.All[i].References(scope) {
+			return true
+		}
+	}
+	for i := range c.Any {
+		if c.Any[i].References(scope) {
+			return true
+		}
+	}
+	return c.Not != nil && c.Not.References(scope)
+}
+
+func (c *Condition) validate(allowResource bool, depth int, nodes *int) error {
+	*nodes++
+	if *nodes > MaxConditionNodes {
+		return fmt.Errorf("condition has more than %d nodes", MaxConditionNodes)
+	}
+	if depth > MaxConditionDepth {
+		return fmt.Errorf("condition is nested deeper than %d levels", MaxConditionDepth)
+	}
+
+	kinds := 0
+	if len(c.All) > 0 {
+		kinds++
+	}
+	if len(c.Any) > 0 {
+		kinds++
+	}
+	if c.Not != nil {
+		kinds++
+	}
+	if c.Attr != "" {
+		kinds++
+	}
+	if kinds != 1 {
+		return fmt.Errorf("each condition must have exactly one of all, any, not or attr")
+	}
+
+	for i := range c.All {
+		if err := c.All[i].validate(allowResource, depth+1, nodes); err != nil {
+			return err
+		}
+	}
+	for i := range c.Any {
+		if err := c.Any[i].validate(allowResource, depth+1, nodes); err != nil {
+			return err
+		}
+	}
+	if c.Not != nil {
+		return c.Not.validate(allowResource, depth+1, nodes)
+	}
+	if c.Attr == "" {
+		return nil
+	}
+
+	if err := validateAttribute(c.Attr, allowResource); err != nil {
+		return err
+	}
+	switch c.Op {
+	case OpExists:
+		if c.Value != nil || c.Ref != "" {
+			return fmt.Errorf("%s: exists takes no value", c.Attr)
+		}
+		return nil
+	case OpEq, OpNeq, OpGt, OpGte, OpLt, OpLte, OpIn, OpNotIn, OpContains:
+	default:
+		return fmt.Errorf("%s: unknown operator %q", c.Attr, c.Op)
+	}
+
+	if c.Ref != "" {
+		if c.Value != nil {
+			return fmt.Errorf("%s: value and ref are mutually exclusive", c.Attr)
+		}
+		return validateAttribute(c.Ref, allowResource)
+	}
+	if c.Value == nil {
+		return fmt.Errorf("%s: value or ref is required", c.Attr)
+	}
+	if c.Op == OpIn || c.Op == OpNotIn {
+		if _, ok := normalize(c.Value).([]any); !ok {
+			return fmt.Errorf("%s: %s requires a list value", c.Attr, c.Op)
+		}
+	}
+	return nil
+}
+
+func validateAttribute(name string, allowResource bool) error {
+	switch attributeScope(name) {
+	case ScopeSubject, ScopeEnvironment:
+		return nil
+	case ScopeResource:
+		if !allowResource {
+			return fmt.Errorf("%s: resource attributes require a resource type", name)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s: attributes must start with subject., resource. or env.", name)
+}
+
+func attributeScope(name string) string {
+	scope, key, ok := strings.Cut(name, ".")
+	if !ok || key == "" {
+		return ""
+	}
+	return scope
+}
+
+// Evaluate reports whether the condition holds for in. Comparisons involving
+// a missing attribute are unknown rather than false, and an unknown result
+// denies, so policies fail closed even when a comparison is wrapped in not.
+func (c *Condition) Evaluate(in Input) bool {
+	return c.evaluate(in) == truthTrue
+}
+
+// truth is a three-valued result: a comparison on a missing attribute is
+// unknown, and not of unknown stays unknown.
+type truth int8
+
+const (
+	truthFalse truth = iota
+	truthTrue
+	truthUnknown
+)
+
+func truthOf(b bool) truth {
+	if b {
+		return truthTrue
+	}
+	return truthFalse
+}
+
+func (c *Condition) evaluate(in Input) truth {
+	switch {
+	case len(c.All) > 0:
+		result := truthTrue
+		for i := range c.All {
+			switch c.All[i].evaluate(in) {
+			case truthFalse:
+				return truthFalse
+			case truthUnknown:
+				result = truthUnknown
+			}
+		}
+		return result
+	case len(c.Any) > 0:
+		result := truthFalse
+		for i := range c.Any {
+			switch c.Any[i].evaluate(in) {
+			case truthTrue:
+				return truthTrue
+			case truthUnknown:
+				result = truthUnknown
+			}
+		}
+		return result
+	case c.Not != nil:
+		switch c.Not.evaluate(in) {
+		case truthTrue:
+			return truthFalse
+		case truthFalse:
+			return truthTrue
+		}
+		return truthUnknown
+	}
+
+	left, ok := in.lookup(c.Attr)
+	if c.Op == OpExists {
+		return truthOf(ok)
+	}
+	if !ok {
+		return truthUnknown
+	}
+
+	right := normalize(c.Value)
+	if c.Ref != "" {
+		if right, ok = in.lookup(c.Ref); !ok {
+			return truthUnknown
+		}
+	}
+	return truthOf(compare(c.Op, left, right))
+}
+
+func (in Input) lookup(name string) (any, bool) {
+	scope, key, _ := strings.Cut(name, ".")
+	var attrs Attributes
+	switch scope {
+	case ScopeSubject:
+		attrs = in.Subject
+	case ScopeResource:
+		attrs = in.Resource
+	case ScopeEnvironment:
+		attrs = in.Environment
+	}
+	value, ok := attrs[key]
+	if !ok {
+		return nil, false
+	}
+	value = normalize(value)
+	return value, value != nil
+}
+
+func compare(op Operator, left, right any) bool {
+	switch op {
+	case OpEq:
+		return equal(left, right)
+	case OpNeq:
+		return !equal(left, right)
+	case OpIn, OpNotIn:
+		list, ok := right.([]any)
+		if !ok {
+			return false
+		}
+		found := false
+		for _, item := range list {
+			if equal(left, item) {
+				found = true
+				break
+			}
+		}
+		return found == (op == OpIn)
+	case OpContains:
+		list, ok := left.([]any)
+		if !ok {
+			return false
+		}
+		for _, item := range list {
+			if equal(item, right) {
+				return true
+			}
+		}
+		return false
+	}
+
+	cmp, ok := order(left, right)
+	if !ok {
+		return false
+	}
+	switch op {
+	case OpGt:
+		return cmp > 0
+	case OpGte:
+		return cmp >= 0
+	case OpLt:
+		return cmp < 0
+	case OpLte:
+		return cmp <= 0
+	}
+	return false
+}
+
+// equal compares strings case-insensitively so UUIDs and enum values match
+// however they were written in the policy.
+func equal(a, b any) bool {
+	switch av := a.(type) {
+	case float64:
+		bv, ok := b.(float64)
+		return ok && av == bv
+	case string:
+		bv, ok := b.(string)
+		return ok && strings.EqualFold(av, bv)
+	case bool:
+		bv, ok := b.(bool)
+		return ok && av == bv
+	}
+	return false
+}
+
+// order compares numbers numerically and strings lexically, which also
+// orders "15:04" times and ISO dates correctly.
+func order(a, b any) (int, bool) {
+	switch av := a.(type) {
+	case float64:
+		bv, ok := b.(float64)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case av < bv:
+			return -1, true
+		case av > bv:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		bv, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(av, bv), true
+	}
+	return 0, false
+}
+
+// normalize maps attribute values onto the JSON types conditions are written
+// in: float64, string, bool and []any.
+func normalize(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case float64, string, bool:
+		return v
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case uuid.UUID:
+		return v.String()
+	case *uuid.UUID:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case *string:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case fmt.Stringer:
+		return v.String()
+	case []any:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = normalize(item)
+		}
+		return items
+	case []string:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items
+	case []uuid.UUID:
+		items := make([]any, len(v))
+		for i, item := range v {
+			items[i] = item.String()
+		}
+		return items
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint())
+	}
+	return nil
+}
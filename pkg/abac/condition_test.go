@@ -0,0 +1,144 @@
+package abac
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseCondition(t *testing.T, raw string) Condition {
+	t.Helper()
+	var c Condition
+	require.NoError(t, json.Unmarshal([]byte(raw), &c))
+	return c
+}
+
+func TestConditionEvaluate(t *testing.T) {
+	approver := uuid.New()
+	submitter := uuid.New()
+
+	tests := []struct {
+		name      string
+		condition string
+		input     Input
+		expected  bool
+	}{
+		{
+			name:      "approver is not the submitter",
+			condition: `{"attr":"resource.submitted_by","op":"neq","ref":"subject.id"}`,
+			input: Input{
+				Subject:  Attributes{"id": approver},
+				Resource: Attributes{"submitted_by": &submitter},
+			},
+			expected: true,
+		},
+		{
+			name:      "approver submitted the participant",
+			condition: `{"attr":"resource.submitted_by","op":"neq","ref":"subject.id"}`,
+			input: Input{
+				Subject:  Attributes{"id": submitter},
+				Resource: Attributes{"submitted_by": &submitter},
+			},
+			expected: false,
+		},
+		{
+			name:      "missing attribute fails closed",
+			condition: `{"attr":"resource.submitted_by","op":"neq","ref":"subject.id"}`,
+			input: Input{
+				Subject:  Attributes{"id": approver},
+				Resource: Attributes{"submitted_by": (*uuid.UUID)(nil)},
+			},
+			expected: false,
+		},
+		{
+			name:      "not around a missing attribute fails closed",
+			condition: `{"not":{"attr":"subject.department","op":"eq","value":"audit"}}`,
+			input:     Input{Subject: Attributes{"id": approver}},
+			expected:  false,
+		},
+		{
+			name:      "not around a missing ref fails closed",
+			condition: `{"not":{"attr":"resource.submitted_by","op":"eq","ref":"subject.id"}}`,
+			input:     Input{Resource: Attributes{"submitted_by": &submitter}},
+			expected:  false,
+		},
+		{
+			name:      "not around a present attribute",
+			condition: `{"not":{"attr":"subject.department","op":"eq","value":"audit"}}`,
+			input:     Input{Subject: Attributes{"department": "lending"}},
+			expected:  true,
+		},
+		{
+			name:      "any still matches when another branch is unknown",
+			condition: `{"any":[{"attr":"subject.department","op":"eq","value":"audit"},{"attr":"subject.id","op":"eq","value":"` + approver.String() + `"}]}`,
+			input:     Input{Subject: Attributes{"id": approver}},
+			expected:  true,
+		},
+		{
+			name:      "within business hours on a weekday",
+			condition: `{"all":[{"attr":"env.hour","op":"gte","value":9},{"attr":"env.hour","op":"lt","value":17},{"not":{"attr":"env.weekday","op":"in","value":["saturday","sunday"]}}]}`,
+			input:     Input{Environment: EnvironmentAt(time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC), time.UTC, "")},
+			expected:  true,
+		},
+		{
+			name:      "outside business hours",
+			condition: `{"all":[{"attr":"env.hour","op":"gte","value":9},{"attr":"env.hour","op":"lt","value":17}]}`,
+			input:     Input{Environment: EnvironmentAt(time.Date(2026, 10, 19, 20, 0, 0, 0, time.UTC), time.UTC, "")},
+			expected:  false,
+		},
+		{
+			name:      "subject role membership",
+			condition: `{"any":[{"attr":"subject.roles","op":"contains","value":"SUPERVISOR"},{"attr":"subject.id","op":"eq","value":"` + approver.String() + `"}]}`,
+			input:     Input{Subject: Attributes{"id": uuid.New(), "roles": []string{"SUPERVISOR"}}},
+			expected:  true,
+		},
+		{
+			name:      "exists",
+			condition: `{"attr":"resource.branch_id","op":"exists"}`,
+			input:     Input{Resource: Attributes{"branch_id": uuid.New()}},
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := parseCondition(t, tt.condition)
+			assert.Equal(t, tt.expected, c.Evaluate(tt.input))
+		})
+	}
+}
+
+func TestConditionValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     string
+		allowResource bool
+		wantErr       bool
+	}{
+		{name: "valid comparison", condition: `{"attr":"env.hour","op":"gte","value":9}`},
+		{name: "valid resource reference", condition: `{"attr":"resource.submitted_by","op":"neq","ref":"subject.id"}`, allowResource: true},
+		{name: "resource without resource type", condition: `{"attr":"resource.submitted_by","op":"neq","ref":"subject.id"}`, wantErr: true},
+		{name: "unknown scope", condition: `{"attr":"user.id","op":"eq","value":"x"}`, wantErr: true},
+		{name: "unknown operator", condition: `{"attr":"env.hour","op":"between","value":9}`, wantErr: true},
+		{name: "missing value", condition: `{"attr":"env.hour","op":"gte"}`, wantErr: true},
+		{name: "in without list", condition: `{"attr":"env.weekday","op":"in","value":"monday"}`, wantErr: true},
+		{name: "mixed node", condition: `{"attr":"env.hour","op":"gte","value":9,"all":[{"attr":"env.hour","op":"lt","value":17}]}`, wantErr: true},
+		{name: "too deep", condition: `{"not":{"not":{"not":{"not":{"not":{"attr":"env.hour","op":"exists"}}}}}}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := parseCondition(t, tt.condition)
+			err := c.Validate(tt.allowResource)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
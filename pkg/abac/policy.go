@@ -0,0 +1,58 @@
+package abac
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attributes are the flat key/value facts a condition can reference, e.g.
+// subject.id or resource.submitted_by.
+type Attributes map[string]any
+
+type Input struct {
+	Subject     Attributes
+	Resource    Attributes
+	Environment Attributes
+}
+
+const (
+	ScopeSubject     = "subject"
+	ScopeResource    = "resource"
+	ScopeEnvironment = "env"
+)
+
+// Decision is the outcome of evaluating every policy that applies to a
+// request. Violations from dry-run policies are reported but never deny.
+type Decision struct {
+	Allowed    bool        `json:"allowed"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+type Violation struct {
+	PolicyID uuid.UUID `json:"policy_id"`
+	Name     string    `json:"name"`
+	DryRun   bool      `json:"dry_run"`
+}
+
+// EnvironmentAt builds the environment attributes for a request made at now,
+// with calendar values taken in the given location.
+func EnvironmentAt(now time.Time, loc *time.Location, ipAddress string) Attributes {
+	local := now.In(loc)
+	env := Attributes{
+		"hour":    local.Hour(),
+		"minute":  local.Minute(),
+		"time":    local.Format("15:04"),
+		"date":    local.Format("2006-01-02"),
+		"weekday": lowerWeekday(local.Weekday()),
+	}
+	if ipAddress != "" {
+		env["ip_address"] = ipAddress
+	}
+	return env
+}
+
+func lowerWeekday(day time.Weekday) string {
+	name := day.String()
+	return string(name[0]+'a'-'A') + name[1:]
+}
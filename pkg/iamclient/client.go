@@ -1,35 +1,103 @@
 package iamclient
 
 import (
-	"crypto/rsa"
+	"crypto"
 	"fmt"
+	"net/http"
+	"time"
 
 	"iam-service/pkg/jwt"
 )
 
 type Client struct {
-	PublicKey        *rsa.PublicKey
+	PublicKey        crypto.PublicKey
+	KeyRing          *jwt.KeyRing
+	SigningMethod    string
 	Issuer           string
 	RequiredAudience string
 	RequiredProduct  string
 }
 
+// JWKSURL points at the IAM service's /.well-known/jwks.json and is preferred
+// over PublicKeyPath: keys are cached, picked by the token's kid and fetched
+// again when a token names a kid that is not cached yet, so tokens signed
+// after a key rotation keep validating. JWKSRefreshInterval defaults to ten
+// minutes; JWKSMinRefetchInterval bounds how often unknown kids may trigger a
+// fetch and defaults to ten seconds.
+//
+// SigningMethod is optional; when empty it is derived from the public key
+// (RSA -> RS256, P-256 -> ES256, Ed25519 -> EdDSA). With JWKSURL it restricts
+// the published keys that are accepted.
 type Config struct {
-	PublicKeyPath    string
-	Issuer           string
-	RequiredAudience string
-	RequiredProduct  string
+	JWKSURL                string
+	JWKSRefreshInterval    time.Duration
+	JWKSMinRefetchInterval time.Duration
+	PublicKeyPath          string
+	SigningMethod          string
+	Issuer                 string
+	RequiredAudience       string
+	RequiredProduct        string
 }
 
 func NewClient(config *Config) (*Client, error) {
+	if config.JWKSURL != "" {
+		return newJWKSClient(config)
+	}
 
 	publicKey, err := jwt.LoadPublicKeyFromFile(config.PublicKeyPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load public key: %w", err)
 	}
 
+	signingMethod, err := jwt.AlgorithmForKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load public key: %w", err)
+	}
+	if config.SigningMethod != "" && config.SigningMethod != signingMethod {
+		return nil, fmt.Errorf("public key is a %s key but signing method %s was requested", signingMethod, config.SigningMethod)
+	}
+
 	return &Client{
 		PublicKey:        publicKey,
+		SigningMethod:    signingMethod,
+		Issuer:           config.Issuer,
+		RequiredAudience: config.RequiredAudience,
+		RequiredProduct:  config.RequiredProduct,
+	}, nil
+}
+
+func newJWKSClient(config *Config) (*Client, error) {
+	if config.SigningMethod != "" && !jwt.IsAsymmetric(config.SigningMethod) {
+		return nil, fmt.Errorf("signing method %s cannot be verified with a jwks", config.SigningMethod)
+	}
+
+	refreshInterval := config.JWKSRefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+
+	store := &jwksKeyStore{
+		url:        config.JWKSURL,
+		algorithm:  config.SigningMethod,
+		httpClient: &http.Client{Timeout: jwksRequestTimeout},
+	}
+	keyRing, err := jwt.NewKeyRing(store, jwt.KeyRingOptions{
+		Algorithm:       config.SigningMethod,
+		RefreshInterval: refreshInterval,
+		ForcedReloadGap: config.JWKSMinRefetchInterval,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jwks: %w", err)
+	}
+
+	active, err := keyRing.Active()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jwks: %w", err)
+	}
+
+	return &Client{
+		KeyRing:          keyRing,
+		SigningMethod:    active.Algorithm,
 		Issuer:           config.Issuer,
 		RequiredAudience: config.RequiredAudience,
 		RequiredProduct:  config.RequiredProduct,
@@ -38,8 +106,9 @@ func NewClient(config *Config) (*Client, error) {
 
 func (c *Client) ValidateToken(tokenString string) (*jwt.JWTClaims, error) {
 	tokenConfig := &jwt.TokenConfig{
-		SigningMethod: "RS256",
+		SigningMethod: c.SigningMethod,
 		PublicKey:     c.PublicKey,
+		KeyRing:       c.KeyRing,
 		Issuer:        c.Issuer,
 	}
 
@@ -48,15 +117,22 @@ func (c *Client) ValidateToken(tokenString string) (*jwt.JWTClaims, error) {
 		return nil, err
 	}
 
+	if claims.IsRestricted() {
+		return nil, fmt.Errorf("restricted token: only accepted by IAM endpoints for scope %q", claims.Scope)
+	}
+
 	if c.RequiredAudience != "" && !claims.HasAudience(c.RequiredAudience) {
 		return nil, fmt.Errorf("invalid audience: token not intended for this service")
 	}
 
+	// RequiredProduct may be configured as either the product code or its ID.
 	if c.RequiredProduct != "" {
 		if claims.ProductID == nil {
 			return nil, fmt.Errorf("product context required but not found in token")
 		}
-
+		if claims.ProductCode != c.RequiredProduct && claims.ProductID.String() != c.RequiredProduct {
+			return nil, fmt.Errorf("invalid product: token not scoped to %s", c.RequiredProduct)
+		}
 	}
 
 	return claims, nil
@@ -0,0 +1,99 @@
+package iamclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"iam-service/pkg/jwt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serveJWKS(t *testing.T, ring *jwt.KeyRing, fetches *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		keys := []jwt.JWK{}
+		for _, key := range ring.PublishedKeys() {
+			jwk, err := jwt.NewJWK(key.PublicKey, key.Kid)
+			require.NoError(t, err)
+			keys = append(keys, jwk)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": keys})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func issueToken(t *testing.T, ring *jwt.KeyRing) string {
+	t.Helper()
+	token, err := jwt.GenerateAccessToken(uuid.New(), "test@example.com", nil, nil, nil, nil, nil, uuid.New(), &jwt.TokenConfig{
+		SigningMethod: jwt.SigningMethodRS256,
+		KeyRing:       ring,
+		AccessExpiry:  15 * time.Minute,
+		Issuer:        "iam-service",
+	})
+	require.NoError(t, err)
+	return token
+}
+
+func TestValidateToken_JWKSFollowsKeyRotation(t *testing.T) {
+	issuer, err := jwt.NewKeyRing(jwt.NewFileKeyStore(t.TempDir()), jwt.KeyRingOptions{})
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	server := serveJWKS(t, issuer, &fetches)
+
+	client, err := NewClient(&Config{
+		JWKSURL:                server.URL,
+		JWKSMinRefetchInterval: time.Nanosecond,
+		Issuer:                 "iam-service",
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), fetches.Load())
+
+	_, err = client.ValidateToken(issueToken(t, issuer))
+	require.NoError(t, err)
+
+	// The first rotation activates the published next key, which the client
+	// already holds; the second activates a key it has never seen.
+	_, err = issuer.Rotate()
+	require.NoError(t, err)
+	_, err = client.ValidateToken(issueToken(t, issuer))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	_, err = issuer.Rotate()
+	require.NoError(t, err)
+	claims, err := client.ValidateToken(issueToken(t, issuer))
+	require.NoError(t, err)
+	assert.Equal(t, "test@example.com", claims.Email)
+	assert.Equal(t, int32(2), fetches.Load(), "an unknown kid should refetch the jwks")
+}
+
+func TestValidateToken_JWKSRejectsForeignKey(t *testing.T) {
+	issuer, err := jwt.NewKeyRing(jwt.NewFileKeyStore(t.TempDir()), jwt.KeyRingOptions{})
+	require.NoError(t, err)
+	foreign, err := jwt.NewKeyRing(jwt.NewFileKeyStore(t.TempDir()), jwt.KeyRingOptions{})
+	require.NoError(t, err)
+
+	var fetches atomic.Int32
+	server := serveJWKS(t, issuer, &fetches)
+
+	client, err := NewClient(&Config{
+		JWKSURL:                server.URL,
+		JWKSMinRefetchInterval: time.Nanosecond,
+		Issuer:                 "iam-service",
+	})
+	require.NoError(t, err)
+
+	_, err = client.ValidateToken(issueToken(t, foreign))
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package iamclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"iam-service/pkg/jwt"
+)
+
+const (
+	defaultJWKSRefreshInterval = 10 * time.Minute
+	jwksRequestTimeout         = 5 * time.Second
+)
+
+// jwksKeyStore reads the verification keys published at the IAM service's
+// /.well-known/jwks.json. The JWKS does not say which key is signing, so every
+// published key is loaded as active; the client only ever verifies. A
+// non-empty algorithm skips keys of any other type.
+type jwksKeyStore struct {
+	url        string
+	algorithm  string
+	httpClient *http.Client
+}
+
+func (s *jwksKeyStore) LoadKeys() ([]*jwt.SigningKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build jwks request: %w", err)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jwt.JWK `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+
+	keys := make([]*jwt.SigningKey, 0, len(body.Keys))
+	for _, jwk := range body.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		publicKey, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+		algorithm, err := jwt.AlgorithmForKey(publicKey)
+		if err != nil || (jwk.Alg != "" && jwk.Alg != algorithm) {
+			continue
+		}
+		if s.algorithm != "" && algorithm != s.algorithm {
+			continue
+		}
+		keys = append(keys, &jwt.SigningKey{
+			Kid:       jwk.Kid,
+			Algorithm: algorithm,
+			Status:    jwt.KeyStatusActive,
+			PublicKey: publicKey,
+		})
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwks at %s has no usable signing keys", s.url)
+	}
+	return keys, nil
+}
+
+func (s *jwksKeyStore) SaveKeys(keys []*jwt.SigningKey) error {
+	return jwt.ErrKeyRingReadOnly
+}
@@ -0,0 +1,110 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("decodes png", func(t *testing.T) {
+		data := encodePNG(t, image.NewRGBA(image.Rect(0, 0, 40, 20)))
+
+		img, format, err := Decode(data)
+		require.NoError(t, err)
+		assert.Equal(t, "png", format)
+		assert.Equal(t, 40, img.Bounds().Dx())
+	})
+
+	t.Run("rejects unknown formats", func(t *testing.T) {
+		_, _, err := Decode([]byte("%PDF-1.7 not an image"))
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("rejects oversized dimensions before decoding", func(t *testing.T) {
+		data := encodePNG(t, image.NewGray(image.Rect(0, 0, MaxDimension+1, 1)))
+
+		_, _, err := Decode(data)
+		assert.ErrorIs(t, err, ErrTooLarge)
+	})
+}
+
+func TestSquareThumbnail(t *testing.T) {
+	t.Run("crops the centre of a wide image", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 300, 100))
+		for y := 0; y < 100; y++ {
+			for x := 0; x < 300; x++ {
+				c := color.RGBA{R: 255, A: 255}
+				if x >= 100 && x < 200 {
+					c = color.RGBA{B: 255, A: 255}
+				}
+				src.SetRGBA(x, y, c)
+			}
+		}
+
+		thumb := SquareThumbnail(src, 50)
+		assert.Equal(t, image.Rect(0, 0, 50, 50), thumb.Bounds())
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, thumb.RGBAAt(0, 0))
+		assert.Equal(t, color.RGBA{B: 255, A: 255}, thumb.RGBAAt(49, 49))
+	})
+
+	t.Run("averages pixels when downscaling", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+		src.SetRGBA(0, 0, color.RGBA{A: 255})
+		src.SetRGBA(1, 0, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+		src.SetRGBA(0, 1, color.RGBA{A: 255})
+		src.SetRGBA(1, 1, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+		thumb := SquareThumbnail(src, 1)
+		assert.Equal(t, color.RGBA{R: 127, G: 127, B: 127, A: 255}, thumb.RGBAAt(0, 0))
+	})
+
+	t.Run("upscales small images", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+		thumb := SquareThumbnail(src, 64)
+		assert.Equal(t, 64, thumb.Bounds().Dx())
+	})
+
+	t.Run("flattens transparency onto white", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+		thumb := SquareThumbnail(src, 2)
+		assert.Equal(t, color.RGBA{R: 255, G: 255, B: 255, A: 255}, thumb.RGBAAt(0, 0))
+	})
+}
+
+func TestScale(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 120, 80))
+	for y := 0; y < 80; y++ {
+		for x := 0; x < 120; x++ {
+			src.SetRGBA(x, y, color.RGBA{G: uint8(x), A: 255})
+		}
+	}
+
+	square := CropSquare(src)
+	require.Equal(t, image.Rect(0, 0, 80, 80), square.Bounds())
+
+	for _, size := range []int{16, 40} {
+		assert.Equal(t, SquareThumbnail(src, size).Pix, Scale(square, size).Pix)
+	}
+}
+
+func TestEncodeJPEG(t *testing.T) {
+	data, err := EncodeJPEG(image.NewRGBA(image.Rect(0, 0, 8, 8)), 85)
+	require.NoError(t, err)
+
+	_, format, err := Decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+}
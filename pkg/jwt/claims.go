@@ -8,17 +8,35 @@ import (
 )
 
 type JWTClaims struct {
-	UserID      uuid.UUID  `json:"user_id"`
-	Email       string     `json:"email"`
-	TenantID    *uuid.UUID `json:"tenant_id,omitempty"`
-	ProductID   *uuid.UUID `json:"product_id,omitempty"`
-	Roles       []string   `json:"roles"`
-	Permissions []string   `json:"permissions,omitempty"`
-	BranchID    *uuid.UUID `json:"branch_id,omitempty"`
-	SessionID   uuid.UUID  `json:"session_id"`
+	UserID      uuid.UUID   `json:"user_id"`
+	Email       string      `json:"email"`
+	TenantID    *uuid.UUID  `json:"tenant_id,omitempty"`
+	ProductID   *uuid.UUID  `json:"product_id,omitempty"`
+	ProductCode string      `json:"product_code,omitempty"`
+	Roles       []string    `json:"roles"`
+	Permissions []string    `json:"permissions,omitempty"`
+	Scopes      Scopes      `json:"scopes,omitempty"`
+	BranchID    *uuid.UUID  `json:"branch_id,omitempty"`
+	SessionID   uuid.UUID   `json:"session_id"`
+	Actor       *ActorClaim `json:"act,omitempty"`
+	Scope       string      `json:"scope,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// Restricted token scopes. A token carrying one of them is only accepted by
+// the endpoints that resolve the restriction.
+const (
+	ScopePasswordChange = "password_change"
+	ScopeConsent        = "consent"
+)
+
+// ActorClaim is the RFC 8693 "act" claim. It identifies the admin acting on
+// behalf of the token subject during impersonation.
+type ActorClaim struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email,omitempty"`
+}
+
 func (c *JWTClaims) IsExpired() bool {
 	if c.ExpiresAt == nil {
 		return false
@@ -85,6 +103,22 @@ func (c *JWTClaims) HasPermission(permissionCode string) bool {
 	return false
 }
 
+func (c *JWTClaims) IsImpersonated() bool {
+	return c.Actor != nil
+}
+
+func (c *JWTClaims) IsPasswordChangeOnly() bool {
+	return c.Scope == ScopePasswordChange
+}
+
+func (c *JWTClaims) IsConsentOnly() bool {
+	return c.Scope == ScopeConsent
+}
+
+func (c *JWTClaims) IsRestricted() bool {
+	return c.Scope != ""
+}
+
 func (c *JWTClaims) HasAudience(audience string) bool {
 	for _, aud := range c.Audience {
 		if aud == audience {
@@ -99,11 +133,24 @@ type ProductClaim struct {
 	ProductCode string    `json:"product_code"`
 	Roles       []string  `json:"roles,omitempty"`
 	Permissions []string  `json:"permissions,omitempty"`
+	Scopes      Scopes    `json:"scopes,omitempty"`
+}
+
+// PermissionScope limits a permission to the listed branches ("branch") or to
+// resources owned by the subject ("self").
+type PermissionScope struct {
+	Level     string      `json:"level"`
+	BranchIDs []uuid.UUID `json:"branch_ids,omitempty"`
 }
 
+// Scopes maps permission codes to their scope. Permissions without an entry
+// are granted tenant wide.
+type Scopes map[string]PermissionScope
+
 type TenantClaim struct {
-	TenantID uuid.UUID      `json:"tenant_id"`
-	Products []ProductClaim `json:"products,omitempty"`
+	TenantID          uuid.UUID      `json:"tenant_id"`
+	RegistrationTypes []string       `json:"registration_types,omitempty"`
+	Products          []ProductClaim `json:"products,omitempty"`
 }
 
 type MultiTenantClaims struct {
@@ -111,6 +158,7 @@ type MultiTenantClaims struct {
 	Email     string        `json:"email"`
 	Tenants   []TenantClaim `json:"tenants,omitempty"`
 	SessionID uuid.UUID     `json:"session_id"`
+	Actor     *ActorClaim   `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -121,6 +169,10 @@ func (c *MultiTenantClaims) IsExpired() bool {
 	return c.ExpiresAt.Before(time.Now())
 }
 
+func (c *MultiTenantClaims) IsImpersonated() bool {
+	return c.Actor != nil
+}
+
 func (c *MultiTenantClaims) HasTenant(tenantID uuid.UUID) bool {
 	for _, t := range c.Tenants {
 		if t.TenantID == tenantID {
@@ -1,7 +1,7 @@
 package jwt
 
 import (
-	"crypto/rsa"
+	"crypto"
 	"fmt"
 	"time"
 
@@ -15,8 +15,10 @@ type TokenConfig struct {
 	AccessSecret  string
 	RefreshSecret string
 
-	PrivateKey *rsa.PrivateKey
-	PublicKey  *rsa.PublicKey
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+
+	KeyRing *KeyRing
 
 	AccessExpiry  time.Duration
 	RefreshExpiry time.Duration
@@ -58,21 +60,55 @@ func GenerateAccessToken(
 		},
 	}
 
-	var token *jwt.Token
-	var signingKey interface{}
+	tokenString, err := sign(claims, config.AccessSecret, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return tokenString, nil
+}
 
-	if config.SigningMethod == "RS256" {
-		token = jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-		signingKey = config.PrivateKey
-	} else {
+// GenerateProductAccessToken issues a single tenant+product token carrying
+// only that product's roles and permissions. Audience and expiry come from
+// config, so callers narrow them on a copy before issuing.
+func GenerateProductAccessToken(
+	userID uuid.UUID,
+	email string,
+	tenantID uuid.UUID,
+	product ProductClaim,
+	sessionID uuid.UUID,
+	config *TokenConfig,
+) (string, error) {
+	now := time.Now()
+	expiresAt := now.Add(config.AccessExpiry)
 
-		token = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-		signingKey = []byte(config.AccessSecret)
+	claims := &JWTClaims{
+		UserID:      userID,
+		Email:       email,
+		TenantID:    &tenantID,
+		ProductID:   &product.ProductID,
+		ProductCode: product.ProductCode,
+		Roles:       product.Roles,
+		Permissions: product.Permissions,
+		Scopes:      product.Scopes,
+		SessionID:   sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID.String(),
+			Issuer:    config.Issuer,
+			Audience:  config.Audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+	if claims.Roles == nil {
+		claims.Roles = []string{}
 	}
 
-	tokenString, err := token.SignedString(signingKey)
+	tokenString, err := sign(claims, config.AccessSecret, config)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign token: %w", err)
+		return "", fmt.Errorf("failed to sign product token: %w", err)
 	}
 
 	return tokenString, nil
@@ -104,25 +140,111 @@ func GenerateMultiTenantAccessToken(
 		},
 	}
 
-	var token *jwt.Token
-	var signingKey interface{}
+	tokenString, err := sign(claims, config.AccessSecret, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign multi-tenant token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// GenerateImpersonationAccessToken issues a multi-tenant token for the
+// impersonated user carrying an "act" claim that names the real admin.
+func GenerateImpersonationAccessToken(
+	userID uuid.UUID,
+	email string,
+	tenants []TenantClaim,
+	sessionID uuid.UUID,
+	actor ActorClaim,
+	config *TokenConfig,
+) (string, error) {
+	now := time.Now()
+	expiresAt := now.Add(config.AccessExpiry)
+
+	claims := &MultiTenantClaims{
+		UserID:    userID,
+		Email:     email,
+		Tenants:   tenants,
+		SessionID: sessionID,
+		Actor:     &actor,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID.String(),
+			Issuer:    config.Issuer,
+			Audience:  config.Audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
 
-	if config.SigningMethod == "RS256" {
-		token = jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-		signingKey = config.PrivateKey
-	} else {
-		token = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-		signingKey = []byte(config.AccessSecret)
+	tokenString, err := sign(claims, config.AccessSecret, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign impersonation token: %w", err)
 	}
 
-	tokenString, err := token.SignedString(signingKey)
+	return tokenString, nil
+}
+
+// GeneratePasswordChangeToken issues a restricted token without tenant, role
+// or permission claims. It only lets the user change their password.
+func GeneratePasswordChangeToken(
+	userID uuid.UUID,
+	email string,
+	sessionID uuid.UUID,
+	config *TokenConfig,
+) (string, error) {
+	tokenString, err := generateRestrictedToken(userID, email, sessionID, ScopePasswordChange, config)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign multi-tenant token: %w", err)
+		return "", fmt.Errorf("failed to sign password change token: %w", err)
 	}
+	return tokenString, nil
+}
 
+// GenerateConsentToken issues a restricted token that only lets the user
+// review and accept the consent documents they have not accepted yet.
+func GenerateConsentToken(
+	userID uuid.UUID,
+	email string,
+	sessionID uuid.UUID,
+	config *TokenConfig,
+) (string, error) {
+	tokenString, err := generateRestrictedToken(userID, email, sessionID, ScopeConsent, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign consent token: %w", err)
+	}
 	return tokenString, nil
 }
 
+func generateRestrictedToken(
+	userID uuid.UUID,
+	email string,
+	sessionID uuid.UUID,
+	scope string,
+	config *TokenConfig,
+) (string, error) {
+	now := time.Now()
+	expiresAt := now.Add(config.AccessExpiry)
+
+	claims := &JWTClaims{
+		UserID:    userID,
+		Email:     email,
+		SessionID: sessionID,
+		Scope:     scope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Subject:   userID.String(),
+			Issuer:    config.Issuer,
+			Audience:  config.Audience,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			NotBefore: jwt.NewNumericDate(now),
+		},
+	}
+
+	return sign(claims, config.AccessSecret, config)
+}
+
 func GenerateRefreshToken(
 	userID uuid.UUID,
 	sessionID uuid.UUID,
@@ -141,22 +263,25 @@ func GenerateRefreshToken(
 		ID:        sessionID.String(),
 	}
 
-	var token *jwt.Token
-	var signingKey interface{}
+	tokenString, err := sign(claims, config.RefreshSecret, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign refresh token: %w", err)
+	}
 
-	if config.SigningMethod == "RS256" {
-		token = jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-		signingKey = config.PrivateKey
-	} else {
+	return tokenString, nil
+}
 
-		token = jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-		signingKey = []byte(config.RefreshSecret)
+// sign issues the token with the configured algorithm. Asymmetric methods sign
+// with the key ring's active key and stamp its kid, or with the static private
+// key when no key ring is configured; HS256 uses hmacSecret.
+func sign(claims jwt.Claims, hmacSecret string, config *TokenConfig) (string, error) {
+	if !IsAsymmetric(config.SigningMethod) {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(hmacSecret))
 	}
 
-	tokenString, err := token.SignedString(signingKey)
+	token, signingKey, err := newAsymmetricToken(claims, config)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign refresh token: %w", err)
+		return "", fmt.Errorf("failed to resolve signing key: %w", err)
 	}
-
-	return tokenString, nil
+	return token.SignedString(signingKey)
 }
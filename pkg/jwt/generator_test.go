@@ -142,3 +142,115 @@ func TestGenerateMultiTenantAccessToken_JTI(t *testing.T) {
 	_, err = uuid.Parse(claims.RegisteredClaims.ID)
 	assert.NoError(t, err, "JTI should be a valid UUID")
 }
+
+func TestGenerateProductAccessToken(t *testing.T) {
+	config := &TokenConfig{
+		SigningMethod: "HS256",
+		AccessSecret:  "test-secret",
+		AccessExpiry:  5 * time.Minute,
+		Issuer:        "iam-service",
+		Audience:      []string{"payroll-service"},
+	}
+
+	userID := uuid.New()
+	tenantID := uuid.New()
+	product := ProductClaim{
+		ProductID:   uuid.New(),
+		ProductCode: "PAYROLL",
+		Roles:       []string{"viewer"},
+		Permissions: []string{"payroll:read"},
+	}
+
+	token, err := GenerateProductAccessToken(userID, "test@example.com", tenantID, product, uuid.New(), config)
+	require.NoError(t, err)
+
+	claims, err := ParseAccessToken(token, config)
+	require.NoError(t, err)
+
+	assert.Equal(t, tenantID, claims.GetTenantID())
+	assert.Equal(t, product.ProductID, claims.GetProductID())
+	assert.Equal(t, "PAYROLL", claims.ProductCode)
+	assert.Equal(t, []string{"viewer"}, claims.Roles)
+	assert.Equal(t, []string{"payroll:read"}, claims.Permissions)
+	assert.True(t, claims.HasAudience("payroll-service"))
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), claims.ExpiresAt.Time, 5*time.Second)
+}
+
+func TestGenerateImpersonationAccessToken(t *testing.T) {
+	config := &TokenConfig{
+		SigningMethod: "HS256",
+		AccessSecret:  "test-secret",
+		AccessExpiry:  15 * time.Minute,
+		Issuer:        "iam-service",
+		Audience:      []string{"iam-service"},
+	}
+
+	userID := uuid.New()
+	adminID := uuid.New()
+
+	token, err := GenerateImpersonationAccessToken(
+		userID,
+		"participant@example.com",
+		[]TenantClaim{{TenantID: uuid.New()}},
+		uuid.New(),
+		ActorClaim{Subject: adminID.String(), Email: "admin@example.com"},
+		config,
+	)
+	require.NoError(t, err)
+
+	claims, err := ParseMultiTenantAccessToken(token, config)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+	require.True(t, claims.IsImpersonated())
+	assert.Equal(t, adminID.String(), claims.Actor.Subject)
+	assert.Equal(t, "admin@example.com", claims.Actor.Email)
+}
+
+func TestGeneratePasswordChangeToken(t *testing.T) {
+	config := &TokenConfig{
+		SigningMethod: "HS256",
+		AccessSecret:  "test-secret",
+		AccessExpiry:  10 * time.Minute,
+		Issuer:        "iam-service",
+		Audience:      []string{"iam-service"},
+	}
+
+	userID := uuid.New()
+
+	token, err := GeneratePasswordChangeToken(userID, "user@example.com", uuid.New(), config)
+	require.NoError(t, err)
+
+	claims, err := ParseAccessToken(token, config)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+	assert.True(t, claims.IsPasswordChangeOnly())
+	assert.Empty(t, claims.Roles)
+	assert.Nil(t, claims.TenantID)
+
+	multiClaims, err := ParseMultiTenantAccessToken(token, config)
+	require.NoError(t, err)
+	assert.Empty(t, multiClaims.Tenants)
+}
+
+func TestGenerateConsentToken(t *testing.T) {
+	config := &TokenConfig{
+		SigningMethod: "HS256",
+		AccessSecret:  "test-secret",
+		AccessExpiry:  10 * time.Minute,
+		Issuer:        "iam-service",
+		Audience:      []string{"iam-service"},
+	}
+
+	userID := uuid.New()
+
+	token, err := GenerateConsentToken(userID, "user@example.com", uuid.New(), config)
+	require.NoError(t, err)
+
+	claims, err := ParseAccessToken(token, config)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+	assert.True(t, claims.IsConsentOnly())
+	assert.True(t, claims.IsRestricted())
+	assert.False(t, claims.IsPasswordChangeOnly())
+	assert.Empty(t, claims.Roles)
+}
@@ -0,0 +1,525 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type KeyStatus string
+
+const (
+	KeyStatusNext     KeyStatus = "next"
+	KeyStatusActive   KeyStatus = "active"
+	KeyStatusPrevious KeyStatus = "previous"
+	KeyStatusRetired  KeyStatus = "retired"
+)
+
+const (
+	signingKeyBits = 2048
+
+	// minForcedReloadGap bounds how often an unknown kid can force a store
+	// reload, so garbage kids cannot be used to hammer the key store.
+	minForcedReloadGap = 10 * time.Second
+)
+
+var (
+	ErrNoActiveKey     = errors.New("key ring has no active signing key")
+	ErrUnknownKeyID    = errors.New("unknown signing key id")
+	ErrKeyRingReadOnly = errors.New("key ring store is read-only")
+)
+
+// SigningKey moves through next -> active -> previous -> retired. Next and
+// previous keys are published so verifiers can pick up a key before it signs
+// anything and keep accepting it until its tokens have expired. Retired keys
+// are no longer published but still verify refresh tokens locally until the
+// ring's retention elapses.
+//
+// Keys held outside the process set Signer instead of PrivateKey.
+type SigningKey struct {
+	Kid         string
+	Algorithm   string
+	Status      KeyStatus
+	PrivateKey  crypto.Signer
+	Signer      crypto.Signer
+	PublicKey   crypto.PublicKey
+	CreatedAt   time.Time
+	ActivatedAt *time.Time
+	RetiredAt   *time.Time
+}
+
+func (k *SigningKey) IsPublished() bool {
+	return k.Status != KeyStatusRetired
+}
+
+type KeyStore interface {
+	LoadKeys() ([]*SigningKey, error)
+	SaveKeys(keys []*SigningKey) error
+}
+
+// RotatingKeyStore is implemented by stores that manage key versions
+// themselves, such as Vault Transit. The ring delegates Rotate to them.
+type RotatingKeyStore interface {
+	KeyStore
+	RotateKeys() error
+}
+
+type KeyRingOptions struct {
+	// Algorithm is used for newly generated keys; it defaults to RS256.
+	Algorithm       string
+	RefreshInterval time.Duration
+	Retention       time.Duration
+	Seed            crypto.Signer
+	// ForcedReloadGap overrides how soon after a load an unknown kid may
+	// trigger another one. It defaults to minForcedReloadGap.
+	ForcedReloadGap time.Duration
+}
+
+type KeyRing struct {
+	store           KeyStore
+	algorithm       string
+	refreshInterval time.Duration
+	retention       time.Duration
+	forcedReloadGap time.Duration
+
+	mu       sync.RWMutex
+	keys     []*SigningKey
+	loadedAt time.Time
+}
+
+func NewKeyRing(store KeyStore, opts KeyRingOptions) (*KeyRing, error) {
+	algorithm := opts.Algorithm
+	if algorithm == "" {
+		algorithm = SigningMethodRS256
+	}
+	if !IsAsymmetric(algorithm) {
+		return nil, fmt.Errorf("unsupported key ring algorithm %q", algorithm)
+	}
+
+	keys, err := store.LoadKeys()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing keys: %w", err)
+	}
+
+	if len(keys) == 0 {
+		keys, err = bootstrapKeys(algorithm, opts.Seed)
+		if err != nil {
+			return nil, err
+		}
+		if err := store.SaveKeys(keys); err != nil {
+			return nil, fmt.Errorf("failed to save signing keys: %w", err)
+		}
+	}
+
+	forcedReloadGap := opts.ForcedReloadGap
+	if forcedReloadGap <= 0 {
+		forcedReloadGap = minForcedReloadGap
+	}
+
+	return &KeyRing{
+		store:           store,
+		algorithm:       algorithm,
+		refreshInterval: opts.RefreshInterval,
+		retention:       opts.Retention,
+		forcedReloadGap: forcedReloadGap,
+		keys:            keys,
+		loadedAt:        time.Now(),
+	}, nil
+}
+
+func NewSigningKey(algorithm string, status KeyStatus) (*SigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+	switch algorithm {
+	case SigningMethodRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, signingKeyBits)
+	case SigningMethodES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case SigningMethodEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing method %q", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	return NewSigningKeyFromPrivate(privateKey, status)
+}
+
+func NewSigningKeyFromPrivate(privateKey crypto.Signer, status KeyStatus) (*SigningKey, error) {
+	publicKey := privateKey.Public()
+	algorithm, err := AlgorithmForKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	key := &SigningKey{
+		Kid:        KeyThumbprint(publicKey),
+		Algorithm:  algorithm,
+		Status:     status,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+		CreatedAt:  now,
+	}
+	if status == KeyStatusActive {
+		key.ActivatedAt = &now
+	}
+	return key, nil
+}
+
+func bootstrapKeys(algorithm string, seed crypto.Signer) ([]*SigningKey, error) {
+	var active *SigningKey
+	var err error
+	if seed != nil {
+		if seedAlgorithm, _ := AlgorithmForKey(seed.Public()); seedAlgorithm == algorithm {
+			active, err = NewSigningKeyFromPrivate(seed, KeyStatusActive)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if active == nil {
+		active, err = NewSigningKey(algorithm, KeyStatusActive)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	next, err := NewSigningKey(algorithm, KeyStatusNext)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*SigningKey{active, next}, nil
+}
+
+func (r *KeyRing) Active() (*SigningKey, error) {
+	r.refreshIfStale()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if key := activeKey(r.keys); key != nil {
+		return key, nil
+	}
+	return nil, ErrNoActiveKey
+}
+
+func (r *KeyRing) Key(kid string) (*SigningKey, error) {
+	r.refreshIfStale()
+
+	if key := r.find(kid); key != nil {
+		return key, nil
+	}
+
+	// Another instance may have rotated since our last refresh.
+	r.mu.RLock()
+	canReload := time.Since(r.loadedAt) >= r.forcedReloadGap
+	r.mu.RUnlock()
+	if canReload {
+		if err := r.reload(); err == nil {
+			if key := r.find(kid); key != nil {
+				return key, nil
+			}
+		}
+	}
+
+	return nil, ErrUnknownKeyID
+}
+
+func (r *KeyRing) PublishedKeys() []*SigningKey {
+	r.refreshIfStale()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]*SigningKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		if key.IsPublished() {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (r *KeyRing) Keys() []*SigningKey {
+	r.refreshIfStale()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	keys := make([]*SigningKey, len(r.keys))
+	copy(keys, r.keys)
+	return keys
+}
+
+func (r *KeyRing) NeedsRotation(interval time.Duration) bool {
+	if interval <= 0 {
+		return false
+	}
+	return rotationDue(r.Keys(), interval)
+}
+
+// Rotate promotes the next key to active, demotes the active key to previous,
+// retires the previous key, drops retired keys past retention and generates a
+// fresh next key. It returns the newly active key.
+func (r *KeyRing) Rotate() (*SigningKey, error) {
+	active, _, err := r.rotate(0)
+	return active, err
+}
+
+// RotateIfDue rotates only when the active key in the store is older than
+// interval. NeedsRotation answers from cached keys, so instances sharing a
+// store would otherwise all rotate on the same schedule and push the key
+// that is still signing out of the published set. It reports whether a
+// rotation happened.
+func (r *KeyRing) RotateIfDue(interval time.Duration) (*SigningKey, bool, error) {
+	if interval <= 0 {
+		return nil, false, nil
+	}
+	return r.rotate(interval)
+}
+
+// rotate holds the ring lock from loading the keys until the rotated set is
+// saved. A positive interval re-checks against the freshly loaded keys and
+// leaves them untouched when the rotation is no longer due.
+func (r *KeyRing) rotate(interval time.Duration) (*SigningKey, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, err := r.store.LoadKeys()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to load signing keys: %w", err)
+	}
+	now := time.Now()
+	if interval > 0 && len(current) > 0 && !rotationDue(current, interval) {
+		r.keys = current
+		r.loadedAt = now
+		return nil, false, nil
+	}
+
+	if store, ok := r.store.(RotatingKeyStore); ok {
+		if err := store.RotateKeys(); err != nil {
+			return nil, false, fmt.Errorf("failed to rotate signing keys: %w", err)
+		}
+		keys, err := r.store.LoadKeys()
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to load signing keys: %w", err)
+		}
+		r.keys = keys
+		r.loadedAt = time.Now()
+		active := activeKey(keys)
+		if active == nil {
+			return nil, false, ErrNoActiveKey
+		}
+		return active, true, nil
+	}
+
+	// A next key generated for a different algorithm never signed anything,
+	// so it is replaced when the configured signing method changes.
+	hasNext := false
+	filtered := current[:0]
+	for _, key := range current {
+		if key.Status == KeyStatusNext {
+			if key.Algorithm != r.algorithm {
+				continue
+			}
+			hasNext = true
+		}
+		filtered = append(filtered, key)
+	}
+	current = filtered
+	if !hasNext {
+		next, err := NewSigningKey(r.algorithm, KeyStatusNext)
+		if err != nil {
+			return nil, false, err
+		}
+		current = append(current, next)
+	}
+
+	rotated := make([]*SigningKey, 0, len(current)+1)
+	var active *SigningKey
+	for _, existing := range current {
+		key := *existing
+		switch key.Status {
+		case KeyStatusRetired:
+			if r.isExpired(&key, now) {
+				continue
+			}
+		case KeyStatusPrevious:
+			key.Status = KeyStatusRetired
+			key.RetiredAt = &now
+		case KeyStatusActive:
+			key.Status = KeyStatusPrevious
+		case KeyStatusNext:
+			key.Status = KeyStatusActive
+			key.ActivatedAt = &now
+			active = &key
+		}
+		rotated = append(rotated, &key)
+	}
+
+	next, err := NewSigningKey(r.algorithm, KeyStatusNext)
+	if err != nil {
+		return nil, false, err
+	}
+	rotated = append(rotated, next)
+
+	if err := r.store.SaveKeys(rotated); err != nil {
+		return nil, false, fmt.Errorf("failed to save signing keys: %w", err)
+	}
+
+	r.keys = rotated
+	r.loadedAt = now
+
+	return active, true, nil
+}
+
+func activeKey(keys []*SigningKey) *SigningKey {
+	for _, key := range keys {
+		if key.Status == KeyStatusActive {
+			return key
+		}
+	}
+	return nil
+}
+
+func rotationDue(keys []*SigningKey, interval time.Duration) bool {
+	active := activeKey(keys)
+	if active == nil {
+		return true
+	}
+
+	activatedAt := active.CreatedAt
+	if active.ActivatedAt != nil {
+		activatedAt = *active.ActivatedAt
+	}
+	return time.Since(activatedAt) >= interval
+}
+
+func (r *KeyRing) find(kid string) *SigningKey {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	for _, key := range r.keys {
+		if key.Kid == kid && !r.isExpired(key, now) {
+			return key
+		}
+	}
+	return nil
+}
+
+func (r *KeyRing) isExpired(key *SigningKey, now time.Time) bool {
+	if key.Status != KeyStatusRetired || key.RetiredAt == nil || r.retention <= 0 {
+		return false
+	}
+	return now.Sub(*key.RetiredAt) > r.retention
+}
+
+func (r *KeyRing) reload() error {
+	keys, err := r.store.LoadKeys()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loadedAt = time.Now()
+	if err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		r.keys = keys
+	}
+	return nil
+}
+
+func (r *KeyRing) refreshIfStale() {
+	if r.refreshInterval <= 0 {
+		return
+	}
+
+	r.mu.RLock()
+	stale := time.Since(r.loadedAt) >= r.refreshInterval
+	r.mu.RUnlock()
+
+	if stale {
+		// Keep serving the cached keys if the store is temporarily unavailable.
+		_ = r.reload()
+	}
+}
+
+func newAsymmetricToken(claims jwt.Claims, config *TokenConfig) (*jwt.Token, interface{}, error) {
+	if config.KeyRing == nil {
+		method, err := signingMethodFor(config.SigningMethod)
+		if err != nil {
+			return nil, nil, err
+		}
+		return jwt.NewWithClaims(method, claims), config.PrivateKey, nil
+	}
+
+	key, err := config.KeyRing.Active()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var method jwt.SigningMethod
+	var signingKey interface{}
+	if key.Signer != nil {
+		method, err = externalSigningMethodFor(key.Algorithm)
+		signingKey = key.Signer
+	} else {
+		method, err = signingMethodFor(key.Algorithm)
+		signingKey = key.PrivateKey
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.Kid
+	return token, signingKey, nil
+}
+
+func verificationKey(token *jwt.Token, config *TokenConfig) (interface{}, error) {
+	alg := token.Method.Alg()
+
+	if config.KeyRing == nil {
+		if alg != config.SigningMethod {
+			return nil, fmt.Errorf("unexpected signing method: %v (expected %s)", alg, config.SigningMethod)
+		}
+		return config.PublicKey, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	if kid != "" {
+		key, err := config.KeyRing.Key(kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != alg {
+			return nil, fmt.Errorf("unexpected signing method: %v (expected %s)", alg, key.Algorithm)
+		}
+		return key.PublicKey, nil
+	}
+
+	// Tokens issued before the key ring was introduced carry no kid.
+	if config.PublicKey != nil {
+		if keyAlg, _ := AlgorithmForKey(config.PublicKey); keyAlg != alg {
+			return nil, fmt.Errorf("unexpected signing method: %v (expected %s)", alg, keyAlg)
+		}
+		return config.PublicKey, nil
+	}
+	keySet := jwt.VerificationKeySet{}
+	for _, key := range config.KeyRing.PublishedKeys() {
+		if key.Algorithm == alg {
+			keySet.Keys = append(keySet.Keys, key.PublicKey)
+		}
+	}
+	return keySet, nil
+}
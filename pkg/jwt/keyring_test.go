@@ -0,0 +1,361 @@
+package jwt
+
+import (
+	"crypto"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyRing(t *testing.T) *KeyRing {
+	t.Helper()
+	ring, err := NewKeyRing(NewFileKeyStore(t.TempDir()), KeyRingOptions{Retention: time.Hour})
+	require.NoError(t, err)
+	return ring
+}
+
+func newKeyRingTokenConfig(ring *KeyRing) *TokenConfig {
+	return &TokenConfig{
+		SigningMethod: "RS256",
+		KeyRing:       ring,
+		AccessExpiry:  15 * time.Minute,
+		RefreshExpiry: time.Hour,
+		Issuer:        "iam-service",
+		Audience:      []string{"iam-service"},
+	}
+}
+
+func keyStatuses(ring *KeyRing) map[string]KeyStatus {
+	statuses := make(map[string]KeyStatus)
+	for _, key := range ring.Keys() {
+		statuses[key.Kid] = key.Status
+	}
+	return statuses
+}
+
+func TestKeyRing_Bootstrap(t *testing.T) {
+	ring := newTestKeyRing(t)
+
+	keys := ring.Keys()
+	require.Len(t, keys, 2)
+	assert.Equal(t, KeyStatusActive, keys[0].Status)
+	assert.Equal(t, KeyStatusNext, keys[1].Status)
+	assert.Len(t, ring.PublishedKeys(), 2)
+}
+
+func TestKeyRing_Rotate(t *testing.T) {
+	ring := newTestKeyRing(t)
+
+	first, err := ring.Active()
+	require.NoError(t, err)
+	second := ring.Keys()[1]
+
+	active, err := ring.Rotate()
+	require.NoError(t, err)
+	assert.Equal(t, second.Kid, active.Kid)
+
+	statuses := keyStatuses(ring)
+	assert.Equal(t, KeyStatusPrevious, statuses[first.Kid])
+	assert.Equal(t, KeyStatusActive, statuses[second.Kid])
+	assert.Len(t, statuses, 3)
+
+	_, err = ring.Rotate()
+	require.NoError(t, err)
+
+	statuses = keyStatuses(ring)
+	assert.Equal(t, KeyStatusRetired, statuses[first.Kid])
+	assert.Equal(t, KeyStatusPrevious, statuses[second.Kid])
+
+	for _, key := range ring.PublishedKeys() {
+		assert.NotEqual(t, first.Kid, key.Kid, "retired key must not be published")
+	}
+	_, err = ring.Key(first.Kid)
+	assert.NoError(t, err, "retired key should still verify within retention")
+}
+
+func TestKeyRing_TokensCarryKidAndSurviveRotation(t *testing.T) {
+	ring := newTestKeyRing(t)
+	config := newKeyRingTokenConfig(ring)
+
+	userID := uuid.New()
+	tenants := []TenantClaim{{TenantID: uuid.New()}}
+
+	token, err := GenerateMultiTenantAccessToken(userID, "test@example.com", tenants, uuid.New(), config)
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &MultiTenantClaims{})
+	require.NoError(t, err)
+	active, err := ring.Active()
+	require.NoError(t, err)
+	assert.Equal(t, active.Kid, parsed.Header["kid"])
+
+	_, err = ring.Rotate()
+	require.NoError(t, err)
+
+	claims, err := ParseMultiTenantAccessToken(token, config)
+	require.NoError(t, err)
+	assert.Equal(t, userID, claims.UserID)
+}
+
+func TestKeyRing_RotateIfDue_SharedStore(t *testing.T) {
+	store := NewFileKeyStore(t.TempDir())
+	_, err := NewKeyRing(store, KeyRingOptions{})
+	require.NoError(t, err)
+
+	keys, err := store.LoadKeys()
+	require.NoError(t, err)
+	activatedAt := time.Now().Add(-2 * time.Hour)
+	for _, key := range keys {
+		if key.Status == KeyStatusActive {
+			key.ActivatedAt = &activatedAt
+		}
+	}
+	require.NoError(t, store.SaveKeys(keys))
+
+	first, err := NewKeyRing(store, KeyRingOptions{})
+	require.NoError(t, err)
+	second, err := NewKeyRing(store, KeyRingOptions{})
+	require.NoError(t, err)
+	require.True(t, first.NeedsRotation(time.Hour))
+	require.True(t, second.NeedsRotation(time.Hour))
+
+	active, rotated, err := first.RotateIfDue(time.Hour)
+	require.NoError(t, err)
+	require.True(t, rotated)
+
+	_, rotated, err = second.RotateIfDue(time.Hour)
+	require.NoError(t, err)
+	assert.False(t, rotated, "rotation already done by another instance must not repeat")
+
+	current, err := second.Active()
+	require.NoError(t, err)
+	assert.Equal(t, active.Kid, current.Kid)
+	for _, key := range second.PublishedKeys() {
+		if key.Kid == active.Kid {
+			return
+		}
+	}
+	t.Fatal("signing key must stay published")
+}
+
+func TestKeyRing_UnknownKid(t *testing.T) {
+	config := newKeyRingTokenConfig(newTestKeyRing(t))
+	otherConfig := newKeyRingTokenConfig(newTestKeyRing(t))
+
+	token, err := GenerateRefreshToken(uuid.New(), uuid.New(), otherConfig)
+	require.NoError(t, err)
+
+	_, err = ParseRefreshToken(token, config)
+	assert.Error(t, err)
+}
+
+func TestFileKeyStore_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	ring, err := NewKeyRing(NewFileKeyStore(dir), KeyRingOptions{})
+	require.NoError(t, err)
+	_, err = ring.Rotate()
+	require.NoError(t, err)
+
+	reloaded, err := NewKeyRing(NewFileKeyStore(dir), KeyRingOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, keyStatuses(ring), keyStatuses(reloaded))
+}
+
+func TestStaticKeyStore_ReadOnly(t *testing.T) {
+	key, err := NewSigningKey(SigningMethodRS256, KeyStatusActive)
+	require.NoError(t, err)
+
+	store, err := NewStaticKeyStore(key.PrivateKey)
+	require.NoError(t, err)
+
+	ring, err := NewKeyRing(store, KeyRingOptions{})
+	require.NoError(t, err)
+
+	active, err := ring.Active()
+	require.NoError(t, err)
+	assert.Equal(t, KeyThumbprint(key.PublicKey), active.Kid)
+
+	_, err = ring.Rotate()
+	assert.ErrorIs(t, err, ErrKeyRingReadOnly)
+}
+
+type externalSigner struct {
+	key   crypto.Signer
+	calls int
+}
+
+func (s *externalSigner) Public() crypto.PublicKey {
+	return s.key.Public()
+}
+
+func (s *externalSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.calls++
+	return s.key.Sign(rand, digest, opts)
+}
+
+type externalKeyStore struct {
+	algorithm string
+	signers   []*externalSigner
+	rotatedAt time.Time
+}
+
+func (s *externalKeyStore) LoadKeys() ([]*SigningKey, error) {
+	keys := make([]*SigningKey, 0, len(s.signers))
+	for i, signer := range s.signers {
+		key := &SigningKey{
+			Kid:       KeyThumbprint(signer.Public()),
+			Algorithm: s.algorithm,
+			Status:    KeyStatusPrevious,
+			PublicKey: signer.Public(),
+		}
+		if i == len(s.signers)-1 {
+			key.Status = KeyStatusActive
+			key.Signer = signer
+			key.CreatedAt = s.rotatedAt
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (s *externalKeyStore) SaveKeys(keys []*SigningKey) error {
+	return ErrKeyRingReadOnly
+}
+
+func (s *externalKeyStore) RotateKeys() error {
+	key, err := NewSigningKey(s.algorithm, KeyStatusActive)
+	if err != nil {
+		return err
+	}
+	s.signers = append(s.signers, &externalSigner{key: key.PrivateKey})
+	s.rotatedAt = time.Now()
+	return nil
+}
+
+func TestKeyRing_RotateIfDue_RotatingStore(t *testing.T) {
+	store := &externalKeyStore{algorithm: SigningMethodRS256}
+	require.NoError(t, store.RotateKeys())
+	store.rotatedAt = time.Now().Add(-2 * time.Hour)
+	ring, err := NewKeyRing(store, KeyRingOptions{})
+	require.NoError(t, err)
+	require.True(t, ring.NeedsRotation(time.Hour))
+
+	// Another instance rotated the Transit key after this ring loaded.
+	require.NoError(t, store.RotateKeys())
+
+	_, rotated, err := ring.RotateIfDue(time.Hour)
+	require.NoError(t, err)
+	assert.False(t, rotated)
+	assert.Len(t, store.signers, 2)
+
+	store.rotatedAt = time.Now().Add(-2 * time.Hour)
+	active, rotated, err := ring.RotateIfDue(time.Hour)
+	require.NoError(t, err)
+	assert.True(t, rotated)
+	require.Len(t, store.signers, 3)
+	assert.Equal(t, KeyThumbprint(store.signers[2].Public()), active.Kid)
+}
+
+func TestKeyRing_ExternalSigner(t *testing.T) {
+	for _, algorithm := range []string{SigningMethodRS256, SigningMethodES256, SigningMethodEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			store := &externalKeyStore{algorithm: algorithm}
+			require.NoError(t, store.RotateKeys())
+
+			ring, err := NewKeyRing(store, KeyRingOptions{Algorithm: algorithm})
+			require.NoError(t, err)
+			config := newKeyRingTokenConfig(ring)
+
+			token, err := GenerateRefreshToken(uuid.New(), uuid.New(), config)
+			require.NoError(t, err)
+			assert.Equal(t, 1, store.signers[0].calls)
+
+			active, err := ring.Rotate()
+			require.NoError(t, err)
+			assert.Equal(t, KeyThumbprint(store.signers[1].Public()), active.Kid)
+			assert.Len(t, ring.PublishedKeys(), 2)
+
+			_, err = ParseRefreshToken(token, config)
+			assert.NoError(t, err, "token signed by the previous version should still verify")
+		})
+	}
+}
+
+func TestKeyRing_Algorithms(t *testing.T) {
+	for _, algorithm := range []string{SigningMethodRS256, SigningMethodES256, SigningMethodEdDSA} {
+		t.Run(algorithm, func(t *testing.T) {
+			ring, err := NewKeyRing(NewFileKeyStore(t.TempDir()), KeyRingOptions{Algorithm: algorithm})
+			require.NoError(t, err)
+			config := newKeyRingTokenConfig(ring)
+			config.SigningMethod = algorithm
+
+			token, err := GenerateMultiTenantAccessToken(uuid.New(), "test@example.com", nil, uuid.New(), config)
+			require.NoError(t, err)
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &MultiTenantClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, parsed.Method.Alg())
+
+			_, err = ParseMultiTenantAccessToken(token, config)
+			require.NoError(t, err)
+
+			active, err := ring.Active()
+			require.NoError(t, err)
+			jwk, err := NewJWK(active.PublicKey, active.Kid)
+			require.NoError(t, err)
+			assert.Equal(t, algorithm, jwk.Alg)
+			assert.Equal(t, active.Kid, KeyThumbprint(active.PublicKey))
+
+			decoded, err := jwk.PublicKey()
+			require.NoError(t, err)
+			assert.Equal(t, active.Kid, KeyThumbprint(decoded))
+		})
+	}
+}
+
+func TestKeyRing_SwitchAlgorithmOnRotate(t *testing.T) {
+	dir := t.TempDir()
+	rsaRing, err := NewKeyRing(NewFileKeyStore(dir), KeyRingOptions{Algorithm: SigningMethodRS256})
+	require.NoError(t, err)
+	rsaConfig := newKeyRingTokenConfig(rsaRing)
+	token, err := GenerateRefreshToken(uuid.New(), uuid.New(), rsaConfig)
+	require.NoError(t, err)
+
+	ecRing, err := NewKeyRing(NewFileKeyStore(dir), KeyRingOptions{Algorithm: SigningMethodES256})
+	require.NoError(t, err)
+	active, err := ecRing.Rotate()
+	require.NoError(t, err)
+	assert.Equal(t, SigningMethodES256, active.Algorithm)
+
+	ecConfig := newKeyRingTokenConfig(ecRing)
+	ecConfig.SigningMethod = SigningMethodES256
+	_, err = ParseRefreshToken(token, ecConfig)
+	assert.NoError(t, err, "RS256 tokens should verify against the previous key after switching")
+}
+
+func TestParse_RejectsAlgorithmMismatch(t *testing.T) {
+	key, err := NewSigningKey(SigningMethodES256, KeyStatusActive)
+	require.NoError(t, err)
+
+	config := &TokenConfig{
+		SigningMethod: SigningMethodES256,
+		PrivateKey:    key.PrivateKey,
+		PublicKey:     key.PublicKey,
+		RefreshExpiry: time.Hour,
+		Issuer:        "iam-service",
+	}
+	token, err := GenerateRefreshToken(uuid.New(), uuid.New(), config)
+	require.NoError(t, err)
+
+	_, err = ParseRefreshToken(token, config)
+	require.NoError(t, err)
+
+	config.SigningMethod = SigningMethodEdDSA
+	_, err = ParseRefreshToken(token, config)
+	assert.Error(t, err)
+}
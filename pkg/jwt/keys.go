@@ -1,6 +1,10 @@
 package jwt
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -8,7 +12,40 @@ import (
 	"os"
 )
 
-func LoadPrivateKeyFromFile(path string) (*rsa.PrivateKey, error) {
+const (
+	SigningMethodHS256 = "HS256"
+	SigningMethodRS256 = "RS256"
+	SigningMethodES256 = "ES256"
+	SigningMethodEdDSA = "EdDSA"
+)
+
+// IsAsymmetric reports whether the signing method uses a public/private key
+// pair, i.e. anything other than the shared-secret HS256.
+func IsAsymmetric(signingMethod string) bool {
+	switch signingMethod {
+	case SigningMethodRS256, SigningMethodES256, SigningMethodEdDSA:
+		return true
+	}
+	return false
+}
+
+// AlgorithmForKey returns the JWS algorithm used with the given public key.
+func AlgorithmForKey(publicKey crypto.PublicKey) (string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return SigningMethodRS256, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return "", fmt.Errorf("unsupported EC curve %s (only P-256 is supported)", key.Curve.Params().Name)
+		}
+		return SigningMethodES256, nil
+	case ed25519.PublicKey:
+		return SigningMethodEdDSA, nil
+	}
+	return "", fmt.Errorf("unsupported public key type %T", publicKey)
+}
+
+func LoadPrivateKeyFromFile(path string) (crypto.Signer, error) {
 	keyData, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read private key file: %w", err)
@@ -19,29 +56,34 @@ func LoadPrivateKeyFromFile(path string) (*rsa.PrivateKey, error) {
 		return nil, fmt.Errorf("failed to decode PEM block from private key")
 	}
 
-	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
-	if err != nil {
-
-		key, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
-		if err2 != nil {
-			return nil, fmt.Errorf("failed to parse private key (tried PKCS1 and PKCS8): %w", err)
-		}
-		var ok bool
-		privateKey, ok = key.(*rsa.PrivateKey)
-		if !ok {
-			return nil, fmt.Errorf("not an RSA private key")
-		}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+	if privateKey, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return checkSigningKey(privateKey)
 	}
 
-	return privateKey, nil
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key (tried PKCS1, SEC1 and PKCS8): %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return checkSigningKey(signer)
 }
 
-func LoadPublicKeyFromFile(path string) (*rsa.PublicKey, error) {
+func LoadPublicKeyFromFile(path string) (crypto.PublicKey, error) {
 	keyData, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read public key file: %w", err)
 	}
 
+	return ParsePublicKeyPEM(keyData)
+}
+
+func ParsePublicKeyPEM(keyData []byte) (crypto.PublicKey, error) {
 	block, _ := pem.Decode(keyData)
 	if block == nil {
 		return nil, fmt.Errorf("failed to decode PEM block from public key")
@@ -52,10 +94,24 @@ func LoadPublicKeyFromFile(path string) (*rsa.PublicKey, error) {
 		return nil, fmt.Errorf("failed to parse public key: %w", err)
 	}
 
-	rsaPublicKey, ok := publicKey.(*rsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("not an RSA public key")
+	if _, err := AlgorithmForKey(publicKey); err != nil {
+		return nil, err
 	}
 
-	return rsaPublicKey, nil
+	return publicKey, nil
+}
+
+func EncodePublicKeyPEM(publicKey crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+func checkSigningKey(signer crypto.Signer) (crypto.Signer, error) {
+	if _, err := AlgorithmForKey(signer.Public()); err != nil {
+		return nil, err
+	}
+	return signer, nil
 }
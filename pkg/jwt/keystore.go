@@ -0,0 +1,158 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const keyRingManifestFile = "keyring.json"
+
+type keyManifestEntry struct {
+	Kid         string     `json:"kid"`
+	Status      KeyStatus  `json:"status"`
+	CreatedAt   time.Time  `json:"created_at"`
+	ActivatedAt *time.Time `json:"activated_at,omitempty"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+}
+
+// FileKeyStore keeps each private key as <kid>.pem next to a keyring.json
+// manifest holding the key states.
+type FileKeyStore struct {
+	Dir string
+}
+
+func NewFileKeyStore(dir string) *FileKeyStore {
+	return &FileKeyStore{Dir: dir}
+}
+
+func (s *FileKeyStore) LoadKeys() ([]*SigningKey, error) {
+	data, err := os.ReadFile(filepath.Join(s.Dir, keyRingManifestFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read key ring manifest: %w", err)
+	}
+
+	var entries []keyManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse key ring manifest: %w", err)
+	}
+
+	keys := make([]*SigningKey, 0, len(entries))
+	for _, entry := range entries {
+		privateKey, err := LoadPrivateKeyFromFile(s.keyPath(entry.Kid))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing key %s: %w", entry.Kid, err)
+		}
+		key, err := NewSigningKeyFromPrivate(privateKey, entry.Status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load signing key %s: %w", entry.Kid, err)
+		}
+		key.Kid = entry.Kid
+		key.CreatedAt = entry.CreatedAt
+		key.ActivatedAt = entry.ActivatedAt
+		key.RetiredAt = entry.RetiredAt
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (s *FileKeyStore) SaveKeys(keys []*SigningKey) error {
+	if err := os.MkdirAll(s.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create key directory: %w", err)
+	}
+
+	kept := make(map[string]bool, len(keys))
+	entries := make([]keyManifestEntry, 0, len(keys))
+	for _, key := range keys {
+		path := s.keyPath(key.Kid)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if err := writePrivateKeyFile(path, key.PrivateKey); err != nil {
+				return err
+			}
+		}
+		kept[key.Kid] = true
+		entries = append(entries, keyManifestEntry{
+			Kid:         key.Kid,
+			Status:      key.Status,
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+		})
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode key ring manifest: %w", err)
+	}
+
+	manifestPath := filepath.Join(s.Dir, keyRingManifestFile)
+	tmpPath := manifestPath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write key ring manifest: %w", err)
+	}
+	if err := os.Rename(tmpPath, manifestPath); err != nil {
+		return fmt.Errorf("failed to write key ring manifest: %w", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(s.Dir, "*.pem"))
+	if err != nil {
+		return nil
+	}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		if !kept[kid] {
+			_ = os.Remove(file)
+		}
+	}
+
+	return nil
+}
+
+func (s *FileKeyStore) keyPath(kid string) string {
+	return filepath.Join(s.Dir, kid+".pem")
+}
+
+func writePrivateKeyFile(path string, privateKey crypto.Signer) error {
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return fmt.Errorf("failed to encode private key: %w", err)
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write private key file: %w", err)
+	}
+	return nil
+}
+
+// StaticKeyStore serves a single fixed key pair, e.g. the legacy
+// private_key_path/public_key_path configuration. It cannot be rotated.
+type StaticKeyStore struct {
+	key *SigningKey
+}
+
+func NewStaticKeyStore(privateKey crypto.Signer) (*StaticKeyStore, error) {
+	key, err := NewSigningKeyFromPrivate(privateKey, KeyStatusActive)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticKeyStore{key: key}, nil
+}
+
+func (s *StaticKeyStore) LoadKeys() ([]*SigningKey, error) {
+	return []*SigningKey{s.key}, nil
+}
+
+func (s *StaticKeyStore) SaveKeys(keys []*SigningKey) error {
+	return ErrKeyRingReadOnly
+}
@@ -9,11 +9,8 @@ import (
 
 func ParseAccessToken(tokenString string, config *TokenConfig) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if config.SigningMethod == "RS256" {
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v (expected RS256)", token.Header["alg"])
-			}
-			return config.PublicKey, nil
+		if IsAsymmetric(config.SigningMethod) {
+			return verificationKey(token, config)
 		} else {
 
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -53,11 +50,8 @@ func ParseAccessToken(tokenString string, config *TokenConfig) (*JWTClaims, erro
 
 func ParseMultiTenantAccessToken(tokenString string, config *TokenConfig) (*MultiTenantClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &MultiTenantClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if config.SigningMethod == "RS256" {
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v (expected RS256)", token.Header["alg"])
-			}
-			return config.PublicKey, nil
+		if IsAsymmetric(config.SigningMethod) {
+			return verificationKey(token, config)
 		} else {
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 				return nil, fmt.Errorf("unexpected signing method: %v (expected HS256)", token.Header["alg"])
@@ -96,11 +90,8 @@ func ParseMultiTenantAccessToken(tokenString string, config *TokenConfig) (*Mult
 
 func ParseRefreshToken(tokenString string, config *TokenConfig) (*jwt.RegisteredClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if config.SigningMethod == "RS256" {
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v (expected RS256)", token.Header["alg"])
-			}
-			return config.PublicKey, nil
+		if IsAsymmetric(config.SigningMethod) {
+			return verificationKey(token, config)
 		} else {
 
 			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
@@ -0,0 +1,96 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signingMethodSigner signs tokens through a crypto.Signer so the private key
+// can live outside the process (e.g. in Vault Transit). It is only used for
+// issuing; verification goes through the standard method for the algorithm.
+type signingMethodSigner struct {
+	verifier jwt.SigningMethod
+}
+
+var (
+	SigningMethodRS256Signer jwt.SigningMethod = &signingMethodSigner{verifier: jwt.SigningMethodRS256}
+	SigningMethodES256Signer jwt.SigningMethod = &signingMethodSigner{verifier: jwt.SigningMethodES256}
+	SigningMethodEdDSASigner jwt.SigningMethod = &signingMethodSigner{verifier: jwt.SigningMethodEdDSA}
+)
+
+func (m *signingMethodSigner) Alg() string {
+	return m.verifier.Alg()
+}
+
+func (m *signingMethodSigner) Sign(signingString string, key interface{}) ([]byte, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s signer: %w", m.Alg(), jwt.ErrInvalidKeyType)
+	}
+
+	if m.Alg() == SigningMethodEdDSA {
+		return signer.Sign(rand.Reader, []byte(signingString), crypto.Hash(0))
+	}
+
+	digest := sha256.Sum256([]byte(signingString))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.Alg() == SigningMethodES256 {
+		return ecdsaSignatureToJWS(signature)
+	}
+	return signature, nil
+}
+
+func (m *signingMethodSigner) Verify(signingString string, sig []byte, key interface{}) error {
+	return m.verifier.Verify(signingString, sig, key)
+}
+
+// ecdsaSignatureToJWS converts the ASN.1 DER signature returned by
+// crypto.Signer into the fixed-size r||s form required by RFC 7518.
+func ecdsaSignatureToJWS(der []byte) ([]byte, error) {
+	var parsed struct {
+		R, S *big.Int
+	}
+	if _, err := asn1.Unmarshal(der, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to decode ECDSA signature: %w", err)
+	}
+
+	const size = 32
+	signature := make([]byte, 2*size)
+	parsed.R.FillBytes(signature[:size])
+	parsed.S.FillBytes(signature[size:])
+	return signature, nil
+}
+
+func signingMethodFor(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case SigningMethodRS256:
+		return jwt.SigningMethodRS256, nil
+	case SigningMethodES256:
+		return jwt.SigningMethodES256, nil
+	case SigningMethodEdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	}
+	return nil, fmt.Errorf("unsupported signing method %q", alg)
+}
+
+func externalSigningMethodFor(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case SigningMethodRS256:
+		return SigningMethodRS256Signer, nil
+	case SigningMethodES256:
+		return SigningMethodES256Signer, nil
+	case SigningMethodEdDSA:
+		return SigningMethodEdDSASigner, nil
+	}
+	return nil, fmt.Errorf("unsupported signing method %q", alg)
+}
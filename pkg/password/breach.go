@@ -0,0 +1,157 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"iam-service/pkg/errors"
+)
+
+type BreachMode string
+
+const (
+	BreachModeOff   BreachMode = "OFF"
+	BreachModeWarn  BreachMode = "WARN"
+	BreachModeBlock BreachMode = "BLOCK"
+)
+
+const BreachedPasswordWarning = "This password has appeared in a known data breach"
+
+type BreachChecker interface {
+	IsBreached(password string) bool
+}
+
+type NoopBreachChecker struct{}
+
+func (NoopBreachChecker) IsBreached(string) bool {
+	return false
+}
+
+// SHA1PrefixSet holds the first 8 bytes of each breached password's SHA-1,
+// sorted for binary search. At 8 bytes per entry the false-positive rate is
+// negligible for any realistic corpus.
+type SHA1PrefixSet struct {
+	prefixes []uint64
+}
+
+// NewSHA1PrefixSet takes ownership of prefixes and sorts it in place; the
+// dataset can hold hundreds of millions of entries, so it is not copied.
+func NewSHA1PrefixSet(prefixes []uint64) *SHA1PrefixSet {
+	slices.Sort(prefixes)
+	return &SHA1PrefixSet{prefixes: slices.Compact(prefixes)}
+}
+
+// LoadSHA1PrefixFile loads a dataset from disk. Files ending in .bin hold
+// big-endian uint64 prefixes; anything else is read as text with one hex
+// SHA-1 per line, optionally followed by ":count" (the HIBP download format).
+func LoadSHA1PrefixFile(path string) (*SHA1PrefixSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open breach dataset: %w", err)
+	}
+	defer file.Close()
+
+	if strings.HasSuffix(path, ".bin") {
+		return ReadBinarySHA1Prefixes(file)
+	}
+	return ReadSHA1Prefixes(file)
+}
+
+func ReadSHA1Prefixes(r io.Reader) (*SHA1PrefixSet, error) {
+	var prefixes []uint64
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if idx := strings.IndexByte(text, ':'); idx >= 0 {
+			text = text[:idx]
+		}
+		if len(text) < 16 {
+			return nil, fmt.Errorf("breach dataset line %d: hash is too short", line)
+		}
+		raw, err := hex.DecodeString(text[:16])
+		if err != nil {
+			return nil, fmt.Errorf("breach dataset line %d: %w", line, err)
+		}
+		prefixes = append(prefixes, binary.BigEndian.Uint64(raw))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read breach dataset: %w", err)
+	}
+	return NewSHA1PrefixSet(prefixes), nil
+}
+
+// ReadBinarySHA1Prefixes decodes the file straight into the prefix slice
+// instead of buffering the raw bytes first.
+func ReadBinarySHA1Prefixes(r io.Reader) (*SHA1PrefixSet, error) {
+	var prefixes []uint64
+	if file, ok := r.(*os.File); ok {
+		if info, err := file.Stat(); err == nil {
+			prefixes = make([]uint64, 0, info.Size()/8)
+		}
+	}
+
+	buf := bufio.NewReader(r)
+	var raw [8]byte
+	for {
+		n, err := io.ReadFull(buf, raw[:])
+		if err == io.EOF {
+			break
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("breach dataset size %d is not a multiple of 8", len(prefixes)*8+n)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read breach dataset: %w", err)
+		}
+		prefixes = append(prefixes, binary.BigEndian.Uint64(raw[:]))
+	}
+	return NewSHA1PrefixSet(prefixes), nil
+}
+
+// WriteBinary writes the set in the compact format read by LoadSHA1PrefixFile.
+// cmd/breachset uses it to convert the HIBP text download.
+func (s *SHA1PrefixSet) WriteBinary(w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	var raw [8]byte
+	for _, prefix := range s.prefixes {
+		binary.BigEndian.PutUint64(raw[:], prefix)
+		if _, err := buf.Write(raw[:]); err != nil {
+			return err
+		}
+	}
+	return buf.Flush()
+}
+
+func (s *SHA1PrefixSet) Len() int {
+	return len(s.prefixes)
+}
+
+func (s *SHA1PrefixSet) IsBreached(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	_, found := slices.BinarySearch(s.prefixes, binary.BigEndian.Uint64(sum[:8]))
+	return found
+}
+
+// CheckBreached applies the policy's breach mode. In warn mode the password is
+// accepted and a warning is returned for the caller to surface.
+func (p *Policy) CheckBreached(checker BreachChecker, password string) (string, error) {
+	if checker == nil || p.BreachMode == BreachModeOff || !checker.IsBreached(password) {
+		return "", nil
+	}
+	if p.BreachMode == BreachModeWarn {
+		return BreachedPasswordWarning, nil
+	}
+	return "", errors.ErrValidation("Password has appeared in a known data breach, please choose a different one")
+}
@@ -0,0 +1,75 @@
+package password
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"iam-service/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func TestSHA1PrefixSet(t *testing.T) {
+	dataset := strings.Join([]string{
+		"# top breached passwords",
+		sha1Hex("P@ssw0rd123") + ":52431",
+		"",
+		sha1Hex("Summer2024!"),
+	}, "\n")
+
+	set, err := ReadSHA1Prefixes(strings.NewReader(dataset))
+	require.NoError(t, err)
+	assert.Equal(t, 2, set.Len())
+	assert.True(t, set.IsBreached("P@ssw0rd123"))
+	assert.True(t, set.IsBreached("Summer2024!"))
+	assert.False(t, set.IsBreached("Unl1kely!Passphrase"))
+
+	var buf bytes.Buffer
+	require.NoError(t, set.WriteBinary(&buf))
+	assert.Equal(t, 16, buf.Len())
+
+	loaded, err := ReadBinarySHA1Prefixes(&buf)
+	require.NoError(t, err)
+	assert.True(t, loaded.IsBreached("P@ssw0rd123"))
+	assert.False(t, loaded.IsBreached("Unl1kely!Passphrase"))
+}
+
+func TestReadSHA1Prefixes_InvalidLine(t *testing.T) {
+	_, err := ReadSHA1Prefixes(strings.NewReader("not-a-hash"))
+	assert.Error(t, err)
+
+	_, err = ReadBinarySHA1Prefixes(bytes.NewReader([]byte{1, 2, 3}))
+	assert.Error(t, err)
+}
+
+func TestPolicy_CheckBreached(t *testing.T) {
+	checker := NewSHA1PrefixSet(nil)
+	set, err := ReadSHA1Prefixes(strings.NewReader(sha1Hex("P@ssw0rd123")))
+	require.NoError(t, err)
+
+	warning, err := (&Policy{BreachMode: BreachModeBlock}).CheckBreached(set, "P@ssw0rd123")
+	require.Error(t, err)
+	assert.Equal(t, errors.CodeValidation, err.(*errors.AppError).Code)
+	assert.Empty(t, warning)
+
+	warning, err = (&Policy{BreachMode: BreachModeWarn}).CheckBreached(set, "P@ssw0rd123")
+	require.NoError(t, err)
+	assert.Equal(t, BreachedPasswordWarning, warning)
+
+	warning, err = (&Policy{BreachMode: BreachModeOff}).CheckBreached(set, "P@ssw0rd123")
+	require.NoError(t, err)
+	assert.Empty(t, warning)
+
+	warning, err = DefaultPolicy().CheckBreached(checker, "P@ssw0rd123")
+	require.NoError(t, err)
+	assert.Empty(t, warning)
+}
@@ -0,0 +1,128 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"iam-service/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	DefaultMinLength    = 8
+	DefaultMaxLength    = MaxBcryptBytes
+	DefaultHistoryDepth = 5
+
+	// MaxBcryptBytes is bcrypt's input limit. Longer passwords would be
+	// silently truncated, so they are rejected whatever MaxLength says.
+	MaxBcryptBytes = 72
+)
+
+type Policy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUppercase bool
+	RequireLowercase bool
+	RequireNumber    bool
+	RequireSpecial   bool
+	MaxAgeDays       int
+	HistoryDepth     int
+	BannedWords      []string
+	BreachMode       BreachMode
+}
+
+// DefaultPolicy is applied when no tenant or application policy is configured.
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MinLength:        DefaultMinLength,
+		MaxLength:        DefaultMaxLength,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireNumber:    true,
+		RequireSpecial:   true,
+		HistoryDepth:     DefaultHistoryDepth,
+		BreachMode:       BreachModeBlock,
+	}
+}
+
+// Validate checks the password against the policy. contextWords (e.g. the
+// user's email local part or name) are rejected the same way as banned words.
+func (p *Policy) Validate(password string, contextWords ...string) error {
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		return errors.ErrValidation(fmt.Sprintf("Password must be at least %d characters long", p.MinLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return errors.ErrValidation(fmt.Sprintf("Password must be at most %d characters long", p.MaxLength))
+	}
+	if len(password) > MaxBcryptBytes {
+		return errors.ErrValidation(fmt.Sprintf("Password must be at most %d bytes long", MaxBcryptBytes))
+	}
+
+	hasUpper := false
+	hasLower := false
+	hasNumber := false
+	hasSpecial := false
+
+	for _, char := range password {
+		switch {
+		case char >= 'A' && char <= 'Z':
+			hasUpper = true
+		case char >= 'a' && char <= 'z':
+			hasLower = true
+		case char >= '0' && char <= '9':
+			hasNumber = true
+		case char >= '!' && char <= '/' || char >= ':' && char <= '@' || char >= '[' && char <= '`' || char >= '{' && char <= '~':
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		return errors.ErrValidation("Password must contain at least one uppercase letter")
+	}
+	if p.RequireLowercase && !hasLower {
+		return errors.ErrValidation("Password must contain at least one lowercase letter")
+	}
+	if p.RequireNumber && !hasNumber {
+		return errors.ErrValidation("Password must contain at least one number")
+	}
+	if p.RequireSpecial && !hasSpecial {
+		return errors.ErrValidation("Password must contain at least one special character")
+	}
+
+	lowered := strings.ToLower(password)
+	for _, word := range append(p.BannedWords, contextWords...) {
+		word = strings.ToLower(strings.TrimSpace(word))
+		// Very short words would reject too many legitimate passwords.
+		if len(word) < 3 {
+			continue
+		}
+		if strings.Contains(lowered, word) {
+			return errors.ErrValidation("Password must not contain easily guessable words")
+		}
+	}
+
+	return nil
+}
+
+// ContextWords returns the personal words a password must not contain: the
+// local part of the email address followed by the given names.
+func ContextWords(email string, names ...string) []string {
+	localPart, _, _ := strings.Cut(email, "@")
+	return append([]string{localPart}, names...)
+}
+
+// MatchesAny reports whether the password matches one of the bcrypt hashes.
+func MatchesAny(password string, hashes []string) bool {
+	for _, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"iam-service/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        *Policy
+		password      string
+		contextWords  []string
+		expectedError string
+	}{
+		{
+			name:     "default policy accepts strong password",
+			policy:   DefaultPolicy(),
+			password: "Str0ng!Pass",
+		},
+		{
+			name:          "too short",
+			policy:        DefaultPolicy(),
+			password:      "Sh0rt!",
+			expectedError: "at least 8 characters",
+		},
+		{
+			name:          "too long",
+			policy:        &Policy{MinLength: 8, MaxLength: 10},
+			password:      "abcdefghijkl",
+			expectedError: "at most 10 characters",
+		},
+		{
+			name:          "longer than bcrypt input limit",
+			policy:        &Policy{MinLength: 8, MaxLength: 256},
+			password:      strings.Repeat("a", MaxBcryptBytes+1),
+			expectedError: "at most 72 bytes",
+		},
+		{
+			name:          "missing uppercase",
+			policy:        DefaultPolicy(),
+			password:      "weak1234!",
+			expectedError: "uppercase",
+		},
+		{
+			name:     "classes not required",
+			policy:   &Policy{MinLength: 12},
+			password: "correcthorsebattery",
+		},
+		{
+			name:          "banned word is case-insensitive",
+			policy:        &Policy{MinLength: 8, BannedWords: []string{"Frendz"}},
+			password:      "myFRENDZpassword",
+			expectedError: "guessable",
+		},
+		{
+			name:          "context word rejected",
+			policy:        &Policy{MinLength: 8},
+			password:      "johndoe2024",
+			contextWords:  []string{"johndoe"},
+			expectedError: "guessable",
+		},
+		{
+			name:         "short context words are ignored",
+			policy:       &Policy{MinLength: 8},
+			password:     "abcdefghij",
+			contextWords: []string{"ab"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(tt.password, tt.contextWords...)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			appErr, ok := err.(*errors.AppError)
+			require.True(t, ok)
+			assert.Equal(t, errors.CodeValidation, appErr.Code)
+			assert.Contains(t, appErr.Message, tt.expectedError)
+		})
+	}
+}
+
+func TestMatchesAny(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("Old!Pass123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	assert.True(t, MatchesAny("Old!Pass123", []string{"", string(hash)}))
+	assert.False(t, MatchesAny("New!Pass123", []string{string(hash)}))
+	assert.False(t, MatchesAny("Old!Pass123", nil))
+}
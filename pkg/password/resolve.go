@@ -0,0 +1,152 @@
+package password
+
+import (
+	"context"
+	"slices"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// PolicySource looks up the stored policy for a tenant/product scope. A nil
+// tenant selects the platform-wide policy.
+type PolicySource interface {
+	Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error)
+}
+
+// ResolvePolicy returns the most specific policy configured for the scope,
+// falling back to the built-in default.
+func ResolvePolicy(ctx context.Context, source PolicySource, tenantID, productID *uuid.UUID) (*Policy, error) {
+	policy, err := source.Resolve(ctx, tenantID, productID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return DefaultPolicy(), nil
+		}
+		return nil, errors.ErrInternal("failed to resolve password policy").WithError(err)
+	}
+	return FromEntity(policy), nil
+}
+
+// ResolveTenantPolicy returns the strictest of the tenant policy and the
+// policies of productIDs within the tenant, so a password that opens several
+// of the tenant's applications satisfies each of them. A product without its
+// own policy falls back to the tenant's.
+func ResolveTenantPolicy(ctx context.Context, source PolicySource, tenantID uuid.UUID, productIDs []uuid.UUID) (*Policy, error) {
+	policy, err := ResolvePolicy(ctx, source, &tenantID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := []*Policy{policy}
+	for _, productID := range productIDs {
+		policy, err := ResolvePolicy(ctx, source, &tenantID, &productID)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return Strictest(policies...), nil
+}
+
+// ResolveUserPolicy combines the platform policy with the tenant and
+// application policies of every tenant in tenantIDs, so a password accepted
+// for the user satisfies all of the tenants and products they use. productIDs
+// are looked up in every tenant; a product only has a policy in its own.
+func ResolveUserPolicy(ctx context.Context, source PolicySource, tenantIDs, productIDs []uuid.UUID) (*Policy, error) {
+	policy, err := ResolvePolicy(ctx, source, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := []*Policy{policy}
+	for _, tenantID := range tenantIDs {
+		policy, err := ResolveTenantPolicy(ctx, source, tenantID, productIDs)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return Strictest(policies...), nil
+}
+
+// UserScopes collects the tenants of the user's registrations and the
+// distinct products of those registrations and of the user's role
+// assignments, as input for ResolveUserPolicy.
+func UserScopes(registrations []entity.UserTenantRegistration, userRoles []entity.UserRole) (tenantIDs, productIDs []uuid.UUID) {
+	tenantIDs = make([]uuid.UUID, 0, len(registrations))
+	for i := range registrations {
+		tenantIDs = append(tenantIDs, registrations[i].TenantID)
+		if productID := registrations[i].GetProductID(); productID != nil && !slices.Contains(productIDs, *productID) {
+			productIDs = append(productIDs, *productID)
+		}
+	}
+	for _, userRole := range userRoles {
+		if userRole.ProductID != nil && !slices.Contains(productIDs, *userRole.ProductID) {
+			productIDs = append(productIDs, *userRole.ProductID)
+		}
+	}
+	return tenantIDs, productIDs
+}
+
+func FromEntity(policy *entity.PasswordPolicy) *Policy {
+	return &Policy{
+		MinLength:        policy.MinLength,
+		MaxLength:        policy.MaxLength,
+		RequireUppercase: policy.RequireUppercase,
+		RequireLowercase: policy.RequireLowercase,
+		RequireNumber:    policy.RequireNumber,
+		RequireSpecial:   policy.RequireSpecial,
+		MaxAgeDays:       policy.MaxAgeDays,
+		HistoryDepth:     policy.HistoryDepth,
+		BannedWords:      policy.GetBannedWords(),
+		BreachMode:       BreachMode(policy.BreachCheck),
+	}
+}
+
+// Strictest merges policies rule by rule, keeping the strictest setting of
+// each. Zero MaxLength and MaxAgeDays mean "unset" and never win.
+func Strictest(policies ...*Policy) *Policy {
+	result := &Policy{BreachMode: BreachModeOff}
+	for _, policy := range policies {
+		result.MinLength = max(result.MinLength, policy.MinLength)
+		result.MaxLength = minPositive(result.MaxLength, policy.MaxLength)
+		result.RequireUppercase = result.RequireUppercase || policy.RequireUppercase
+		result.RequireLowercase = result.RequireLowercase || policy.RequireLowercase
+		result.RequireNumber = result.RequireNumber || policy.RequireNumber
+		result.RequireSpecial = result.RequireSpecial || policy.RequireSpecial
+		result.MaxAgeDays = minPositive(result.MaxAgeDays, policy.MaxAgeDays)
+		result.HistoryDepth = max(result.HistoryDepth, policy.HistoryDepth)
+		for _, word := range policy.BannedWords {
+			if !slices.Contains(result.BannedWords, word) {
+				result.BannedWords = append(result.BannedWords, word)
+			}
+		}
+		if breachModeRank(policy.BreachMode) > breachModeRank(result.BreachMode) {
+			result.BreachMode = policy.BreachMode
+		}
+	}
+	return result
+}
+
+func minPositive(a, b int) int {
+	if a <= 0 {
+		return b
+	}
+	if b <= 0 {
+		return a
+	}
+	return min(a, b)
+}
+
+func breachModeRank(mode BreachMode) int {
+	switch mode {
+	case BreachModeBlock:
+		return 2
+	case BreachModeWarn:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,144 @@
+package password
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPolicySource keys tenant policies by tenant ID and application policies
+// by product ID. Like the repository, an application policy wins over its
+// tenant's.
+type stubPolicySource map[uuid.UUID]*entity.PasswordPolicy
+
+func (s stubPolicySource) Resolve(ctx context.Context, tenantID, productID *uuid.UUID) (*entity.PasswordPolicy, error) {
+	if productID != nil {
+		if policy, ok := s[*productID]; ok {
+			return policy, nil
+		}
+	}
+	key := uuid.Nil
+	if tenantID != nil {
+		key = *tenantID
+	}
+	if policy, ok := s[key]; ok {
+		return policy, nil
+	}
+	return nil, errors.ErrNotFound("password policy not found")
+}
+
+func TestResolveUserPolicy(t *testing.T) {
+	strictTenant := uuid.New()
+	laxTenant := uuid.New()
+	unconfiguredTenant := uuid.New()
+	strictProduct := uuid.New()
+
+	source := stubPolicySource{
+		uuid.Nil: {
+			MinLength:   10,
+			MaxLength:   64,
+			MaxAgeDays:  90,
+			BannedWords: json.RawMessage(`["frendz"]`),
+			BreachCheck: string(BreachModeWarn),
+		},
+		strictTenant: {
+			MinLength:      14,
+			MaxLength:      72,
+			RequireSpecial: true,
+			MaxAgeDays:     30,
+			HistoryDepth:   10,
+			BannedWords:    json.RawMessage(`["saving"]`),
+			BreachCheck:    string(BreachModeOff),
+		},
+		laxTenant: {
+			MinLength:   8,
+			BreachCheck: string(BreachModeOff),
+		},
+		strictProduct: {
+			MinLength:     16,
+			RequireNumber: true,
+			MaxAgeDays:    45,
+			BreachCheck:   string(BreachModeBlock),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		tenantIDs  []uuid.UUID
+		productIDs []uuid.UUID
+		expected   *Policy
+	}{
+		{
+			name:     "platform policy only",
+			expected: FromEntity(source[uuid.Nil]),
+		},
+		{
+			name:      "lax tenant cannot weaken platform policy",
+			tenantIDs: []uuid.UUID{laxTenant},
+			expected: &Policy{
+				MinLength:   10,
+				MaxLength:   64,
+				MaxAgeDays:  90,
+				BannedWords: []string{"frendz"},
+				BreachMode:  BreachModeWarn,
+			},
+		},
+		{
+			name:      "strictest rule wins across tenants",
+			tenantIDs: []uuid.UUID{laxTenant, strictTenant},
+			expected: &Policy{
+				MinLength:      14,
+				MaxLength:      64,
+				RequireSpecial: true,
+				MaxAgeDays:     30,
+				HistoryDepth:   10,
+				BannedWords:    []string{"frendz", "saving"},
+				BreachMode:     BreachModeWarn,
+			},
+		},
+		{
+			name:       "stricter application policy applies on top of its tenant",
+			tenantIDs:  []uuid.UUID{laxTenant},
+			productIDs: []uuid.UUID{strictProduct},
+			expected: &Policy{
+				MinLength:     16,
+				MaxLength:     64,
+				RequireNumber: true,
+				MaxAgeDays:    45,
+				BannedWords:   []string{"frendz"},
+				BreachMode:    BreachModeBlock,
+			},
+		},
+		{
+			name:      "unconfigured tenant falls back to the default policy",
+			tenantIDs: []uuid.UUID{unconfiguredTenant},
+			expected: &Policy{
+				MinLength:        10,
+				MaxLength:        64,
+				RequireUppercase: true,
+				RequireLowercase: true,
+				RequireNumber:    true,
+				RequireSpecial:   true,
+				MaxAgeDays:       90,
+				HistoryDepth:     DefaultHistoryDepth,
+				BannedWords:      []string{"frendz"},
+				BreachMode:       BreachModeBlock,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ResolveUserPolicy(context.Background(), source, tt.tenantIDs, tt.productIDs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package rbac
+
+import (
+	"context"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+)
+
+// UserRoleSource returns the user's assignments that are in effect now.
+type UserRoleSource interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error)
+}
+
+// RoleSource returns the active roles among ids.
+type RoleSource interface {
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error)
+}
+
+// PermissionSource returns the permission codes of the roles, including the
+// ones they inherit from their ancestors.
+type PermissionSource interface {
+	GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error)
+}
+
+// HeldPermissions returns the permission codes the user currently holds in the
+// tenant through its own roles and the platform-wide system roles.
+func HeldPermissions(
+	ctx context.Context,
+	userRoles UserRoleSource,
+	roles RoleSource,
+	permissions PermissionSource,
+	tenantID, userID uuid.UUID,
+) (map[string]struct{}, error) {
+	assignments, err := userRoles.ListActiveByUserID(ctx, userID, nil)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get actor roles").WithError(err)
+	}
+	if len(assignments) == 0 {
+		return map[string]struct{}{}, nil
+	}
+
+	roleIDs := make([]uuid.UUID, len(assignments))
+	for i, assignment := range assignments {
+		roleIDs[i] = assignment.RoleID
+	}
+	held, err := roles.GetByIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get actor roles").WithError(err)
+	}
+
+	tenantRoleIDs := make([]uuid.UUID, 0, len(held))
+	for _, role := range held {
+		if role.AppliesToTenant(tenantID) {
+			tenantRoleIDs = append(tenantRoleIDs, role.ID)
+		}
+	}
+	codes, err := permissions.GetCodesByRoleIDs(ctx, tenantRoleIDs)
+	if err != nil {
+		return nil, errors.ErrInternal("failed to get actor permissions").WithError(err)
+	}
+
+	result := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		result[code] = struct{}{}
+	}
+	return result, nil
+}
+
+// VerifyDelegable rejects codes the actor does not hold in the tenant, so
+// nobody can hand out more than they have. subject names what grants the
+// codes in the error message, e.g. "Role ADMIN".
+func VerifyDelegable(
+	ctx context.Context,
+	userRoles UserRoleSource,
+	roles RoleSource,
+	permissions PermissionSource,
+	tenantID, actorID uuid.UUID,
+	subject string,
+	codes []string,
+) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	held, err := HeldPermissions(ctx, userRoles, roles, permissions, tenantID, actorID)
+	if err != nil {
+		return err
+	}
+	for _, code := range codes {
+		if _, ok := held[code]; !ok {
+			return errors.ErrForbidden(subject + " grants permission " + code + " that you do not hold")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package rbac
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubGrants holds one user's assignments, the active roles and the codes
+// each role carries.
+type stubGrants struct {
+	assignments []entity.UserRole
+	roles       map[uuid.UUID]*entity.Role
+	codes       map[uuid.UUID][]string
+}
+
+func (s *stubGrants) ListActiveByUserID(ctx context.Context, userID uuid.UUID, productID *uuid.UUID) ([]entity.UserRole, error) {
+	return s.assignments, nil
+}
+
+func (s *stubGrants) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Role, error) {
+	var roles []*entity.Role
+	for _, id := range ids {
+		if role, ok := s.roles[id]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+func (s *stubGrants) GetCodesByRoleIDs(ctx context.Context, roleIDs []uuid.UUID) ([]string, error) {
+	var codes []string
+	for _, id := range roleIDs {
+		codes = append(codes, s.codes[id]...)
+	}
+	return codes, nil
+}
+
+func TestVerifyDelegable(t *testing.T) {
+	tenantID := uuid.New()
+	otherTenantID := uuid.New()
+	actorID := uuid.New()
+	tenantRole := uuid.New()
+	systemRole := uuid.New()
+	foreignRole := uuid.New()
+	inactiveRole := uuid.New()
+
+	grants := &stubGrants{
+		assignments: []entity.UserRole{
+			{UserID: actorID, RoleID: tenantRole},
+			{UserID: actorID, RoleID: systemRole},
+			{UserID: actorID, RoleID: foreignRole},
+			{UserID: actorID, RoleID: inactiveRole},
+		},
+		roles: map[uuid.UUID]*entity.Role{
+			tenantRole:  {ID: tenantRole, TenantID: &tenantID, IsActive: true},
+			systemRole:  {ID: systemRole, IsActive: true},
+			foreignRole: {ID: foreignRole, TenantID: &otherTenantID, IsActive: true},
+		},
+		codes: map[uuid.UUID][]string{
+			tenantRole:   {"participant:read"},
+			systemRole:   {"report:read"},
+			foreignRole:  {"role:delete"},
+			inactiveRole: {"user:delete"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		codes   []string
+		wantErr bool
+	}{
+		{name: "nothing to delegate", codes: nil},
+		{name: "held through a tenant role", codes: []string{"participant:read"}},
+		{name: "held through a system role", codes: []string{"report:read"}},
+		{name: "held only in another tenant", codes: []string{"role:delete"}, wantErr: true},
+		{name: "held only through an inactive role", codes: []string{"user:delete"}, wantErr: true},
+		{name: "one code missing", codes: []string{"participant:read", "role:update"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyDelegable(context.Background(), grants, grants, grants, tenantID, actorID, "Role TELLER", tt.codes)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+			var appErr *errors.AppError
+			require.True(t, errors.As(err, &appErr))
+			assert.Equal(t, http.StatusForbidden, appErr.HTTPStatus)
+			assert.Contains(t, appErr.Message, "Role TELLER")
+		})
+	}
+}
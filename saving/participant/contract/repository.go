@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"iam-service/entity"
+	"iam-service/pkg/abac"
 
 	"github.com/google/uuid"
 )
@@ -17,6 +18,10 @@ type ParticipantFilter struct {
 	PerPage       int
 	SortBy        string
 	SortOrder     string
+
+	// BranchIDs and OwnerID narrow the result to a caller's access scope.
+	BranchIDs []uuid.UUID
+	OwnerID   *uuid.UUID
 }
 
 type ParticipantRepository interface {
@@ -25,6 +30,17 @@ type ParticipantRepository interface {
 	Update(ctx context.Context, participant *entity.Participant) error
 	SoftDelete(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, filter *ParticipantFilter) ([]*entity.Participant, int64, error)
+	GetByUserID(ctx context.Context, tenantID, applicationID, userID uuid.UUID) (*entity.Participant, error)
+	ListApprovedByIdentificationNumber(ctx context.Context, tenantID uuid.UUID, applicationID *uuid.UUID, identificationNumber string) ([]entity.Participant, error)
+	LinkUser(ctx context.Context, participantID, userID uuid.UUID) error
+}
+
+type BranchRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.Branch, error)
+}
+
+type PolicyAuthorizer interface {
+	Authorize(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string, input abac.Input) (*abac.Decision, error)
 }
 
 type ParticipantIdentityRepository interface {
@@ -80,3 +96,7 @@ type ParticipantStatusHistoryRepository interface {
 	Create(ctx context.Context, history *entity.ParticipantStatusHistory) error
 	ListByParticipantID(ctx context.Context, participantID uuid.UUID) ([]*entity.ParticipantStatusHistory, error)
 }
+
+type UserTenantRegistrationRepository interface {
+	ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error)
+}
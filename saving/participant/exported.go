@@ -20,6 +20,9 @@ func NewUsecase(
 	beneficiaryRepo contract.ParticipantBeneficiaryRepository,
 	statusHistoryRepo contract.ParticipantStatusHistoryRepository,
 	fileStorage contract.FileStorageAdapter,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	branchRepo contract.BranchRepository,
+	policyAuthorizer contract.PolicyAuthorizer,
 ) Usecase {
 	return internal.NewUsecase(
 		cfg,
@@ -33,5 +36,8 @@ func NewUsecase(
 		beneficiaryRepo,
 		statusHistoryRepo,
 		fileStorage,
+		userTenantRegRepo,
+		branchRepo,
+		policyAuthorizer,
 	)
 }
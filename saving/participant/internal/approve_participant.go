@@ -25,10 +25,18 @@ func (uc *usecase) ApproveParticipant(ctx context.Context, req *participantdto.A
 			return err
 		}
 
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
 		if !participant.CanBeApproved() {
 			return errors.ErrBadRequest(fmt.Sprintf("participant in %s status cannot be approved", participant.Status))
 		}
 
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:approve", req.Subject); err != nil {
+			return err
+		}
+
 		now := time.Now()
 		fromStatus := string(participant.Status)
 
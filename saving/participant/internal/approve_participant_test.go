@@ -6,6 +6,7 @@ import (
 
 	"iam-service/entity"
 	"iam-service/saving/participant/participantdto"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
@@ -24,6 +25,7 @@ func TestUsecase_ApproveParticipant(t *testing.T) {
 	tests := []struct {
 		name    string
 		req     *participantdto.ApproveParticipantRequest
+		policy  *abac.Condition
 		setup   func(*MockTransactionManager, *MockParticipantRepository, *MockParticipantStatusHistoryRepository, *MockParticipantIdentityRepository, *MockParticipantAddressRepository, *MockParticipantBankAccountRepository, *MockParticipantFamilyMemberRepository, *MockParticipantEmploymentRepository, *MockParticipantBeneficiaryRepository)
 		wantErr bool
 		errKind errors.Kind
@@ -54,6 +56,51 @@ func TestUsecase_ApproveParticipant(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "success - policy reads roles from the request subject",
+			req: &participantdto.ApproveParticipantRequest{
+				ParticipantID: uuid.New(),
+				TenantID:      tenantID,
+				UserID:        approverID,
+				Subject:       abac.Attributes{"id": approverID, "roles": []string{"CHECKER"}},
+			},
+			policy: &abac.Condition{Attr: "subject.roles", Op: abac.OpContains, Value: "CHECKER"},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, histRepo *MockParticipantStatusHistoryRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusPendingApproval, tenantID, applicationID, userID)
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(participant, nil)
+				partRepo.On("Update", mock.Anything, mock.MatchedBy(func(p *entity.Participant) bool {
+					return p.Status == entity.ParticipantStatusApproved && p.ApprovedBy != nil && *p.ApprovedBy == approverID
+				})).Return(nil)
+				histRepo.On("Create", mock.Anything, mock.MatchedBy(func(h *entity.ParticipantStatusHistory) bool {
+					return h.ToStatus == string(entity.ParticipantStatusApproved) && *h.FromStatus == string(entity.ParticipantStatusPendingApproval)
+				})).Return(nil)
+				identRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantIdentity{}, nil)
+				addrRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantAddress{}, nil)
+				bankRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantBankAccount{}, nil)
+				famRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantFamilyMember{}, nil)
+				empRepo.On("GetByParticipantID", mock.Anything, mock.Anything).Return(nil, errors.ErrNotFound("not found"))
+				benRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantBeneficiary{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "error - policy denies a subject without the required role",
+			req: &participantdto.ApproveParticipantRequest{
+				ParticipantID: uuid.New(),
+				TenantID:      tenantID,
+				UserID:        approverID,
+				Subject:       abac.Attributes{"id": approverID, "roles": []string{"MAKER"}},
+			},
+			policy: &abac.Condition{Attr: "subject.roles", Op: abac.OpContains, Value: "CHECKER"},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, histRepo *MockParticipantStatusHistoryRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusPendingApproval, tenantID, applicationID, userID)
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
 		{
 			name: "error - participant not found",
 			req: &participantdto.ApproveParticipantRequest{
@@ -128,6 +175,24 @@ func TestUsecase_ApproveParticipant(t *testing.T) {
 			wantErr: true,
 			errKind: errors.KindBadRequest,
 		},
+		{
+			name: "error - policy forbids approving own submission",
+			req: &participantdto.ApproveParticipantRequest{
+				ParticipantID: uuid.New(),
+				TenantID:      tenantID,
+				UserID:        approverID,
+				Subject:       abac.Attributes{"id": approverID},
+			},
+			policy: &abac.Condition{Attr: "resource.submitted_by", Op: abac.OpNeq, Ref: "subject.id"},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, histRepo *MockParticipantStatusHistoryRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusPendingApproval, tenantID, applicationID, userID)
+				participant.SubmittedBy = &approverID
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -146,6 +211,7 @@ func TestUsecase_ApproveParticipant(t *testing.T) {
 			tt.setup(txMgr, partRepo, histRepo, identRepo, addrRepo, bankRepo, famRepo, empRepo, benRepo)
 
 			uc := newTestUsecase(txMgr, partRepo, identRepo, addrRepo, bankRepo, famRepo, empRepo, benRepo, histRepo, fileStorage)
+			uc.policyAuthorizer = &conditionPolicyAuthorizer{condition: tt.policy}
 
 			resp, err := uc.ApproveParticipant(context.Background(), tt.req)
 
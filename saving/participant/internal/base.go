@@ -17,6 +17,9 @@ type usecase struct {
 	beneficiaryRepo   contract.ParticipantBeneficiaryRepository
 	statusHistoryRepo contract.ParticipantStatusHistoryRepository
 	fileStorage       contract.FileStorageAdapter
+	userTenantRegRepo contract.UserTenantRegistrationRepository
+	branchRepo        contract.BranchRepository
+	policyAuthorizer  contract.PolicyAuthorizer
 }
 
 func NewUsecase(
@@ -31,6 +34,9 @@ func NewUsecase(
 	beneficiaryRepo contract.ParticipantBeneficiaryRepository,
 	statusHistoryRepo contract.ParticipantStatusHistoryRepository,
 	fileStorage contract.FileStorageAdapter,
+	userTenantRegRepo contract.UserTenantRegistrationRepository,
+	branchRepo contract.BranchRepository,
+	policyAuthorizer contract.PolicyAuthorizer,
 ) contract.Usecase {
 	return &usecase{
 		cfg:               cfg,
@@ -44,5 +50,8 @@ func NewUsecase(
 		beneficiaryRepo:   beneficiaryRepo,
 		statusHistoryRepo: statusHistoryRepo,
 		fileStorage:       fileStorage,
+		userTenantRegRepo: userTenantRegRepo,
+		branchRepo:        branchRepo,
+		policyAuthorizer:  policyAuthorizer,
 	}
 }
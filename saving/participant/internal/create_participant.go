@@ -12,9 +12,14 @@ import (
 )
 
 func (uc *usecase) CreateParticipant(ctx context.Context, req *participantdto.CreateParticipantRequest) (*participantdto.ParticipantResponse, error) {
+	branchID, err := uc.resolveParticipantBranch(ctx, req.TenantID, req.BranchID, req.Scope)
+	if err != nil {
+		return nil, err
+	}
+
 	var result *participantdto.ParticipantResponse
 
-	err := uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+	err = uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		now := time.Now()
 
 		participant := &entity.Participant{
@@ -22,6 +27,7 @@ func (uc *usecase) CreateParticipant(ctx context.Context, req *participantdto.Cr
 			TenantID:      req.TenantID,
 			ApplicationID: req.ApplicationID,
 			UserID:        &req.UserID,
+			BranchID:      branchID,
 			FullName:      req.FullName,
 			Status:        entity.ParticipantStatusDraft,
 			CreatedBy:     req.UserID,
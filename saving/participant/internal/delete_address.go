@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
 )
 
-func (uc *usecase) DeleteAddress(ctx context.Context, addressID, participantID, tenantID string) error {
+func (uc *usecase) DeleteAddress(ctx context.Context, addressID, participantID, tenantID string, scope *entity.AccessScope, subject abac.Attributes) error {
 	aID, err := uuid.Parse(addressID)
 	if err != nil {
 		return errors.ErrBadRequest("invalid address ID")
@@ -35,6 +37,14 @@ func (uc *usecase) DeleteAddress(ctx context.Context, addressID, participantID,
 			return err
 		}
 
+		if err := validateParticipantScope(participant, scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:update", subject); err != nil {
+			return err
+		}
+
 		if err := validateEditableState(participant); err != nil {
 			return err
 		}
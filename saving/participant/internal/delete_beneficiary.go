@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
 )
 
-func (uc *usecase) DeleteBeneficiary(ctx context.Context, beneficiaryID, participantID, tenantID string) error {
+func (uc *usecase) DeleteBeneficiary(ctx context.Context, beneficiaryID, participantID, tenantID string, scope *entity.AccessScope, subject abac.Attributes) error {
 	bID, err := uuid.Parse(beneficiaryID)
 	if err != nil {
 		return errors.ErrBadRequest("invalid beneficiary ID")
@@ -35,6 +37,14 @@ func (uc *usecase) DeleteBeneficiary(ctx context.Context, beneficiaryID, partici
 			return err
 		}
 
+		if err := validateParticipantScope(participant, scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:update", subject); err != nil {
+			return err
+		}
+
 		if err := validateEditableState(participant); err != nil {
 			return err
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
@@ -27,6 +28,8 @@ func TestUsecase_DeleteIdentity(t *testing.T) {
 		identityID  string
 		participantID string
 		tenantID    string
+		scope       *entity.AccessScope
+		policy      *abac.Condition
 		setup       func(*MockTransactionManager, *MockParticipantRepository, *MockParticipantIdentityRepository)
 		wantErr     bool
 		errKind     errors.Kind
@@ -181,6 +184,36 @@ func TestUsecase_DeleteIdentity(t *testing.T) {
 			wantErr: true,
 			errKind: errors.KindForbidden,
 		},
+		{
+			name:          "error - participant outside caller's branch scope",
+			identityID:    identityID.String(),
+			participantID: participantID.String(),
+			tenantID:      tenantID.String(),
+			scope:         &entity.AccessScope{Level: entity.ScopeLevelBranch, UserID: uuid.New(), BranchIDs: []uuid.UUID{uuid.New()}},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, identRepo *MockParticipantIdentityRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				participant.ID = participantID
+				partRepo.On("GetByID", mock.Anything, participantID).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
+		{
+			name:          "error - participant policy denies the edit",
+			identityID:    identityID.String(),
+			participantID: participantID.String(),
+			tenantID:      tenantID.String(),
+			policy:        &abac.Condition{Attr: "resource.status", Op: abac.OpEq, Value: string(entity.ParticipantStatusRejected)},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, identRepo *MockParticipantIdentityRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				participant.ID = participantID
+				partRepo.On("GetByID", mock.Anything, participantID).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -199,8 +232,9 @@ func TestUsecase_DeleteIdentity(t *testing.T) {
 			tt.setup(txMgr, partRepo, identRepo)
 
 			uc := newTestUsecase(txMgr, partRepo, identRepo, addrRepo, bankRepo, famRepo, empRepo, benRepo, histRepo, fileStorage)
+			uc.policyAuthorizer = &conditionPolicyAuthorizer{condition: tt.policy}
 
-			err := uc.DeleteIdentity(context.Background(), tt.identityID, tt.participantID, tt.tenantID)
+			err := uc.DeleteIdentity(context.Background(), tt.identityID, tt.participantID, tt.tenantID, tt.scope, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
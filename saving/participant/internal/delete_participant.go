@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 
+	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
 )
 
-func (uc *usecase) DeleteParticipant(ctx context.Context, participantID, tenantID, userID string) error {
+func (uc *usecase) DeleteParticipant(ctx context.Context, participantID, tenantID, userID string, scope *entity.AccessScope, subject abac.Attributes) error {
 	pID, err := uuid.Parse(participantID)
 	if err != nil {
 		return errors.ErrBadRequest("invalid participant ID")
@@ -30,6 +32,14 @@ func (uc *usecase) DeleteParticipant(ctx context.Context, participantID, tenantI
 			return err
 		}
 
+		if err := validateParticipantScope(participant, scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:delete", subject); err != nil {
+			return err
+		}
+
 		if !participant.IsDraft() {
 			return errors.ErrBadRequest("only DRAFT participants can be deleted")
 		}
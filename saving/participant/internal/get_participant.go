@@ -4,12 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"iam-service/entity"
 	"iam-service/saving/participant/participantdto"
 
 	"github.com/google/uuid"
 )
 
-func (uc *usecase) GetParticipant(ctx context.Context, participantID, tenantID string) (*participantdto.ParticipantResponse, error) {
+func (uc *usecase) GetParticipant(ctx context.Context, participantID, tenantID string, scope *entity.AccessScope) (*participantdto.ParticipantResponse, error) {
 	pID, err := uuid.Parse(participantID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid participant ID: %w", err)
@@ -29,5 +30,9 @@ func (uc *usecase) GetParticipant(ctx context.Context, participantID, tenantID s
 		return nil, err
 	}
 
+	if err := validateParticipantScope(participant, scope); err != nil {
+		return nil, err
+	}
+
 	return uc.buildFullParticipantResponse(ctx, participant)
 }
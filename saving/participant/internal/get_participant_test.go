@@ -24,6 +24,7 @@ func TestUsecase_GetParticipant(t *testing.T) {
 		name          string
 		participantID string
 		tenantID      string
+		scope         *entity.AccessScope
 		setup         func(*MockParticipantRepository, *MockParticipantIdentityRepository, *MockParticipantAddressRepository, *MockParticipantBankAccountRepository, *MockParticipantFamilyMemberRepository, *MockParticipantEmploymentRepository, *MockParticipantBeneficiaryRepository)
 		wantErr       bool
 		errKind       errors.Kind
@@ -113,6 +114,53 @@ func TestUsecase_GetParticipant(t *testing.T) {
 			wantErr: true,
 			errKind: errors.KindForbidden,
 		},
+		{
+			name:          "error - branch scope excludes participant branch",
+			participantID: participantID.String(),
+			tenantID:      tenantID.String(),
+			scope:         &entity.AccessScope{Level: entity.ScopeLevelBranch, UserID: uuid.New(), BranchIDs: []uuid.UUID{uuid.New()}},
+			setup: func(partRepo *MockParticipantRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				participant.ID = participantID
+				branchID := uuid.New()
+				participant.BranchID = &branchID
+				partRepo.On("GetByID", mock.Anything, participantID).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
+		{
+			name:          "error - self scope excludes other users' participants",
+			participantID: participantID.String(),
+			tenantID:      tenantID.String(),
+			scope:         &entity.AccessScope{Level: entity.ScopeLevelSelf, UserID: uuid.New()},
+			setup: func(partRepo *MockParticipantRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				participant.ID = participantID
+				partRepo.On("GetByID", mock.Anything, participantID).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
+		{
+			name:          "success - self scope allows own participant",
+			participantID: participantID.String(),
+			tenantID:      tenantID.String(),
+			scope:         &entity.AccessScope{Level: entity.ScopeLevelSelf, UserID: userID},
+			setup: func(partRepo *MockParticipantRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				participant.ID = participantID
+				partRepo.On("GetByID", mock.Anything, participantID).Return(participant, nil)
+
+				identRepo.On("ListByParticipantID", mock.Anything, participantID).Return([]*entity.ParticipantIdentity{}, nil)
+				addrRepo.On("ListByParticipantID", mock.Anything, participantID).Return([]*entity.ParticipantAddress{}, nil)
+				bankRepo.On("ListByParticipantID", mock.Anything, participantID).Return([]*entity.ParticipantBankAccount{}, nil)
+				famRepo.On("ListByParticipantID", mock.Anything, participantID).Return([]*entity.ParticipantFamilyMember{}, nil)
+				empRepo.On("GetByParticipantID", mock.Anything, participantID).Return(nil, errors.ErrNotFound("not found"))
+				benRepo.On("ListByParticipantID", mock.Anything, participantID).Return([]*entity.ParticipantBeneficiary{}, nil)
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -132,7 +180,7 @@ func TestUsecase_GetParticipant(t *testing.T) {
 
 			uc := newTestUsecase(txMgr, partRepo, identRepo, addrRepo, bankRepo, famRepo, empRepo, benRepo, histRepo, fileStorage)
 
-			resp, err := uc.GetParticipant(context.Background(), tt.participantID, tt.tenantID)
+			resp, err := uc.GetParticipant(context.Background(), tt.participantID, tt.tenantID, tt.scope)
 
 			if tt.wantErr {
 				assert.Error(t, err)
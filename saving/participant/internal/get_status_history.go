@@ -4,12 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"iam-service/entity"
 	"iam-service/saving/participant/participantdto"
 
 	"github.com/google/uuid"
 )
 
-func (uc *usecase) GetStatusHistory(ctx context.Context, participantID, tenantID string) ([]participantdto.StatusHistoryResponse, error) {
+func (uc *usecase) GetStatusHistory(ctx context.Context, participantID, tenantID string, scope *entity.AccessScope) ([]participantdto.StatusHistoryResponse, error) {
 	pID, err := uuid.Parse(participantID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid participant ID: %w", err)
@@ -29,6 +30,10 @@ func (uc *usecase) GetStatusHistory(ctx context.Context, participantID, tenantID
 		return nil, err
 	}
 
+	if err := validateParticipantScope(participant, scope); err != nil {
+		return nil, err
+	}
+
 	histories, err := uc.statusHistoryRepo.ListByParticipantID(ctx, pID)
 	if err != nil {
 		return nil, fmt.Errorf("list status history: %w", err)
@@ -8,6 +8,7 @@ import (
 
 	"iam-service/entity"
 	"iam-service/saving/participant/participantdto"
+	"iam-service/pkg/abac"
 	"iam-service/pkg/errors"
 
 	"github.com/google/uuid"
@@ -20,6 +21,95 @@ func validateParticipantOwnership(participant *entity.Participant, tenantID uuid
 	return nil
 }
 
+// validateParticipantScope checks a participant against the caller's access
+// scope: branch scope needs the participant in one of the caller's branches,
+// self scope needs the caller to have created or be the participant.
+func validateParticipantScope(participant *entity.Participant, scope *entity.AccessScope) error {
+	if !scope.IsRestricted() {
+		return nil
+	}
+
+	switch scope.Level {
+	case entity.ScopeLevelBranch:
+		if scope.AllowsBranch(participant.BranchID) {
+			return nil
+		}
+	case entity.ScopeLevelSelf:
+		if participant.CreatedBy == scope.UserID || (participant.UserID != nil && *participant.UserID == scope.UserID) {
+			return nil
+		}
+	}
+	return errors.ErrForbidden("participant is outside your access scope")
+}
+
+// resolveParticipantBranch picks the branch for a new participant. Branch
+// scoped callers may only use their own branches and default to their only
+// branch; other callers may use any active branch of the tenant.
+func (uc *usecase) resolveParticipantBranch(ctx context.Context, tenantID uuid.UUID, branchID *uuid.UUID, scope *entity.AccessScope) (*uuid.UUID, error) {
+	if scope.IsRestricted() && scope.Level == entity.ScopeLevelBranch {
+		if branchID == nil {
+			if len(scope.BranchIDs) != 1 {
+				return nil, errors.ErrBadRequest("branch_id is required")
+			}
+			return &scope.BranchIDs[0], nil
+		}
+		if !scope.AllowsBranch(branchID) {
+			return nil, errors.ErrForbidden("branch is outside your access scope")
+		}
+		return branchID, nil
+	}
+
+	if branchID == nil {
+		return nil, nil
+	}
+	branch, err := uc.branchRepo.GetByID(ctx, *branchID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrNotFound("branch not found")
+		}
+		return nil, fmt.Errorf("get branch: %w", err)
+	}
+	if branch.TenantID != tenantID {
+		return nil, errors.ErrBadRequest("branch does not belong to this tenant")
+	}
+	if !branch.IsActive {
+		return nil, errors.ErrBadRequest("branch is inactive")
+	}
+	return branchID, nil
+}
+
+const participantResourceType = "participant"
+
+// authorizeParticipant evaluates the tenant policies bound to participants
+// for an action on a loaded participant, e.g. an approver approving a
+// participant they submitted. subject is the one EnforcePolicies evaluated
+// for the request.
+func (uc *usecase) authorizeParticipant(ctx context.Context, participant *entity.Participant, permissionCode string, subject abac.Attributes) error {
+	decision, err := uc.policyAuthorizer.Authorize(ctx, participant.TenantID, permissionCode, participantResourceType, abac.Input{
+		Subject:  subject,
+		Resource: participantAttributes(participant),
+	})
+	if err != nil {
+		return fmt.Errorf("authorize %s: %w", permissionCode, err)
+	}
+	if !decision.Allowed {
+		return errors.ErrForbidden("action denied by authorization policy")
+	}
+	return nil
+}
+
+func participantAttributes(participant *entity.Participant) abac.Attributes {
+	return abac.Attributes{
+		"id":             participant.ID,
+		"status":         string(participant.Status),
+		"application_id": participant.ApplicationID,
+		"branch_id":      participant.BranchID,
+		"user_id":        participant.UserID,
+		"created_by":     participant.CreatedBy,
+		"submitted_by":   participant.SubmittedBy,
+	}
+}
+
 func validateEditableState(participant *entity.Participant) error {
 	if !participant.CanBeEdited() {
 		return errors.ErrBadRequest(fmt.Sprintf("participant in %s status cannot be edited", participant.Status))
@@ -184,6 +274,7 @@ func (uc *usecase) buildFullParticipantResponse(ctx context.Context, participant
 		TenantID:        participant.TenantID,
 		ApplicationID:   participant.ApplicationID,
 		UserID:          participant.UserID,
+		BranchID:        participant.BranchID,
 		FullName:        participant.FullName,
 		Gender:          participant.Gender,
 		PlaceOfBirth:    participant.PlaceOfBirth,
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"iam-service/pkg/errors"
+	"iam-service/saving/participant/participantdto"
+
+	"github.com/google/uuid"
+)
+
+func (uc *usecase) LinkUser(ctx context.Context, req *participantdto.LinkUserRequest) (*participantdto.ParticipantResponse, error) {
+	var result *participantdto.ParticipantResponse
+
+	err := uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		participant, err := uc.participantRepo.GetByID(txCtx, req.ParticipantID)
+		if err != nil {
+			return fmt.Errorf("get participant: %w", err)
+		}
+
+		if err := validateParticipantOwnership(participant, req.TenantID); err != nil {
+			return err
+		}
+
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:link", req.Subject); err != nil {
+			return err
+		}
+
+		if !participant.IsApproved() {
+			return errors.ErrBadRequest(fmt.Sprintf("participant in %s status cannot be linked", participant.Status))
+		}
+
+		if participant.UserID != nil {
+			if *participant.UserID != req.LinkedUserID {
+				return errors.ErrConflict("participant is already linked to another user")
+			}
+		} else {
+			if err := uc.validateTenantMember(txCtx, req.LinkedUserID, req.TenantID); err != nil {
+				return err
+			}
+
+			existing, err := uc.participantRepo.GetByUserID(txCtx, req.TenantID, participant.ApplicationID, req.LinkedUserID)
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("get linked participant: %w", err)
+			}
+			if existing != nil {
+				return errors.ErrConflict("user is already linked to another participant")
+			}
+
+			participant.UserID = &req.LinkedUserID
+			if err := uc.participantRepo.Update(txCtx, participant); err != nil {
+				return fmt.Errorf("update participant: %w", err)
+			}
+		}
+
+		resp, err := uc.buildFullParticipantResponse(txCtx, participant)
+		if err != nil {
+			return fmt.Errorf("build response: %w", err)
+		}
+		result = resp
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (uc *usecase) UnlinkUser(ctx context.Context, req *participantdto.UnlinkUserRequest) (*participantdto.ParticipantResponse, error) {
+	var result *participantdto.ParticipantResponse
+
+	err := uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
+		participant, err := uc.participantRepo.GetByID(txCtx, req.ParticipantID)
+		if err != nil {
+			return fmt.Errorf("get participant: %w", err)
+		}
+
+		if err := validateParticipantOwnership(participant, req.TenantID); err != nil {
+			return err
+		}
+
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:link", req.Subject); err != nil {
+			return err
+		}
+
+		if participant.UserID == nil {
+			return errors.ErrBadRequest("participant is not linked to a user")
+		}
+
+		participant.UserID = nil
+		if err := uc.participantRepo.Update(txCtx, participant); err != nil {
+			return fmt.Errorf("update participant: %w", err)
+		}
+
+		resp, err := uc.buildFullParticipantResponse(txCtx, participant)
+		if err != nil {
+			return fmt.Errorf("build response: %w", err)
+		}
+		result = resp
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validateTenantMember ensures only users with an active registration in the
+// tenant can be linked to its participants.
+func (uc *usecase) validateTenantMember(ctx context.Context, userID, tenantID uuid.UUID) error {
+	registrations, err := uc.userTenantRegRepo.ListActiveByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list user registrations: %w", err)
+	}
+	for _, registration := range registrations {
+		if registration.TenantID == tenantID {
+			return nil
+		}
+	}
+	return errors.ErrBadRequest("user is not an active member of this tenant")
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"iam-service/entity"
+	"iam-service/pkg/errors"
+	"iam-service/saving/participant/participantdto"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_LinkUser(t *testing.T) {
+	tenantID := uuid.New()
+	applicationID := uuid.New()
+	adminID := uuid.New()
+	linkedUserID := uuid.New()
+
+	unlinkedParticipant := func(status entity.ParticipantStatus) *entity.Participant {
+		participant := createMockParticipant(status, tenantID, applicationID, adminID)
+		participant.UserID = nil
+		return participant
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*MockParticipantRepository, *MockUserTenantRegistrationRepository)
+		wantErr bool
+		errKind errors.Kind
+	}{
+		{
+			name: "success - links approved participant to tenant member",
+			setup: func(partRepo *MockParticipantRepository, regRepo *MockUserTenantRegistrationRepository) {
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(unlinkedParticipant(entity.ParticipantStatusApproved), nil)
+				regRepo.On("ListActiveByUserID", mock.Anything, linkedUserID).Return([]entity.UserTenantRegistration{{TenantID: tenantID}}, nil)
+				partRepo.On("GetByUserID", mock.Anything, tenantID, applicationID, linkedUserID).Return(nil, errors.ErrNotFound("participant not found"))
+				partRepo.On("Update", mock.Anything, mock.MatchedBy(func(p *entity.Participant) bool {
+					return p.UserID != nil && *p.UserID == linkedUserID
+				})).Return(nil)
+			},
+		},
+		{
+			name: "error - participant not approved",
+			setup: func(partRepo *MockParticipantRepository, regRepo *MockUserTenantRegistrationRepository) {
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(unlinkedParticipant(entity.ParticipantStatusDraft), nil)
+			},
+			wantErr: true,
+			errKind: errors.KindBadRequest,
+		},
+		{
+			name: "error - participant linked to another user",
+			setup: func(partRepo *MockParticipantRepository, regRepo *MockUserTenantRegistrationRepository) {
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(createMockParticipant(entity.ParticipantStatusApproved, tenantID, applicationID, adminID), nil)
+			},
+			wantErr: true,
+			errKind: errors.KindDuplicate,
+		},
+		{
+			name: "error - user is not a tenant member",
+			setup: func(partRepo *MockParticipantRepository, regRepo *MockUserTenantRegistrationRepository) {
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(unlinkedParticipant(entity.ParticipantStatusApproved), nil)
+				regRepo.On("ListActiveByUserID", mock.Anything, linkedUserID).Return([]entity.UserTenantRegistration{{TenantID: uuid.New()}}, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindBadRequest,
+		},
+		{
+			name: "error - user already linked to another participant",
+			setup: func(partRepo *MockParticipantRepository, regRepo *MockUserTenantRegistrationRepository) {
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(unlinkedParticipant(entity.ParticipantStatusApproved), nil)
+				regRepo.On("ListActiveByUserID", mock.Anything, linkedUserID).Return([]entity.UserTenantRegistration{{TenantID: tenantID}}, nil)
+				partRepo.On("GetByUserID", mock.Anything, tenantID, applicationID, linkedUserID).Return(createMockParticipant(entity.ParticipantStatusApproved, tenantID, applicationID, linkedUserID), nil)
+			},
+			wantErr: true,
+			errKind: errors.KindDuplicate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txMgr := new(MockTransactionManager)
+			partRepo := new(MockParticipantRepository)
+			identRepo := new(MockParticipantIdentityRepository)
+			addrRepo := new(MockParticipantAddressRepository)
+			bankRepo := new(MockParticipantBankAccountRepository)
+			famRepo := new(MockParticipantFamilyMemberRepository)
+			empRepo := new(MockParticipantEmploymentRepository)
+			benRepo := new(MockParticipantBeneficiaryRepository)
+			histRepo := new(MockParticipantStatusHistoryRepository)
+			fileStorage := new(MockFileStorageAdapter)
+			regRepo := new(MockUserTenantRegistrationRepository)
+
+			txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+			identRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantIdentity{}, nil).Maybe()
+			addrRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantAddress{}, nil).Maybe()
+			bankRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantBankAccount{}, nil).Maybe()
+			famRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantFamilyMember{}, nil).Maybe()
+			empRepo.On("GetByParticipantID", mock.Anything, mock.Anything).Return(nil, errors.ErrNotFound("not found")).Maybe()
+			benRepo.On("ListByParticipantID", mock.Anything, mock.Anything).Return([]*entity.ParticipantBeneficiary{}, nil).Maybe()
+			tt.setup(partRepo, regRepo)
+
+			uc := newTestUsecase(txMgr, partRepo, identRepo, addrRepo, bankRepo, famRepo, empRepo, benRepo, histRepo, fileStorage)
+			uc.userTenantRegRepo = regRepo
+
+			resp, err := uc.LinkUser(context.Background(), &participantdto.LinkUserRequest{
+				TenantID:      tenantID,
+				ParticipantID: uuid.New(),
+				UserID:        adminID,
+				LinkedUserID:  linkedUserID,
+			})
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, resp)
+				var appErr *errors.AppError
+				require.True(t, errors.As(err, &appErr))
+				assert.Equal(t, tt.errKind, appErr.Kind)
+				partRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, &linkedUserID, resp.UserID)
+			partRepo.AssertExpectations(t)
+		})
+	}
+}
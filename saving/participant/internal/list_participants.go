@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 
+	"iam-service/entity"
 	"iam-service/saving/participant/contract"
 	"iam-service/saving/participant/participantdto"
 )
@@ -21,6 +22,24 @@ func (uc *usecase) ListParticipants(ctx context.Context, req *participantdto.Lis
 		SortOrder:     req.SortOrder,
 	}
 
+	if req.Scope.IsRestricted() {
+		switch req.Scope.Level {
+		case entity.ScopeLevelBranch:
+			if len(req.Scope.BranchIDs) == 0 {
+				return &participantdto.ListParticipantsResponse{
+					Participants: []participantdto.ParticipantSummaryResponse{},
+					Pagination: participantdto.PaginationMeta{
+						Page:    req.Page,
+						PerPage: req.PerPage,
+					},
+				}, nil
+			}
+			filter.BranchIDs = req.Scope.BranchIDs
+		case entity.ScopeLevelSelf:
+			filter.OwnerID = &req.Scope.UserID
+		}
+	}
+
 	participants, total, err := uc.participantRepo.List(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("list participants: %w", err)
@@ -31,6 +50,7 @@ func (uc *usecase) ListParticipants(ctx context.Context, req *participantdto.Lis
 		summaries = append(summaries, participantdto.ParticipantSummaryResponse{
 			ID:             p.ID,
 			FullName:       p.FullName,
+			BranchID:       p.BranchID,
 			KTPNumber:      p.KTPNumber,
 			EmployeeNumber: p.EmployeeNumber,
 			PhoneNumber:    p.PhoneNumber,
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"iam-service/entity"
+	"iam-service/pkg/abac"
 	"iam-service/saving/participant/contract"
 
 	"github.com/google/uuid"
@@ -65,6 +66,27 @@ func (m *MockParticipantRepository) List(ctx context.Context, filter *contract.P
 	return args.Get(0).([]*entity.Participant), args.Get(1).(int64), args.Error(2)
 }
 
+func (m *MockParticipantRepository) GetByUserID(ctx context.Context, tenantID, applicationID, userID uuid.UUID) (*entity.Participant, error) {
+	args := m.Called(ctx, tenantID, applicationID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*entity.Participant), args.Error(1)
+}
+
+func (m *MockParticipantRepository) ListApprovedByIdentificationNumber(ctx context.Context, tenantID uuid.UUID, applicationID *uuid.UUID, identificationNumber string) ([]entity.Participant, error) {
+	args := m.Called(ctx, tenantID, applicationID, identificationNumber)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Participant), args.Error(1)
+}
+
+func (m *MockParticipantRepository) LinkUser(ctx context.Context, participantID, userID uuid.UUID) error {
+	args := m.Called(ctx, participantID, userID)
+	return args.Error(0)
+}
+
 // MockParticipantIdentityRepository mocks the identity repository
 type MockParticipantIdentityRepository struct {
 	mock.Mock
@@ -319,7 +341,38 @@ func (m *MockFileStorageAdapter) DeleteFile(ctx context.Context, bucket, objectK
 	return args.Error(0)
 }
 
+func (m *MockFileStorageAdapter) DeletePrefix(ctx context.Context, bucket, prefix string) error {
+	args := m.Called(ctx, bucket, prefix)
+	return args.Error(0)
+}
+
 func (m *MockFileStorageAdapter) GetPresignedURL(ctx context.Context, bucket, objectKey string, expiry time.Duration) (string, error) {
 	args := m.Called(ctx, bucket, objectKey, expiry)
 	return args.String(0), args.Error(1)
 }
+
+// MockUserTenantRegistrationRepository mocks the user tenant registration repository
+type MockUserTenantRegistrationRepository struct {
+	mock.Mock
+}
+
+func (m *MockUserTenantRegistrationRepository) ListActiveByUserID(ctx context.Context, userID uuid.UUID) ([]entity.UserTenantRegistration, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserTenantRegistration), args.Error(1)
+}
+
+// conditionPolicyAuthorizer evaluates a single policy condition in place of
+// the policy engine. Without a condition every request is allowed.
+type conditionPolicyAuthorizer struct {
+	condition *abac.Condition
+}
+
+func (a *conditionPolicyAuthorizer) Authorize(ctx context.Context, tenantID uuid.UUID, permissionCode, resourceType string, input abac.Input) (*abac.Decision, error) {
+	if a.condition == nil || a.condition.Evaluate(input) {
+		return &abac.Decision{Allowed: true}, nil
+	}
+	return &abac.Decision{Allowed: false}, nil
+}
@@ -25,10 +25,18 @@ func (uc *usecase) RejectParticipant(ctx context.Context, req *participantdto.Re
 			return err
 		}
 
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
 		if !participant.CanBeRejected() {
 			return errors.ErrBadRequest(fmt.Sprintf("participant in %s status cannot be rejected", participant.Status))
 		}
 
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:reject", req.Subject); err != nil {
+			return err
+		}
+
 		now := time.Now()
 		fromStatus := string(participant.Status)
 
@@ -25,6 +25,14 @@ func (uc *usecase) SaveBankAccount(ctx context.Context, req *participantdto.Save
 			return err
 		}
 
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:update", req.Subject); err != nil {
+			return err
+		}
+
 		if err := validateEditableState(participant); err != nil {
 			return err
 		}
@@ -25,10 +25,18 @@ func (uc *usecase) SubmitParticipant(ctx context.Context, req *participantdto.Su
 			return err
 		}
 
+		if err := validateParticipantScope(participant, req.Scope); err != nil {
+			return err
+		}
+
 		if !participant.CanBeSubmitted() {
 			return errors.ErrBadRequest(fmt.Sprintf("participant in %s status cannot be submitted", participant.Status))
 		}
 
+		if err := uc.authorizeParticipant(txCtx, participant, "participant:submit", req.Subject); err != nil {
+			return err
+		}
+
 		now := time.Now()
 		fromStatus := string(participant.Status)
 
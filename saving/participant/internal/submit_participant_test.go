@@ -104,6 +104,22 @@ func TestUsecase_SubmitParticipant(t *testing.T) {
 			wantErr: true,
 			errKind: errors.KindForbidden,
 		},
+		{
+			name: "error - participant created by someone else under self scope",
+			req: &participantdto.SubmitParticipantRequest{
+				ParticipantID: uuid.New(),
+				TenantID:      tenantID,
+				UserID:        userID,
+				Scope:         &entity.AccessScope{Level: entity.ScopeLevelSelf, UserID: uuid.New()},
+			},
+			setup: func(txMgr *MockTransactionManager, partRepo *MockParticipantRepository, histRepo *MockParticipantStatusHistoryRepository, identRepo *MockParticipantIdentityRepository, addrRepo *MockParticipantAddressRepository, bankRepo *MockParticipantBankAccountRepository, famRepo *MockParticipantFamilyMemberRepository, empRepo *MockParticipantEmploymentRepository, benRepo *MockParticipantBeneficiaryRepository) {
+				txMgr.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+				participant := createMockParticipant(entity.ParticipantStatusDraft, tenantID, applicationID, userID)
+				partRepo.On("GetByID", mock.Anything, mock.Anything).Return(participant, nil)
+			},
+			wantErr: true,
+			errKind: errors.KindForbidden,
+		},
 		{
 			name: "error - cannot submit PENDING_APPROVAL participant",
 			req: &participantdto.SubmitParticipantRequest{
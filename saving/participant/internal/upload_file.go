@@ -19,6 +19,14 @@ func (uc *usecase) UploadFile(ctx context.Context, req *participantdto.UploadFil
 		return nil, err
 	}
 
+	if err := validateParticipantScope(participant, req.Scope); err != nil {
+		return nil, err
+	}
+
+	if err := uc.authorizeParticipant(ctx, participant, "participant:update", req.Subject); err != nil {
+		return nil, err
+	}
+
 	if err := validateEditableState(participant); err != nil {
 		return nil, err
 	}
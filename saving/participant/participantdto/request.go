@@ -3,14 +3,19 @@ package participantdto
 import (
 	"time"
 
+	"iam-service/entity"
+	"iam-service/pkg/abac"
+
 	"github.com/google/uuid"
 )
 
 type CreateParticipantRequest struct {
-	TenantID      uuid.UUID `json:"-"`
-	ApplicationID uuid.UUID `json:"-"`
-	UserID        uuid.UUID `json:"-"`
-	FullName      string    `json:"full_name" validate:"required,min=2,max=255"`
+	TenantID      uuid.UUID           `json:"-"`
+	ApplicationID uuid.UUID           `json:"-"`
+	UserID        uuid.UUID           `json:"-"`
+	Scope         *entity.AccessScope `json:"-"`
+	FullName      string              `json:"full_name" validate:"required,min=2,max=255"`
+	BranchID      *uuid.UUID          `json:"branch_id,omitempty"`
 }
 
 type UpdatePersonalDataRequest struct {
@@ -27,6 +32,9 @@ type UpdatePersonalDataRequest struct {
 	KTPNumber     *string    `json:"ktp_number,omitempty" validate:"omitempty,len=16,numeric"`
 	EmployeeNumber *string   `json:"employee_number,omitempty" validate:"omitempty,max=50"`
 	PhoneNumber   *string    `json:"phone_number,omitempty" validate:"omitempty,max=20"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveIdentityRequest struct {
@@ -39,6 +47,9 @@ type SaveIdentityRequest struct {
 	IssueDate         *time.Time `json:"issue_date,omitempty"`
 	ExpiryDate        *time.Time `json:"expiry_date,omitempty"`
 	PhotoFilePath     *string    `json:"photo_file_path,omitempty" validate:"omitempty,max=500"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveAddressRequest struct {
@@ -56,6 +67,9 @@ type SaveAddressRequest struct {
 	RW              *string    `json:"rw,omitempty" validate:"omitempty,max=5"`
 	AddressLine     *string    `json:"address_line,omitempty" validate:"omitempty,max=500"`
 	IsPrimary       bool       `json:"is_primary"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveBankAccountRequest struct {
@@ -70,6 +84,9 @@ type SaveBankAccountRequest struct {
 	IsPrimary         bool       `json:"is_primary"`
 	IssueDate         *time.Time `json:"issue_date,omitempty"`
 	ExpiryDate        *time.Time `json:"expiry_date,omitempty"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveFamilyMemberRequest struct {
@@ -80,6 +97,9 @@ type SaveFamilyMemberRequest struct {
 	RelationshipType      string     `json:"relationship_type" validate:"required,max=50"`
 	IsDependent           bool       `json:"is_dependent"`
 	SupportingDocFilePath *string    `json:"supporting_doc_file_path,omitempty" validate:"omitempty,max=500"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveEmploymentRequest struct {
@@ -102,6 +122,9 @@ type SaveEmploymentRequest struct {
 	SubLocationName    *string    `json:"sub_location_name,omitempty" validate:"omitempty,max=255"`
 	RetirementDate     *time.Time `json:"retirement_date,omitempty"`
 	RetirementTypeCode *string    `json:"retirement_type_code,omitempty" validate:"omitempty,max=50"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SaveBeneficiaryRequest struct {
@@ -113,24 +136,55 @@ type SaveBeneficiaryRequest struct {
 	FamilyCardPhotoFilePath *string    `json:"family_card_photo_file_path,omitempty" validate:"omitempty,max=500"`
 	BankBookPhotoFilePath   *string    `json:"bank_book_photo_file_path,omitempty" validate:"omitempty,max=500"`
 	AccountNumber           *string    `json:"account_number,omitempty" validate:"omitempty,max=50"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type UploadFileRequest struct {
 	TenantID      uuid.UUID `json:"-"`
 	ParticipantID uuid.UUID `json:"-"`
 	FieldName     string    `json:"-"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type SubmitParticipantRequest struct {
 	TenantID      uuid.UUID `json:"-"`
 	ParticipantID uuid.UUID `json:"-"`
 	UserID        uuid.UUID `json:"-"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type ApproveParticipantRequest struct {
 	TenantID      uuid.UUID `json:"-"`
 	ParticipantID uuid.UUID `json:"-"`
 	UserID        uuid.UUID `json:"-"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
+}
+
+type LinkUserRequest struct {
+	TenantID      uuid.UUID `json:"-"`
+	ParticipantID uuid.UUID `json:"-"`
+	UserID        uuid.UUID `json:"-"`
+	LinkedUserID  uuid.UUID `json:"user_id" validate:"required"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
+}
+
+type UnlinkUserRequest struct {
+	TenantID      uuid.UUID `json:"-"`
+	ParticipantID uuid.UUID `json:"-"`
+	UserID        uuid.UUID `json:"-"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type RejectParticipantRequest struct {
@@ -138,6 +192,9 @@ type RejectParticipantRequest struct {
 	ParticipantID uuid.UUID `json:"-"`
 	UserID        uuid.UUID `json:"-"`
 	Reason        string    `json:"reason" validate:"required,min=10,max=500"`
+
+	Scope   *entity.AccessScope `json:"-"`
+	Subject abac.Attributes     `json:"-"`
 }
 
 type ListParticipantsRequest struct {
@@ -149,4 +206,6 @@ type ListParticipantsRequest struct {
 	PerPage       int        `json:"per_page" validate:"min=1,max=100"`
 	SortBy        string     `json:"sort_by,omitempty" validate:"omitempty,oneof=created_at updated_at full_name status"`
 	SortOrder     string     `json:"sort_order,omitempty" validate:"omitempty,oneof=asc desc"`
+
+	Scope *entity.AccessScope `json:"-"`
 }